@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/importer"
 	"github.com/R-a-dio/valkyrie/storage"
 	"github.com/R-a-dio/valkyrie/streamer/audio"
 	"github.com/spf13/cobra"
@@ -30,6 +32,7 @@ func DatabaseCommand() *cobra.Command {
 				return []string{"flac", "mp3", "opus"}, cobra.ShellCompDirectiveFilterFileExt | cobra.ShellCompDirectiveNoFileComp
 			},
 		},
+		DatabaseImportCommand(),
 		&cobra.Command{
 			Use:   "add-user <username> <password>",
 			Short: "add a user to the database",
@@ -119,6 +122,83 @@ func addSingleTrack(ctx context.Context, cmd *cobra.Command, db radio.StorageSer
 	return nil
 }
 
+const (
+	flagImportDryRun        = "dry-run"
+	flagImportBatchSize     = "batch-size"
+	flagImportState         = "state"
+	flagImportNoFingerprint = "no-fingerprint"
+	flagImportTags          = "tags"
+	flagImportSummary       = "summary"
+)
+
+func DatabaseImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <directory>...",
+		Short: "import directories of music files into the database",
+		Long: `import walks the directories given and adds all music files found to the database,
+files are deduplicated by metadata and acoustic fingerprint and copied into the music
+directory. A JSON summary of what happened to each file is written when done.`,
+		RunE: SimpleCommand(DatabaseImport),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().Bool(flagImportDryRun, false, "report what would be imported without changing anything")
+	cmd.Flags().Int(flagImportBatchSize, importer.DefaultBatchSize, "amount of tracks to insert per transaction")
+	cmd.Flags().String(flagImportState, "", "file to record progress in, an interrupted import can be resumed by passing the same file")
+	cmd.Flags().Bool(flagImportNoFingerprint, false, "disable deduplication by acoustic fingerprint")
+	cmd.Flags().String(flagImportTags, "", "tags to give all imported tracks")
+	cmd.Flags().String(flagImportSummary, "-", "file to write the JSON summary to, - for stdout")
+	return cmd
+}
+
+func DatabaseImport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	cfg := cfgFromContext(ctx)
+	db, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	dryRun, _ := flags.GetBool(flagImportDryRun)
+	batchSize, _ := flags.GetInt(flagImportBatchSize)
+	statePath, _ := flags.GetString(flagImportState)
+	noFingerprint, _ := flags.GetBool(flagImportNoFingerprint)
+	tags, _ := flags.GetString(flagImportTags)
+	summaryPath, _ := flags.GetString(flagImportSummary)
+
+	imp := importer.New(db, importer.Options{
+		MusicPath:   cfg.Conf().MusicPath,
+		DryRun:      dryRun,
+		BatchSize:   batchSize,
+		StatePath:   statePath,
+		Fingerprint: !noFingerprint,
+		Tags:        tags,
+		Acceptor:    "command-line-interface",
+	})
+
+	summary, runErr := imp.Run(ctx, args...)
+	if summary == nil {
+		return runErr
+	}
+
+	// write the summary even if we failed halfway through
+	out := cmd.OutOrStdout()
+	if summaryPath != "-" {
+		f, err := os.Create(summaryPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err = summary.WriteJSON(out); err != nil {
+		return err
+	}
+	return runErr
+}
+
 func DatabaseAddUser(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 	db, err := storage.Open(ctx, cfgFromContext(ctx))
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"github.com/R-a-dio/valkyrie/library"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagLibraryFixMissing = "fix-missing"
+	flagLibraryFixOrphans = "fix-orphans"
+	flagLibraryFixTags    = "fix-tags"
+	flagLibraryFixLength  = "fix-length"
+	flagLibraryFixHash    = "fix-hash"
+)
+
+func LibraryCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:     "library",
+		GroupID: "jobs",
+		Short:   "commands to check the music library",
+	}
+
+	scan := &cobra.Command{
+		Use:   "scan",
+		Short: "compare the music directory with the database and report any drift",
+		Long: `scan reports tracks with a missing file, files without a track, tracks with file tags
+that differ from the database, tracks with an unknown length and tracks with a stored hash
+that doesn't match their metadata. Nothing is changed unless
+the matching --fix-* flag is given. A JSON report is written to stdout when done.`,
+		RunE: SimpleCommand(LibraryScan),
+		Args: cobra.NoArgs,
+	}
+	scan.Flags().Bool(flagLibraryFixMissing, false, "mark tracks with a missing file as unusable and needing replacement")
+	scan.Flags().Bool(flagLibraryFixOrphans, false, "move files without a track into the '"+library.OrphanDir+"' directory")
+	scan.Flags().Bool(flagLibraryFixTags, false, "write the metadata from the database into the file tags")
+	scan.Flags().Bool(flagLibraryFixLength, false, "probe files for their length and store it")
+	scan.Flags().Bool(flagLibraryFixHash, false, "update stored hashes that differ from the hash of the metadata")
+
+	root.AddCommand(scan)
+	return root
+}
+
+func LibraryScan(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	cfg := cfgFromContext(ctx)
+	db, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var opts library.Options
+	flags := cmd.Flags()
+	opts.FixMissing, _ = flags.GetBool(flagLibraryFixMissing)
+	opts.FixOrphans, _ = flags.GetBool(flagLibraryFixOrphans)
+	opts.FixTags, _ = flags.GetBool(flagLibraryFixTags)
+	opts.FixLength, _ = flags.GetBool(flagLibraryFixLength)
+	opts.FixHash, _ = flags.GetBool(flagLibraryFixHash)
+
+	scanner := library.NewScanner(db, afero.NewOsFs(), cfg.Conf().MusicPath, opts)
+
+	report, err := scanner.Scan(ctx)
+	if report != nil {
+		if werr := report.WriteJSON(cmd.OutOrStdout()); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return err
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/ircbot"
 	"github.com/R-a-dio/valkyrie/jobs"
-	"github.com/R-a-dio/valkyrie/manager"
 	"github.com/R-a-dio/valkyrie/proxy"
 	"github.com/R-a-dio/valkyrie/search/bleve"
 	"github.com/R-a-dio/valkyrie/streamer"
@@ -91,13 +90,7 @@ func main() {
 
 	// service commands
 	root.AddCommand(
-		&cobra.Command{
-			Use:     "manager",
-			GroupID: "services",
-			Short:   "run the manager, inter-process state management",
-			Args:    cobra.NoArgs,
-			RunE:    Command(manager.Execute),
-		},
+		ManagerCommand(),
 		&cobra.Command{
 			Use:     "irc",
 			GroupID: "services",
@@ -186,12 +179,41 @@ func main() {
 			Args:    cobra.NoArgs,
 			RunE:    Command(jobs.ExecuteVerifier),
 		},
+		&cobra.Command{
+			Use:     "fingerprint",
+			GroupID: "jobs",
+			Short:   "creates acoustic fingerprints for tracks that are missing one",
+			Args:    cobra.NoArgs,
+			RunE:    Command(jobs.ExecuteFingerprint),
+		},
+		&cobra.Command{
+			Use:     "recommend",
+			GroupID: "jobs",
+			Short:   "recomputes track similarity from favorites and request history",
+			Args:    cobra.NoArgs,
+			RunE:    Command(jobs.ExecuteRecommend),
+		},
+		&cobra.Command{
+			Use:     "import-lyrics",
+			GroupID: "jobs",
+			Short:   "imports lyrics and notes from files next to the audio of tracks without any",
+			Args:    cobra.NoArgs,
+			RunE:    Command(jobs.ExecuteLyricsImport),
+		},
+		&cobra.Command{
+			Use:     "journal-prune",
+			GroupID: "jobs",
+			Short:   "removes manager journal entries older than the configured retention",
+			Args:    cobra.NoArgs,
+			RunE:    Command(jobs.ExecuteJournalPrune),
+		},
 	)
 
 	// subcommands
 	root.AddCommand(
 		DatabaseCommand(),
 		MigrationCommand(),
+		LibraryCommand(),
 	)
 
 	cmd, err := root.ExecuteC()
@@ -255,6 +277,24 @@ func LoadConfig(cmd *cobra.Command) (config.Config, error) {
 	return config.LoadFile(configFile, os.Getenv("HANYUU_CONFIG"))
 }
 
+// reloadConfig reloads the configuration stored in ctx from the same files
+// LoadConfig uses, if anything goes wrong the old configuration is kept
+func reloadConfig(ctx context.Context, cmd *cobra.Command) {
+	logger := zerolog.Ctx(ctx)
+	configFile, _ := cmd.Flags().GetString(flagConfig)
+
+	restart, err := cfgFromContext(ctx).Reload(configFile, os.Getenv("HANYUU_CONFIG"))
+	if err != nil {
+		logger.Error().Ctx(ctx).Err(err).Msg("failed to reload configuration, keeping the old one")
+		return
+	}
+
+	for _, name := range restart {
+		logger.Warn().Ctx(ctx).Str("field", name).Msg("configuration change requires a restart to take effect")
+	}
+	logger.Info().Ctx(ctx).Msg("configuration reloaded")
+}
+
 // executeCommand sets up the environment and executes the function given with it, it
 // handles OS signals, config loading, telemetry setup and systemd notifications
 func executeCommand(fn cobraFn) cobraFn {
@@ -269,6 +309,8 @@ func executeCommand(fn cobraFn) cobraFn {
 			return err
 		}
 		ctx = cfgWithContext(ctx, cfg)
+		// our identity towards other services is the command we're running
+		cfg.SetIdentity(constructServiceName(cmd))
 
 		// setup telemetry if this is wanted
 		if enable, _ := cmd.Flags().GetBool(flagTelemetry); enable || cfg.Conf().Telemetry.Use {
@@ -283,7 +325,7 @@ func executeCommand(fn cobraFn) cobraFn {
 		}
 
 		// run the OS signal handler
-		ctx = signalHandler(ctx)
+		ctx = signalHandler(ctx, cmd)
 
 		// add the updated ctx to the cmd
 		cmd.SetContext(ctx)
@@ -310,7 +352,7 @@ func executeCommand(fn cobraFn) cobraFn {
 	}
 }
 
-func signalHandler(ctx context.Context) context.Context {
+func signalHandler(ctx context.Context, cmd *cobra.Command) context.Context {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// put in a way for the commands to tell us to not react to USR2
@@ -334,11 +376,13 @@ func signalHandler(ctx context.Context) context.Context {
 					zerolog.Ctx(ctx).Info().Msg("SIGINT received")
 					return
 				case syscall.SIGHUP:
-					// reload the configuration file, NOT IMPLEMENTED
-					zerolog.Ctx(ctx).Info().Msg("SIGHUP received: not implemented")
-					// notify systemd that we reloaded correctly even though it isn't implemented,
-					// otherwise it will hang forever waiting for us to signal it
-					if fdstore.Notify(fdstore.Reloading) == nil {
+					// reload the configuration file
+					zerolog.Ctx(ctx).Info().Msg("SIGHUP received")
+					notified := fdstore.Notify(fdstore.Reloading) == nil
+					reloadConfig(ctx, cmd)
+					// notify systemd that we're done, even if the reload failed we're
+					// still running with the old configuration
+					if notified {
 						_ = fdstore.Notify(fdstore.Ready)
 					}
 				case syscall.SIGUSR2:
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/manager"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagReplayAt   = "at"
+	flagReplayFrom = "from"
+)
+
+func ManagerCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:     "manager",
+		GroupID: "services",
+		Short:   "run the manager, inter-process state management",
+		Args:    cobra.NoArgs,
+		RunE:    Command(manager.Execute),
+	}
+
+	replay := &cobra.Command{
+		Use:   "replay",
+		Short: "rebuild the manager status at a point in time from the journal",
+		Long: `replay rebuilds the status the manager had at the time given with --at from the
+journal of updates it received. If --from is given every update between --from and --at
+is shown with the status after it was applied. Times are in RFC3339 format, the result
+is written as JSON to stdout.`,
+		RunE: SimpleCommand(ManagerReplay),
+		Args: cobra.NoArgs,
+	}
+	replay.Flags().String(flagReplayAt, "", "time to rebuild the status at, defaults to now")
+	replay.Flags().String(flagReplayFrom, "", "time to start showing updates from")
+
+	root.AddCommand(replay)
+	return root
+}
+
+type replayStep struct {
+	ID     uint64
+	Kind   radio.JournalKind
+	Time   time.Time
+	Source string
+	Data   json.RawMessage
+	Status radio.Status
+}
+
+type replayResult struct {
+	At      time.Time
+	Status  radio.Status
+	Updates []replayStep `json:",omitempty"`
+}
+
+func ManagerReplay(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	cfg := cfgFromContext(ctx)
+
+	parse := func(name string, def time.Time) (time.Time, error) {
+		s, _ := cmd.Flags().GetString(name)
+		if s == "" {
+			return def, nil
+		}
+		return time.Parse(time.RFC3339, s)
+	}
+
+	at, err := parse(flagReplayAt, time.Now())
+	if err != nil {
+		return err
+	}
+	from, err := parse(flagReplayFrom, at)
+	if err != nil {
+		return err
+	}
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	status, steps, err := manager.Replay(ctx, store, from, at)
+	if err != nil {
+		return err
+	}
+
+	res := replayResult{
+		At:     at,
+		Status: status,
+	}
+	for _, step := range steps {
+		res.Updates = append(res.Updates, replayStep{
+			ID:     step.Entry.ID,
+			Kind:   step.Entry.Kind,
+			Time:   step.Entry.Time,
+			Source: step.Entry.Source,
+			Data:   step.Entry.Data,
+			Status: step.Status,
+		})
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "\t")
+	return enc.Encode(res)
+}
@@ -46,6 +46,44 @@ type config struct {
 
 	// tunein.com scrobbling configuration
 	Tunein tunein
+
+	// RPC configures authentication and encryption of the RPC APIs
+	RPC rpcSecurity
+}
+
+// rpcSecurity configures authentication of the RPC APIs, every service uses
+// its own credentials from Identities both when serving its RPC API and when
+// connecting to the RPC API of another service
+type rpcSecurity struct {
+	// CAFile is the certificate authority used to verify certificates
+	CAFile string
+	// Identities are the credentials of each service, by the name of the
+	// command that runs it (for example "website" or "listener-tracker")
+	Identities map[string]rpcIdentity
+	// Services configures each RPC API by the name of the section its RPCAddr
+	// is in, one of "manager", "streamer", "irc", "proxy" or "tracker"
+	Services map[string]rpcService
+}
+
+type rpcIdentity struct {
+	// CertFile and KeyFile are the certificate and key used for TLS, the common
+	// name of the certificate should be the name of the service
+	CertFile string
+	KeyFile  string
+	// Token is the shared secret sent to APIs that use token mode
+	Token string
+}
+
+type rpcService struct {
+	// Mode is how clients are authenticated: "insecure" to not authenticate at
+	// all, "token" for a shared-secret token or "mtls" for mutual TLS. Both
+	// "token" and "mtls" use TLS, so they need CAFile and a certificate for
+	// the service serving the API
+	Mode string
+	// Allow maps full method names, such as "/radio.Proxy/KickSource", to the
+	// identities allowed to call them, "*" allows any identity. This replaces
+	// the built-in rules, methods without any rule can't be called
+	Allow map[string][]string
 }
 
 type tracker struct {
@@ -158,6 +196,12 @@ type website struct {
 	// AdminMonitoringUserHeader is the header to use for passing in the username
 	AdminMonitoringUserHeader string
 	AdminMonitoringRoleHeader string
+
+	// TwoFactorPermissions is a list of permissions that require the user to
+	// have two-factor authentication enabled, users without it will not have
+	// these permissions until they enable it. Users with the dev permission
+	// lose it as well since it implies all others
+	TwoFactorPermissions []string
 }
 
 // streamer contains all the fields only relevant to the streamer
@@ -175,6 +219,17 @@ type streamer struct {
 	// ConnectTimeout is how long to wait before connecting if the
 	// proxy has no streamer. Set to 0 to disable
 	ConnectTimeout Duration
+	// QueueStrategy is how random songs are picked for the queue, either
+	// "random" or "similar" to prefer songs similar to the one before it
+	QueueStrategy string
+	// ModeratedRequests puts requests in a pool of pending requests that
+	// listeners can vote on instead of adding them to the queue directly
+	ModeratedRequests bool
+	// MonitorAddr is the address for the http monitor staff can use to listen
+	// to the streamer directly, use port 0 to disable. The monitor uses basic
+	// authentication over plain http, so only bind it to a trusted network or
+	// put it behind a proxy that adds TLS. Disabled by default
+	MonitorAddr AddrPort
 }
 
 // irc contains all the fields only relevant to the irc bot
@@ -194,6 +249,9 @@ type irc struct {
 	Channels []string
 	// MainChannel is the channel for announceing songs
 	MainChannel string
+	// AuditChannel is the channel administrative actions are announced in,
+	// leave empty to disable these announcements
+	AuditChannel string
 	// AllowFlood determines if flood protection is off or on
 	AllowFlood bool
 	// EnableEcho allows you to enable/disable IRC messages output
@@ -213,6 +271,19 @@ type manager struct {
 	GuestProxyAddr URL
 	// GuestAuthPeriod is how long a guest will be authorized to do things as a guest
 	GuestAuthPeriod Duration
+	// Replicas are the RPC addresses of the other manager replicas, if any are
+	// configured the replicas elect a leader between them and clients fail
+	// over to them when RPCAddr is unreachable
+	Replicas []AddrPort
+	// AdvertiseAddr is the address other replicas can reach this one on, the
+	// RPCAddr is used if it's empty
+	AdvertiseAddr AddrPort
+	// LeaseDuration is how long a leader stays leader without renewing its
+	// lease, a replica takes over at most this long after the leader is gone
+	LeaseDuration Duration
+	// JournalRetention is how long entries are kept in the journal, older
+	// entries are removed by the journal-prune job
+	JournalRetention Duration
 }
 
 type tunein struct {
@@ -260,6 +331,8 @@ func (e errors) Error() string {
 // Config is a type-safe wrapper around the config type
 type Config struct {
 	config *atomic.Value
+	// identity is the name of the service we're running as
+	identity *atomic.Pointer[string]
 
 	reloader *reload
 
@@ -282,6 +355,7 @@ type reload struct {
 func newConfig(c config) Config {
 	cfg := Config{
 		config:   new(atomic.Value),
+		identity: new(atomic.Pointer[string]),
 		reloader: new(reload),
 	}
 
@@ -3,7 +3,10 @@ package config
 import (
 	"bytes"
 	"net/netip"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -93,3 +96,65 @@ func TestAddrPort(t *testing.T) {
 		}
 	})
 }
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "hanyuu.toml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0600))
+	}
+
+	write(`
+userrequestdelay = "1h"
+[irc]
+channels = ["#a"]
+`)
+	cfg, err := LoadFile(filename)
+	require.NoError(t, err)
+
+	var reloads int
+	cfg.OnReload(func() { reloads++ })
+	delay := Value(cfg, func(cfg Config) time.Duration {
+		return time.Duration(cfg.Conf().UserRequestDelay)
+	})
+	require.Equal(t, time.Hour, delay())
+
+	t.Run("valid", func(t *testing.T) {
+		write(`
+userrequestdelay = "2h"
+[irc]
+channels = ["#a", "#b"]
+[website]
+websiteaddr = "localhost:9999"
+`)
+		restart, err := cfg.Reload(filename)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Website.WebsiteAddr"}, restart)
+		assert.Equal(t, 1, reloads)
+		assert.Equal(t, time.Hour*2, delay())
+		assert.Equal(t, []string{"#a", "#b"}, cfg.Conf().IRC.Channels)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		write(`
+userrequestdelay = "-1h"
+[irc]
+channels = ["nothash"]
+`)
+		_, err := cfg.Reload(filename)
+		var verr ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Len(t, verr, 2)
+
+		// nothing should have changed
+		assert.Equal(t, 1, reloads)
+		assert.Equal(t, time.Hour*2, delay())
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := cfg.Reload(filepath.Join(dir, "missing.toml"))
+		require.Error(t, err)
+		assert.Equal(t, 1, reloads)
+	})
+}
@@ -33,6 +33,8 @@ var defaultConfig = config{
 		StreamURL:       "http://127.0.0.1:1337/main.mp3",
 		RequestsEnabled: true,
 		ConnectTimeout:  Duration(time.Second * 30),
+		QueueStrategy:   "random",
+		MonitorAddr:     MustParseAddrPort("localhost:0"),
 	},
 	IRC: irc{
 		RPCAddr:        MustParseAddrPort(":4444"),
@@ -41,10 +43,12 @@ var defaultConfig = config{
 		AnnouncePeriod: Duration(time.Second * 15),
 	},
 	Manager: manager{
-		RPCAddr:         MustParseAddrPort(":4646"),
-		FallbackNames:   []string{"fallback"},
-		GuestProxyAddr:  "//localhost:9123",
-		GuestAuthPeriod: Duration(time.Hour * 24),
+		RPCAddr:          MustParseAddrPort(":4646"),
+		FallbackNames:    []string{"fallback"},
+		GuestProxyAddr:   "//localhost:9123",
+		GuestAuthPeriod:  Duration(time.Hour * 24),
+		LeaseDuration:    Duration(time.Second * 10),
+		JournalRetention: Duration(time.Hour * 24 * 30),
 	},
 	Search: search{
 		Endpoint:  "http://127.0.0.1:9200/",
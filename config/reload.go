@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidationError is returned when a configuration is invalid, it contains a
+// description of each problem found
+type ValidationError []string
+
+func (e ValidationError) Error() string {
+	return "config: invalid configuration: " + strings.Join(e, "; ")
+}
+
+// validate checks the configuration for values that can't be right
+func (c config) validate() error {
+	var problems ValidationError
+	invalid := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if c.Providers.Storage == "" {
+		invalid("Providers.Storage is empty")
+	}
+	if c.Providers.Search == "" {
+		invalid("Providers.Search is empty")
+	}
+	if c.UserRequestDelay < 0 {
+		invalid("UserRequestDelay is negative")
+	}
+	if c.UserUploadDelay < 0 {
+		invalid("UserUploadDelay is negative")
+	}
+	if c.Proxy.KickTimeoutDuration < 0 {
+		invalid("Proxy.KickTimeoutDuration is negative")
+	}
+	for _, channel := range c.IRC.Channels {
+		if !strings.HasPrefix(channel, "#") && !strings.HasPrefix(channel, "&") {
+			invalid("IRC.Channels contains invalid channel %q", channel)
+		}
+	}
+	switch c.Streamer.QueueStrategy {
+	case "", "random", "similar":
+	default:
+		invalid("Streamer.QueueStrategy is unknown: %q", c.Streamer.QueueStrategy)
+	}
+	for name, service := range c.RPC.Services {
+		switch service.Mode {
+		case "", "insecure", "token", "mtls":
+		default:
+			invalid("RPC.Services.%s.Mode is unknown: %q", name, service.Mode)
+		}
+	}
+	if len(c.Manager.Replicas) > 0 && c.Manager.LeaseDuration <= 0 {
+		invalid("Manager.LeaseDuration is not positive while Manager.Replicas is set")
+	}
+	if c.Tunein.Enabled && c.Tunein.Endpoint == "" {
+		invalid("Tunein.Endpoint is empty while Tunein is enabled")
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// restartFields are the fields that are only used when a service starts, a
+// change to any of these won't be applied until the service is restarted
+var restartFields = []struct {
+	name string
+	get  func(config) any
+}{
+	{"Providers", func(c config) any { return c.Providers }},
+	{"Database", func(c config) any { return c.Database }},
+	{"TemplatePath", func(c config) any { return c.TemplatePath }},
+	{"AssetsPath", func(c config) any { return c.AssetsPath }},
+	{"Website.WebsiteAddr", func(c config) any { return c.Website.WebsiteAddr }},
+	{"Website.CSRFSecret", func(c config) any { return c.Website.CSRFSecret }},
+	{"Streamer.RPCAddr", func(c config) any { return c.Streamer.RPCAddr }},
+	{"IRC.RPCAddr", func(c config) any { return c.IRC.RPCAddr }},
+	{"IRC.Server", func(c config) any { return c.IRC.Server }},
+	{"IRC.BindAddr", func(c config) any { return c.IRC.BindAddr }},
+	{"IRC.AllowFlood", func(c config) any { return c.IRC.AllowFlood }},
+	{"IRC.EnableEcho", func(c config) any { return c.IRC.EnableEcho }},
+	{"Manager.RPCAddr", func(c config) any { return c.Manager.RPCAddr }},
+	{"Manager.AdvertiseAddr", func(c config) any { return c.Manager.AdvertiseAddr }},
+	{"Manager.LeaseDuration", func(c config) any { return c.Manager.LeaseDuration }},
+	{"Search", func(c config) any { return c.Search }},
+	{"Proxy.RPCAddr", func(c config) any { return c.Proxy.RPCAddr }},
+	{"Proxy.ListenAddr", func(c config) any { return c.Proxy.ListenAddr }},
+	{"Tracker.RPCAddr", func(c config) any { return c.Tracker.RPCAddr }},
+	{"Tracker.ListenAddr", func(c config) any { return c.Tracker.ListenAddr }},
+	{"Telemetry", func(c config) any { return c.Telemetry }},
+	{"RPC", func(c config) any { return c.RPC }},
+}
+
+// restartRequired returns the names of the fields that differ between old and
+// new and require a restart to be applied
+func restartRequired(old, new config) []string {
+	var res []string
+	for _, field := range restartFields {
+		if !reflect.DeepEqual(field.get(old), field.get(new)) {
+			res = append(res, field.name)
+		}
+	}
+	return res
+}
+
+// Reload loads the configuration from the first of filenames that exists and
+// validates it, if it's valid it replaces the current configuration and the
+// reload callbacks are called. It returns the names of the fields that
+// changed but require a restart to take effect.
+//
+// The current configuration is left untouched if an error is returned
+func (c Config) Reload(filenames ...string) ([]string, error) {
+	loaded, err := LoadFile(filenames...)
+	if err != nil {
+		return nil, err
+	}
+
+	new := loaded.Conf()
+	if err := new.validate(); err != nil {
+		return nil, err
+	}
+
+	restart := restartRequired(c.Conf(), new)
+
+	c.StoreConf(new)
+	c.TriggerReload()
+	return restart, nil
+}
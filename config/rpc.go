@@ -2,6 +2,10 @@ package config
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/rpc"
@@ -9,9 +13,97 @@ import (
 	"google.golang.org/grpc"
 )
 
-func prepareService[T any](cfg Config, creator func(*grpc.ClientConn) T, addrFn func() string) T {
+// Names of the RPC APIs as used in the RPC configuration
+const (
+	RPCManager  = "manager"
+	RPCStreamer = "streamer"
+	RPCIRC      = "irc"
+	RPCProxy    = "proxy"
+	RPCTracker  = "tracker"
+)
+
+// SetIdentity sets the name of the service we're running as, this picks the
+// credentials used for the RPC APIs
+func (c Config) SetIdentity(name string) {
+	c.identity.Store(&name)
+}
+
+// Identity returns the name set with SetIdentity
+func (c Config) Identity() string {
+	if name := c.identity.Load(); name != nil {
+		return *name
+	}
+	return ""
+}
+
+// RPCSecurity returns the security settings used to serve or connect to the
+// RPC API of service
+func (c Config) RPCSecurity(service string) (rpc.Security, error) {
+	conf := c.Conf().RPC
+	identity := c.Identity()
+	svc := conf.Services[service]
+
+	sec := rpc.Security{
+		Mode:     rpc.SecurityMode(svc.Mode),
+		Identity: identity,
+		Allow:    svc.Allow,
+	}
+	if sec.Mode == rpc.SecurityInsecure || sec.Mode == "" {
+		return sec, nil
+	}
+
+	// tokens are only ever sent over TLS, so we need a CA to verify the
+	// server with
+	if sec.Mode == rpc.SecurityToken && conf.CAFile == "" {
+		return sec, fmt.Errorf("config: RPC token mode for %q requires RPC.CAFile", service)
+	}
+
+	own := conf.Identities[identity]
+	sec.Token = own.Token
+	sec.Tokens = make(map[string]string, len(conf.Identities))
+	for name, id := range conf.Identities {
+		sec.Tokens[name] = id.Token
+	}
+
+	if conf.CAFile != "" {
+		pem, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return sec, fmt.Errorf("config: failed to read RPC.CAFile: %w", err)
+		}
+		sec.CA = x509.NewCertPool()
+		if !sec.CA.AppendCertsFromPEM(pem) {
+			return sec, fmt.Errorf("config: no certificates found in RPC.CAFile")
+		}
+	}
+
+	if own.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(own.CertFile, own.KeyFile)
+		if err != nil {
+			return sec, fmt.Errorf("config: failed to load certificate of %q: %w", identity, err)
+		}
+		sec.Certificate = &cert
+	}
+	return sec, nil
+}
+
+// RPCServerOptions returns the options for a grpc server serving the RPC API
+// of service
+func (c Config) RPCServerOptions(service string) ([]grpc.ServerOption, error) {
+	sec, err := c.RPCSecurity(service)
+	if err != nil {
+		return nil, err
+	}
+	return sec.ServerOptions()
+}
+
+func prepareService[T any](cfg Config, service string, creator func(*grpc.ClientConn) T, addrFn func() string) T {
+	return creator(prepareConn(cfg, service, addrFn))
+}
+
+func prepareConn(cfg Config, service string, addrFn func() string) *grpc.ClientConn {
 	addr := addrFn()
-	conn := rpc.PrepareConn(addr)
+
+	conn := cfg.DialRPC(service, addr)
 
 	cfg.OnReload(func() {
 		// see if the address changed, if it didn't we don't have to close
@@ -21,7 +113,20 @@ func prepareService[T any](cfg Config, creator func(*grpc.ClientConn) T, addrFn
 		}
 	})
 
-	return creator(conn)
+	return conn
+}
+
+// DialRPC prepares a connection to the RPC API of service at addr
+func (c Config) DialRPC(service string, addr string) *grpc.ClientConn {
+	sec, err := c.RPCSecurity(service)
+	if err != nil {
+		panic("failed to setup rpc security: " + err.Error())
+	}
+	opts, err := sec.DialOptions(addr)
+	if err != nil {
+		panic("failed to setup rpc security: " + err.Error())
+	}
+	return rpc.PrepareConn(addr, opts...)
 }
 
 func newGuestService(cfg Config) radio.GuestService {
@@ -31,7 +136,7 @@ func newGuestService(cfg Config) radio.GuestService {
 
 	return &guestService{
 		Value(cfg, func(cfg Config) radio.GuestService {
-			return prepareService(cfg, rpc.NewGuestService, addrFn)
+			return prepareService(cfg, RPCManager, rpc.NewGuestService, addrFn)
 		}),
 	}
 }
@@ -60,6 +165,10 @@ func (g *guestService) Do(ctx context.Context, nick string, action radio.GuestAc
 	return g.fn().Do(ctx, nick, action)
 }
 
+func (g *guestService) Redeem(ctx context.Context, code string, nick string) (*radio.User, error) {
+	return g.fn().Redeem(ctx, code, nick)
+}
+
 var _ radio.GuestService = &guestService{}
 
 func newManagerService(cfg Config) radio.ManagerService {
@@ -68,7 +177,19 @@ func newManagerService(cfg Config) radio.ManagerService {
 	})
 	return &managerService{
 		Value(cfg, func(cfg Config) radio.ManagerService {
-			return prepareService(cfg, rpc.NewManagerService, addrFn)
+			var replicas []*grpc.ClientConn
+			for i := range cfg.Conf().Manager.Replicas {
+				replicas = append(replicas, prepareConn(cfg, RPCManager, func() string {
+					if replicas := cfg.Conf().Manager.Replicas; i < len(replicas) {
+						return replicas[i].String()
+					}
+					return ""
+				}))
+			}
+
+			return prepareService(cfg, RPCManager, func(conn *grpc.ClientConn) radio.ManagerService {
+				return rpc.NewManagerService(conn, replicas...)
+			}, addrFn)
 		}),
 	}
 }
@@ -134,7 +255,7 @@ func newProxyService(cfg Config) radio.ProxyService {
 	})
 	return &proxyService{
 		Value(cfg, func(cfg Config) radio.ProxyService {
-			return prepareService(cfg, rpc.NewProxyService, addrFn)
+			return prepareService(cfg, RPCProxy, rpc.NewProxyService, addrFn)
 		}),
 	}
 }
@@ -163,13 +284,25 @@ func (p *proxyService) ListSources(ctx context.Context) ([]radio.ProxySource, er
 	return p.fn().ListSources(ctx)
 }
 
+func (p *proxyService) PlanHandover(ctx context.Context, h radio.Handover) error {
+	return p.fn().PlanHandover(ctx, h)
+}
+
+func (p *proxyService) CancelHandover(ctx context.Context) error {
+	return p.fn().CancelHandover(ctx)
+}
+
+func (p *proxyService) HandoverStream(ctx context.Context) (eventstream.Stream[radio.Handover], error) {
+	return p.fn().HandoverStream(ctx)
+}
+
 func newStreamerService(cfg Config) radio.StreamerService {
 	addrFn := Value(cfg, func(cfg Config) string {
 		return cfg.Conf().Streamer.RPCAddr.String()
 	})
 	return &streamerService{
 		Value(cfg, func(cfg Config) radio.StreamerService {
-			return prepareService(cfg, rpc.NewStreamerService, addrFn)
+			return prepareService(cfg, RPCStreamer, rpc.NewStreamerService, addrFn)
 		}),
 	}
 }
@@ -204,7 +337,7 @@ func newQueueService(cfg Config) radio.QueueService {
 	})
 	return &queueService{
 		Value(cfg, func(cfg Config) radio.QueueService {
-			return prepareService(cfg, rpc.NewQueueService, addrFn)
+			return prepareService(cfg, RPCStreamer, rpc.NewQueueService, addrFn)
 		}),
 	}
 }
@@ -238,13 +371,28 @@ func (q *queueService) ResetReserved(ctx context.Context) error {
 	return q.fn().ResetReserved(ctx)
 }
 
+// Move implements radio.QueueService.
+func (q *queueService) Move(ctx context.Context, id radio.QueueID, position int) error {
+	return q.fn().Move(ctx, id, position)
+}
+
+// Insert implements radio.QueueService.
+func (q *queueService) Insert(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error) {
+	return q.fn().Insert(ctx, song, position)
+}
+
+// Pin implements radio.QueueService.
+func (q *queueService) Pin(ctx context.Context, id radio.QueueID, pinned bool) error {
+	return q.fn().Pin(ctx, id, pinned)
+}
+
 func newTrackerService(cfg Config) radio.ListenerTrackerService {
 	addrFn := Value(cfg, func(cfg Config) string {
 		return cfg.Conf().Tracker.RPCAddr.String()
 	})
 	return &trackerService{
 		Value(cfg, func(cfg Config) radio.ListenerTrackerService {
-			return prepareService(cfg, rpc.NewListenerTrackerService, addrFn)
+			return prepareService(cfg, RPCTracker, rpc.NewListenerTrackerService, addrFn)
 		}),
 	}
 }
@@ -269,7 +417,7 @@ func newIRCService(cfg Config) radio.AnnounceService {
 	})
 	return &ircService{
 		Value(cfg, func(cfg Config) radio.AnnounceService {
-			return prepareService(cfg, rpc.NewAnnouncerService, addrFn)
+			return prepareService(cfg, RPCIRC, rpc.NewAnnouncerService, addrFn)
 		}),
 	}
 }
@@ -296,3 +444,8 @@ func (i *ircService) AnnounceUser(ctx context.Context, user *radio.User) error {
 func (i *ircService) AnnounceMurder(ctx context.Context, by *radio.User, force bool) error {
 	return i.fn().AnnounceMurder(ctx, by, force)
 }
+
+// AnnounceAudit implements radio.AnnounceService.
+func (i *ircService) AnnounceAudit(ctx context.Context, entry radio.AuditEntry) error {
+	return i.fn().AnnounceAudit(ctx, entry)
+}
@@ -177,6 +177,23 @@ func SelectDelay(err error) (Delay, bool) {
 	return 0, false
 }
 
+// SelectInfo returns the first non-empty Info found in the error given, if none
+// is found ok will be false
+func SelectInfo(err error) (Info, bool) {
+	e, ok := err.(*Error)
+	if !ok {
+		return "", false
+	}
+
+	if e.Info != "" {
+		return e.Info, true
+	}
+	if e.Err != nil {
+		return SelectInfo(e.Err)
+	}
+	return "", false
+}
+
 // Select returns an *Error with the given Kind from the error given
 func Select(kind Kind, err error) (*Error, bool) {
 	e, ok := err.(*Error)
@@ -384,6 +401,17 @@ const (
 	SubmissionUnknown                  // Submission does not exist
 	Spam                               // Comment is spam
 	Duplicate                          // Duplicate where one isn't allowed
+	TokenUnknown                       // API token does not exist
+	TwoFactorUnknown                   // User has no two-factor authentication
+	TwoFactorRequired                  // Login requires a two-factor code
+	ListenerAccountUnknown             // Listener account does not exist
+	NoLeader                           // No leader is elected between replicas
+	GuestInviteUnknown                 // Guest invite does not exist or can't be redeemed
+	SourceUnknown                      // Proxy source does not exist
+	PendingRequestUnknown              // Pending request does not exist
+	QueueEntryUnknown                  // Queue entry does not exist
+	QueueEntryPinned                   // Queue entry is pinned in place
+	LyricsUnknown                      // Track has no lyrics or notes
 )
 
 func (k Kind) String() string {
@@ -466,6 +494,28 @@ func (k Kind) String() string {
 		return "this is SPAM"
 	case Duplicate:
 		return "duplicate entry"
+	case TokenUnknown:
+		return "unknown api token"
+	case TwoFactorUnknown:
+		return "two-factor authentication not configured"
+	case TwoFactorRequired:
+		return "two-factor code required"
+	case ListenerAccountUnknown:
+		return "unknown listener account"
+	case NoLeader:
+		return "no leader elected"
+	case GuestInviteUnknown:
+		return "unknown guest invite"
+	case SourceUnknown:
+		return "unknown source"
+	case PendingRequestUnknown:
+		return "unknown pending request"
+	case QueueEntryUnknown:
+		return "unknown queue entry"
+	case QueueEntryPinned:
+		return "queue entry is pinned"
+	case LyricsUnknown:
+		return "unknown lyrics"
 	}
 
 	return "unknown error kind"
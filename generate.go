@@ -1,7 +1,7 @@
 package radio
 
 //go:generate go generate ./rpc/generate.go
-//go:generate moq -out mocks/radio.gen.go -pkg mocks . SearchService ManagerService StreamerService QueueService AnnounceService StorageTx StorageService SessionStorageService SessionStorage QueueStorageService QueueStorage SongStorageService SongStorage TrackStorageService TrackStorage RequestStorageService RequestStorage UserStorageService UserStorage StatusStorageService StatusStorage NewsStorageService NewsStorage SubmissionStorageService SubmissionStorage RelayStorage RelayStorageService ScheduleStorageService ScheduleStorage
+//go:generate moq -out mocks/radio.gen.go -pkg mocks . SearchService ManagerService StreamerService QueueService AnnounceService StorageTx StorageService SessionStorageService SessionStorage QueueStorageService QueueStorage SongStorageService SongStorage TrackStorageService TrackStorage RequestStorageService RequestStorage UserStorageService UserStorage StatusStorageService StatusStorage NewsStorageService NewsStorage SubmissionStorageService SubmissionStorage RelayStorage RelayStorageService ScheduleStorageService ScheduleStorage APITokenStorageService APITokenStorage AuditStorageService AuditStorage ListenerAccountStorageService ListenerAccountStorage FingerprintStorageService FingerprintStorage RecommendationStorageService RecommendationStorage LeaseStorageService LeaseStorage JournalStorageService JournalStorage GuestStorageService GuestStorage GuestService RequestPoolStorageService RequestPoolStorage LyricsStorageService LyricsStorage
 //go:generate moq -out mocks/templates.gen.go -pkg mocks ./templates/ Executor TemplateSelectable
 //go:generate moq -out mocks/streamer.gen.go -pkg mocks ./streamer/audio/ Reader
 //go:generate moq -out mocks/util.gen.go -pkg mocks ./mocks/ FS File FileInfo
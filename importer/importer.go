@@ -0,0 +1,427 @@
+// Package importer implements bulk importing of a music library into the database
+package importer
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/R-a-dio/valkyrie/website/admin"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// DefaultBatchSize is the amount of tracks inserted per transaction if
+	// no batch size is given
+	DefaultBatchSize = 50
+	// DefaultAcceptor is the acceptor used if none is given
+	DefaultAcceptor = "importer"
+
+	// fingerprintLengthMargin is how much the length of two tracks can differ
+	// before we stop comparing their fingerprints
+	fingerprintLengthMargin = time.Second * 5
+)
+
+// Options are the options for an import
+type Options struct {
+	// MusicPath is the directory files are copied into
+	MusicPath string
+	// DryRun reports what would happen without changing anything
+	DryRun bool
+	// BatchSize is the amount of tracks inserted per transaction
+	BatchSize int
+	// StatePath is the file used to keep track of progress, files listed in it
+	// are skipped such that an interrupted import can be resumed. Empty disables
+	// resuming
+	StatePath string
+	// Fingerprint enables deduplication by acoustic fingerprint
+	Fingerprint bool
+	// Tags are the tags given to imported tracks
+	Tags string
+	// Acceptor is the username recorded as acceptor of the imported tracks
+	Acceptor string
+}
+
+// Importer imports directory trees of audio files into the database
+type Importer struct {
+	storage radio.StorageService
+	opts    Options
+
+	// probe and fingerprint are swapped out in tests
+	probe       func(ctx context.Context, filename string) (*audio.Info, error)
+	fingerprint func(ctx context.Context, filename string) (radio.Fingerprint, error)
+}
+
+// New returns an Importer that imports into the storage given
+func New(storage radio.StorageService, opts Options) *Importer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.Acceptor == "" {
+		opts.Acceptor = DefaultAcceptor
+	}
+
+	return &Importer{
+		storage:     storage,
+		opts:        opts,
+		probe:       audio.ProbeText,
+		fingerprint: audio.Fingerprint,
+	}
+}
+
+// pendingTrack is a track that passed all checks and is waiting to be inserted
+type pendingTrack struct {
+	path        string
+	song        radio.Song
+	fingerprint radio.Fingerprint
+}
+
+// run holds the state of a single Run
+type run struct {
+	*Importer
+	state   *state
+	summary *Summary
+
+	batch []pendingTrack
+	// hashes of all tracks we've seen this run, mapped to their path
+	hashes map[radio.SongHash]string
+}
+
+// Run imports all audio files found in the directories given
+func (imp *Importer) Run(ctx context.Context, roots ...string) (*Summary, error) {
+	const op errors.Op = "importer.Importer.Run"
+
+	statePath := imp.opts.StatePath
+	if imp.opts.DryRun {
+		// a dry-run shouldn't mark anything as done
+		statePath = ""
+	}
+	st, err := openState(statePath)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer st.Close()
+
+	r := &run{
+		Importer: imp,
+		state:    st,
+		summary: &Summary{
+			DryRun:  imp.opts.DryRun,
+			Started: time.Now(),
+		},
+		hashes: make(map[radio.SongHash]string),
+	}
+
+	for _, root := range roots {
+		root, err = filepath.Abs(root)
+		if err != nil {
+			return r.summary, errors.E(op, err)
+		}
+
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !isAudioFile(path) {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return r.file(ctx, path)
+		})
+		if err != nil {
+			return r.summary, errors.E(op, err)
+		}
+	}
+
+	if err = r.flush(ctx); err != nil {
+		return r.summary, errors.E(op, err)
+	}
+
+	r.summary.Finished = time.Now()
+	return r.summary, nil
+}
+
+func isAudioFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".opus", ".mp3", ".flac", ".ogg":
+		return true
+	}
+	return false
+}
+
+// file handles a single file, the returned error is only non-nil if we should
+// stop the import altogether
+func (r *run) file(ctx context.Context, path string) error {
+	if r.state.Done(path) {
+		r.summary.add(Result{Path: path, Status: StatusResumed})
+		return nil
+	}
+
+	song, err := r.songFromFile(ctx, path)
+	if err != nil {
+		r.fail(ctx, path, err)
+		return nil
+	}
+	res := Result{
+		Path:     path,
+		Metadata: song.Metadata,
+	}
+
+	// check for duplicates by hash, both in the database and in this run
+	if other, ok := r.hashes[song.Hash]; ok {
+		res.Status = StatusDuplicateHash
+		res.Error = "duplicate of " + other
+		return r.done(res)
+	}
+	existing, err := r.storage.Song(ctx).FromHash(song.Hash)
+	if err != nil && !errors.Is(errors.SongUnknown, err) {
+		r.fail(ctx, path, err)
+		return nil
+	}
+	if existing != nil && existing.HasTrack() {
+		res.Status = StatusDuplicateHash
+		res.DuplicateOf = existing.TrackID
+		return r.done(res)
+	}
+
+	// then by fingerprint if enabled
+	var fp radio.Fingerprint
+	if r.opts.Fingerprint {
+		fp, err = r.fingerprint(ctx, path)
+		if err != nil {
+			r.fail(ctx, path, err)
+			return nil
+		}
+
+		candidates, err := r.storage.Fingerprint(ctx).Candidates(song.Length, fingerprintLengthMargin)
+		if err != nil {
+			r.fail(ctx, path, err)
+			return nil
+		}
+		if match, similarity, ok := audio.FingerprintMatch(fp, candidates); ok {
+			res.Status = StatusDuplicateFingerprint
+			res.DuplicateOf = match.TrackID
+			res.Similarity = similarity
+			return r.done(res)
+		}
+		// tracks from earlier batches are in the database by now, except when doing
+		// a dry-run in which case we miss those
+		for _, other := range r.batch {
+			if other.fingerprint == nil {
+				continue
+			}
+			if similarity := audio.FingerprintSimilarity(fp, other.fingerprint); similarity >= audio.FingerprintThreshold {
+				res.Status = StatusDuplicateFingerprint
+				res.Similarity = similarity
+				res.Error = "duplicate of " + other.path
+				return r.done(res)
+			}
+		}
+	}
+
+	r.hashes[song.Hash] = path
+	r.batch = append(r.batch, pendingTrack{
+		path:        path,
+		song:        song,
+		fingerprint: fp,
+	})
+	if len(r.batch) >= r.opts.BatchSize {
+		return r.flush(ctx)
+	}
+	return nil
+}
+
+// songFromFile creates a song from the tags of the file given
+func (r *run) songFromFile(ctx context.Context, path string) (radio.Song, error) {
+	info, err := r.probe(ctx, path)
+	if err != nil {
+		return radio.Song{}, err
+	}
+
+	// as fallback we use the filename as '{artist} - {title}'
+	fn := filepath.Base(path)
+	fn = strings.TrimSuffix(fn, filepath.Ext(fn))
+	artist, title, _ := strings.Cut(fn, " - ")
+	if info.Title == "" {
+		info.Title = title
+	}
+	if info.Artist == "" {
+		info.Artist = artist
+	}
+
+	song := radio.Song{
+		Length: info.Duration,
+		DatabaseTrack: &radio.DatabaseTrack{
+			Artist:     info.Artist,
+			Title:      info.Title,
+			Album:      info.Album,
+			FilePath:   path,
+			Tags:       r.opts.Tags,
+			Acceptor:   r.opts.Acceptor,
+			LastEditor: r.opts.Acceptor,
+			Usable:     true,
+		},
+	}
+	song.Hydrate()
+	return song, nil
+}
+
+// done records the result and marks the file as done
+func (r *run) done(res Result) error {
+	r.summary.add(res)
+	return r.state.Mark(res.Path)
+}
+
+// fail records a failure for the file given, failed files aren't marked as done
+// so they will be retried by a resumed import
+func (r *run) fail(ctx context.Context, path string, err error) {
+	zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("path", path).Msg("failed to import")
+	r.summary.add(Result{
+		Path:   path,
+		Status: StatusFailed,
+		Error:  err.Error(),
+	})
+}
+
+// flush inserts the current batch, if inserting fails the whole batch is marked
+// as failed and nothing is kept
+func (r *run) flush(ctx context.Context) error {
+	batch := r.batch
+	r.batch = r.batch[:0:0]
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if r.opts.DryRun {
+		for _, pt := range batch {
+			r.summary.add(Result{
+				Path:     pt.path,
+				Status:   StatusWouldImport,
+				Metadata: pt.song.Metadata,
+			})
+		}
+		return nil
+	}
+
+	ids, err := r.insert(ctx, batch)
+	if err != nil {
+		for _, pt := range batch {
+			r.fail(ctx, pt.path, err)
+		}
+		return nil
+	}
+
+	paths := make([]string, len(batch))
+	for i, pt := range batch {
+		paths[i] = pt.path
+		r.summary.add(Result{
+			Path:     pt.path,
+			Status:   StatusImported,
+			Metadata: pt.song.Metadata,
+			TrackID:  ids[i],
+		})
+	}
+	return r.state.Mark(paths...)
+}
+
+// insert inserts the batch given in a single transaction and copies the files into
+// the music directory
+func (r *run) insert(ctx context.Context, batch []pendingTrack) (ids []radio.TrackID, err error) {
+	const op errors.Op = "importer.run.insert"
+
+	ts, tx, err := r.storage.TrackTx(ctx, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	fps, _, err := r.storage.FingerprintTx(ctx, tx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// remove any files we copied if we end up failing
+	var copied []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, path := range copied {
+			os.Remove(path)
+		}
+	}()
+
+	for _, pt := range batch {
+		song := pt.song
+
+		song.TrackID, err = ts.Insert(song)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		var filename string
+		filename, err = admin.GenerateMusicFilename(song)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		dst := filepath.Join(r.opts.MusicPath, filename)
+		if err = copyFile(pt.path, dst); err != nil {
+			return nil, errors.E(op, err)
+		}
+		copied = append(copied, dst)
+
+		song.FilePath = filename
+		if err = ts.UpdateMetadata(song); err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		if pt.fingerprint != nil {
+			err = fps.Store(radio.TrackFingerprint{
+				TrackID:     song.TrackID,
+				Length:      song.Length,
+				Fingerprint: pt.fingerprint,
+			})
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+
+		ids = append(ids, song.TrackID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return ids, nil
+}
+
+// copyFile copies src to dst, dst should not exist yet
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	return out.Close()
+}
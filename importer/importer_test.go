@@ -0,0 +1,183 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLibrary creates a directory with the files given, the file contents are
+// the filename
+func testLibrary(t *testing.T, files ...string) string {
+	dir := t.TempDir()
+	for _, name := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(name), 0644))
+	}
+	return dir
+}
+
+func testProbe(ctx context.Context, filename string) (*audio.Info, error) {
+	if strings.Contains(filename, "broken") {
+		return nil, errors.E(errors.Testing)
+	}
+	return &audio.Info{Duration: time.Minute * 3}, nil
+}
+
+type testStorage struct {
+	*mocks.StorageServiceMock
+
+	inserted []radio.Song
+	updated  []radio.Song
+	// existing is the hash of a song that already exists
+	existing radio.SongHash
+}
+
+func newTestStorage(t *testing.T) *testStorage {
+	ts := &testStorage{}
+
+	songs := &mocks.SongStorageMock{
+		FromHashFunc: func(hash radio.SongHash) (*radio.Song, error) {
+			if hash == ts.existing {
+				return &radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 99}}, nil
+			}
+			return nil, errors.E(errors.SongUnknown)
+		},
+	}
+	tracks := &mocks.TrackStorageMock{
+		InsertFunc: func(song radio.Song) (radio.TrackID, error) {
+			ts.inserted = append(ts.inserted, song)
+			return radio.TrackID(len(ts.inserted)), nil
+		},
+		UpdateMetadataFunc: func(song radio.Song) error {
+			ts.updated = append(ts.updated, song)
+			return nil
+		},
+	}
+	fingerprints := &mocks.FingerprintStorageMock{
+		CandidatesFunc: func(length, margin time.Duration) ([]radio.TrackFingerprint, error) {
+			return nil, nil
+		},
+		StoreFunc: func(trackFingerprint radio.TrackFingerprint) error {
+			return nil
+		},
+	}
+
+	ts.StorageServiceMock = &mocks.StorageServiceMock{
+		SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
+			return songs
+		},
+		FingerprintFunc: func(contextMoqParam context.Context) radio.FingerprintStorage {
+			return fingerprints
+		},
+		TrackTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+			return tracks, mocks.CommitTx(t), nil
+		},
+		FingerprintTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+			return fingerprints, storageTx, nil
+		},
+	}
+	return ts
+}
+
+func newTestImporter(storage radio.StorageService, opts Options) *Importer {
+	imp := New(storage, opts)
+	imp.probe = testProbe
+	imp.fingerprint = func(ctx context.Context, filename string) (radio.Fingerprint, error) {
+		return make(radio.Fingerprint, 1000), nil
+	}
+	return imp
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	lib := testLibrary(t,
+		"artist - one.mp3",
+		"sub/artist - two.flac",
+		"sub/again/artist - one.mp3",
+		"artist - existing.mp3",
+		"broken.mp3",
+		"cover.jpg",
+	)
+	music := t.TempDir()
+	storage := newTestStorage(t)
+	storage.existing = radio.NewSongHash("artist - existing")
+
+	imp := newTestImporter(storage, Options{
+		MusicPath: music,
+		BatchSize: 1,
+		StatePath: filepath.Join(t.TempDir(), "state"),
+	})
+
+	summary, err := imp.Run(ctx, lib)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, summary.Imported)
+	assert.Equal(t, 2, summary.Duplicates)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Len(t, summary.Results, 5, "non-audio files should be ignored")
+	require.Len(t, storage.inserted, 2)
+	require.Len(t, storage.updated, 2)
+
+	// files should have been copied into the music directory
+	for _, song := range storage.updated {
+		assert.Equal(t, filepath.Base(song.FilePath), song.FilePath, "FilePath should be relative to MusicPath")
+		_, err := os.Stat(filepath.Join(music, song.FilePath))
+		assert.NoError(t, err)
+	}
+
+	// running it again should resume and only retry the failed file
+	summary, err = imp.Run(ctx, lib)
+	require.NoError(t, err)
+	assert.Equal(t, 4, summary.Resumed)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Len(t, storage.inserted, 2)
+
+	var buf bytes.Buffer
+	require.NoError(t, summary.WriteJSON(&buf))
+	assert.Contains(t, buf.String(), `"status": "failed"`)
+}
+
+func TestImportDryRun(t *testing.T) {
+	ctx := context.Background()
+	lib := testLibrary(t, "artist - one.mp3", "artist - two.mp3")
+	music := t.TempDir()
+	state := filepath.Join(t.TempDir(), "state")
+	storage := newTestStorage(t)
+
+	imp := newTestImporter(storage, Options{
+		MusicPath:   music,
+		DryRun:      true,
+		StatePath:   state,
+		Fingerprint: true,
+	})
+
+	summary, err := imp.Run(ctx, lib)
+	require.NoError(t, err)
+
+	assert.True(t, summary.DryRun)
+	// our test fingerprints are all identical
+	assert.Equal(t, 1, summary.Imported)
+	assert.Equal(t, 1, summary.Duplicates)
+	assert.Equal(t, StatusWouldImport, summary.Results[1].Status)
+	assert.Empty(t, storage.inserted)
+	assert.Empty(t, storage.TrackTxCalls())
+
+	entries, err := os.ReadDir(music)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "dry-run shouldn't copy anything")
+	_, err = os.Stat(state)
+	assert.ErrorIs(t, err, os.ErrNotExist, "dry-run shouldn't create state")
+}
@@ -0,0 +1,79 @@
+package importer
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// state keeps track of which files have been handled such that an interrupted
+// import can be resumed, it's stored as a file with one path per line
+type state struct {
+	done map[string]struct{}
+	f    *os.File
+}
+
+// openState opens the state file at path, creating it if it doesn't exist. An
+// empty path returns a state that doesn't persist anything
+func openState(path string) (*state, error) {
+	const op errors.Op = "importer.openState"
+
+	s := &state{done: make(map[string]struct{})}
+	if path == "" {
+		return s, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			s.done[line] = struct{}{}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		f.Close()
+		return nil, errors.E(op, err)
+	}
+
+	s.f = f
+	return s, nil
+}
+
+// Done returns true if path was handled before
+func (s *state) Done(path string) bool {
+	_, ok := s.done[path]
+	return ok
+}
+
+// Mark marks the paths given as handled
+func (s *state) Mark(paths ...string) error {
+	const op errors.Op = "importer.state.Mark"
+
+	for _, path := range paths {
+		s.done[path] = struct{}{}
+		if s.f == nil {
+			continue
+		}
+		if _, err := s.f.WriteString(path + "\n"); err != nil {
+			return errors.E(op, err)
+		}
+	}
+	if s.f != nil {
+		if err := s.f.Sync(); err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+func (s *state) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	return s.f.Close()
+}
@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+// Status is the outcome of importing a single file
+type Status string
+
+const (
+	// StatusImported means the file was copied and added to the database
+	StatusImported Status = "imported"
+	// StatusWouldImport means the file would've been imported if this wasn't a dry-run
+	StatusWouldImport Status = "would_import"
+	// StatusDuplicateHash means a track with the same metadata already exists
+	StatusDuplicateHash Status = "duplicate_hash"
+	// StatusDuplicateFingerprint means a track with the same audio already exists
+	StatusDuplicateFingerprint Status = "duplicate_fingerprint"
+	// StatusResumed means the file was handled by a previous run
+	StatusResumed Status = "resumed"
+	// StatusFailed means something went wrong, see Result.Error
+	StatusFailed Status = "failed"
+)
+
+// Result is the result of importing a single file
+type Result struct {
+	Path     string        `json:"path"`
+	Status   Status        `json:"status"`
+	Metadata string        `json:"metadata,omitempty"`
+	TrackID  radio.TrackID `json:"track_id,omitempty"`
+	// DuplicateOf is the track this file is a duplicate of
+	DuplicateOf radio.TrackID `json:"duplicate_of,omitempty"`
+	// Similarity is the fingerprint similarity for StatusDuplicateFingerprint
+	Similarity float64 `json:"similarity,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Summary is the machine-readable summary of an import
+type Summary struct {
+	DryRun   bool      `json:"dry_run"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+
+	Imported   int `json:"imported"`
+	Duplicates int `json:"duplicates"`
+	Resumed    int `json:"resumed"`
+	Failed     int `json:"failed"`
+
+	Results []Result `json:"results"`
+}
+
+func (s *Summary) add(res Result) {
+	switch res.Status {
+	case StatusImported, StatusWouldImport:
+		s.Imported++
+	case StatusDuplicateHash, StatusDuplicateFingerprint:
+		s.Duplicates++
+	case StatusResumed:
+		s.Resumed++
+	case StatusFailed:
+		s.Failed++
+	}
+	s.Results = append(s.Results, res)
+}
+
+// WriteJSON writes the summary as JSON to w
+func (s *Summary) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(s)
+}
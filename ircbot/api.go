@@ -19,8 +19,12 @@ import (
 	"google.golang.org/grpc"
 )
 
-func NewGRPCServer(ctx context.Context, service radio.AnnounceService) (*grpc.Server, error) {
-	gs := rpc.NewGrpcServer(ctx)
+func NewGRPCServer(ctx context.Context, cfg config.Config, service radio.AnnounceService) (*grpc.Server, error) {
+	opts, err := cfg.RPCServerOptions(config.RPCIRC)
+	if err != nil {
+		return nil, err
+	}
+	gs := rpc.NewGrpcServer(ctx, opts...)
 	rpc.RegisterAnnouncerServer(gs, rpc.NewAnnouncer(service))
 
 	return gs, nil
@@ -31,6 +35,9 @@ func NewAnnounceService(cfg config.Config, storage radio.StorageService, bot *Bo
 		cfgMainChannel: config.Value(cfg, func(cfg config.Config) string {
 			return cfg.Conf().IRC.MainChannel
 		}),
+		cfgAuditChannel: config.Value(cfg, func(cfg config.Config) string {
+			return cfg.Conf().IRC.AuditChannel
+		}),
 		cfgAnnouncePeriod: config.Value(cfg, func(cfg config.Config) time.Duration {
 			return time.Duration(cfg.Conf().IRC.AnnouncePeriod)
 		}),
@@ -48,6 +55,7 @@ var _ radio.AnnounceService = (*announceService)(nil)
 
 type announceService struct {
 	cfgMainChannel    func() string
+	cfgAuditChannel   func() string
 	cfgAnnouncePeriod func() time.Duration
 	Storage           radio.StorageService
 
@@ -308,6 +316,25 @@ func (ann *announceService) AnnounceMurder(ctx context.Context, by *radio.User,
 	return nil
 }
 
+func (ann *announceService) AnnounceAudit(ctx context.Context, entry radio.AuditEntry) error {
+	const op errors.Op = "ircbot/announceService.AnnounceAudit"
+	ctx, span := otel.Tracer("").Start(ctx, string(op))
+	defer span.End()
+
+	channel := ann.cfgAuditChannel()
+	if channel == "" {
+		// audit announcements are disabled
+		return nil
+	}
+
+	message := Fmt("Audit: {red}%s{c} did {green}%s{c} on %s", entry.Actor, entry.Action, entry.Target)
+	if entry.IP != "" {
+		message += Fmt(" (%s)", entry.IP)
+	}
+	ann.bot.c.Cmd.Message(channel, message)
+	return nil
+}
+
 func (ann *announceService) AnnounceUser(ctx context.Context, user *radio.User) error {
 	const op errors.Op = "ircbot/announceService.AnnounceUser"
 	ctx, span := otel.Tracer("").Start(ctx, string(op))
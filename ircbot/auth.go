@@ -120,3 +120,22 @@ func (e *Event) HasDeveloperAccess() (bool, error) {
 
 	return user.UserPermissions.Has(radio.PermDev), nil
 }
+
+// ListenerAccount returns the listener account that claimed the nick of the source
+// of the event, or nil if the nick isn't claimed. ok is false if the nick is claimed
+// but the source isn't authenticated with nickserv, in which case they shouldn't be
+// allowed to act on behalf of the account
+func (e *Event) ListenerAccount() (account *radio.ListenerAccount, ok bool, err error) {
+	const op errors.Op = "irc/ListenerAccount"
+
+	account, err = e.Storage.ListenerAccount(e.Ctx).GetByNick(e.Source.Name)
+	if err != nil {
+		if errors.Is(errors.ListenerAccountUnknown, err) {
+			// not claimed, anyone is free to use it
+			return nil, true, nil
+		}
+		return nil, false, errors.E(op, err)
+	}
+
+	return account, e.IsAuthed(), nil
+}
@@ -36,6 +36,9 @@ var (
 	reTrackTags       = "tags( (?P<TrackID>[0-9]+)?)?$"
 	reGuestAuth       = `(guest|guestauth|auth)( (?P<Nick>.+?))?(\s|$)`
 	reGuestCreate     = `newguest( (?P<Nick>.+?))?(\s|$)`
+	reClaimNick       = "claim (?P<Code>[a-zA-Z0-9]+)$"
+	reSimilar         = "sim(ilar)?( (?P<TrackID>[0-9]+))?$"
+	reVote            = "v(ote)? (?P<TrackID>[0-9]+)$"
 )
 
 type HandlerFn func(Event) error
@@ -156,6 +159,9 @@ var reHandlers = []RegexHandler{
 	{"guest_auth", reGuestAuth, GuestAuth},
 	{"guest_create", reGuestCreate, GuestCreate},
 	{"request_fave_track", reRequestFave, FaveSearchTrackRequest},
+	{"claim_nick", reClaimNick, ClaimNick},
+	{"similar_track", reSimilar, SimilarTrack},
+	{"vote_request", reVote, VoteRequest},
 }
 
 func RegisterCommandHandlers(ctx context.Context, b *Bot, handlers ...RegexHandler) error {
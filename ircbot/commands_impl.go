@@ -295,6 +295,16 @@ func FaveTrack(e Event) error {
 		song = *s
 	}
 
+	// claimed nicks can only have their favorites changed by the owner
+	_, ok, err := e.ListenerAccount()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !ok {
+		e.EchoPrivate("Your nick is claimed by a listener account, identify with NickServ to change your favorites.")
+		return nil
+	}
+
 	// now check to see if we want to favorite or unfavorite something
 	var dbFunc = ss.AddFavorite
 	if e.Arguments.Bool("isNegative") {
@@ -586,13 +596,74 @@ func SearchTrack(e Event) error {
 	} else {
 		var res radio.SearchResult
 		query := e.Arguments["Query"]
-		res, err = e.Bot.Searcher.Search(e.Ctx, query, radio.SearchOptions{Limit: 5})
+		res, err = e.Bot.Searcher.Search(e.Ctx, query, radio.SearchOptions{
+			Limit: 5,
+			Fuzzy: true,
+		})
 		if err != nil {
+			if errors.Is(errors.SearchNoResults, err) && len(res.Suggestions) > 0 {
+				echoSuggestions(e, "Your search returned no results", res.Suggestions)
+				return nil
+			}
 			return errors.E(op, err)
 		}
 		songs = res.Songs
+
+		if res.Fuzzy && len(res.Suggestions) > 0 {
+			echoSuggestions(e, "No exact matches", res.Suggestions)
+		}
+	}
+
+	message, args := formatSongList(songs)
+	e.Echo(message, args...)
+	return nil
+}
+
+// SimilarTrack shows the tracks that listeners who like a track also like
+func SimilarTrack(e Event) error {
+	const op errors.Op = "irc/SimilarTrack"
+
+	song, err := e.ArgumentTrack("TrackID")
+	if err != nil {
+		if errors.Is(errors.SongUnknown, err) {
+			return errors.E(op, err)
+		}
+
+		// no track given so use the current track
+		song, err = e.CurrentTrack()
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	if !song.HasTrack() {
+		e.EchoPrivate("Song is not in the database")
+		return nil
+	}
+
+	similar, err := e.Storage.Recommendation(e.Ctx).Similar(song.TrackID, 5)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if len(similar) == 0 {
+		e.Echo("No similar songs known for {green}%s", song.Metadata)
+		return nil
+	}
+
+	songs := make([]radio.Song, len(similar))
+	for i, s := range similar {
+		songs[i] = s.Song
 	}
 
+	message, args := formatSongList(songs)
+	e.Echo(message, args...)
+	return nil
+}
+
+// formatSongList formats songs as a list showing if they're requestable and when
+// they were last played
+func formatSongList(songs []radio.Song) (string, []any) {
 	var (
 		// setup formatting strings
 		requestableColor   = "{green}"
@@ -628,8 +699,18 @@ func SearchTrack(e Event) error {
 		args = append(args, song.Metadata, song.TrackID, lastPlayed)
 	}
 
-	e.Echo(strings.Join(message, " | "), args...)
-	return nil
+	return strings.Join(message, " | "), args
+}
+
+// echoSuggestions tells the user what they might've meant to search for
+func echoSuggestions(e Event, prefix string, suggestions []string) {
+	message := make([]string, len(suggestions))
+	args := make([]any, len(suggestions))
+	for i, s := range suggestions {
+		message[i] = "{green}%s{clear}"
+		args[i] = s
+	}
+	e.Echo(prefix+", did you mean: "+strings.Join(message, ", ")+"?", args...)
 }
 
 func RequestTrack(e Event) error {
@@ -645,6 +726,73 @@ func RequestTrack(e Event) error {
 		return errors.E(op, err)
 	}
 
+	// add it to the request history if their nick is claimed
+	account, ok, err := e.ListenerAccount()
+	if err == nil && account != nil && ok {
+		err = e.Storage.ListenerAccount(e.Ctx).AddRequest(account.ID, song.TrackID)
+	}
+	if err != nil {
+		// the request itself went through, so don't fail on this
+		zerolog.Ctx(e.Ctx).Error().Ctx(e.Ctx).Err(errors.E(op, err)).Msg("failed to add listener request")
+	}
+
+	// moderated requests go into the pool instead of the queue, so tell the
+	// user how others can help it along
+	if e.Bot.cfgModeratedRequests() {
+		e.EchoPrivate("Your request is pending, others can vote for it with {green}.vote %d", song.TrackID)
+	}
+	return nil
+}
+
+func VoteRequest(e Event) error {
+	const op errors.Op = "irc/VoteRequest"
+
+	id, err := radio.ParseTrackID(e.Arguments["TrackID"])
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = e.Storage.RequestPool(e.Ctx).Vote(id, e.Source.Host)
+	if err != nil {
+		switch {
+		case errors.Is(errors.Duplicate, err):
+			e.EchoPrivate("You already voted for that request.")
+			return nil
+		case errors.Is(errors.PendingRequestUnknown, err):
+			e.EchoPrivate("That song isn't a pending request.")
+			return nil
+		}
+		return errors.E(op, err)
+	}
+
+	e.EchoPrivate("Your vote for {green}%d{clear} has been counted.", id)
+	return nil
+}
+
+func ClaimNick(e Event) error {
+	const op errors.Op = "irc/ClaimNick"
+
+	// a claim only means something if nobody else can use the nick
+	if !e.IsAuthed() {
+		e.EchoPrivate("You need to be identified with NickServ to claim your nick.")
+		return nil
+	}
+
+	ls := e.Storage.ListenerAccount(e.Ctx)
+	account, err := ls.ClaimNick(e.Arguments["Code"], e.Source.Name)
+	if err != nil {
+		switch {
+		case errors.Is(errors.ListenerAccountUnknown, err):
+			e.EchoPrivate("That claim code is invalid or has expired.")
+			return nil
+		case errors.Is(errors.Duplicate, err):
+			e.EchoPrivate("Your nick is already claimed by another account.")
+			return nil
+		}
+		return errors.E(op, err)
+	}
+
+	e.EchoPrivate("Your nick is now claimed by the account {green}%s{clear}.", account.Username)
 	return nil
 }
 
@@ -162,6 +162,25 @@ func TestRegexpHandlers(t *testing.T) {
 	testCases["track_tags"] = []trhcase{}
 	testCases["guest_auth"] = []trhcase{}
 	testCases["guest_create"] = []trhcase{}
+	testCases["claim_nick"] = []trhcase{
+		{input: ".claim abCD1234", checks: []checker{hasValue("Code", "abCD1234")}},
+		{input: "!claim 12345678", checks: []checker{hasValue("Code", "12345678")}},
+		{input: ".claim", shouldFail: true},
+		{input: ".claim two words", shouldFail: true},
+	}
+	testCases["similar_track"] = []trhcase{
+		{input: ".similar"},
+		{input: ".sim"},
+		{input: ".sim 503", checks: []checker{hasValue("TrackID", "503")}},
+		{input: "!similar 1023232", checks: []checker{hasValue("TrackID", "1023232")}},
+		{input: ".similar something", shouldFail: true},
+	}
+	testCases["vote_request"] = []trhcase{
+		{input: ".vote 503", checks: []checker{hasValue("TrackID", "503")}},
+		{input: "!v 1023232", checks: []checker{hasValue("TrackID", "1023232")}},
+		{input: ".vote", shouldFail: true},
+		{input: ".vote something", shouldFail: true},
+	}
 
 	for _, re := range reHandlers {
 		t.Run(re.name, func(t *testing.T) {
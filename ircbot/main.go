@@ -4,6 +4,8 @@ import (
 	"context"
 	"net"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff"
@@ -35,7 +37,7 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	announce := NewAnnounceService(cfg, b.Storage, b)
 
 	// setup a http server for our RPC API
-	srv, err := NewGRPCServer(ctx, announce)
+	srv, err := NewGRPCServer(ctx, cfg, announce)
 	if err != nil {
 		return err
 	}
@@ -138,6 +140,9 @@ func NewBot(ctx context.Context, cfg config.Config) (*Bot, error) {
 		cfgMainChannel: config.Value(cfg, func(cfg config.Config) string {
 			return cfg.Conf().IRC.MainChannel
 		}),
+		cfgModeratedRequests: config.Value(cfg, func(cfg config.Config) bool {
+			return cfg.Conf().Streamer.ModeratedRequests
+		}),
 		Storage:  store,
 		Searcher: ss,
 		Queue:    cfg.Queue,
@@ -145,7 +150,15 @@ func NewBot(ctx context.Context, cfg config.Config) (*Bot, error) {
 		Manager:  cfg.Manager,
 		Guest:    cfg.Guest,
 		c:        girc.New(ircConf),
+		reloaded: make(chan struct{}, 1),
 	}
+	// sync the channels and nick right away when the configuration changes
+	cfg.OnReload(func() {
+		select {
+		case b.reloaded <- struct{}{}:
+		default:
+		}
+	})
 
 	RegisterGuestHandlers(ctx, b.c, cfg.Guest)
 	if err = RegisterCommonHandlers(b, b.c); err != nil {
@@ -161,11 +174,12 @@ func NewBot(ctx context.Context, cfg config.Config) (*Bot, error) {
 }
 
 type Bot struct {
-	cfgUserRequestDelay func() time.Duration
-	cfgNick             func() string
-	cfgNickPassword     func() string
-	cfgChannels         func() []string
-	cfgMainChannel      func() string
+	cfgUserRequestDelay  func() time.Duration
+	cfgNick              func() string
+	cfgNickPassword      func() string
+	cfgChannels          func() []string
+	cfgMainChannel       func() string
+	cfgModeratedRequests func() bool
 
 	Storage radio.StorageService
 
@@ -182,6 +196,8 @@ type Bot struct {
 	UserValue      *util.Value[*radio.User]
 
 	c *girc.Client
+	// reloaded is signaled when the configuration was reloaded
+	reloaded chan struct{}
 }
 
 // runClient connects the irc client and tries to keep it connected until
@@ -223,9 +239,13 @@ func (b *Bot) syncConfiguration(ctx context.Context) {
 	tick := time.NewTicker(time.Second * 30)
 	defer tick.Stop()
 
+	// channels are the channels we were configured to be in last time
+	channels := b.cfgChannels()
+
 	for {
 		select {
 		case <-tick.C:
+		case <-b.reloaded:
 		case <-ctx.Done():
 			return
 		}
@@ -241,11 +261,23 @@ func (b *Bot) syncConfiguration(ctx context.Context) {
 		}
 
 		// check if we're still on all our wanted channels
-		for _, wanted := range b.cfgChannels() {
-			if !b.c.IsInChannel(wanted) {
-				b.c.Cmd.Join(wanted)
+		wanted := b.cfgChannels()
+		for _, channel := range wanted {
+			if !b.c.IsInChannel(channel) {
+				b.c.Cmd.Join(channel)
+			}
+		}
+
+		// and leave the ones that were removed from the configuration
+		for _, channel := range channels {
+			removed := !slices.ContainsFunc(wanted, func(w string) bool {
+				return strings.EqualFold(w, channel)
+			})
+			if removed && b.c.IsInChannel(channel) {
+				b.c.Cmd.Part(channel)
 			}
 		}
+		channels = wanted
 	}
 }
 
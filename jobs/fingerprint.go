@@ -0,0 +1,70 @@
+//go:build !nostreamer
+// +build !nostreamer
+
+package jobs
+
+import (
+	"context"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/rs/zerolog"
+)
+
+// ExecuteFingerprint creates acoustic fingerprints for all usable tracks that
+// don't have one yet
+func ExecuteFingerprint(ctx context.Context, cfg config.Config) error {
+	logger := zerolog.Ctx(ctx)
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	fs := store.Fingerprint(ctx)
+	ts := store.Track(ctx)
+
+	ids, err := fs.Missing()
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Ctx(ctx).Int("amount", len(ids)).Msg("fingerprinting tracks")
+
+	root := cfg.Conf().MusicPath
+	for _, id := range ids {
+		song, err := ts.Get(id)
+		if err != nil {
+			logger.Error().Ctx(ctx).Err(err).Uint64("track_id", uint64(id)).Msg("failed to get track")
+			continue
+		}
+
+		filename := util.AbsolutePath(root, song.FilePath)
+		fp, err := audio.Fingerprint(ctx, filename)
+		if err != nil {
+			logger.Error().Ctx(ctx).
+				Err(err).
+				Uint64("track_id", uint64(id)).
+				Str("filename", filename).
+				Msg("failed to fingerprint file")
+			continue
+		}
+
+		err = fs.Store(radio.TrackFingerprint{
+			TrackID:     id,
+			Length:      song.Length,
+			Fingerprint: fp,
+		})
+		if err != nil {
+			logger.Error().Ctx(ctx).Err(err).Uint64("track_id", uint64(id)).Msg("failed to store fingerprint")
+			continue
+		}
+
+		logger.Info().Ctx(ctx).Uint64("track_id", uint64(id)).Msg("success")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/rs/zerolog"
+)
+
+// ExecuteJournalPrune removes manager journal entries that are older than the
+// configured retention
+func ExecuteJournalPrune(ctx context.Context, cfg config.Config) error {
+	retention := time.Duration(cfg.Conf().Manager.JournalRetention)
+	if retention <= 0 {
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("journal retention disabled")
+		return nil
+	}
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	before := time.Now().Add(-retention)
+	n, err := store.Journal(ctx).Prune(before)
+	if err != nil {
+		return err
+	}
+
+	zerolog.Ctx(ctx).Info().Ctx(ctx).Int64("amount", n).Time("before", before).Msg("pruned journal")
+	return nil
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/util/lrc"
+	"github.com/rs/zerolog"
+	"github.com/spf13/afero"
+)
+
+// lyricsImportUser is the name used as editor of imported lyrics
+const lyricsImportUser = "import"
+
+// ExecuteLyricsImport imports lyrics and notes from the files next to the audio
+// of all tracks that don't have any yet
+func ExecuteLyricsImport(ctx context.Context, cfg config.Config) error {
+	logger := zerolog.Ctx(ctx)
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	songs, err := store.Track(ctx).AllRaw()
+	if err != nil {
+		return err
+	}
+
+	ls := store.Lyrics(ctx)
+	fsys := afero.NewOsFs()
+	root := cfg.Conf().MusicPath
+
+	var imported int
+	for _, song := range songs {
+		_, err := ls.Get(song.TrackID)
+		if err == nil {
+			// already has lyrics, don't overwrite any edits
+			continue
+		}
+		if !errors.Is(errors.LyricsUnknown, err) {
+			logger.Error().Ctx(ctx).Err(err).Uint64("track_id", uint64(song.TrackID)).Msg("failed to get lyrics")
+			continue
+		}
+
+		filename := util.AbsolutePath(root, song.FilePath)
+		lyrics, notes, err := lrc.ReadFiles(fsys, filename)
+		if err != nil {
+			logger.Error().Ctx(ctx).
+				Err(err).
+				Uint64("track_id", uint64(song.TrackID)).
+				Str("filename", filename).
+				Msg("failed to read lyrics")
+			continue
+		}
+		if lyrics == "" && notes == "" {
+			continue
+		}
+
+		err = ls.Update(radio.TrackLyrics{
+			TrackID:   song.TrackID,
+			Lyrics:    lyrics,
+			Notes:     notes,
+			UpdatedBy: lyricsImportUser,
+		})
+		if err != nil {
+			logger.Error().Ctx(ctx).Err(err).Uint64("track_id", uint64(song.TrackID)).Msg("failed to store lyrics")
+			continue
+		}
+		imported++
+	}
+
+	logger.Info().Ctx(ctx).Int("amount", imported).Msg("imported lyrics")
+	return nil
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/recommend"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/rs/zerolog"
+)
+
+// recommendRequestHistory is how far back we look at requests
+const recommendRequestHistory = time.Hour * 24 * 365
+
+// ExecuteRecommend recomputes the similarity between tracks from favorites and
+// listener request history
+func ExecuteRecommend(ctx context.Context, cfg config.Config) error {
+	logger := zerolog.Ctx(ctx)
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	rs := store.Recommendation(ctx)
+
+	favs, err := rs.Favorites()
+	if err != nil {
+		return err
+	}
+
+	reqs, err := rs.Requests(time.Now().Add(-recommendRequestHistory))
+	if err != nil {
+		return err
+	}
+
+	sims := recommend.Compute(favs, reqs, recommend.DefaultOptions)
+
+	err = rs.Replace(sims)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Ctx(ctx).
+		Int("favorites", len(favs)).
+		Int("requests", len(reqs)).
+		Int("similarities", len(sims)).
+		Msg("recommend: computed track similarity")
+	return nil
+}
@@ -0,0 +1,406 @@
+// Package library implements an integrity scanner that compares the music
+// directory with the tracks in the database
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/rs/zerolog"
+	"github.com/spf13/afero"
+)
+
+const (
+	// PendingDir is the directory in the music path that holds submissions
+	PendingDir = "pending"
+	// OrphanDir is the directory in the music path that orphaned files are
+	// moved to by the orphan fix
+	OrphanDir = "orphans"
+)
+
+// Kind is the kind of problem found
+type Kind string
+
+const (
+	// KindMissingFile is a track with a FilePath that doesn't exist
+	KindMissingFile Kind = "missing_file"
+	// KindOrphanFile is a file in the music path that has no track
+	KindOrphanFile Kind = "orphan_file"
+	// KindTagMismatch is a track with file tags that differ from the database
+	KindTagMismatch Kind = "tag_mismatch"
+	// KindUnknownLength is a track without a length
+	KindUnknownLength Kind = "unknown_length"
+	// KindHashDrift is a track with a stored hash that differs from the hash
+	// of its metadata
+	KindHashDrift Kind = "hash_drift"
+)
+
+// Options selects which fixes are applied, everything is only reported by default
+type Options struct {
+	// FixMissing marks tracks with a missing file as unusable and needing replacement
+	FixMissing bool
+	// FixOrphans moves orphaned files into OrphanDir
+	FixOrphans bool
+	// FixTags writes the metadata from the database into the file
+	FixTags bool
+	// FixLength probes the file for its length and stores it
+	FixLength bool
+	// FixHash updates the stored hash to the hash of the metadata
+	FixHash bool
+}
+
+// Finding is a single problem found by the scanner
+type Finding struct {
+	Kind    Kind          `json:"kind"`
+	TrackID radio.TrackID `json:"track_id,omitempty"`
+	Path    string        `json:"path"`
+	Detail  string        `json:"detail,omitempty"`
+	// Fixed is true if a fix was applied
+	Fixed    bool   `json:"fixed"`
+	FixError string `json:"fix_error,omitempty"`
+}
+
+// Report is the machine-readable result of a scan
+type Report struct {
+	Started  time.Time    `json:"started"`
+	Finished time.Time    `json:"finished"`
+	Tracks   int          `json:"tracks"`
+	Files    int          `json:"files"`
+	Counts   map[Kind]int `json:"counts"`
+	Findings []Finding    `json:"findings"`
+	Options  Options      `json:"options"`
+}
+
+func (r *Report) add(f Finding) {
+	r.Counts[f.Kind]++
+	r.Findings = append(r.Findings, f)
+}
+
+// WriteJSON writes the report as JSON to w
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(r)
+}
+
+// Scanner compares the music path with the tracks in storage
+type Scanner struct {
+	storage   radio.StorageService
+	fs        afero.Fs
+	musicPath string
+	opts      Options
+
+	// these are swapped out in tests
+	probe         func(ctx context.Context, filename string) (*audio.Info, error)
+	probeDuration func(ctx context.Context, filename string) (time.Duration, error)
+	writeTags     func(ctx context.Context, fs afero.Fs, filename string, song radio.Song) error
+}
+
+// NewScanner returns a Scanner that checks musicPath against the tracks in storage
+func NewScanner(storage radio.StorageService, fs afero.Fs, musicPath string, opts Options) *Scanner {
+	return &Scanner{
+		storage:       storage,
+		fs:            fs,
+		musicPath:     musicPath,
+		opts:          opts,
+		probe:         audio.ProbeText,
+		probeDuration: audio.ProbeDuration,
+		writeTags:     writeTags,
+	}
+}
+
+// Scan runs the scan and applies any fixes enabled
+func (s *Scanner) Scan(ctx context.Context) (*Report, error) {
+	const op errors.Op = "library.Scanner.Scan"
+
+	report := &Report{
+		Started: time.Now(),
+		Counts:  make(map[Kind]int),
+		Options: s.opts,
+	}
+
+	ts := s.storage.Track(ctx)
+	tracks, err := ts.All()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	report.Tracks = len(tracks)
+
+	// All recalculates the hash, so get the hashes as stored separately
+	raw, err := ts.AllRaw()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	stored := make(map[radio.TrackID]radio.SongHash, len(raw))
+	for _, song := range raw {
+		if song.HasTrack() {
+			stored[song.TrackID] = song.Hash
+		}
+	}
+
+	// all files referenced by tracks
+	known := make(map[string]struct{}, len(tracks))
+
+	for _, song := range tracks {
+		if err := ctx.Err(); err != nil {
+			return report, errors.E(op, err)
+		}
+		if !song.HasTrack() {
+			continue
+		}
+		if f, ok := s.checkHash(ctx, song, stored[song.TrackID]); ok {
+			report.add(f)
+		}
+		if song.FilePath == "" {
+			continue
+		}
+
+		path := util.AbsolutePath(s.musicPath, song.FilePath)
+		known[filepath.Clean(path)] = struct{}{}
+
+		_, err := s.fs.Stat(path)
+		if err != nil {
+			if !errors.IsE(err, fs.ErrNotExist) {
+				return report, errors.E(op, err)
+			}
+			report.add(s.fixMissing(ctx, song, path))
+			// nothing else to check if we have no file
+			continue
+		}
+
+		if f, ok := s.checkTags(ctx, song, path); ok {
+			report.add(f)
+		}
+		if song.Length == 0 {
+			report.add(s.fixLength(ctx, song, path))
+		}
+	}
+
+	err = afero.Walk(s.fs, s.musicPath, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == filepath.Join(s.musicPath, PendingDir) || path == filepath.Join(s.musicPath, OrphanDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		report.Files++
+		if _, ok := known[filepath.Clean(path)]; !ok {
+			report.add(s.fixOrphan(ctx, path))
+		}
+		return nil
+	})
+	if err != nil {
+		return report, errors.E(op, err)
+	}
+
+	report.Finished = time.Now()
+	return report, nil
+}
+
+// fix logs the finding given and runs fn if enabled is true
+func fix(ctx context.Context, f Finding, enabled bool, fn func() error) Finding {
+	zerolog.Ctx(ctx).Info().Ctx(ctx).
+		Str("kind", string(f.Kind)).
+		Uint64("track_id", uint64(f.TrackID)).
+		Str("path", f.Path).
+		Str("detail", f.Detail).
+		Msg("finding")
+
+	if !enabled {
+		return f
+	}
+
+	if err := fn(); err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).
+			Str("kind", string(f.Kind)).
+			Str("path", f.Path).
+			Msg("failed to fix")
+		f.FixError = err.Error()
+		return f
+	}
+	f.Fixed = true
+	return f
+}
+
+func (s *Scanner) fixMissing(ctx context.Context, song radio.Song, path string) Finding {
+	f := Finding{
+		Kind:    KindMissingFile,
+		TrackID: song.TrackID,
+		Path:    path,
+	}
+
+	return fix(ctx, f, s.opts.FixMissing, func() error {
+		ts := s.storage.Track(ctx)
+
+		err := ts.UpdateUsable(song, radio.TrackStateUnverified)
+		if err != nil {
+			return err
+		}
+
+		song.NeedReplacement = true
+		return ts.UpdateMetadata(song)
+	})
+}
+
+func (s *Scanner) fixOrphan(ctx context.Context, path string) Finding {
+	f := Finding{
+		Kind: KindOrphanFile,
+		Path: path,
+	}
+
+	return fix(ctx, f, s.opts.FixOrphans, func() error {
+		rel, err := filepath.Rel(s.musicPath, path)
+		if err != nil {
+			return err
+		}
+
+		dst := filepath.Join(s.musicPath, OrphanDir, rel)
+		if err = s.fs.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		return s.fs.Rename(path, dst)
+	})
+}
+
+// checkTags compares the tags of the file with the track, ok is false if
+// they are the same
+func (s *Scanner) checkTags(ctx context.Context, song radio.Song, path string) (f Finding, ok bool) {
+	f = Finding{
+		Kind:    KindTagMismatch,
+		TrackID: song.TrackID,
+		Path:    path,
+	}
+
+	info, err := s.probe(ctx, path)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("path", path).Msg("failed to probe file")
+		return f, false
+	}
+
+	var diff []string
+	compare := func(field, db, file string) {
+		if strings.TrimSpace(db) != strings.TrimSpace(file) {
+			diff = append(diff, fmt.Sprintf("%s: %q != %q", field, db, file))
+		}
+	}
+	compare("artist", song.Artist, info.Artist)
+	compare("title", song.Title, info.Title)
+	compare("album", song.Album, info.Album)
+	if len(diff) == 0 {
+		return f, false
+	}
+	f.Detail = strings.Join(diff, ", ")
+
+	return fix(ctx, f, s.opts.FixTags, func() error {
+		return s.writeTags(ctx, s.fs, path, song)
+	}), true
+}
+
+// checkHash compares the stored hash of the track with the hash of its
+// metadata, ok is false if they are the same
+func (s *Scanner) checkHash(ctx context.Context, song radio.Song, current radio.SongHash) (f Finding, ok bool) {
+	f = Finding{
+		Kind:    KindHashDrift,
+		TrackID: song.TrackID,
+		Path:    util.AbsolutePath(s.musicPath, song.FilePath),
+	}
+
+	expected := radio.NewSongHash(radio.Metadata(song.Artist, song.Title))
+	if current.IsZero() || current == expected {
+		return f, false
+	}
+	f.Detail = fmt.Sprintf("stored %s != %s", current, expected)
+
+	return fix(ctx, f, s.opts.FixHash, func() error {
+		// UpdateMetadata recalculates the hash and compares it to the one we
+		// give it, so restore the stored one to make it aware of the change
+		song.Hash = current
+		return s.storage.Track(ctx).UpdateMetadata(song)
+	}), true
+}
+
+func (s *Scanner) fixLength(ctx context.Context, song radio.Song, path string) Finding {
+	f := Finding{
+		Kind:    KindUnknownLength,
+		TrackID: song.TrackID,
+		Path:    path,
+	}
+
+	return fix(ctx, f, s.opts.FixLength, func() error {
+		length, err := s.probeDuration(ctx, path)
+		if err != nil {
+			return err
+		}
+		if length == 0 {
+			return errors.E(errors.InvalidArgument, errors.Info("file has no duration"))
+		}
+
+		ss := s.storage.Song(ctx)
+		if song.ID == 0 {
+			// the length is stored on the song entry, which might not exist yet
+			// if the track was never played
+			created, err := ss.Create(song)
+			if err != nil {
+				return err
+			}
+			song.ID = created.ID
+		}
+		return ss.UpdateLength(song, length)
+	})
+}
+
+// writeTags writes the metadata of song into the file at filename, the file is
+// replaced atomically
+func writeTags(ctx context.Context, fs afero.Fs, filename string, song radio.Song) error {
+	const op errors.Op = "library.writeTags"
+
+	f, err := fs.Open(filename)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer f.Close()
+
+	mb, err := audio.WriteMetadata(ctx, f, song)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer mb.Close()
+
+	// write to a temporary file next to the original and then swap them
+	tmp := filename + ".tmp"
+	out, err := fs.Create(tmp)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	_, err = io.Copy(out, mb)
+	if err != nil {
+		out.Close()
+		fs.Remove(tmp)
+		return errors.E(op, err)
+	}
+	if err = out.Close(); err != nil {
+		fs.Remove(tmp)
+		return errors.E(op, err)
+	}
+
+	if err = fs.Rename(tmp, filename); err != nil {
+		fs.Remove(tmp)
+		return errors.E(op, err)
+	}
+	return nil
+}
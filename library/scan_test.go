@@ -0,0 +1,156 @@
+package library
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSong(id radio.TrackID, path string, length time.Duration) radio.Song {
+	return radio.Song{
+		ID:     radio.SongID(id),
+		Length: length,
+		DatabaseTrack: &radio.DatabaseTrack{
+			TrackID:  id,
+			Artist:   "artist",
+			Title:    "title",
+			FilePath: path,
+		},
+	}
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+	const musicPath = "/music"
+
+	fs := afero.NewMemMapFs()
+	for _, name := range []string{
+		"1_ok.mp3",
+		"2_badtags.mp3",
+		"3_nolength.mp3",
+		"orphan.mp3",
+		"sub/orphan.flac",
+		"pending/submission.mp3",
+	} {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(musicPath, name), []byte(name), 0644))
+	}
+
+	tracks := &mocks.TrackStorageMock{
+		AllFunc: func() ([]radio.Song, error) {
+			return []radio.Song{
+				testSong(1, "1_ok.mp3", time.Minute),
+				testSong(2, "2_badtags.mp3", time.Minute),
+				testSong(3, "3_nolength.mp3", 0),
+				testSong(4, "4_missing.mp3", time.Minute),
+			}, nil
+		},
+		AllRawFunc: func() ([]radio.Song, error) {
+			var res []radio.Song
+			for id := radio.TrackID(1); id <= 4; id++ {
+				song := testSong(id, "", 0)
+				song.Hash = radio.NewSongHash(radio.Metadata(song.Artist, song.Title))
+				if id == 1 {
+					// stored before the artist was renamed
+					song.Hash = radio.NewSongHash(radio.Metadata("old artist", song.Title))
+				}
+				res = append(res, song)
+			}
+			return res, nil
+		},
+		UpdateUsableFunc: func(song radio.Song, state radio.TrackState) error {
+			return nil
+		},
+		UpdateMetadataFunc: func(song radio.Song) error {
+			return nil
+		},
+	}
+	songs := &mocks.SongStorageMock{
+		UpdateLengthFunc: func(song radio.Song, duration time.Duration) error {
+			return nil
+		},
+	}
+	storage := &mocks.StorageServiceMock{
+		TrackFunc: func(contextMoqParam context.Context) radio.TrackStorage {
+			return tracks
+		},
+		SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
+			return songs
+		},
+	}
+
+	newScanner := func(opts Options) *Scanner {
+		s := NewScanner(storage, fs, musicPath, opts)
+		s.probe = func(ctx context.Context, filename string) (*audio.Info, error) {
+			if filepath.Base(filename) == "2_badtags.mp3" {
+				return &audio.Info{Artist: "someone else", Title: "title"}, nil
+			}
+			return &audio.Info{Artist: "artist", Title: "title"}, nil
+		}
+		s.probeDuration = func(ctx context.Context, filename string) (time.Duration, error) {
+			return time.Minute * 4, nil
+		}
+		s.writeTags = func(ctx context.Context, fs afero.Fs, filename string, song radio.Song) error {
+			return nil
+		}
+		return s
+	}
+
+	t.Run("report only", func(t *testing.T) {
+		report, err := newScanner(Options{}).Scan(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, report.Tracks)
+		assert.Equal(t, 5, report.Files, "pending should be skipped")
+		assert.Equal(t, 1, report.Counts[KindMissingFile])
+		assert.Equal(t, 2, report.Counts[KindOrphanFile])
+		assert.Equal(t, 1, report.Counts[KindTagMismatch])
+		assert.Equal(t, 1, report.Counts[KindUnknownLength])
+		assert.Equal(t, 1, report.Counts[KindHashDrift])
+		for _, f := range report.Findings {
+			assert.False(t, f.Fixed)
+		}
+		assert.Empty(t, tracks.UpdateUsableCalls())
+		assert.Empty(t, songs.UpdateLengthCalls())
+	})
+
+	t.Run("fix", func(t *testing.T) {
+		report, err := newScanner(Options{
+			FixMissing: true,
+			FixOrphans: true,
+			FixTags:    true,
+			FixLength:  true,
+			FixHash:    true,
+		}).Scan(ctx)
+		require.NoError(t, err)
+
+		for _, f := range report.Findings {
+			assert.True(t, f.Fixed, "finding should be fixed: %v", f)
+		}
+
+		calls := tracks.UpdateMetadataCalls()
+		require.Len(t, calls, 2)
+		// the hash fix passes the stored hash so the update notices the change
+		assert.Equal(t, radio.TrackID(1), calls[0].Song.TrackID)
+		assert.Equal(t, radio.NewSongHash(radio.Metadata("old artist", "title")), calls[0].Song.Hash)
+		assert.True(t, calls[1].Song.NeedReplacement)
+		require.Len(t, songs.UpdateLengthCalls(), 1)
+		assert.Equal(t, time.Minute*4, songs.UpdateLengthCalls()[0].Duration)
+
+		ok, err := afero.Exists(fs, filepath.Join(musicPath, OrphanDir, "sub/orphan.flac"))
+		require.NoError(t, err)
+		assert.True(t, ok, "orphan should be moved")
+
+		// orphans should now be gone
+		report, err = newScanner(Options{}).Scan(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, report.Counts[KindOrphanFile])
+	})
+}
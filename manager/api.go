@@ -5,6 +5,7 @@ import (
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/rpc"
 	"github.com/R-a-dio/valkyrie/util/eventstream"
@@ -14,8 +15,12 @@ import (
 )
 
 // NewGRPCServer sets up a net/http server ready to serve RPC requests
-func NewGRPCServer(ctx context.Context, m *Manager, g *GuestService) (*grpc.Server, error) {
-	gs := rpc.NewGrpcServer(ctx)
+func NewGRPCServer(ctx context.Context, cfg config.Config, m *Manager, g *GuestService) (*grpc.Server, error) {
+	opts, err := cfg.RPCServerOptions(config.RPCManager)
+	if err != nil {
+		return nil, err
+	}
+	gs := rpc.NewGrpcServer(ctx, opts...)
 	rpc.RegisterManagerServer(gs, rpc.NewManager(m))
 	rpc.RegisterGuestServer(gs, rpc.NewGuest(g))
 
@@ -56,6 +61,14 @@ func (m *Manager) UpdateUser(ctx context.Context, u *radio.User) error {
 	_, span := otel.Tracer("").Start(ctx, string(op))
 	defer span.End()
 
+	leader, err := m.leader()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if leader != nil {
+		return leader.UpdateUser(ctx, u)
+	}
+
 	// update the user from storage here, this is a temporary fix until the
 	// new guest system is introduced since this really just works around a
 	// small update issue with the users display name
@@ -72,6 +85,7 @@ func (m *Manager) UpdateUser(ctx context.Context, u *radio.User) error {
 		}
 	}
 
+	m.journal(ctx, radio.JournalUser, u)
 	m.userStream.Send(u)
 	if u != nil {
 		m.logger.Info().Ctx(ctx).Str("username", u.Username).Msg("updating stream user")
@@ -87,6 +101,14 @@ func (m *Manager) UpdateSong(ctx context.Context, su *radio.SongUpdate) error {
 	ctx, span := otel.Tracer("").Start(ctx, string(op))
 	defer span.End()
 
+	leader, err := m.leader()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if leader != nil {
+		return leader.UpdateSong(ctx, su)
+	}
+
 	if su == nil {
 		m.logger.Error().Ctx(ctx).Msg("received nil SongUpdate")
 		return errors.E(op, errors.InvalidArgument, "received nil SongUpdate")
@@ -170,7 +192,9 @@ func (m *Manager) UpdateSong(ctx context.Context, su *radio.SongUpdate) error {
 	}
 
 	m.logger.Info().Ctx(ctx).Str("metadata", song.Metadata).Dur("song_length", song.Length).Msg("updating stream song")
-	m.songStream.Send(&radio.SongUpdate{Song: *song, Info: info})
+	update := &radio.SongUpdate{Song: *song, Info: info}
+	m.journal(ctx, radio.JournalSong, update)
+	m.songStream.Send(update)
 	return nil
 }
 
@@ -180,6 +204,15 @@ func (m *Manager) UpdateThread(ctx context.Context, thread radio.Thread) error {
 	_, span := otel.Tracer("").Start(ctx, string(op))
 	defer span.End()
 
+	leader, err := m.leader()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if leader != nil {
+		return leader.UpdateThread(ctx, thread)
+	}
+
+	m.journal(ctx, radio.JournalThread, thread)
 	m.threadStream.Send(thread)
 	return nil
 }
@@ -190,6 +223,15 @@ func (m *Manager) UpdateListeners(ctx context.Context, listeners radio.Listeners
 	_, span := otel.Tracer("").Start(ctx, string(op))
 	defer span.End()
 
+	leader, err := m.leader()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if leader != nil {
+		return leader.UpdateListeners(ctx, listeners)
+	}
+
+	m.journal(ctx, radio.JournalListeners, listeners)
 	m.listenerStream.Send(listeners)
 	return nil
 }
@@ -260,6 +302,14 @@ func (m *Manager) UpdateFromStorage(ctx context.Context) error {
 	ctx, span := otel.Tracer("").Start(ctx, string(op))
 	defer span.End()
 
+	leader, err := m.leader()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if leader != nil {
+		return leader.UpdateFromStorage(ctx)
+	}
+
 	m.updateUserFromStorage(ctx)
 	m.updateSongFromStorage(ctx)
 
@@ -337,7 +387,9 @@ func (m *Manager) runStatusUpdates(ctx context.Context, ready chan struct{}) {
 			zerolog.Ctx(ctx).Info().Ctx(ctx).Any("song", su).Msg("running status update")
 			m.mu.Lock()
 
-			if su == nil || !m.status.Song.EqualTo(su.Song) {
+			// only the leader records plays, the other replicas would
+			// just be recording the same play again
+			if (su == nil || !m.status.Song.EqualTo(su.Song)) && m.isLeader() {
 				err := m.finishSong(ctx, m.status, songStartListenerCount, songStartUser)
 				if err != nil {
 					zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed finishSong")
@@ -366,7 +418,9 @@ func (m *Manager) runStatusUpdates(ctx context.Context, ready chan struct{}) {
 			m.statusStream.Send(m.status)
 		}
 		// and a copy to the persistent storage
-		m.updateStreamStatus(m.status)
+		if m.isLeader() {
+			m.updateStreamStatus(m.status)
+		}
 		m.mu.Unlock()
 	}
 }
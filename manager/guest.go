@@ -18,8 +18,10 @@ import (
 const (
 	GUEST_PASSWORD_LENGTH  = 20
 	GUEST_EXPIRE_LOOP_TICK = time.Minute * 5
-	GUEST_THREAD_LIMIT     = 3
-	GUEST_KILL_LIMIT       = 3
+	// GUEST_THREAD_LIMIT and GUEST_KILL_LIMIT are the limits used for guests
+	// authorized from irc, guests from an invite use the limits of the invite
+	GUEST_THREAD_LIMIT = 3
+	GUEST_KILL_LIMIT   = 3
 )
 
 var _ radio.GuestService = &GuestService{}
@@ -27,7 +29,9 @@ var _ radio.GuestService = &GuestService{}
 type GuestService struct {
 	logger       *zerolog.Logger
 	us           radio.UserStorageService
+	gst          radio.GuestStorageService
 	proxyAddress func() string
+	authPeriod   func() time.Duration
 
 	mu         sync.Mutex
 	Authorized map[GuestNick]*Guest
@@ -44,12 +48,13 @@ func resolveGuestProxyAddr(ctx context.Context, uri *url.URL) (string, error) {
 	return addrs[0].String(), nil
 }
 
-func NewGuestService(ctx context.Context, cfg config.Config, m radio.ManagerService, us radio.UserStorageService) (*GuestService, error) {
+func NewGuestService(ctx context.Context, cfg config.Config, m radio.ManagerService, us radio.UserStorageService, gst radio.GuestStorageService) (*GuestService, error) {
 	const op errors.Op = "manager/NewGuestService"
 
 	gs := &GuestService{
 		logger: zerolog.Ctx(ctx),
 		us:     us,
+		gst:    gst,
 		proxyAddress: config.Value(cfg, func(cfg config.Config) string {
 			addr, err := resolveGuestProxyAddr(ctx, cfg.Conf().Manager.GuestProxyAddr.URL())
 			if err != nil {
@@ -58,6 +63,9 @@ func NewGuestService(ctx context.Context, cfg config.Config, m radio.ManagerServ
 			}
 			return addr
 		}),
+		authPeriod: config.Value(cfg, func(cfg config.Config) time.Duration {
+			return time.Duration(cfg.Conf().Manager.GuestAuthPeriod)
+		}),
 		Authorized: map[GuestNick]*Guest{},
 	}
 
@@ -65,6 +73,11 @@ func NewGuestService(ctx context.Context, cfg config.Config, m radio.ManagerServ
 		return nil, errors.E(op, "Manager.GuestProxyAddr is not configured (correctly)")
 	}
 
+	// restore the guests that were authorized before we restarted
+	if err := gs.loadAuths(ctx); err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	util.StreamValue(ctx, m.CurrentUser, func(ctx context.Context, user *radio.User) {
 		// nothing to do if the user got unset completely
 		if user == nil {
@@ -77,18 +90,70 @@ func NewGuestService(ctx context.Context, cfg config.Config, m radio.ManagerServ
 		defer gs.mu.Unlock()
 
 		for _, guest := range gs.Authorized {
-			if guest.User.ID != user.ID {
+			if guest.User.ID != user.ID || guest.HasStreamed {
 				continue
 			}
 			guest.HasStreamed = true
+			gs.saveAuth(ctx, guest)
 		}
 	})
 
 	// run a goroutine to expire users periodically
-	go gs.loopExpire(ctx, time.Duration(cfg.Conf().Manager.GuestAuthPeriod))
+	go gs.loopExpire(ctx)
 	return gs, nil
 }
 
+// loadAuths loads the authorizations from storage, any that already ended are
+// removed instead
+func (gs *GuestService) loadAuths(ctx context.Context) error {
+	const op errors.Op = "manager/GuestService.loadAuths"
+
+	auths, err := gs.gst.Guest(ctx).Auths()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	now := time.Now()
+	for _, auth := range auths {
+		if !now.Before(auth.End) {
+			gs.deleteAuth(ctx, auth.Nick)
+			continue
+		}
+
+		user, err := gs.getUser(ctx, radio.NickToUsername(auth.Nick))
+		if err != nil {
+			gs.logger.Error().Ctx(ctx).Err(err).Str("nick", auth.Nick).Msg("failed to restore guest")
+			continue
+		}
+
+		gs.Authorized[auth.Nick] = &Guest{
+			GuestAuth: auth,
+			User:      user,
+		}
+	}
+	return nil
+}
+
+// saveAuth persists the authorization of the guest given, errors are logged
+// since the guest is still authorized until we restart
+func (gs *GuestService) saveAuth(ctx context.Context, guest *Guest) {
+	err := gs.gst.Guest(ctx).SaveAuth(guest.GuestAuth)
+	if err != nil {
+		gs.logger.Error().Ctx(ctx).Err(err).Str("nick", guest.Nick).Msg("failed to save guest authorization")
+	}
+}
+
+// deleteAuth removes the persisted authorization of the nick given
+func (gs *GuestService) deleteAuth(ctx context.Context, nick GuestNick) {
+	err := gs.gst.Guest(ctx).DeleteAuth(nick)
+	if err != nil {
+		gs.logger.Error().Ctx(ctx).Err(err).Str("nick", nick).Msg("failed to delete guest authorization")
+	}
+}
+
 func (gs *GuestService) getUser(ctx context.Context, username string) (*radio.User, error) {
 	const op errors.Op = "manager/GuestService.getOrCreateUser"
 	user, err := gs.us.User(ctx).Get(username)
@@ -192,32 +257,119 @@ func (gs *GuestService) Auth(ctx context.Context, nick GuestNick) (*radio.User,
 
 	gs.logger.Info().Ctx(ctx).Str("nick", nick).Msg("guest auth request")
 
-	user, err := gs.getUser(ctx, radio.NickToUsername(nick))
+	user, err := gs.prepareUser(ctx, nick)
 	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	now := time.Now()
+	gs.authorize(ctx, user, radio.GuestAuth{
+		Nick:        nick,
+		UserID:      user.ID,
+		Start:       now,
+		End:         now.Add(gs.authPeriod()),
+		KillLimit:   GUEST_KILL_LIMIT,
+		ThreadLimit: GUEST_THREAD_LIMIT,
+	})
+
+	return user, nil
+}
+
+// Redeem redeems the invite with the code given and authorizes the nick for
+// the window of the invite, the guest user is created if it doesn't exist yet
+func (gs *GuestService) Redeem(ctx context.Context, code string, nick GuestNick) (*radio.User, error) {
+	const op errors.Op = "manager/GuestService.Redeem"
+	nick = strings.ToLower(nick)
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gs.logger.Info().Ctx(ctx).Str("nick", nick).Msg("guest redeem request")
+
+	// get the user before redeeming so that a broken user storage doesn't
+	// use up the invite, a missing user is created after redeeming so that
+	// an invalid code doesn't leave an unused user around
+	user, err := gs.getUser(ctx, radio.NickToUsername(nick))
+	if err != nil && !errors.Is(errors.UserUnknown, err) {
 		gs.logger.Error().Ctx(ctx).Err(err).Msg("failed to getUser")
 		return nil, errors.E(op, err)
 	}
 
-	// check if the user IP is still up-to-date, this should always be set to
-	// the guest proxy server
-	if user.IP != gs.proxyAddress() {
-		err := gs.updateUserIP(ctx, user, gs.proxyAddress())
+	invite, err := gs.gst.Guest(ctx).RedeemInvite(code, nick)
+	if err != nil {
+		gs.logger.Error().Ctx(ctx).Err(err).Str("nick", nick).Msg("failed to redeem invite")
+		return nil, errors.E(op, err)
+	}
+
+	if user == nil {
+		// nobody gets to see this password, the website starts a session for
+		// the guest after redeeming and they can make an api token from there
+		passwd, err := radio.GenerateRandomPassword(GUEST_PASSWORD_LENGTH)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		user, err = gs.createUser(ctx, radio.NickToUsername(nick), passwd)
 		if err != nil {
-			gs.logger.Error().Ctx(ctx).Err(err).Msg("failed to set guest users IP address")
-		} else {
-			user.IP = gs.proxyAddress()
+			gs.logger.Error().Ctx(ctx).Err(err).Str("nick", nick).Msg("failed to createUser")
+			return nil, errors.E(op, err)
 		}
 	}
+	gs.updateProxyIP(ctx, user)
+
+	gs.authorize(ctx, user, radio.GuestAuth{
+		Nick:        nick,
+		UserID:      user.ID,
+		InviteID:    invite.ID,
+		Start:       invite.Start,
+		End:         invite.End,
+		Mount:       invite.Mount,
+		Priority:    invite.Priority,
+		KillLimit:   invite.KillLimit,
+		ThreadLimit: invite.ThreadLimit,
+	})
 
-	gs.Authorized[nick] = &Guest{
-		Nick:     nick,
-		User:     user,
-		AuthTime: time.Now(),
+	return user, nil
+}
+
+// prepareUser returns the guest user of nick and makes sure the IP of it is
+// up-to-date, gs.mu should be held by the caller
+func (gs *GuestService) prepareUser(ctx context.Context, nick GuestNick) (*radio.User, error) {
+	user, err := gs.getUser(ctx, radio.NickToUsername(nick))
+	if err != nil {
+		gs.logger.Error().Ctx(ctx).Err(err).Msg("failed to getUser")
+		return nil, err
 	}
 
+	gs.updateProxyIP(ctx, user)
 	return user, nil
 }
 
+// updateProxyIP makes sure the IP of the user is set to the guest proxy
+// server, gs.mu should be held by the caller
+func (gs *GuestService) updateProxyIP(ctx context.Context, user *radio.User) {
+	if user.IP == gs.proxyAddress() {
+		return
+	}
+
+	err := gs.updateUserIP(ctx, user, gs.proxyAddress())
+	if err != nil {
+		gs.logger.Error().Ctx(ctx).Err(err).Msg("failed to set guest users IP address")
+		return
+	}
+	user.IP = gs.proxyAddress()
+}
+
+// authorize adds the guest to the authorization list, gs.mu should be held
+// by the caller
+func (gs *GuestService) authorize(ctx context.Context, user *radio.User, auth radio.GuestAuth) {
+	guest := &Guest{
+		GuestAuth: auth,
+		User:      user,
+	}
+	gs.Authorized[auth.Nick] = guest
+	gs.saveAuth(ctx, guest)
+}
+
 func (gs *GuestService) Deauth(ctx context.Context, nick GuestNick) error {
 	nick = strings.ToLower(nick)
 
@@ -234,6 +386,7 @@ func (gs *GuestService) Deauth(ctx context.Context, nick GuestNick) error {
 
 	// remove the nick from the authorization list
 	delete(gs.Authorized, nick)
+	gs.deleteAuth(ctx, nick)
 
 	return nil
 }
@@ -259,6 +412,12 @@ func (gs *GuestService) canDo(ctx context.Context, nick GuestNick, action radio.
 		return false, nil
 	}
 
+	// guests can't do anything outside of the window they're authorized for
+	if !guest.IsActive(time.Now()) {
+		gs.logger.Info().Ctx(ctx).Str("nick", nick).Any("action", action).Msg("denying because outside of authorized window")
+		return false, nil
+	}
+
 	// some actions are limited per auth
 	switch action {
 	case radio.GuestKill:
@@ -268,66 +427,62 @@ func (gs *GuestService) canDo(ctx context.Context, nick GuestNick, action radio.
 			return false, nil
 		}
 		// guests can't kill if they hit the kill limit
-		if guest.KillAttempts >= GUEST_KILL_LIMIT {
-			gs.logger.Info().Ctx(ctx).Str("nick", nick).Any("action", action).Int("limit", GUEST_KILL_LIMIT).Msg("denying because guest.KillAttempts>=limit")
+		if guest.KillAttempts >= guest.KillLimit {
+			gs.logger.Info().Ctx(ctx).Str("nick", nick).Any("action", action).Int("limit", guest.KillLimit).Msg("denying because guest.KillAttempts>=limit")
 			return false, nil
 		}
 		if increment {
 			guest.KillAttempts++
+			gs.saveAuth(ctx, guest)
 		}
 	case radio.GuestThread:
 		// guests can't set the thread if they've done it too many times
-		if guest.ThreadSets >= GUEST_THREAD_LIMIT {
-			gs.logger.Info().Ctx(ctx).Str("nick", nick).Any("action", action).Int("limit", GUEST_THREAD_LIMIT).Msg("denying because guest.ThreadSets>=limit")
+		if guest.ThreadSets >= guest.ThreadLimit {
+			gs.logger.Info().Ctx(ctx).Str("nick", nick).Any("action", action).Int("limit", guest.ThreadLimit).Msg("denying because guest.ThreadSets>=limit")
 			return false, nil
 		}
 		if increment {
 			guest.ThreadSets++
+			gs.saveAuth(ctx, guest)
 		}
 	}
 	return true, nil
 }
 
-func (gs *GuestService) loopExpire(ctx context.Context, timeout time.Duration) {
+func (gs *GuestService) loopExpire(ctx context.Context) {
 	ticker := time.NewTicker(GUEST_EXPIRE_LOOP_TICK)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
-			gs.doExpire(timeout)
+			gs.doExpire(ctx, time.Now())
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (gs *GuestService) doExpire(timeout time.Duration) {
+// doExpire removes all guests whose authorized window ended before now
+func (gs *GuestService) doExpire(ctx context.Context, now time.Time) {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
 
 	for nick, guest := range gs.Authorized {
-		if time.Since(guest.AuthTime) < timeout {
+		if now.Before(guest.End) {
 			continue
 		}
 
 		delete(gs.Authorized, nick)
+		gs.deleteAuth(ctx, nick)
 	}
 }
 
 type GuestNick = string
 
 type Guest struct {
-	Nick GuestNick
+	// GuestAuth is the authorization of the guest, this is what gets persisted
+	radio.GuestAuth
 
 	User *radio.User
-	// AuthTime is the time this guest got authorized
-	AuthTime time.Time
-
-	// HasStreamed indicates if this guest has been "live" on the stream
-	HasStreamed bool
-	// ThreadSets is the amount of times this guest has used their .thread privilege
-	ThreadSets int
-	// KillAttempts is the amount of times this guest has used their .kill privilege
-	KillAttempts int
 }
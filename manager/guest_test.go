@@ -3,17 +3,70 @@ package manager
 import (
 	"context"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/mocks"
 	"github.com/R-a-dio/valkyrie/util/eventstream"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// newGuestStorage returns a GuestStorageService that keeps everything in memory
+func newGuestStorage(invites ...radio.GuestInvite) *mocks.GuestStorageServiceMock {
+	var mu sync.Mutex
+	auths := map[string]radio.GuestAuth{}
+
+	gs := &mocks.GuestStorageMock{
+		RedeemInviteFunc: func(code, nick string) (*radio.GuestInvite, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			for i, invite := range invites {
+				if invite.Code != code || invite.IsRedeemed() || !time.Now().Before(invite.End) {
+					continue
+				}
+				if invite.Nick != "" && invite.Nick != nick {
+					continue
+				}
+				now := time.Now()
+				invites[i].RedeemedBy, invites[i].RedeemedAt = nick, &now
+				return &invites[i], nil
+			}
+			return nil, errors.E(errors.GuestInviteUnknown)
+		},
+		SaveAuthFunc: func(auth radio.GuestAuth) error {
+			mu.Lock()
+			defer mu.Unlock()
+			auths[auth.Nick] = auth
+			return nil
+		},
+		DeleteAuthFunc: func(nick string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			delete(auths, nick)
+			return nil
+		},
+		AuthsFunc: func() ([]radio.GuestAuth, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			res := make([]radio.GuestAuth, 0, len(auths))
+			for _, auth := range auths {
+				res = append(res, auth)
+			}
+			return res, nil
+		},
+	}
+	return &mocks.GuestStorageServiceMock{
+		GuestFunc: func(contextMoqParam context.Context) radio.GuestStorage {
+			return gs
+		},
+	}
+}
+
 func TestGuestExpire(t *testing.T) {
 	ctx := context.Background()
 	cfg := config.TestConfig()
@@ -42,7 +95,7 @@ func TestGuestExpire(t *testing.T) {
 		},
 	}
 
-	gs, err := NewGuestService(ctx, cfg, m, uss)
+	gs, err := NewGuestService(ctx, cfg, m, uss, newGuestStorage())
 	require.NoError(t, err)
 
 	nick := "test-user"
@@ -55,21 +108,24 @@ func TestGuestExpire(t *testing.T) {
 
 	assert.Len(t, gs.Authorized, 1)
 
-	// do an expire with zero timeout, so basically everyone should expire
-	gs.doExpire(0)
+	// do an expire a day from now, so basically everyone should expire
+	gs.doExpire(ctx, time.Now().Add(time.Hour*24))
 	ok, err = gs.CanDo(ctx, nick, radio.GuestNone)
 	if assert.NoError(t, err) {
 		assert.False(t, ok)
 	}
 	assert.Len(t, gs.Authorized, 0)
 
-	// add a crafted guest user in that got authed an hour ago
+	// add a crafted guest user whose window ended 30 minutes ago
 	gs.Authorized["a-while-ago"] = &Guest{
-		AuthTime: time.Now().Add(-time.Hour),
+		GuestAuth: radio.GuestAuth{
+			Start: time.Now().Add(-time.Hour),
+			End:   time.Now().Add(-time.Minute * 30),
+		},
 	}
 
-	// expire everyone that went past the 30 minutes since
-	gs.doExpire(time.Minute * 30)
+	// expire everyone whose window has ended
+	gs.doExpire(ctx, time.Now())
 
 	assert.Len(t, gs.Authorized, 0)
 }
@@ -101,7 +157,7 @@ func TestGuestCanDo(t *testing.T) {
 		},
 	}
 
-	gs, err := NewGuestService(ctx, cfg, m, uss)
+	gs, err := NewGuestService(ctx, cfg, m, uss, newGuestStorage())
 	require.NoError(t, err)
 
 	nick := "test"
@@ -122,6 +178,81 @@ func TestGuestCanDo(t *testing.T) {
 	}
 }
 
+func TestGuestRedeem(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+	us := &mocks.UserStorageMock{
+		GetFunc: func(name string) (*radio.User, error) {
+			return &radio.User{Username: name}, nil
+		},
+		UpdateFunc: func(user radio.User) (radio.User, error) {
+			return user, nil
+		},
+	}
+	uss := &mocks.UserStorageServiceMock{
+		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+			return us
+		},
+	}
+	m := &mocks.ManagerServiceMock{
+		CurrentUserFunc: func(contextMoqParam context.Context) (eventstream.Stream[*radio.User], error) {
+			return eventstream.NewEventStream[*radio.User](nil).SubStream(contextMoqParam), nil
+		},
+	}
+
+	now := time.Now()
+	gst := newGuestStorage(
+		radio.GuestInvite{ID: 1, Code: "now", Start: now.Add(-time.Minute), End: now.Add(time.Hour), Mount: "/main.mp3", Priority: 5, KillLimit: 1, ThreadLimit: 0},
+		radio.GuestInvite{ID: 2, Code: "later", Start: now.Add(time.Hour), End: now.Add(time.Hour * 2), KillLimit: 1},
+		radio.GuestInvite{ID: 3, Code: "bound", Nick: "someone", Start: now, End: now.Add(time.Hour)},
+	)
+
+	gs, err := NewGuestService(ctx, cfg, m, uss, gst)
+	require.NoError(t, err)
+
+	// an invite can only be redeemed once
+	_, err = gs.Redeem(ctx, "now", "guest")
+	require.NoError(t, err)
+	_, err = gs.Redeem(ctx, "now", "other")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+
+	// limits of the invite should be used
+	ok, err := gs.Do(ctx, "guest", radio.GuestThread)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = gs.Do(ctx, "guest", radio.GuestKill)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = gs.Do(ctx, "guest", radio.GuestKill)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// a window that hasn't started yet doesn't allow anything
+	_, err = gs.Redeem(ctx, "later", "early")
+	require.NoError(t, err)
+	ok, err = gs.CanDo(ctx, "early", radio.GuestNone)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// invites for a specific nick can't be redeemed by others
+	_, err = gs.Redeem(ctx, "bound", "guest")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+	_, err = gs.Redeem(ctx, "bound", "Someone")
+	require.NoError(t, err)
+
+	// the guests should survive a restart
+	restarted, err := NewGuestService(ctx, cfg, m, uss, gst)
+	require.NoError(t, err)
+	assert.Len(t, restarted.Authorized, 3)
+	assert.Equal(t, 1, restarted.Authorized["guest"].KillAttempts)
+	// and keep the mount and priority of their invite
+	assert.Equal(t, "/main.mp3", restarted.Authorized["guest"].Mount)
+	assert.Equal(t, 5, restarted.Authorized["guest"].Priority)
+	ok, err = restarted.Do(ctx, "guest", radio.GuestKill)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
 func TestResolveGuestProxyAddr(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -142,3 +273,67 @@ func mustURL(s string) *url.URL {
 	}
 	return u
 }
+
+func TestGuestRedeemCreatesUser(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+
+	var created []radio.User
+	us := &mocks.UserStorageMock{
+		GetFunc: func(name string) (*radio.User, error) {
+			for _, u := range created {
+				if u.Username == name {
+					return &u, nil
+				}
+			}
+			return nil, errors.E(errors.UserUnknown)
+		},
+		UpdateFunc: func(user radio.User) (radio.User, error) {
+			return user, nil
+		},
+		CreateFunc: func(user radio.User) (radio.UserID, error) {
+			created = append(created, user)
+			return radio.UserID(len(created)), nil
+		},
+		CreateDJFunc: func(user radio.User, dJ radio.DJ) (radio.DJID, error) {
+			return 1, nil
+		},
+	}
+	uss := &mocks.UserStorageServiceMock{
+		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+			return us
+		},
+		UserTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
+			return us, mocks.CommitTx(t), nil
+		},
+	}
+	m := &mocks.ManagerServiceMock{
+		CurrentUserFunc: func(contextMoqParam context.Context) (eventstream.Stream[*radio.User], error) {
+			return eventstream.NewEventStream[*radio.User](nil).SubStream(contextMoqParam), nil
+		},
+	}
+
+	now := time.Now()
+	gst := newGuestStorage(
+		radio.GuestInvite{ID: 1, Code: "now", Start: now.Add(-time.Minute), End: now.Add(time.Hour)},
+	)
+
+	gs, err := NewGuestService(ctx, cfg, m, uss, gst)
+	require.NoError(t, err)
+
+	// an invalid code shouldn't create a user
+	_, err = gs.Redeem(ctx, "invalid", "newguest")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+	assert.Empty(t, created)
+
+	// but a valid one should if the user doesn't exist yet
+	user, err := gs.Redeem(ctx, "now", "NewGuest")
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, radio.NickToUsername("newguest"), user.Username)
+	assert.True(t, radio.IsGuest(*user))
+
+	ok, err := gs.CanDo(ctx, "newguest", radio.GuestNone)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,208 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/rpc"
+	"github.com/rs/zerolog"
+)
+
+// journalBufferSize is the amount of journal entries that can be waiting on
+// storage, entries are dropped if more than this are waiting
+const journalBufferSize = 256
+
+// journalTimeout is how long we wait on storage when appending an entry
+const journalTimeout = time.Second * 2
+
+// journal records an update in the journal, the entry is written in the
+// background so that updates never wait on storage. Failures are only logged
+// since the update itself still happened
+func (m *Manager) journal(ctx context.Context, kind radio.JournalKind, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		m.logger.Error().Ctx(ctx).Err(err).Str("kind", string(kind)).Msg("failed to encode journal entry")
+		return
+	}
+
+	source := rpc.SourceFromContext(ctx)
+	if source == "" {
+		source = "manager"
+	}
+
+	m.journalWriter.Add(ctx, radio.JournalEntry{
+		Kind:   kind,
+		Time:   time.Now(),
+		Source: source,
+		Data:   data,
+	})
+}
+
+// journalWriter appends journal entries to storage in the order they were
+// added from a single goroutine
+type journalWriter struct {
+	logger  *zerolog.Logger
+	storage radio.JournalStorageService
+
+	entries chan radio.JournalEntry
+	// done is closed when run has returned
+	done chan struct{}
+}
+
+func newJournalWriter(ctx context.Context, storage radio.JournalStorageService) *journalWriter {
+	jw := &journalWriter{
+		logger:  zerolog.Ctx(ctx),
+		storage: storage,
+		entries: make(chan radio.JournalEntry, journalBufferSize),
+		done:    make(chan struct{}),
+	}
+	go jw.run(ctx)
+	return jw
+}
+
+// Add queues the entry to be written, it never blocks and drops the entry if
+// too many are waiting already
+func (jw *journalWriter) Add(ctx context.Context, entry radio.JournalEntry) {
+	if jw == nil {
+		return
+	}
+
+	select {
+	case jw.entries <- entry:
+	default:
+		jw.logger.Error().Ctx(ctx).Str("kind", string(entry.Kind)).Msg("journal buffer full, dropping entry")
+	}
+}
+
+// Wait waits for run to return, everything added before ctx was canceled is
+// written by then
+func (jw *journalWriter) Wait() {
+	<-jw.done
+}
+
+func (jw *journalWriter) run(ctx context.Context) {
+	defer close(jw.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			// write what is left before we stop
+			for {
+				select {
+				case entry := <-jw.entries:
+					jw.write(ctx, entry)
+				default:
+					return
+				}
+			}
+		case entry := <-jw.entries:
+			jw.write(ctx, entry)
+		}
+	}
+}
+
+func (jw *journalWriter) write(ctx context.Context, entry radio.JournalEntry) {
+	// entries should still be written when we're stopping
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), journalTimeout)
+	defer cancel()
+
+	err := jw.storage.Journal(ctx).Append(entry)
+	if err != nil {
+		jw.logger.Error().Ctx(ctx).Err(err).Str("kind", string(entry.Kind)).Msg("failed to append journal entry")
+	}
+}
+
+// ApplyJournal applies the journal entry given to status the same way the
+// manager applies the update
+func ApplyJournal(status *radio.Status, entry radio.JournalEntry) error {
+	const op errors.Op = "manager/ApplyJournal"
+
+	var err error
+	switch entry.Kind {
+	case radio.JournalUser:
+		var user *radio.User
+		if err = json.Unmarshal(entry.Data, &user); err != nil {
+			break
+		}
+		status.StreamUser = user
+		if user != nil {
+			status.User = *user
+			status.StreamerName = user.DJ.Name
+		}
+	case radio.JournalSong:
+		var su *radio.SongUpdate
+		if err = json.Unmarshal(entry.Data, &su); err != nil {
+			break
+		}
+		if su != nil {
+			status.Song = su.Song
+			status.SongInfo = su.Info
+		}
+	case radio.JournalThread:
+		err = json.Unmarshal(entry.Data, &status.Thread)
+	case radio.JournalListeners:
+		err = json.Unmarshal(entry.Data, &status.Listeners)
+	default:
+		return errors.E(op, errors.InvalidArgument, errors.Info("unknown journal kind: "+string(entry.Kind)))
+	}
+	if err != nil {
+		return errors.E(op, err, errors.Info(string(entry.Kind)))
+	}
+	return nil
+}
+
+// ReplayStep is the status after an entry was applied
+type ReplayStep struct {
+	Entry  radio.JournalEntry
+	Status radio.Status
+}
+
+// Replay rebuilds the status as it was at the time given from the journal, if
+// from is before that it also returns the status after each entry between
+// from and at
+func Replay(ctx context.Context, store radio.JournalStorageService, from, at time.Time) (radio.Status, []ReplayStep, error) {
+	const op errors.Op = "manager/Replay"
+
+	js := store.Journal(ctx)
+
+	var status radio.Status
+	var steps []ReplayStep
+
+	// the state right before the range we want to show
+	start := at
+	if from.Before(at) {
+		start = from.Add(-time.Nanosecond)
+	}
+
+	last, err := js.Last(start)
+	if err != nil {
+		return status, nil, errors.E(op, err)
+	}
+	for _, entry := range last {
+		if err := ApplyJournal(&status, entry); err != nil {
+			return status, nil, errors.E(op, err)
+		}
+	}
+
+	if start.Equal(at) {
+		return status, nil, nil
+	}
+
+	entries, err := js.Range(from, at)
+	if err != nil {
+		return status, nil, errors.E(op, err)
+	}
+	for _, entry := range entries {
+		if err := ApplyJournal(&status, entry); err != nil {
+			return status, nil, errors.E(op, err)
+		}
+		steps = append(steps, ReplayStep{
+			Entry:  entry,
+			Status: status,
+		})
+	}
+	return status, steps, nil
+}
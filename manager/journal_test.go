@@ -0,0 +1,124 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newJournalStorage returns a JournalStorage that keeps entries in memory
+func newJournalStorage() *mocks.JournalStorageMock {
+	var mu sync.Mutex
+	var entries []radio.JournalEntry
+
+	return &mocks.JournalStorageMock{
+		AppendFunc: func(entry radio.JournalEntry) error {
+			mu.Lock()
+			defer mu.Unlock()
+			entry.ID = uint64(len(entries) + 1)
+			entries = append(entries, entry)
+			return nil
+		},
+		RangeFunc: func(from, to time.Time) ([]radio.JournalEntry, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var res []radio.JournalEntry
+			for _, entry := range entries {
+				if !entry.Time.Before(from) && !entry.Time.After(to) {
+					res = append(res, entry)
+				}
+			}
+			return res, nil
+		},
+		LastFunc: func(before time.Time) ([]radio.JournalEntry, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			last := map[radio.JournalKind]radio.JournalEntry{}
+			for _, entry := range entries {
+				if !entry.Time.After(before) {
+					last[entry.Kind] = entry
+				}
+			}
+			var res []radio.JournalEntry
+			for _, entry := range entries {
+				if last[entry.Kind].ID == entry.ID {
+					res = append(res, entry)
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+func TestJournalReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := zerolog.Nop()
+
+	js := newJournalStorage()
+	store := &mocks.StorageServiceMock{
+		JournalFunc: func(contextMoqParam context.Context) radio.JournalStorage {
+			return js
+		},
+	}
+	m := &Manager{logger: &logger, Storage: store}
+	m.journalWriter = newJournalWriter(ctx, store)
+
+	user := &radio.User{ID: 5, Username: "dj", DJ: radio.DJ{ID: 10, Name: "DJ Name"}}
+	song := &radio.SongUpdate{
+		Song: radio.NewSong("artist - title"),
+		Info: radio.SongInfo{Start: time.Now().Truncate(time.Second)},
+	}
+
+	m.journal(ctx, radio.JournalUser, user)
+	m.journal(ctx, radio.JournalThread, "first thread")
+	m.journal(ctx, radio.JournalSong, song)
+	middle := time.Now()
+	time.Sleep(time.Millisecond)
+	m.journal(ctx, radio.JournalListeners, radio.Listeners(50))
+	m.journal(ctx, radio.JournalThread, "second thread")
+	m.journal(ctx, radio.JournalUser, (*radio.User)(nil))
+
+	// wait for the writer to finish writing everything
+	cancel()
+	m.journalWriter.Wait()
+	ctx = context.Background()
+
+	for _, call := range js.AppendCalls() {
+		assert.Equal(t, "manager", call.JournalEntry.Source)
+	}
+
+	status, steps, err := Replay(ctx, store, middle, middle)
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+	assert.Equal(t, "first thread", status.Thread)
+	assert.Equal(t, "DJ Name", status.StreamerName)
+	require.NotNil(t, status.StreamUser)
+	assert.Equal(t, user.ID, status.StreamUser.ID)
+	assert.Equal(t, song.Metadata, status.Song.Metadata)
+	assert.True(t, song.Info.Start.Equal(status.SongInfo.Start))
+	assert.Zero(t, status.Listeners)
+
+	status, steps, err = Replay(ctx, store, middle, time.Now())
+	require.NoError(t, err)
+	require.Len(t, steps, 3)
+	assert.Equal(t, radio.Listeners(50), steps[0].Status.Listeners)
+	assert.Equal(t, "first thread", steps[0].Status.Thread)
+	assert.Equal(t, "second thread", steps[1].Status.Thread)
+	// a nil user means the streamer left, but the last user is kept
+	assert.Nil(t, status.StreamUser)
+	assert.Equal(t, user.ID, status.User.ID)
+}
+
+func TestApplyJournalUnknownKind(t *testing.T) {
+	var status radio.Status
+	err := ApplyJournal(&status, radio.JournalEntry{Kind: "unknown"})
+	assert.Error(t, err)
+}
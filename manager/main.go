@@ -18,11 +18,21 @@ import (
 	"github.com/R-a-dio/valkyrie/util/eventstream"
 	"github.com/Wessie/fdstore"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 )
 
+// STREAM_HISTORY_SIZE is the amount of values kept by the song and status
+// streams for clients that resume after a reconnect
+const STREAM_HISTORY_SIZE = 32
+
 // Execute executes a manager with the context and configuration given; it returns with
 // any error that occurs; Execution can be interrupted by canceling the context given.
 func Execute(ctx context.Context, cfg config.Config) error {
+	// we cancel this ourselves on the way out so that the journal writer
+	// also stops when we return for a restart
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	store, err := storage.Open(ctx, cfg)
 	if err != nil {
 		return err
@@ -40,29 +50,65 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	if err != nil {
 		return err
 	}
+	// this runs after everything else has stopped, so that the journal entries
+	// of the last updates are written before we exit
+	defer func() {
+		cancel()
+		m.journalWriter.Wait()
+	}()
+
+	// if we have replicas we have to elect a leader between us first
+	if conf := cfg.Conf().Manager; len(conf.Replicas) > 0 {
+		self := conf.AdvertiseAddr
+		if !self.Addr().IsValid() {
+			self = conf.RPCAddr
+		}
+
+		m.replication = &Replication{
+			Self:          self.String(),
+			LeaseDuration: time.Duration(conf.LeaseDuration),
+			Dial: func(addr string) *grpc.ClientConn {
+				return cfg.DialRPC(config.RPCManager, addr)
+			},
+			Client: func(conn *grpc.ClientConn) radio.ManagerService {
+				return rpc.NewManagerService(conn)
+			},
+		}
+
+		electionCtx, electionCancel := context.WithCancel(ctx)
+		electionDone := make(chan struct{})
+		go func() {
+			defer close(electionDone)
+			m.runElection(electionCtx)
+		}()
+		// wait for the election to stop so that our lease is released
+		defer func() {
+			electionCancel()
+			<-electionDone
+		}()
+	}
 
 	// separate cancel for the guest service since it depends on the manager
 	guestCtx, guestCancel := context.WithCancel(ctx)
 	defer guestCancel()
 
-	gs, err := NewGuestService(guestCtx, cfg, m, store)
+	gs, err := NewGuestService(guestCtx, cfg, m, store, store)
 	if err != nil {
 		return err
 	}
 
-	// setup tunein integration if it's enabled
-	if cfg.Conf().Tunein.Enabled {
-		tu, err := NewTuneinUpdater(ctx, cfg, m, http.DefaultClient)
-		if err != nil {
-			zerolog.Ctx(ctx).WithLevel(zerolog.PanicLevel).Err(err).Ctx(ctx).Msg("failed to setup tunein updater")
-			// continue running if this fails, we don't care that much about tunein
-		} else {
-			defer tu.Close()
-		}
+	// setup tunein integration, it only sends updates while it's enabled so
+	// that it can be turned on and off with a configuration reload
+	tu, err := NewTuneinUpdater(ctx, cfg, m, http.DefaultClient)
+	if err != nil {
+		zerolog.Ctx(ctx).WithLevel(zerolog.PanicLevel).Err(err).Ctx(ctx).Msg("failed to setup tunein updater")
+		// continue running if this fails, we don't care that much about tunein
+	} else {
+		defer tu.Close()
 	}
 
 	// setup a http server for our RPC API
-	srv, err := NewGRPCServer(ctx, m, gs)
+	srv, err := NewGRPCServer(ctx, cfg, m, gs)
 	if err != nil {
 		return err
 	}
@@ -134,6 +180,7 @@ func NewManager(ctx context.Context, store radio.StorageService, prober audio.Pr
 		prober:  prober,
 		status:  radio.Status{},
 	}
+	m.journalWriter = newJournalWriter(ctx, store)
 
 	// if we have state from a previous process, use that
 	if len(state) > 0 {
@@ -154,12 +201,14 @@ func NewManager(ctx context.Context, store radio.StorageService, prober audio.Pr
 
 	m.userStream = eventstream.NewEventStream(m.status.StreamUser)
 	m.threadStream = eventstream.NewEventStream(m.status.Thread)
-	m.songStream = eventstream.NewEventStream(&radio.SongUpdate{
+	// song and status keep some history so that clients can resume after
+	// a reconnect without missing song changes
+	m.songStream = eventstream.NewEventStreamHistory(&radio.SongUpdate{
 		Song: m.status.Song,
 		Info: m.status.SongInfo,
-	})
+	}, STREAM_HISTORY_SIZE)
 	m.listenerStream = eventstream.NewEventStream(radio.Listeners(m.status.Listeners))
-	m.statusStream = eventstream.NewEventStream(m.status)
+	m.statusStream = eventstream.NewEventStreamHistory(m.status, STREAM_HISTORY_SIZE)
 
 	ready := make(chan struct{})
 	go m.runStatusUpdates(ctx, ready)
@@ -177,6 +226,11 @@ type Manager struct {
 	running atomic.Bool
 	Storage radio.StorageService
 	prober  audio.Prober
+	// journalWriter writes journal entries in the background
+	journalWriter *journalWriter
+
+	// replication is non-nil if we're one of multiple replicas
+	replication *Replication
 
 	// mu protects the fields below and their contents
 	mu     sync.Mutex
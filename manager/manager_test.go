@@ -100,6 +100,9 @@ func TestManager(t *testing.T) {
 		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
 			return us
 		},
+		JournalFunc: func(contextMoqParam context.Context) radio.JournalStorage {
+			return newJournalStorage()
+		},
 	}
 	prober := func(ctx context.Context, song radio.Song) (time.Duration, error) {
 		return 0, errors.New("not implemented")
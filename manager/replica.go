@@ -0,0 +1,200 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// leaseName is the name of the lease the manager replicas elect a leader with
+const leaseName = "manager"
+
+// DialFn returns a connection to the manager replica at addr
+type DialFn func(addr string) *grpc.ClientConn
+
+// ClientFn returns a radio.ManagerService using the connection given
+type ClientFn func(*grpc.ClientConn) radio.ManagerService
+
+// Replication is the state of a manager running as one of multiple replicas,
+// only the leader does anything, the other replicas mirror the status of the
+// leader and forward any updates they receive to it
+type Replication struct {
+	// Self is the address other replicas can reach us on, this is what is
+	// stored as the holder of the lease
+	Self string
+	// LeaseDuration is how long the lease is valid for without renewal
+	LeaseDuration time.Duration
+	Dial          DialFn
+	Client        ClientFn
+
+	mu sync.Mutex
+	// leader is the address of the current leader, empty if unknown
+	leader string
+	// conn and client are the connection to the leader, nil if we are the
+	// leader ourselves or the leader is unknown
+	conn   *grpc.ClientConn
+	client radio.ManagerService
+	// cancel stops the replication from the leader
+	cancel context.CancelFunc
+}
+
+// isLeader returns true if we are the leader, a manager without replication
+// is always the leader
+func (m *Manager) isLeader() bool {
+	r := m.replication
+	if r == nil {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.leader == r.Self
+}
+
+// leader returns the leader updates should be forwarded to, it returns nil if
+// we are the leader ourselves
+func (m *Manager) leader() (radio.ManagerService, error) {
+	const op errors.Op = "manager/Manager.leader"
+
+	r := m.replication
+	if r == nil {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.leader == r.Self {
+		return nil, nil
+	}
+	if r.client == nil {
+		return nil, errors.E(op, errors.NoLeader)
+	}
+	return r.client, nil
+}
+
+// runElection takes part in the leader election until ctx is canceled, the
+// lease is released when it returns if we're the leader at that time
+func (m *Manager) runElection(ctx context.Context) {
+	r := m.replication
+
+	ticker := time.NewTicker(r.LeaseDuration / 3)
+	defer ticker.Stop()
+
+	defer func() {
+		// release the lease so that another replica can take over right away
+		// instead of waiting for it to expire
+		if m.isLeader() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*2)
+			defer cancel()
+
+			if err := m.Storage.Lease(ctx).Release(leaseName, r.Self); err != nil {
+				zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to release leader lease")
+			}
+		}
+		m.setLeader(ctx, "")
+	}()
+
+	var lastRenewal time.Time
+	for {
+		holder, err := m.Storage.Lease(ctx).Acquire(leaseName, r.Self, r.LeaseDuration)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to acquire leader lease")
+			// if we can't renew our lease in time another replica might
+			// take over, so stop acting as leader before that can happen
+			if m.isLeader() && time.Since(lastRenewal) > r.LeaseDuration/2 {
+				m.setLeader(ctx, "")
+			}
+		} else {
+			if holder == r.Self {
+				lastRenewal = time.Now()
+			}
+			m.setLeader(ctx, holder)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// setLeader switches to the leader given, an empty string means the leader is
+// unknown
+func (m *Manager) setLeader(ctx context.Context, leader string) {
+	r := m.replication
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.leader == leader {
+		return
+	}
+
+	// stop replicating from the old leader
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	if r.conn != nil {
+		r.conn.Close()
+		r.conn, r.client = nil, nil
+	}
+
+	r.leader = leader
+	switch leader {
+	case r.Self:
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("became leader")
+	case "":
+		zerolog.Ctx(ctx).Warn().Ctx(ctx).Msg("no leader")
+	default:
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Str("leader", leader).Msg("following leader")
+		r.conn = r.Dial(leader)
+		r.client = r.Client(r.conn)
+
+		var replCtx context.Context
+		replCtx, r.cancel = context.WithCancel(ctx)
+		m.replicate(replCtx, r.client)
+	}
+}
+
+// replicate mirrors the streams of the leader given into our own streams until
+// ctx is canceled
+func (m *Manager) replicate(ctx context.Context, leader radio.ManagerService) {
+	go replicateStream(ctx, leader.CurrentUser, m.userStream)
+	go replicateStream(ctx, leader.CurrentThread, m.threadStream)
+	go replicateStream(ctx, leader.CurrentSong, m.songStream)
+	go replicateStream(ctx, leader.CurrentListeners, m.listenerStream)
+}
+
+func replicateStream[T any](ctx context.Context, fn func(context.Context) (eventstream.Stream[T], error), dst *eventstream.EventStream[T]) {
+	rater := rate.NewLimiter(rate.Every(time.Second), 1)
+	for rater.Wait(ctx) == nil {
+		stream, err := fn(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("replication: stream error")
+			}
+			continue
+		}
+
+		for {
+			v, err := stream.Next()
+			if err != nil {
+				if ctx.Err() == nil {
+					zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("replication: next error")
+				}
+				break
+			}
+			dst.Send(v)
+		}
+		stream.Close()
+	}
+}
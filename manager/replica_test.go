@@ -0,0 +1,158 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// newLeaseStorage returns a LeaseStorage that keeps leases in memory, leases
+// don't expire and have to be released
+func newLeaseStorage() *mocks.LeaseStorageMock {
+	var mu sync.Mutex
+	leases := map[string]string{}
+
+	return &mocks.LeaseStorageMock{
+		AcquireFunc: func(name, holder string, ttl time.Duration) (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if leases[name] == "" {
+				leases[name] = holder
+			}
+			return leases[name], nil
+		},
+		ReleaseFunc: func(name, holder string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if leases[name] == holder {
+				delete(leases, name)
+			}
+			return nil
+		},
+	}
+}
+
+func newReplica(self string, leases radio.LeaseStorage, leader radio.ManagerService) *Manager {
+	logger := zerolog.Nop()
+	return &Manager{
+		logger: &logger,
+		Storage: &mocks.StorageServiceMock{
+			LeaseFunc: func(contextMoqParam context.Context) radio.LeaseStorage {
+				return leases
+			},
+			JournalFunc: func(contextMoqParam context.Context) radio.JournalStorage {
+				return newJournalStorage()
+			},
+		},
+		replication: &Replication{
+			Self:          self,
+			LeaseDuration: time.Millisecond * 30,
+			Dial: func(addr string) *grpc.ClientConn {
+				return nil
+			},
+			Client: func(*grpc.ClientConn) radio.ManagerService {
+				return leader
+			},
+		},
+		userStream:     eventstream.NewEventStream[*radio.User](nil),
+		threadStream:   eventstream.NewEventStream[radio.Thread](""),
+		songStream:     eventstream.NewEventStream[*radio.SongUpdate](nil),
+		listenerStream: eventstream.NewEventStream[radio.Listeners](0),
+		statusStream:   eventstream.NewEventStream(radio.Status{}),
+	}
+}
+
+func TestReplication(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	leases := newLeaseStorage()
+
+	// the leader as seen by the follower
+	leaderThread := eventstream.NewEventStream[radio.Thread]("leader thread")
+	var forwarded []radio.Thread
+	var forwardedMu sync.Mutex
+	leaderClient := &mocks.ManagerServiceMock{
+		CurrentUserFunc: func(ctx context.Context) (eventstream.Stream[*radio.User], error) {
+			return nil, errors.E(errors.Testing)
+		},
+		CurrentSongFunc: func(ctx context.Context) (eventstream.Stream[*radio.SongUpdate], error) {
+			return nil, errors.E(errors.Testing)
+		},
+		CurrentListenersFunc: func(ctx context.Context) (eventstream.Stream[radio.Listeners], error) {
+			return nil, errors.E(errors.Testing)
+		},
+		CurrentThreadFunc: func(ctx context.Context) (eventstream.Stream[radio.Thread], error) {
+			return leaderThread.SubStream(ctx), nil
+		},
+		UpdateThreadFunc: func(ctx context.Context, thread radio.Thread) error {
+			forwardedMu.Lock()
+			defer forwardedMu.Unlock()
+			forwarded = append(forwarded, thread)
+			return nil
+		},
+	}
+
+	a := newReplica("a", leases, nil)
+	aCtx, aCancel := context.WithCancel(ctx)
+	aDone := make(chan struct{})
+	go func() {
+		defer close(aDone)
+		a.runElection(aCtx)
+	}()
+	require.Eventually(t, a.isLeader, time.Second*5, time.Millisecond)
+
+	b := newReplica("b", leases, leaderClient)
+	go b.runElection(ctx)
+	require.Eventually(t, func() bool {
+		leader, err := b.leader()
+		return leader != nil && err == nil
+	}, time.Second*5, time.Millisecond)
+
+	// the follower should mirror the leader
+	require.Eventually(t, func() bool {
+		return b.threadStream.Latest() == "leader thread"
+	}, time.Second*5, time.Millisecond)
+
+	// and forward any updates to it instead of applying them
+	require.NoError(t, b.UpdateThread(ctx, "from follower"))
+	forwardedMu.Lock()
+	assert.Equal(t, []radio.Thread{"from follower"}, forwarded)
+	forwardedMu.Unlock()
+	assert.Equal(t, "leader thread", b.threadStream.Latest())
+
+	// the leader shouldn't forward anything
+	require.NoError(t, a.UpdateThread(ctx, "from leader"))
+	assert.Eventually(t, func() bool {
+		return a.threadStream.Latest() == "from leader"
+	}, time.Second*5, time.Millisecond)
+
+	// stopping the leader should release the lease and have the follower
+	// take over
+	aCancel()
+	<-aDone
+	assert.False(t, a.isLeader())
+	require.Eventually(t, b.isLeader, time.Second*5, time.Millisecond)
+
+	require.NoError(t, b.UpdateThread(ctx, "new leader"))
+	assert.Eventually(t, func() bool {
+		return b.threadStream.Latest() == "new leader"
+	}, time.Second*5, time.Millisecond)
+}
+
+func TestReplicationNoLeader(t *testing.T) {
+	m := newReplica("a", newLeaseStorage(), nil)
+
+	err := m.UpdateThread(context.Background(), "thread")
+	assert.True(t, errors.Is(errors.NoLeader, err))
+}
@@ -24,6 +24,7 @@ var tuneinSplitMetadataRe = regexp.MustCompile("^((?P<artist>.*?) - )?(?P<title>
 type TuneinUpdater struct {
 	cancel              context.CancelFunc
 	client              *http.Client
+	cfgTuneinEnabled    func() bool
 	cfgTuneinEndpoint   func() string
 	cfgTuneinPartnerID  func() string
 	cfgTuneinStationID  func() string
@@ -33,6 +34,9 @@ type TuneinUpdater struct {
 func NewTuneinUpdater(ctx context.Context, cfg config.Config, manager radio.ManagerService, client *http.Client) (*TuneinUpdater, error) {
 	tu := &TuneinUpdater{
 		client: client,
+		cfgTuneinEnabled: config.Value(cfg, func(c config.Config) bool {
+			return cfg.Conf().Tunein.Enabled
+		}),
 		cfgTuneinEndpoint: config.Value(cfg, func(c config.Config) string {
 			return cfg.Conf().Tunein.Endpoint
 		}),
@@ -49,7 +53,11 @@ func NewTuneinUpdater(ctx context.Context, cfg config.Config, manager radio.Mana
 	ctx, tu.cancel = context.WithCancel(ctx)
 
 	util.StreamValue(ctx, manager.CurrentSong, func(ctx context.Context, su *radio.SongUpdate) {
-		if su == nil {
+		if su == nil || !tu.cfgTuneinEnabled() {
+			return
+		}
+		// only the leader should update tunein if we have replicas
+		if m, ok := manager.(*Manager); ok && !m.isLeader() {
 			return
 		}
 
@@ -34,6 +34,7 @@ func TestTuneinUpdater(t *testing.T) {
 
 	cfg := config.TestConfig()
 	c := cfg.Conf()
+	c.Tunein.Enabled = true
 	c.Tunein.Key = "a key"
 	c.Tunein.StationID = "s321"
 	c.Tunein.PartnerID = "112333"
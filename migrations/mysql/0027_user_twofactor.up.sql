@@ -0,0 +1,9 @@
+CREATE TABLE `user_twofactor` (
+    `user_id` int unsigned NOT NULL,
+    `secret` varchar(64) NOT NULL,
+    `recovery_codes` TEXT NOT NULL,
+    `enabled_at` TIMESTAMP NULL DEFAULT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`),
+    CONSTRAINT `user_twofactor_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
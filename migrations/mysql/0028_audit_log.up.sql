@@ -0,0 +1,20 @@
+INSERT IGNORE INTO `permission_kinds` (
+    `permission`
+) VALUES 
+    ("audit_view");
+
+CREATE TABLE `audit_log` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `actor_id` int unsigned NOT NULL,
+    `actor` varchar(50) NOT NULL,
+    `action` varchar(50) NOT NULL,
+    `target` varchar(255) NOT NULL,
+    `data_before` MEDIUMTEXT NOT NULL,
+    `data_after` MEDIUMTEXT NOT NULL,
+    `ip` varchar(50) NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `actor_index` (`actor`),
+    KEY `action_index` (`action`),
+    KEY `created_at_index` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,23 @@
+CREATE TABLE `listener_accounts` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `username` varchar(50) NOT NULL,
+    `password` varchar(120) NOT NULL,
+    `nick` varchar(30) NULL DEFAULT NULL,
+    `claim_code` varchar(20) NULL DEFAULT NULL,
+    `claim_expires` TIMESTAMP NULL DEFAULT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `username` (`username`),
+    UNIQUE KEY `nick` (`nick`),
+    UNIQUE KEY `claim_code` (`claim_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `listener_requests` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `account_id` bigint unsigned NOT NULL,
+    `track_id` int(14) unsigned NOT NULL,
+    `time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `account_time_index` (`account_id`, `time`),
+    CONSTRAINT `listener_requests_account` FOREIGN KEY (`account_id`) REFERENCES `listener_accounts` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
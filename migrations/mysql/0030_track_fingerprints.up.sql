@@ -0,0 +1,11 @@
+CREATE TABLE `track_fingerprints` (
+    `track_id` int(14) unsigned NOT NULL,
+    `length` int unsigned NOT NULL DEFAULT '0',
+    `fingerprint` MEDIUMBLOB NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`track_id`),
+    KEY `length_index` (`length`),
+    CONSTRAINT `track_fingerprints_track` FOREIGN KEY (`track_id`) REFERENCES `tracks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+ALTER TABLE `pending` ADD COLUMN `duplicate_id` int(14) unsigned NULL DEFAULT NULL AFTER `dupe_flag`;
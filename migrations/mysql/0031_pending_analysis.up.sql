@@ -0,0 +1 @@
+ALTER TABLE `pending` ADD COLUMN `analysis` TEXT NULL DEFAULT NULL AFTER `duplicate_id`;
@@ -0,0 +1,9 @@
+CREATE TABLE `track_similarity` (
+    `track_id` int(14) unsigned NOT NULL,
+    `similar_id` int(14) unsigned NOT NULL,
+    `score` double NOT NULL,
+    PRIMARY KEY (`track_id`, `similar_id`),
+    KEY `track_score_index` (`track_id`, `score`),
+    CONSTRAINT `track_similarity_track` FOREIGN KEY (`track_id`) REFERENCES `tracks` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `track_similarity_similar` FOREIGN KEY (`similar_id`) REFERENCES `tracks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
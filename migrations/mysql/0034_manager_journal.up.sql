@@ -0,0 +1,10 @@
+CREATE TABLE `manager_journal` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `kind` varchar(16) NOT NULL,
+    `time` datetime(6) NOT NULL,
+    `source` varchar(255) NOT NULL,
+    `data` mediumtext NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `journal_time_index` (`time`),
+    KEY `journal_kind_time_index` (`kind`, `time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
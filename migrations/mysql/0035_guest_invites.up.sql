@@ -0,0 +1,40 @@
+INSERT IGNORE INTO `permission_kinds` (
+    `permission`
+) VALUES 
+    ("guest_invite");
+
+CREATE TABLE `guest_invites` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `code` varchar(64) NOT NULL,
+    `nick` varchar(255) NOT NULL DEFAULT '',
+    `created_by` int unsigned NOT NULL,
+    `created_at` datetime(6) NOT NULL,
+    `starts_at` datetime(6) NOT NULL,
+    `ends_at` datetime(6) NOT NULL,
+    `mount` varchar(255) NOT NULL DEFAULT '',
+    `priority` int(11) NOT NULL DEFAULT 0,
+    `kill_limit` int(11) NOT NULL,
+    `thread_limit` int(11) NOT NULL,
+    `redeemed_by` varchar(255) NOT NULL DEFAULT '',
+    `redeemed_at` datetime(6) DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `guest_invites_code_unique` (`code`),
+    KEY `guest_invites_ends_at_index` (`ends_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `guest_auths` (
+    `nick` varchar(255) NOT NULL,
+    `user_id` int unsigned NOT NULL,
+    `invite_id` bigint(20) unsigned NOT NULL DEFAULT 0,
+    `starts_at` datetime(6) NOT NULL,
+    `ends_at` datetime(6) NOT NULL,
+    `mount` varchar(255) NOT NULL DEFAULT '',
+    `priority` int(11) NOT NULL DEFAULT 0,
+    `kill_limit` int(11) NOT NULL,
+    `thread_limit` int(11) NOT NULL,
+    `has_streamed` tinyint(1) NOT NULL DEFAULT 0,
+    `thread_sets` int(11) NOT NULL DEFAULT 0,
+    `kill_attempts` int(11) NOT NULL DEFAULT 0,
+    PRIMARY KEY (`nick`),
+    CONSTRAINT `guest_auths_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
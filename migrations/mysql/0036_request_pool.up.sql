@@ -0,0 +1,14 @@
+CREATE TABLE `request_pool` (
+    `track_id` int(14) unsigned NOT NULL,
+    `identifier` varchar(255) NOT NULL,
+    `created_at` datetime(6) NOT NULL,
+    PRIMARY KEY (`track_id`),
+    CONSTRAINT `request_pool_track` FOREIGN KEY (`track_id`) REFERENCES `tracks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `request_votes` (
+    `track_id` int(14) unsigned NOT NULL,
+    `identifier` varchar(255) NOT NULL,
+    PRIMARY KEY (`track_id`, `identifier`),
+    CONSTRAINT `request_votes_request` FOREIGN KEY (`track_id`) REFERENCES `request_pool` (`track_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE `queue_position` (
+    `name` varchar(64) NOT NULL,
+    `queue_id` tinytext NOT NULL,
+    `trackid` int(14) unsigned NOT NULL,
+    `time` datetime(6) NOT NULL,
+    `ip` text CHARACTER SET utf8 COLLATE utf8_bin,
+    `type` int(3) DEFAULT '0',
+    `meta` text,
+    `length` float DEFAULT '0',
+    `byte_offset` bigint NOT NULL DEFAULT 0,
+    `updated_at` datetime(6) NOT NULL,
+    PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
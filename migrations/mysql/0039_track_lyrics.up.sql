@@ -0,0 +1,9 @@
+CREATE TABLE `track_lyrics` (
+    `track_id` int(14) unsigned NOT NULL,
+    `lyrics` text NOT NULL,
+    `notes` text NOT NULL,
+    `updated_by` varchar(255) NOT NULL DEFAULT '',
+    `updated_at` datetime(6) NOT NULL,
+    PRIMARY KEY (`track_id`),
+    CONSTRAINT `track_lyrics_track` FOREIGN KEY (`track_id`) REFERENCES `tracks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+ALTER TABLE `pending` ADD COLUMN `checked_at` datetime NULL DEFAULT NULL AFTER `analysis`;
+-- everything submitted before this was checked during the upload already
+UPDATE `pending` SET `checked_at`=`submitted`;
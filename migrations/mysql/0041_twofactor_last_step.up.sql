@@ -0,0 +1 @@
+ALTER TABLE `user_twofactor` ADD COLUMN `last_step` bigint unsigned NOT NULL DEFAULT 0 AFTER `enabled_at`;
@@ -21,6 +21,9 @@ var _ radio.SearchService = &SearchServiceMock{}
 //
 //		// make and configure a mocked radio.SearchService
 //		mockedSearchService := &SearchServiceMock{
+//			CompleteFunc: func(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+//				panic("mock out the Complete method")
+//			},
 //			DeleteFunc: func(contextMoqParam context.Context, trackIDs ...radio.TrackID) error {
 //				panic("mock out the Delete method")
 //			},
@@ -37,6 +40,9 @@ var _ radio.SearchService = &SearchServiceMock{}
 //
 //	}
 type SearchServiceMock struct {
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error)
+
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(contextMoqParam context.Context, trackIDs ...radio.TrackID) error
 
@@ -48,6 +54,15 @@ type SearchServiceMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Prefix is the prefix argument value.
+			Prefix string
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -72,9 +87,50 @@ type SearchServiceMock struct {
 			Songs []radio.Song
 		}
 	}
-	lockDelete sync.RWMutex
-	lockSearch sync.RWMutex
-	lockUpdate sync.RWMutex
+	lockComplete sync.RWMutex
+	lockDelete   sync.RWMutex
+	lockSearch   sync.RWMutex
+	lockUpdate   sync.RWMutex
+}
+
+// Complete calls CompleteFunc.
+func (mock *SearchServiceMock) Complete(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+	if mock.CompleteFunc == nil {
+		panic("SearchServiceMock.CompleteFunc: method is nil but SearchService.Complete was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Prefix string
+		Limit  int
+	}{
+		Ctx:    ctx,
+		Prefix: prefix,
+		Limit:  limit,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(ctx, prefix, limit)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedSearchService.CompleteCalls())
+func (mock *SearchServiceMock) CompleteCalls() []struct {
+	Ctx    context.Context
+	Prefix string
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Prefix string
+		Limit  int
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
 }
 
 // Delete calls DeleteFunc.
@@ -913,6 +969,15 @@ var _ radio.QueueService = &QueueServiceMock{}
 //			EntriesFunc: func(contextMoqParam context.Context) (radio.Queue, error) {
 //				panic("mock out the Entries method")
 //			},
+//			InsertFunc: func(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error) {
+//				panic("mock out the Insert method")
+//			},
+//			MoveFunc: func(ctx context.Context, id radio.QueueID, position int) error {
+//				panic("mock out the Move method")
+//			},
+//			PinFunc: func(ctx context.Context, id radio.QueueID, pinned bool) error {
+//				panic("mock out the Pin method")
+//			},
 //			RemoveFunc: func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
 //				panic("mock out the Remove method")
 //			},
@@ -935,6 +1000,15 @@ type QueueServiceMock struct {
 	// EntriesFunc mocks the Entries method.
 	EntriesFunc func(contextMoqParam context.Context) (radio.Queue, error)
 
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error)
+
+	// MoveFunc mocks the Move method.
+	MoveFunc func(ctx context.Context, id radio.QueueID, position int) error
+
+	// PinFunc mocks the Pin method.
+	PinFunc func(ctx context.Context, id radio.QueueID, pinned bool) error
+
 	// RemoveFunc mocks the Remove method.
 	RemoveFunc func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error)
 
@@ -960,6 +1034,33 @@ type QueueServiceMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Song is the song argument value.
+			Song radio.Song
+			// Position is the position argument value.
+			Position int
+		}
+		// Move holds details about calls to the Move method.
+		Move []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id radio.QueueID
+			// Position is the position argument value.
+			Position int
+		}
+		// Pin holds details about calls to the Pin method.
+		Pin []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id radio.QueueID
+			// Pinned is the pinned argument value.
+			Pinned bool
+		}
 		// Remove holds details about calls to the Remove method.
 		Remove []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -980,6 +1081,9 @@ type QueueServiceMock struct {
 	}
 	lockAddRequest    sync.RWMutex
 	lockEntries       sync.RWMutex
+	lockInsert        sync.RWMutex
+	lockMove          sync.RWMutex
+	lockPin           sync.RWMutex
 	lockRemove        sync.RWMutex
 	lockReserveNext   sync.RWMutex
 	lockResetReserved sync.RWMutex
@@ -1057,6 +1161,126 @@ func (mock *QueueServiceMock) EntriesCalls() []struct {
 	return calls
 }
 
+// Insert calls InsertFunc.
+func (mock *QueueServiceMock) Insert(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error) {
+	if mock.InsertFunc == nil {
+		panic("QueueServiceMock.InsertFunc: method is nil but QueueService.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Song     radio.Song
+		Position int
+	}{
+		Ctx:      ctx,
+		Song:     song,
+		Position: position,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, song, position)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedQueueService.InsertCalls())
+func (mock *QueueServiceMock) InsertCalls() []struct {
+	Ctx      context.Context
+	Song     radio.Song
+	Position int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Song     radio.Song
+		Position int
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// Move calls MoveFunc.
+func (mock *QueueServiceMock) Move(ctx context.Context, id radio.QueueID, position int) error {
+	if mock.MoveFunc == nil {
+		panic("QueueServiceMock.MoveFunc: method is nil but QueueService.Move was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Id       radio.QueueID
+		Position int
+	}{
+		Ctx:      ctx,
+		Id:       id,
+		Position: position,
+	}
+	mock.lockMove.Lock()
+	mock.calls.Move = append(mock.calls.Move, callInfo)
+	mock.lockMove.Unlock()
+	return mock.MoveFunc(ctx, id, position)
+}
+
+// MoveCalls gets all the calls that were made to Move.
+// Check the length with:
+//
+//	len(mockedQueueService.MoveCalls())
+func (mock *QueueServiceMock) MoveCalls() []struct {
+	Ctx      context.Context
+	Id       radio.QueueID
+	Position int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Id       radio.QueueID
+		Position int
+	}
+	mock.lockMove.RLock()
+	calls = mock.calls.Move
+	mock.lockMove.RUnlock()
+	return calls
+}
+
+// Pin calls PinFunc.
+func (mock *QueueServiceMock) Pin(ctx context.Context, id radio.QueueID, pinned bool) error {
+	if mock.PinFunc == nil {
+		panic("QueueServiceMock.PinFunc: method is nil but QueueService.Pin was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Id     radio.QueueID
+		Pinned bool
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Pinned: pinned,
+	}
+	mock.lockPin.Lock()
+	mock.calls.Pin = append(mock.calls.Pin, callInfo)
+	mock.lockPin.Unlock()
+	return mock.PinFunc(ctx, id, pinned)
+}
+
+// PinCalls gets all the calls that were made to Pin.
+// Check the length with:
+//
+//	len(mockedQueueService.PinCalls())
+func (mock *QueueServiceMock) PinCalls() []struct {
+	Ctx    context.Context
+	Id     radio.QueueID
+	Pinned bool
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Id     radio.QueueID
+		Pinned bool
+	}
+	mock.lockPin.RLock()
+	calls = mock.calls.Pin
+	mock.lockPin.RUnlock()
+	return calls
+}
+
 // Remove calls RemoveFunc.
 func (mock *QueueServiceMock) Remove(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
 	if mock.RemoveFunc == nil {
@@ -1167,6 +1391,9 @@ var _ radio.AnnounceService = &AnnounceServiceMock{}
 //
 //		// make and configure a mocked radio.AnnounceService
 //		mockedAnnounceService := &AnnounceServiceMock{
+//			AnnounceAuditFunc: func(contextMoqParam context.Context, auditEntry radio.AuditEntry) error {
+//				panic("mock out the AnnounceAudit method")
+//			},
 //			AnnounceMurderFunc: func(ctx context.Context, by *radio.User, force bool) error {
 //				panic("mock out the AnnounceMurder method")
 //			},
@@ -1186,6 +1413,9 @@ var _ radio.AnnounceService = &AnnounceServiceMock{}
 //
 //	}
 type AnnounceServiceMock struct {
+	// AnnounceAuditFunc mocks the AnnounceAudit method.
+	AnnounceAuditFunc func(contextMoqParam context.Context, auditEntry radio.AuditEntry) error
+
 	// AnnounceMurderFunc mocks the AnnounceMurder method.
 	AnnounceMurderFunc func(ctx context.Context, by *radio.User, force bool) error
 
@@ -1200,6 +1430,13 @@ type AnnounceServiceMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AnnounceAudit holds details about calls to the AnnounceAudit method.
+		AnnounceAudit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// AuditEntry is the auditEntry argument value.
+			AuditEntry radio.AuditEntry
+		}
 		// AnnounceMurder holds details about calls to the AnnounceMurder method.
 		AnnounceMurder []struct {
 			// Ctx is the ctx argument value.
@@ -1231,12 +1468,49 @@ type AnnounceServiceMock struct {
 			User *radio.User
 		}
 	}
+	lockAnnounceAudit   sync.RWMutex
 	lockAnnounceMurder  sync.RWMutex
 	lockAnnounceRequest sync.RWMutex
 	lockAnnounceSong    sync.RWMutex
 	lockAnnounceUser    sync.RWMutex
 }
 
+// AnnounceAudit calls AnnounceAuditFunc.
+func (mock *AnnounceServiceMock) AnnounceAudit(contextMoqParam context.Context, auditEntry radio.AuditEntry) error {
+	if mock.AnnounceAuditFunc == nil {
+		panic("AnnounceServiceMock.AnnounceAuditFunc: method is nil but AnnounceService.AnnounceAudit was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		AuditEntry      radio.AuditEntry
+	}{
+		ContextMoqParam: contextMoqParam,
+		AuditEntry:      auditEntry,
+	}
+	mock.lockAnnounceAudit.Lock()
+	mock.calls.AnnounceAudit = append(mock.calls.AnnounceAudit, callInfo)
+	mock.lockAnnounceAudit.Unlock()
+	return mock.AnnounceAuditFunc(contextMoqParam, auditEntry)
+}
+
+// AnnounceAuditCalls gets all the calls that were made to AnnounceAudit.
+// Check the length with:
+//
+//	len(mockedAnnounceService.AnnounceAuditCalls())
+func (mock *AnnounceServiceMock) AnnounceAuditCalls() []struct {
+	ContextMoqParam context.Context
+	AuditEntry      radio.AuditEntry
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		AuditEntry      radio.AuditEntry
+	}
+	mock.lockAnnounceAudit.RLock()
+	calls = mock.calls.AnnounceAudit
+	mock.lockAnnounceAudit.RUnlock()
+	return calls
+}
+
 // AnnounceMurder calls AnnounceMurderFunc.
 func (mock *AnnounceServiceMock) AnnounceMurder(ctx context.Context, by *radio.User, force bool) error {
 	if mock.AnnounceMurderFunc == nil {
@@ -1491,9 +1765,48 @@ var _ radio.StorageService = &StorageServiceMock{}
 //
 //		// make and configure a mocked radio.StorageService
 //		mockedStorageService := &StorageServiceMock{
+//			APITokenFunc: func(contextMoqParam context.Context) radio.APITokenStorage {
+//				panic("mock out the APIToken method")
+//			},
+//			APITokenTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error) {
+//				panic("mock out the APITokenTx method")
+//			},
+//			AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+//				panic("mock out the Audit method")
+//			},
+//			AuditTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error) {
+//				panic("mock out the AuditTx method")
+//			},
 //			CloseFunc: func() error {
 //				panic("mock out the Close method")
 //			},
+//			FingerprintFunc: func(contextMoqParam context.Context) radio.FingerprintStorage {
+//				panic("mock out the Fingerprint method")
+//			},
+//			FingerprintTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+//				panic("mock out the FingerprintTx method")
+//			},
+//			GuestFunc: func(contextMoqParam context.Context) radio.GuestStorage {
+//				panic("mock out the Guest method")
+//			},
+//			JournalFunc: func(contextMoqParam context.Context) radio.JournalStorage {
+//				panic("mock out the Journal method")
+//			},
+//			LeaseFunc: func(contextMoqParam context.Context) radio.LeaseStorage {
+//				panic("mock out the Lease method")
+//			},
+//			ListenerAccountFunc: func(contextMoqParam context.Context) radio.ListenerAccountStorage {
+//				panic("mock out the ListenerAccount method")
+//			},
+//			ListenerAccountTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error) {
+//				panic("mock out the ListenerAccountTx method")
+//			},
+//			LyricsFunc: func(contextMoqParam context.Context) radio.LyricsStorage {
+//				panic("mock out the Lyrics method")
+//			},
+//			LyricsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error) {
+//				panic("mock out the LyricsTx method")
+//			},
 //			NewsFunc: func(contextMoqParam context.Context) radio.NewsStorage {
 //				panic("mock out the News method")
 //			},
@@ -1506,6 +1819,12 @@ var _ radio.StorageService = &StorageServiceMock{}
 //			QueueTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
 //				panic("mock out the QueueTx method")
 //			},
+//			RecommendationFunc: func(contextMoqParam context.Context) radio.RecommendationStorage {
+//				panic("mock out the Recommendation method")
+//			},
+//			RecommendationTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error) {
+//				panic("mock out the RecommendationTx method")
+//			},
 //			RelayFunc: func(contextMoqParam context.Context) radio.RelayStorage {
 //				panic("mock out the Relay method")
 //			},
@@ -1515,6 +1834,12 @@ var _ radio.StorageService = &StorageServiceMock{}
 //			RequestFunc: func(contextMoqParam context.Context) radio.RequestStorage {
 //				panic("mock out the Request method")
 //			},
+//			RequestPoolFunc: func(contextMoqParam context.Context) radio.RequestPoolStorage {
+//				panic("mock out the RequestPool method")
+//			},
+//			RequestPoolTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+//				panic("mock out the RequestPoolTx method")
+//			},
 //			RequestTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
 //				panic("mock out the RequestTx method")
 //			},
@@ -1564,9 +1889,48 @@ var _ radio.StorageService = &StorageServiceMock{}
 //
 //	}
 type StorageServiceMock struct {
+	// APITokenFunc mocks the APIToken method.
+	APITokenFunc func(contextMoqParam context.Context) radio.APITokenStorage
+
+	// APITokenTxFunc mocks the APITokenTx method.
+	APITokenTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error)
+
+	// AuditFunc mocks the Audit method.
+	AuditFunc func(contextMoqParam context.Context) radio.AuditStorage
+
+	// AuditTxFunc mocks the AuditTx method.
+	AuditTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error)
+
 	// CloseFunc mocks the Close method.
 	CloseFunc func() error
 
+	// FingerprintFunc mocks the Fingerprint method.
+	FingerprintFunc func(contextMoqParam context.Context) radio.FingerprintStorage
+
+	// FingerprintTxFunc mocks the FingerprintTx method.
+	FingerprintTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error)
+
+	// GuestFunc mocks the Guest method.
+	GuestFunc func(contextMoqParam context.Context) radio.GuestStorage
+
+	// JournalFunc mocks the Journal method.
+	JournalFunc func(contextMoqParam context.Context) radio.JournalStorage
+
+	// LeaseFunc mocks the Lease method.
+	LeaseFunc func(contextMoqParam context.Context) radio.LeaseStorage
+
+	// ListenerAccountFunc mocks the ListenerAccount method.
+	ListenerAccountFunc func(contextMoqParam context.Context) radio.ListenerAccountStorage
+
+	// ListenerAccountTxFunc mocks the ListenerAccountTx method.
+	ListenerAccountTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error)
+
+	// LyricsFunc mocks the Lyrics method.
+	LyricsFunc func(contextMoqParam context.Context) radio.LyricsStorage
+
+	// LyricsTxFunc mocks the LyricsTx method.
+	LyricsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error)
+
 	// NewsFunc mocks the News method.
 	NewsFunc func(contextMoqParam context.Context) radio.NewsStorage
 
@@ -1579,6 +1943,12 @@ type StorageServiceMock struct {
 	// QueueTxFunc mocks the QueueTx method.
 	QueueTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error)
 
+	// RecommendationFunc mocks the Recommendation method.
+	RecommendationFunc func(contextMoqParam context.Context) radio.RecommendationStorage
+
+	// RecommendationTxFunc mocks the RecommendationTx method.
+	RecommendationTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error)
+
 	// RelayFunc mocks the Relay method.
 	RelayFunc func(contextMoqParam context.Context) radio.RelayStorage
 
@@ -1588,6 +1958,12 @@ type StorageServiceMock struct {
 	// RequestFunc mocks the Request method.
 	RequestFunc func(contextMoqParam context.Context) radio.RequestStorage
 
+	// RequestPoolFunc mocks the RequestPool method.
+	RequestPoolFunc func(contextMoqParam context.Context) radio.RequestPoolStorage
+
+	// RequestPoolTxFunc mocks the RequestPoolTx method.
+	RequestPoolTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error)
+
 	// RequestTxFunc mocks the RequestTx method.
 	RequestTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error)
 
@@ -1632,9 +2008,84 @@ type StorageServiceMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// APIToken holds details about calls to the APIToken method.
+		APIToken []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// APITokenTx holds details about calls to the APITokenTx method.
+		APITokenTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+		// Audit holds details about calls to the Audit method.
+		Audit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// AuditTx holds details about calls to the AuditTx method.
+		AuditTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// Fingerprint holds details about calls to the Fingerprint method.
+		Fingerprint []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// FingerprintTx holds details about calls to the FingerprintTx method.
+		FingerprintTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+		// Guest holds details about calls to the Guest method.
+		Guest []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// Journal holds details about calls to the Journal method.
+		Journal []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// Lease holds details about calls to the Lease method.
+		Lease []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ListenerAccount holds details about calls to the ListenerAccount method.
+		ListenerAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ListenerAccountTx holds details about calls to the ListenerAccountTx method.
+		ListenerAccountTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+		// Lyrics holds details about calls to the Lyrics method.
+		Lyrics []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// LyricsTx holds details about calls to the LyricsTx method.
+		LyricsTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 		// News holds details about calls to the News method.
 		News []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -1659,6 +2110,18 @@ type StorageServiceMock struct {
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
+		// Recommendation holds details about calls to the Recommendation method.
+		Recommendation []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// RecommendationTx holds details about calls to the RecommendationTx method.
+		RecommendationTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 		// Relay holds details about calls to the Relay method.
 		Relay []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -1676,6 +2139,18 @@ type StorageServiceMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// RequestPool holds details about calls to the RequestPool method.
+		RequestPool []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// RequestPoolTx holds details about calls to the RequestPoolTx method.
+		RequestPoolTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 		// RequestTx holds details about calls to the RequestTx method.
 		RequestTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -1761,365 +2236,410 @@ type StorageServiceMock struct {
 			StorageTx radio.StorageTx
 		}
 	}
-	lockClose         sync.RWMutex
-	lockNews          sync.RWMutex
-	lockNewsTx        sync.RWMutex
-	lockQueue         sync.RWMutex
-	lockQueueTx       sync.RWMutex
-	lockRelay         sync.RWMutex
-	lockRelayTx       sync.RWMutex
-	lockRequest       sync.RWMutex
-	lockRequestTx     sync.RWMutex
-	lockSchedule      sync.RWMutex
-	lockScheduleTx    sync.RWMutex
-	lockSessions      sync.RWMutex
-	lockSessionsTx    sync.RWMutex
-	lockSong          sync.RWMutex
-	lockSongTx        sync.RWMutex
-	lockStatus        sync.RWMutex
-	lockSubmissions   sync.RWMutex
-	lockSubmissionsTx sync.RWMutex
-	lockTrack         sync.RWMutex
-	lockTrackTx       sync.RWMutex
-	lockUser          sync.RWMutex
-	lockUserTx        sync.RWMutex
-}
-
-// Close calls CloseFunc.
-func (mock *StorageServiceMock) Close() error {
-	if mock.CloseFunc == nil {
-		panic("StorageServiceMock.CloseFunc: method is nil but StorageService.Close was just called")
+	lockAPIToken          sync.RWMutex
+	lockAPITokenTx        sync.RWMutex
+	lockAudit             sync.RWMutex
+	lockAuditTx           sync.RWMutex
+	lockClose             sync.RWMutex
+	lockFingerprint       sync.RWMutex
+	lockFingerprintTx     sync.RWMutex
+	lockGuest             sync.RWMutex
+	lockJournal           sync.RWMutex
+	lockLease             sync.RWMutex
+	lockListenerAccount   sync.RWMutex
+	lockListenerAccountTx sync.RWMutex
+	lockLyrics            sync.RWMutex
+	lockLyricsTx          sync.RWMutex
+	lockNews              sync.RWMutex
+	lockNewsTx            sync.RWMutex
+	lockQueue             sync.RWMutex
+	lockQueueTx           sync.RWMutex
+	lockRecommendation    sync.RWMutex
+	lockRecommendationTx  sync.RWMutex
+	lockRelay             sync.RWMutex
+	lockRelayTx           sync.RWMutex
+	lockRequest           sync.RWMutex
+	lockRequestPool       sync.RWMutex
+	lockRequestPoolTx     sync.RWMutex
+	lockRequestTx         sync.RWMutex
+	lockSchedule          sync.RWMutex
+	lockScheduleTx        sync.RWMutex
+	lockSessions          sync.RWMutex
+	lockSessionsTx        sync.RWMutex
+	lockSong              sync.RWMutex
+	lockSongTx            sync.RWMutex
+	lockStatus            sync.RWMutex
+	lockSubmissions       sync.RWMutex
+	lockSubmissionsTx     sync.RWMutex
+	lockTrack             sync.RWMutex
+	lockTrackTx           sync.RWMutex
+	lockUser              sync.RWMutex
+	lockUserTx            sync.RWMutex
+}
+
+// APIToken calls APITokenFunc.
+func (mock *StorageServiceMock) APIToken(contextMoqParam context.Context) radio.APITokenStorage {
+	if mock.APITokenFunc == nil {
+		panic("StorageServiceMock.APITokenFunc: method is nil but StorageService.APIToken was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockClose.Lock()
-	mock.calls.Close = append(mock.calls.Close, callInfo)
-	mock.lockClose.Unlock()
-	return mock.CloseFunc()
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockAPIToken.Lock()
+	mock.calls.APIToken = append(mock.calls.APIToken, callInfo)
+	mock.lockAPIToken.Unlock()
+	return mock.APITokenFunc(contextMoqParam)
 }
 
-// CloseCalls gets all the calls that were made to Close.
+// APITokenCalls gets all the calls that were made to APIToken.
 // Check the length with:
 //
-//	len(mockedStorageService.CloseCalls())
-func (mock *StorageServiceMock) CloseCalls() []struct {
+//	len(mockedStorageService.APITokenCalls())
+func (mock *StorageServiceMock) APITokenCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
+		ContextMoqParam context.Context
 	}
-	mock.lockClose.RLock()
-	calls = mock.calls.Close
-	mock.lockClose.RUnlock()
+	mock.lockAPIToken.RLock()
+	calls = mock.calls.APIToken
+	mock.lockAPIToken.RUnlock()
 	return calls
 }
 
-// News calls NewsFunc.
-func (mock *StorageServiceMock) News(contextMoqParam context.Context) radio.NewsStorage {
-	if mock.NewsFunc == nil {
-		panic("StorageServiceMock.NewsFunc: method is nil but StorageService.News was just called")
+// APITokenTx calls APITokenTxFunc.
+func (mock *StorageServiceMock) APITokenTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error) {
+	if mock.APITokenTxFunc == nil {
+		panic("StorageServiceMock.APITokenTxFunc: method is nil but StorageService.APITokenTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockNews.Lock()
-	mock.calls.News = append(mock.calls.News, callInfo)
-	mock.lockNews.Unlock()
-	return mock.NewsFunc(contextMoqParam)
+	mock.lockAPITokenTx.Lock()
+	mock.calls.APITokenTx = append(mock.calls.APITokenTx, callInfo)
+	mock.lockAPITokenTx.Unlock()
+	return mock.APITokenTxFunc(contextMoqParam, storageTx)
 }
 
-// NewsCalls gets all the calls that were made to News.
+// APITokenTxCalls gets all the calls that were made to APITokenTx.
 // Check the length with:
 //
-//	len(mockedStorageService.NewsCalls())
-func (mock *StorageServiceMock) NewsCalls() []struct {
+//	len(mockedStorageService.APITokenTxCalls())
+func (mock *StorageServiceMock) APITokenTxCalls() []struct {
 	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockNews.RLock()
-	calls = mock.calls.News
-	mock.lockNews.RUnlock()
+	mock.lockAPITokenTx.RLock()
+	calls = mock.calls.APITokenTx
+	mock.lockAPITokenTx.RUnlock()
 	return calls
 }
 
-// NewsTx calls NewsTxFunc.
-func (mock *StorageServiceMock) NewsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
-	if mock.NewsTxFunc == nil {
-		panic("StorageServiceMock.NewsTxFunc: method is nil but StorageService.NewsTx was just called")
+// Audit calls AuditFunc.
+func (mock *StorageServiceMock) Audit(contextMoqParam context.Context) radio.AuditStorage {
+	if mock.AuditFunc == nil {
+		panic("StorageServiceMock.AuditFunc: method is nil but StorageService.Audit was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
 	}
-	mock.lockNewsTx.Lock()
-	mock.calls.NewsTx = append(mock.calls.NewsTx, callInfo)
-	mock.lockNewsTx.Unlock()
-	return mock.NewsTxFunc(contextMoqParam, storageTx)
+	mock.lockAudit.Lock()
+	mock.calls.Audit = append(mock.calls.Audit, callInfo)
+	mock.lockAudit.Unlock()
+	return mock.AuditFunc(contextMoqParam)
 }
 
-// NewsTxCalls gets all the calls that were made to NewsTx.
+// AuditCalls gets all the calls that were made to Audit.
 // Check the length with:
 //
-//	len(mockedStorageService.NewsTxCalls())
-func (mock *StorageServiceMock) NewsTxCalls() []struct {
+//	len(mockedStorageService.AuditCalls())
+func (mock *StorageServiceMock) AuditCalls() []struct {
 	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}
-	mock.lockNewsTx.RLock()
-	calls = mock.calls.NewsTx
-	mock.lockNewsTx.RUnlock()
+	mock.lockAudit.RLock()
+	calls = mock.calls.Audit
+	mock.lockAudit.RUnlock()
 	return calls
 }
 
-// Queue calls QueueFunc.
-func (mock *StorageServiceMock) Queue(contextMoqParam context.Context) radio.QueueStorage {
-	if mock.QueueFunc == nil {
-		panic("StorageServiceMock.QueueFunc: method is nil but StorageService.Queue was just called")
+// AuditTx calls AuditTxFunc.
+func (mock *StorageServiceMock) AuditTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error) {
+	if mock.AuditTxFunc == nil {
+		panic("StorageServiceMock.AuditTxFunc: method is nil but StorageService.AuditTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockQueue.Lock()
-	mock.calls.Queue = append(mock.calls.Queue, callInfo)
-	mock.lockQueue.Unlock()
-	return mock.QueueFunc(contextMoqParam)
+	mock.lockAuditTx.Lock()
+	mock.calls.AuditTx = append(mock.calls.AuditTx, callInfo)
+	mock.lockAuditTx.Unlock()
+	return mock.AuditTxFunc(contextMoqParam, storageTx)
 }
 
-// QueueCalls gets all the calls that were made to Queue.
+// AuditTxCalls gets all the calls that were made to AuditTx.
 // Check the length with:
 //
-//	len(mockedStorageService.QueueCalls())
-func (mock *StorageServiceMock) QueueCalls() []struct {
+//	len(mockedStorageService.AuditTxCalls())
+func (mock *StorageServiceMock) AuditTxCalls() []struct {
 	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockQueue.RLock()
-	calls = mock.calls.Queue
-	mock.lockQueue.RUnlock()
+	mock.lockAuditTx.RLock()
+	calls = mock.calls.AuditTx
+	mock.lockAuditTx.RUnlock()
 	return calls
 }
 
-// QueueTx calls QueueTxFunc.
-func (mock *StorageServiceMock) QueueTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
-	if mock.QueueTxFunc == nil {
-		panic("StorageServiceMock.QueueTxFunc: method is nil but StorageService.QueueTx was just called")
+// Close calls CloseFunc.
+func (mock *StorageServiceMock) Close() error {
+	if mock.CloseFunc == nil {
+		panic("StorageServiceMock.CloseFunc: method is nil but StorageService.Close was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockClose.Lock()
+	mock.calls.Close = append(mock.calls.Close, callInfo)
+	mock.lockClose.Unlock()
+	return mock.CloseFunc()
+}
+
+// CloseCalls gets all the calls that were made to Close.
+// Check the length with:
+//
+//	len(mockedStorageService.CloseCalls())
+func (mock *StorageServiceMock) CloseCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockClose.RLock()
+	calls = mock.calls.Close
+	mock.lockClose.RUnlock()
+	return calls
+}
+
+// Fingerprint calls FingerprintFunc.
+func (mock *StorageServiceMock) Fingerprint(contextMoqParam context.Context) radio.FingerprintStorage {
+	if mock.FingerprintFunc == nil {
+		panic("StorageServiceMock.FingerprintFunc: method is nil but StorageService.Fingerprint was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
 	}
-	mock.lockQueueTx.Lock()
-	mock.calls.QueueTx = append(mock.calls.QueueTx, callInfo)
-	mock.lockQueueTx.Unlock()
-	return mock.QueueTxFunc(contextMoqParam, storageTx)
+	mock.lockFingerprint.Lock()
+	mock.calls.Fingerprint = append(mock.calls.Fingerprint, callInfo)
+	mock.lockFingerprint.Unlock()
+	return mock.FingerprintFunc(contextMoqParam)
 }
 
-// QueueTxCalls gets all the calls that were made to QueueTx.
+// FingerprintCalls gets all the calls that were made to Fingerprint.
 // Check the length with:
 //
-//	len(mockedStorageService.QueueTxCalls())
-func (mock *StorageServiceMock) QueueTxCalls() []struct {
+//	len(mockedStorageService.FingerprintCalls())
+func (mock *StorageServiceMock) FingerprintCalls() []struct {
 	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}
-	mock.lockQueueTx.RLock()
-	calls = mock.calls.QueueTx
-	mock.lockQueueTx.RUnlock()
+	mock.lockFingerprint.RLock()
+	calls = mock.calls.Fingerprint
+	mock.lockFingerprint.RUnlock()
 	return calls
 }
 
-// Relay calls RelayFunc.
-func (mock *StorageServiceMock) Relay(contextMoqParam context.Context) radio.RelayStorage {
-	if mock.RelayFunc == nil {
-		panic("StorageServiceMock.RelayFunc: method is nil but StorageService.Relay was just called")
+// FingerprintTx calls FingerprintTxFunc.
+func (mock *StorageServiceMock) FingerprintTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+	if mock.FingerprintTxFunc == nil {
+		panic("StorageServiceMock.FingerprintTxFunc: method is nil but StorageService.FingerprintTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockRelay.Lock()
-	mock.calls.Relay = append(mock.calls.Relay, callInfo)
-	mock.lockRelay.Unlock()
-	return mock.RelayFunc(contextMoqParam)
+	mock.lockFingerprintTx.Lock()
+	mock.calls.FingerprintTx = append(mock.calls.FingerprintTx, callInfo)
+	mock.lockFingerprintTx.Unlock()
+	return mock.FingerprintTxFunc(contextMoqParam, storageTx)
 }
 
-// RelayCalls gets all the calls that were made to Relay.
+// FingerprintTxCalls gets all the calls that were made to FingerprintTx.
 // Check the length with:
 //
-//	len(mockedStorageService.RelayCalls())
-func (mock *StorageServiceMock) RelayCalls() []struct {
+//	len(mockedStorageService.FingerprintTxCalls())
+func (mock *StorageServiceMock) FingerprintTxCalls() []struct {
 	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockRelay.RLock()
-	calls = mock.calls.Relay
-	mock.lockRelay.RUnlock()
+	mock.lockFingerprintTx.RLock()
+	calls = mock.calls.FingerprintTx
+	mock.lockFingerprintTx.RUnlock()
 	return calls
 }
 
-// RelayTx calls RelayTxFunc.
-func (mock *StorageServiceMock) RelayTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
-	if mock.RelayTxFunc == nil {
-		panic("StorageServiceMock.RelayTxFunc: method is nil but StorageService.RelayTx was just called")
+// Guest calls GuestFunc.
+func (mock *StorageServiceMock) Guest(contextMoqParam context.Context) radio.GuestStorage {
+	if mock.GuestFunc == nil {
+		panic("StorageServiceMock.GuestFunc: method is nil but StorageService.Guest was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
 	}
-	mock.lockRelayTx.Lock()
-	mock.calls.RelayTx = append(mock.calls.RelayTx, callInfo)
-	mock.lockRelayTx.Unlock()
-	return mock.RelayTxFunc(contextMoqParam, storageTx)
+	mock.lockGuest.Lock()
+	mock.calls.Guest = append(mock.calls.Guest, callInfo)
+	mock.lockGuest.Unlock()
+	return mock.GuestFunc(contextMoqParam)
 }
 
-// RelayTxCalls gets all the calls that were made to RelayTx.
+// GuestCalls gets all the calls that were made to Guest.
 // Check the length with:
 //
-//	len(mockedStorageService.RelayTxCalls())
-func (mock *StorageServiceMock) RelayTxCalls() []struct {
+//	len(mockedStorageService.GuestCalls())
+func (mock *StorageServiceMock) GuestCalls() []struct {
 	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}
-	mock.lockRelayTx.RLock()
-	calls = mock.calls.RelayTx
-	mock.lockRelayTx.RUnlock()
+	mock.lockGuest.RLock()
+	calls = mock.calls.Guest
+	mock.lockGuest.RUnlock()
 	return calls
 }
 
-// Request calls RequestFunc.
-func (mock *StorageServiceMock) Request(contextMoqParam context.Context) radio.RequestStorage {
-	if mock.RequestFunc == nil {
-		panic("StorageServiceMock.RequestFunc: method is nil but StorageService.Request was just called")
+// Journal calls JournalFunc.
+func (mock *StorageServiceMock) Journal(contextMoqParam context.Context) radio.JournalStorage {
+	if mock.JournalFunc == nil {
+		panic("StorageServiceMock.JournalFunc: method is nil but StorageService.Journal was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockRequest.Lock()
-	mock.calls.Request = append(mock.calls.Request, callInfo)
-	mock.lockRequest.Unlock()
-	return mock.RequestFunc(contextMoqParam)
+	mock.lockJournal.Lock()
+	mock.calls.Journal = append(mock.calls.Journal, callInfo)
+	mock.lockJournal.Unlock()
+	return mock.JournalFunc(contextMoqParam)
 }
 
-// RequestCalls gets all the calls that were made to Request.
+// JournalCalls gets all the calls that were made to Journal.
 // Check the length with:
 //
-//	len(mockedStorageService.RequestCalls())
-func (mock *StorageServiceMock) RequestCalls() []struct {
+//	len(mockedStorageService.JournalCalls())
+func (mock *StorageServiceMock) JournalCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockRequest.RLock()
-	calls = mock.calls.Request
-	mock.lockRequest.RUnlock()
+	mock.lockJournal.RLock()
+	calls = mock.calls.Journal
+	mock.lockJournal.RUnlock()
 	return calls
 }
 
-// RequestTx calls RequestTxFunc.
-func (mock *StorageServiceMock) RequestTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
-	if mock.RequestTxFunc == nil {
-		panic("StorageServiceMock.RequestTxFunc: method is nil but StorageService.RequestTx was just called")
+// Lease calls LeaseFunc.
+func (mock *StorageServiceMock) Lease(contextMoqParam context.Context) radio.LeaseStorage {
+	if mock.LeaseFunc == nil {
+		panic("StorageServiceMock.LeaseFunc: method is nil but StorageService.Lease was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
 	}
-	mock.lockRequestTx.Lock()
-	mock.calls.RequestTx = append(mock.calls.RequestTx, callInfo)
-	mock.lockRequestTx.Unlock()
-	return mock.RequestTxFunc(contextMoqParam, storageTx)
+	mock.lockLease.Lock()
+	mock.calls.Lease = append(mock.calls.Lease, callInfo)
+	mock.lockLease.Unlock()
+	return mock.LeaseFunc(contextMoqParam)
 }
 
-// RequestTxCalls gets all the calls that were made to RequestTx.
+// LeaseCalls gets all the calls that were made to Lease.
 // Check the length with:
 //
-//	len(mockedStorageService.RequestTxCalls())
-func (mock *StorageServiceMock) RequestTxCalls() []struct {
+//	len(mockedStorageService.LeaseCalls())
+func (mock *StorageServiceMock) LeaseCalls() []struct {
 	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
 	}
-	mock.lockRequestTx.RLock()
-	calls = mock.calls.RequestTx
-	mock.lockRequestTx.RUnlock()
+	mock.lockLease.RLock()
+	calls = mock.calls.Lease
+	mock.lockLease.RUnlock()
 	return calls
 }
 
-// Schedule calls ScheduleFunc.
-func (mock *StorageServiceMock) Schedule(contextMoqParam context.Context) radio.ScheduleStorage {
-	if mock.ScheduleFunc == nil {
-		panic("StorageServiceMock.ScheduleFunc: method is nil but StorageService.Schedule was just called")
+// ListenerAccount calls ListenerAccountFunc.
+func (mock *StorageServiceMock) ListenerAccount(contextMoqParam context.Context) radio.ListenerAccountStorage {
+	if mock.ListenerAccountFunc == nil {
+		panic("StorageServiceMock.ListenerAccountFunc: method is nil but StorageService.ListenerAccount was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSchedule.Lock()
-	mock.calls.Schedule = append(mock.calls.Schedule, callInfo)
-	mock.lockSchedule.Unlock()
-	return mock.ScheduleFunc(contextMoqParam)
+	mock.lockListenerAccount.Lock()
+	mock.calls.ListenerAccount = append(mock.calls.ListenerAccount, callInfo)
+	mock.lockListenerAccount.Unlock()
+	return mock.ListenerAccountFunc(contextMoqParam)
 }
 
-// ScheduleCalls gets all the calls that were made to Schedule.
+// ListenerAccountCalls gets all the calls that were made to ListenerAccount.
 // Check the length with:
 //
-//	len(mockedStorageService.ScheduleCalls())
-func (mock *StorageServiceMock) ScheduleCalls() []struct {
+//	len(mockedStorageService.ListenerAccountCalls())
+func (mock *StorageServiceMock) ListenerAccountCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockSchedule.RLock()
-	calls = mock.calls.Schedule
-	mock.lockSchedule.RUnlock()
+	mock.lockListenerAccount.RLock()
+	calls = mock.calls.ListenerAccount
+	mock.lockListenerAccount.RUnlock()
 	return calls
 }
 
-// ScheduleTx calls ScheduleTxFunc.
-func (mock *StorageServiceMock) ScheduleTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
-	if mock.ScheduleTxFunc == nil {
-		panic("StorageServiceMock.ScheduleTxFunc: method is nil but StorageService.ScheduleTx was just called")
+// ListenerAccountTx calls ListenerAccountTxFunc.
+func (mock *StorageServiceMock) ListenerAccountTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error) {
+	if mock.ListenerAccountTxFunc == nil {
+		panic("StorageServiceMock.ListenerAccountTxFunc: method is nil but StorageService.ListenerAccountTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2128,17 +2648,17 @@ func (mock *StorageServiceMock) ScheduleTx(contextMoqParam context.Context, stor
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockScheduleTx.Lock()
-	mock.calls.ScheduleTx = append(mock.calls.ScheduleTx, callInfo)
-	mock.lockScheduleTx.Unlock()
-	return mock.ScheduleTxFunc(contextMoqParam, storageTx)
+	mock.lockListenerAccountTx.Lock()
+	mock.calls.ListenerAccountTx = append(mock.calls.ListenerAccountTx, callInfo)
+	mock.lockListenerAccountTx.Unlock()
+	return mock.ListenerAccountTxFunc(contextMoqParam, storageTx)
 }
 
-// ScheduleTxCalls gets all the calls that were made to ScheduleTx.
+// ListenerAccountTxCalls gets all the calls that were made to ListenerAccountTx.
 // Check the length with:
 //
-//	len(mockedStorageService.ScheduleTxCalls())
-func (mock *StorageServiceMock) ScheduleTxCalls() []struct {
+//	len(mockedStorageService.ListenerAccountTxCalls())
+func (mock *StorageServiceMock) ListenerAccountTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2146,48 +2666,48 @@ func (mock *StorageServiceMock) ScheduleTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockScheduleTx.RLock()
-	calls = mock.calls.ScheduleTx
-	mock.lockScheduleTx.RUnlock()
+	mock.lockListenerAccountTx.RLock()
+	calls = mock.calls.ListenerAccountTx
+	mock.lockListenerAccountTx.RUnlock()
 	return calls
 }
 
-// Sessions calls SessionsFunc.
-func (mock *StorageServiceMock) Sessions(contextMoqParam context.Context) radio.SessionStorage {
-	if mock.SessionsFunc == nil {
-		panic("StorageServiceMock.SessionsFunc: method is nil but StorageService.Sessions was just called")
+// Lyrics calls LyricsFunc.
+func (mock *StorageServiceMock) Lyrics(contextMoqParam context.Context) radio.LyricsStorage {
+	if mock.LyricsFunc == nil {
+		panic("StorageServiceMock.LyricsFunc: method is nil but StorageService.Lyrics was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSessions.Lock()
-	mock.calls.Sessions = append(mock.calls.Sessions, callInfo)
-	mock.lockSessions.Unlock()
-	return mock.SessionsFunc(contextMoqParam)
+	mock.lockLyrics.Lock()
+	mock.calls.Lyrics = append(mock.calls.Lyrics, callInfo)
+	mock.lockLyrics.Unlock()
+	return mock.LyricsFunc(contextMoqParam)
 }
 
-// SessionsCalls gets all the calls that were made to Sessions.
+// LyricsCalls gets all the calls that were made to Lyrics.
 // Check the length with:
 //
-//	len(mockedStorageService.SessionsCalls())
-func (mock *StorageServiceMock) SessionsCalls() []struct {
+//	len(mockedStorageService.LyricsCalls())
+func (mock *StorageServiceMock) LyricsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockSessions.RLock()
-	calls = mock.calls.Sessions
-	mock.lockSessions.RUnlock()
+	mock.lockLyrics.RLock()
+	calls = mock.calls.Lyrics
+	mock.lockLyrics.RUnlock()
 	return calls
 }
 
-// SessionsTx calls SessionsTxFunc.
-func (mock *StorageServiceMock) SessionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
-	if mock.SessionsTxFunc == nil {
-		panic("StorageServiceMock.SessionsTxFunc: method is nil but StorageService.SessionsTx was just called")
+// LyricsTx calls LyricsTxFunc.
+func (mock *StorageServiceMock) LyricsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error) {
+	if mock.LyricsTxFunc == nil {
+		panic("StorageServiceMock.LyricsTxFunc: method is nil but StorageService.LyricsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2196,17 +2716,17 @@ func (mock *StorageServiceMock) SessionsTx(contextMoqParam context.Context, stor
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockSessionsTx.Lock()
-	mock.calls.SessionsTx = append(mock.calls.SessionsTx, callInfo)
-	mock.lockSessionsTx.Unlock()
-	return mock.SessionsTxFunc(contextMoqParam, storageTx)
+	mock.lockLyricsTx.Lock()
+	mock.calls.LyricsTx = append(mock.calls.LyricsTx, callInfo)
+	mock.lockLyricsTx.Unlock()
+	return mock.LyricsTxFunc(contextMoqParam, storageTx)
 }
 
-// SessionsTxCalls gets all the calls that were made to SessionsTx.
+// LyricsTxCalls gets all the calls that were made to LyricsTx.
 // Check the length with:
 //
-//	len(mockedStorageService.SessionsTxCalls())
-func (mock *StorageServiceMock) SessionsTxCalls() []struct {
+//	len(mockedStorageService.LyricsTxCalls())
+func (mock *StorageServiceMock) LyricsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2214,48 +2734,48 @@ func (mock *StorageServiceMock) SessionsTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockSessionsTx.RLock()
-	calls = mock.calls.SessionsTx
-	mock.lockSessionsTx.RUnlock()
+	mock.lockLyricsTx.RLock()
+	calls = mock.calls.LyricsTx
+	mock.lockLyricsTx.RUnlock()
 	return calls
 }
 
-// Song calls SongFunc.
-func (mock *StorageServiceMock) Song(contextMoqParam context.Context) radio.SongStorage {
-	if mock.SongFunc == nil {
-		panic("StorageServiceMock.SongFunc: method is nil but StorageService.Song was just called")
+// News calls NewsFunc.
+func (mock *StorageServiceMock) News(contextMoqParam context.Context) radio.NewsStorage {
+	if mock.NewsFunc == nil {
+		panic("StorageServiceMock.NewsFunc: method is nil but StorageService.News was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSong.Lock()
-	mock.calls.Song = append(mock.calls.Song, callInfo)
-	mock.lockSong.Unlock()
-	return mock.SongFunc(contextMoqParam)
+	mock.lockNews.Lock()
+	mock.calls.News = append(mock.calls.News, callInfo)
+	mock.lockNews.Unlock()
+	return mock.NewsFunc(contextMoqParam)
 }
 
-// SongCalls gets all the calls that were made to Song.
+// NewsCalls gets all the calls that were made to News.
 // Check the length with:
 //
-//	len(mockedStorageService.SongCalls())
-func (mock *StorageServiceMock) SongCalls() []struct {
+//	len(mockedStorageService.NewsCalls())
+func (mock *StorageServiceMock) NewsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockSong.RLock()
-	calls = mock.calls.Song
-	mock.lockSong.RUnlock()
+	mock.lockNews.RLock()
+	calls = mock.calls.News
+	mock.lockNews.RUnlock()
 	return calls
 }
 
-// SongTx calls SongTxFunc.
-func (mock *StorageServiceMock) SongTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
-	if mock.SongTxFunc == nil {
-		panic("StorageServiceMock.SongTxFunc: method is nil but StorageService.SongTx was just called")
+// NewsTx calls NewsTxFunc.
+func (mock *StorageServiceMock) NewsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
+	if mock.NewsTxFunc == nil {
+		panic("StorageServiceMock.NewsTxFunc: method is nil but StorageService.NewsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2264,17 +2784,17 @@ func (mock *StorageServiceMock) SongTx(contextMoqParam context.Context, storageT
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockSongTx.Lock()
-	mock.calls.SongTx = append(mock.calls.SongTx, callInfo)
-	mock.lockSongTx.Unlock()
-	return mock.SongTxFunc(contextMoqParam, storageTx)
-}
+	mock.lockNewsTx.Lock()
+	mock.calls.NewsTx = append(mock.calls.NewsTx, callInfo)
+	mock.lockNewsTx.Unlock()
+	return mock.NewsTxFunc(contextMoqParam, storageTx)
+}
 
-// SongTxCalls gets all the calls that were made to SongTx.
+// NewsTxCalls gets all the calls that were made to NewsTx.
 // Check the length with:
 //
-//	len(mockedStorageService.SongTxCalls())
-func (mock *StorageServiceMock) SongTxCalls() []struct {
+//	len(mockedStorageService.NewsTxCalls())
+func (mock *StorageServiceMock) NewsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2282,80 +2802,116 @@ func (mock *StorageServiceMock) SongTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockSongTx.RLock()
-	calls = mock.calls.SongTx
-	mock.lockSongTx.RUnlock()
+	mock.lockNewsTx.RLock()
+	calls = mock.calls.NewsTx
+	mock.lockNewsTx.RUnlock()
 	return calls
 }
 
-// Status calls StatusFunc.
-func (mock *StorageServiceMock) Status(contextMoqParam context.Context) radio.StatusStorage {
-	if mock.StatusFunc == nil {
-		panic("StorageServiceMock.StatusFunc: method is nil but StorageService.Status was just called")
+// Queue calls QueueFunc.
+func (mock *StorageServiceMock) Queue(contextMoqParam context.Context) radio.QueueStorage {
+	if mock.QueueFunc == nil {
+		panic("StorageServiceMock.QueueFunc: method is nil but StorageService.Queue was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockStatus.Lock()
-	mock.calls.Status = append(mock.calls.Status, callInfo)
-	mock.lockStatus.Unlock()
-	return mock.StatusFunc(contextMoqParam)
+	mock.lockQueue.Lock()
+	mock.calls.Queue = append(mock.calls.Queue, callInfo)
+	mock.lockQueue.Unlock()
+	return mock.QueueFunc(contextMoqParam)
 }
 
-// StatusCalls gets all the calls that were made to Status.
+// QueueCalls gets all the calls that were made to Queue.
 // Check the length with:
 //
-//	len(mockedStorageService.StatusCalls())
-func (mock *StorageServiceMock) StatusCalls() []struct {
+//	len(mockedStorageService.QueueCalls())
+func (mock *StorageServiceMock) QueueCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockStatus.RLock()
-	calls = mock.calls.Status
-	mock.lockStatus.RUnlock()
+	mock.lockQueue.RLock()
+	calls = mock.calls.Queue
+	mock.lockQueue.RUnlock()
 	return calls
 }
 
-// Submissions calls SubmissionsFunc.
-func (mock *StorageServiceMock) Submissions(contextMoqParam context.Context) radio.SubmissionStorage {
-	if mock.SubmissionsFunc == nil {
-		panic("StorageServiceMock.SubmissionsFunc: method is nil but StorageService.Submissions was just called")
+// QueueTx calls QueueTxFunc.
+func (mock *StorageServiceMock) QueueTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
+	if mock.QueueTxFunc == nil {
+		panic("StorageServiceMock.QueueTxFunc: method is nil but StorageService.QueueTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
 		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockSubmissions.Lock()
-	mock.calls.Submissions = append(mock.calls.Submissions, callInfo)
-	mock.lockSubmissions.Unlock()
-	return mock.SubmissionsFunc(contextMoqParam)
+	mock.lockQueueTx.Lock()
+	mock.calls.QueueTx = append(mock.calls.QueueTx, callInfo)
+	mock.lockQueueTx.Unlock()
+	return mock.QueueTxFunc(contextMoqParam, storageTx)
 }
 
-// SubmissionsCalls gets all the calls that were made to Submissions.
+// QueueTxCalls gets all the calls that were made to QueueTx.
 // Check the length with:
 //
-//	len(mockedStorageService.SubmissionsCalls())
-func (mock *StorageServiceMock) SubmissionsCalls() []struct {
+//	len(mockedStorageService.QueueTxCalls())
+func (mock *StorageServiceMock) QueueTxCalls() []struct {
 	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockSubmissions.RLock()
-	calls = mock.calls.Submissions
-	mock.lockSubmissions.RUnlock()
+	mock.lockQueueTx.RLock()
+	calls = mock.calls.QueueTx
+	mock.lockQueueTx.RUnlock()
 	return calls
 }
 
-// SubmissionsTx calls SubmissionsTxFunc.
-func (mock *StorageServiceMock) SubmissionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
-	if mock.SubmissionsTxFunc == nil {
-		panic("StorageServiceMock.SubmissionsTxFunc: method is nil but StorageService.SubmissionsTx was just called")
+// Recommendation calls RecommendationFunc.
+func (mock *StorageServiceMock) Recommendation(contextMoqParam context.Context) radio.RecommendationStorage {
+	if mock.RecommendationFunc == nil {
+		panic("StorageServiceMock.RecommendationFunc: method is nil but StorageService.Recommendation was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockRecommendation.Lock()
+	mock.calls.Recommendation = append(mock.calls.Recommendation, callInfo)
+	mock.lockRecommendation.Unlock()
+	return mock.RecommendationFunc(contextMoqParam)
+}
+
+// RecommendationCalls gets all the calls that were made to Recommendation.
+// Check the length with:
+//
+//	len(mockedStorageService.RecommendationCalls())
+func (mock *StorageServiceMock) RecommendationCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockRecommendation.RLock()
+	calls = mock.calls.Recommendation
+	mock.lockRecommendation.RUnlock()
+	return calls
+}
+
+// RecommendationTx calls RecommendationTxFunc.
+func (mock *StorageServiceMock) RecommendationTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error) {
+	if mock.RecommendationTxFunc == nil {
+		panic("StorageServiceMock.RecommendationTxFunc: method is nil but StorageService.RecommendationTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2364,17 +2920,17 @@ func (mock *StorageServiceMock) SubmissionsTx(contextMoqParam context.Context, s
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockSubmissionsTx.Lock()
-	mock.calls.SubmissionsTx = append(mock.calls.SubmissionsTx, callInfo)
-	mock.lockSubmissionsTx.Unlock()
-	return mock.SubmissionsTxFunc(contextMoqParam, storageTx)
+	mock.lockRecommendationTx.Lock()
+	mock.calls.RecommendationTx = append(mock.calls.RecommendationTx, callInfo)
+	mock.lockRecommendationTx.Unlock()
+	return mock.RecommendationTxFunc(contextMoqParam, storageTx)
 }
 
-// SubmissionsTxCalls gets all the calls that were made to SubmissionsTx.
+// RecommendationTxCalls gets all the calls that were made to RecommendationTx.
 // Check the length with:
 //
-//	len(mockedStorageService.SubmissionsTxCalls())
-func (mock *StorageServiceMock) SubmissionsTxCalls() []struct {
+//	len(mockedStorageService.RecommendationTxCalls())
+func (mock *StorageServiceMock) RecommendationTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2382,48 +2938,48 @@ func (mock *StorageServiceMock) SubmissionsTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockSubmissionsTx.RLock()
-	calls = mock.calls.SubmissionsTx
-	mock.lockSubmissionsTx.RUnlock()
+	mock.lockRecommendationTx.RLock()
+	calls = mock.calls.RecommendationTx
+	mock.lockRecommendationTx.RUnlock()
 	return calls
 }
 
-// Track calls TrackFunc.
-func (mock *StorageServiceMock) Track(contextMoqParam context.Context) radio.TrackStorage {
-	if mock.TrackFunc == nil {
-		panic("StorageServiceMock.TrackFunc: method is nil but StorageService.Track was just called")
+// Relay calls RelayFunc.
+func (mock *StorageServiceMock) Relay(contextMoqParam context.Context) radio.RelayStorage {
+	if mock.RelayFunc == nil {
+		panic("StorageServiceMock.RelayFunc: method is nil but StorageService.Relay was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockTrack.Lock()
-	mock.calls.Track = append(mock.calls.Track, callInfo)
-	mock.lockTrack.Unlock()
-	return mock.TrackFunc(contextMoqParam)
+	mock.lockRelay.Lock()
+	mock.calls.Relay = append(mock.calls.Relay, callInfo)
+	mock.lockRelay.Unlock()
+	return mock.RelayFunc(contextMoqParam)
 }
 
-// TrackCalls gets all the calls that were made to Track.
+// RelayCalls gets all the calls that were made to Relay.
 // Check the length with:
 //
-//	len(mockedStorageService.TrackCalls())
-func (mock *StorageServiceMock) TrackCalls() []struct {
+//	len(mockedStorageService.RelayCalls())
+func (mock *StorageServiceMock) RelayCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockTrack.RLock()
-	calls = mock.calls.Track
-	mock.lockTrack.RUnlock()
+	mock.lockRelay.RLock()
+	calls = mock.calls.Relay
+	mock.lockRelay.RUnlock()
 	return calls
 }
 
-// TrackTx calls TrackTxFunc.
-func (mock *StorageServiceMock) TrackTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
-	if mock.TrackTxFunc == nil {
-		panic("StorageServiceMock.TrackTxFunc: method is nil but StorageService.TrackTx was just called")
+// RelayTx calls RelayTxFunc.
+func (mock *StorageServiceMock) RelayTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
+	if mock.RelayTxFunc == nil {
+		panic("StorageServiceMock.RelayTxFunc: method is nil but StorageService.RelayTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2432,17 +2988,17 @@ func (mock *StorageServiceMock) TrackTx(contextMoqParam context.Context, storage
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockTrackTx.Lock()
-	mock.calls.TrackTx = append(mock.calls.TrackTx, callInfo)
-	mock.lockTrackTx.Unlock()
-	return mock.TrackTxFunc(contextMoqParam, storageTx)
+	mock.lockRelayTx.Lock()
+	mock.calls.RelayTx = append(mock.calls.RelayTx, callInfo)
+	mock.lockRelayTx.Unlock()
+	return mock.RelayTxFunc(contextMoqParam, storageTx)
 }
 
-// TrackTxCalls gets all the calls that were made to TrackTx.
+// RelayTxCalls gets all the calls that were made to RelayTx.
 // Check the length with:
 //
-//	len(mockedStorageService.TrackTxCalls())
-func (mock *StorageServiceMock) TrackTxCalls() []struct {
+//	len(mockedStorageService.RelayTxCalls())
+func (mock *StorageServiceMock) RelayTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2450,48 +3006,80 @@ func (mock *StorageServiceMock) TrackTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockTrackTx.RLock()
-	calls = mock.calls.TrackTx
-	mock.lockTrackTx.RUnlock()
+	mock.lockRelayTx.RLock()
+	calls = mock.calls.RelayTx
+	mock.lockRelayTx.RUnlock()
 	return calls
 }
 
-// User calls UserFunc.
-func (mock *StorageServiceMock) User(contextMoqParam context.Context) radio.UserStorage {
-	if mock.UserFunc == nil {
-		panic("StorageServiceMock.UserFunc: method is nil but StorageService.User was just called")
+// Request calls RequestFunc.
+func (mock *StorageServiceMock) Request(contextMoqParam context.Context) radio.RequestStorage {
+	if mock.RequestFunc == nil {
+		panic("StorageServiceMock.RequestFunc: method is nil but StorageService.Request was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockUser.Lock()
-	mock.calls.User = append(mock.calls.User, callInfo)
-	mock.lockUser.Unlock()
-	return mock.UserFunc(contextMoqParam)
+	mock.lockRequest.Lock()
+	mock.calls.Request = append(mock.calls.Request, callInfo)
+	mock.lockRequest.Unlock()
+	return mock.RequestFunc(contextMoqParam)
 }
 
-// UserCalls gets all the calls that were made to User.
+// RequestCalls gets all the calls that were made to Request.
 // Check the length with:
 //
-//	len(mockedStorageService.UserCalls())
-func (mock *StorageServiceMock) UserCalls() []struct {
+//	len(mockedStorageService.RequestCalls())
+func (mock *StorageServiceMock) RequestCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockUser.RLock()
-	calls = mock.calls.User
-	mock.lockUser.RUnlock()
+	mock.lockRequest.RLock()
+	calls = mock.calls.Request
+	mock.lockRequest.RUnlock()
 	return calls
 }
 
-// UserTx calls UserTxFunc.
-func (mock *StorageServiceMock) UserTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
-	if mock.UserTxFunc == nil {
-		panic("StorageServiceMock.UserTxFunc: method is nil but StorageService.UserTx was just called")
+// RequestPool calls RequestPoolFunc.
+func (mock *StorageServiceMock) RequestPool(contextMoqParam context.Context) radio.RequestPoolStorage {
+	if mock.RequestPoolFunc == nil {
+		panic("StorageServiceMock.RequestPoolFunc: method is nil but StorageService.RequestPool was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockRequestPool.Lock()
+	mock.calls.RequestPool = append(mock.calls.RequestPool, callInfo)
+	mock.lockRequestPool.Unlock()
+	return mock.RequestPoolFunc(contextMoqParam)
+}
+
+// RequestPoolCalls gets all the calls that were made to RequestPool.
+// Check the length with:
+//
+//	len(mockedStorageService.RequestPoolCalls())
+func (mock *StorageServiceMock) RequestPoolCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockRequestPool.RLock()
+	calls = mock.calls.RequestPool
+	mock.lockRequestPool.RUnlock()
+	return calls
+}
+
+// RequestPoolTx calls RequestPoolTxFunc.
+func (mock *StorageServiceMock) RequestPoolTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+	if mock.RequestPoolTxFunc == nil {
+		panic("StorageServiceMock.RequestPoolTxFunc: method is nil but StorageService.RequestPoolTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2500,17 +3088,17 @@ func (mock *StorageServiceMock) UserTx(contextMoqParam context.Context, storageT
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockUserTx.Lock()
-	mock.calls.UserTx = append(mock.calls.UserTx, callInfo)
-	mock.lockUserTx.Unlock()
-	return mock.UserTxFunc(contextMoqParam, storageTx)
+	mock.lockRequestPoolTx.Lock()
+	mock.calls.RequestPoolTx = append(mock.calls.RequestPoolTx, callInfo)
+	mock.lockRequestPoolTx.Unlock()
+	return mock.RequestPoolTxFunc(contextMoqParam, storageTx)
 }
 
-// UserTxCalls gets all the calls that were made to UserTx.
+// RequestPoolTxCalls gets all the calls that were made to RequestPoolTx.
 // Check the length with:
 //
-//	len(mockedStorageService.UserTxCalls())
-func (mock *StorageServiceMock) UserTxCalls() []struct {
+//	len(mockedStorageService.RequestPoolTxCalls())
+func (mock *StorageServiceMock) RequestPoolTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2518,64 +3106,120 @@ func (mock *StorageServiceMock) UserTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockUserTx.RLock()
-	calls = mock.calls.UserTx
-	mock.lockUserTx.RUnlock()
+	mock.lockRequestPoolTx.RLock()
+	calls = mock.calls.RequestPoolTx
+	mock.lockRequestPoolTx.RUnlock()
 	return calls
 }
 
-// Ensure, that SessionStorageServiceMock does implement radio.SessionStorageService.
-// If this is not the case, regenerate this file with moq.
-var _ radio.SessionStorageService = &SessionStorageServiceMock{}
+// RequestTx calls RequestTxFunc.
+func (mock *StorageServiceMock) RequestTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
+	if mock.RequestTxFunc == nil {
+		panic("StorageServiceMock.RequestTxFunc: method is nil but StorageService.RequestTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockRequestTx.Lock()
+	mock.calls.RequestTx = append(mock.calls.RequestTx, callInfo)
+	mock.lockRequestTx.Unlock()
+	return mock.RequestTxFunc(contextMoqParam, storageTx)
+}
 
-// SessionStorageServiceMock is a mock implementation of radio.SessionStorageService.
-//
-//	func TestSomethingThatUsesSessionStorageService(t *testing.T) {
+// RequestTxCalls gets all the calls that were made to RequestTx.
+// Check the length with:
 //
-//		// make and configure a mocked radio.SessionStorageService
-//		mockedSessionStorageService := &SessionStorageServiceMock{
-//			SessionsFunc: func(contextMoqParam context.Context) radio.SessionStorage {
-//				panic("mock out the Sessions method")
-//			},
-//			SessionsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
-//				panic("mock out the SessionsTx method")
-//			},
-//		}
-//
-//		// use mockedSessionStorageService in code that requires radio.SessionStorageService
-//		// and then make assertions.
+//	len(mockedStorageService.RequestTxCalls())
+func (mock *StorageServiceMock) RequestTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockRequestTx.RLock()
+	calls = mock.calls.RequestTx
+	mock.lockRequestTx.RUnlock()
+	return calls
+}
+
+// Schedule calls ScheduleFunc.
+func (mock *StorageServiceMock) Schedule(contextMoqParam context.Context) radio.ScheduleStorage {
+	if mock.ScheduleFunc == nil {
+		panic("StorageServiceMock.ScheduleFunc: method is nil but StorageService.Schedule was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockSchedule.Lock()
+	mock.calls.Schedule = append(mock.calls.Schedule, callInfo)
+	mock.lockSchedule.Unlock()
+	return mock.ScheduleFunc(contextMoqParam)
+}
+
+// ScheduleCalls gets all the calls that were made to Schedule.
+// Check the length with:
 //
-//	}
-type SessionStorageServiceMock struct {
-	// SessionsFunc mocks the Sessions method.
-	SessionsFunc func(contextMoqParam context.Context) radio.SessionStorage
+//	len(mockedStorageService.ScheduleCalls())
+func (mock *StorageServiceMock) ScheduleCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockSchedule.RLock()
+	calls = mock.calls.Schedule
+	mock.lockSchedule.RUnlock()
+	return calls
+}
 
-	// SessionsTxFunc mocks the SessionsTx method.
-	SessionsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error)
+// ScheduleTx calls ScheduleTxFunc.
+func (mock *StorageServiceMock) ScheduleTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
+	if mock.ScheduleTxFunc == nil {
+		panic("StorageServiceMock.ScheduleTxFunc: method is nil but StorageService.ScheduleTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockScheduleTx.Lock()
+	mock.calls.ScheduleTx = append(mock.calls.ScheduleTx, callInfo)
+	mock.lockScheduleTx.Unlock()
+	return mock.ScheduleTxFunc(contextMoqParam, storageTx)
+}
 
-	// calls tracks calls to the methods.
-	calls struct {
-		// Sessions holds details about calls to the Sessions method.
-		Sessions []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-		}
-		// SessionsTx holds details about calls to the SessionsTx method.
-		SessionsTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
-		}
+// ScheduleTxCalls gets all the calls that were made to ScheduleTx.
+// Check the length with:
+//
+//	len(mockedStorageService.ScheduleTxCalls())
+func (mock *StorageServiceMock) ScheduleTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	lockSessions   sync.RWMutex
-	lockSessionsTx sync.RWMutex
+	mock.lockScheduleTx.RLock()
+	calls = mock.calls.ScheduleTx
+	mock.lockScheduleTx.RUnlock()
+	return calls
 }
 
 // Sessions calls SessionsFunc.
-func (mock *SessionStorageServiceMock) Sessions(contextMoqParam context.Context) radio.SessionStorage {
+func (mock *StorageServiceMock) Sessions(contextMoqParam context.Context) radio.SessionStorage {
 	if mock.SessionsFunc == nil {
-		panic("SessionStorageServiceMock.SessionsFunc: method is nil but SessionStorageService.Sessions was just called")
+		panic("StorageServiceMock.SessionsFunc: method is nil but StorageService.Sessions was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2591,8 +3235,8 @@ func (mock *SessionStorageServiceMock) Sessions(contextMoqParam context.Context)
 // SessionsCalls gets all the calls that were made to Sessions.
 // Check the length with:
 //
-//	len(mockedSessionStorageService.SessionsCalls())
-func (mock *SessionStorageServiceMock) SessionsCalls() []struct {
+//	len(mockedStorageService.SessionsCalls())
+func (mock *StorageServiceMock) SessionsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
@@ -2605,9 +3249,9 @@ func (mock *SessionStorageServiceMock) SessionsCalls() []struct {
 }
 
 // SessionsTx calls SessionsTxFunc.
-func (mock *SessionStorageServiceMock) SessionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
+func (mock *StorageServiceMock) SessionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
 	if mock.SessionsTxFunc == nil {
-		panic("SessionStorageServiceMock.SessionsTxFunc: method is nil but SessionStorageService.SessionsTx was just called")
+		panic("StorageServiceMock.SessionsTxFunc: method is nil but StorageService.SessionsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2625,8 +3269,8 @@ func (mock *SessionStorageServiceMock) SessionsTx(contextMoqParam context.Contex
 // SessionsTxCalls gets all the calls that were made to SessionsTx.
 // Check the length with:
 //
-//	len(mockedSessionStorageService.SessionsTxCalls())
-func (mock *SessionStorageServiceMock) SessionsTxCalls() []struct {
+//	len(mockedStorageService.SessionsTxCalls())
+func (mock *StorageServiceMock) SessionsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2640,244 +3284,142 @@ func (mock *SessionStorageServiceMock) SessionsTxCalls() []struct {
 	return calls
 }
 
-// Ensure, that SessionStorageMock does implement radio.SessionStorage.
-// If this is not the case, regenerate this file with moq.
-var _ radio.SessionStorage = &SessionStorageMock{}
-
-// SessionStorageMock is a mock implementation of radio.SessionStorage.
-//
-//	func TestSomethingThatUsesSessionStorage(t *testing.T) {
-//
-//		// make and configure a mocked radio.SessionStorage
-//		mockedSessionStorage := &SessionStorageMock{
-//			DeleteFunc: func(sessionToken radio.SessionToken) error {
-//				panic("mock out the Delete method")
-//			},
-//			GetFunc: func(sessionToken radio.SessionToken) (radio.Session, error) {
-//				panic("mock out the Get method")
-//			},
-//			SaveFunc: func(session radio.Session) error {
-//				panic("mock out the Save method")
-//			},
-//		}
-//
-//		// use mockedSessionStorage in code that requires radio.SessionStorage
-//		// and then make assertions.
-//
-//	}
-type SessionStorageMock struct {
-	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(sessionToken radio.SessionToken) error
-
-	// GetFunc mocks the Get method.
-	GetFunc func(sessionToken radio.SessionToken) (radio.Session, error)
-
-	// SaveFunc mocks the Save method.
-	SaveFunc func(session radio.Session) error
-
-	// calls tracks calls to the methods.
-	calls struct {
-		// Delete holds details about calls to the Delete method.
-		Delete []struct {
-			// SessionToken is the sessionToken argument value.
-			SessionToken radio.SessionToken
-		}
-		// Get holds details about calls to the Get method.
-		Get []struct {
-			// SessionToken is the sessionToken argument value.
-			SessionToken radio.SessionToken
-		}
-		// Save holds details about calls to the Save method.
-		Save []struct {
-			// Session is the session argument value.
-			Session radio.Session
-		}
-	}
-	lockDelete sync.RWMutex
-	lockGet    sync.RWMutex
-	lockSave   sync.RWMutex
-}
-
-// Delete calls DeleteFunc.
-func (mock *SessionStorageMock) Delete(sessionToken radio.SessionToken) error {
-	if mock.DeleteFunc == nil {
-		panic("SessionStorageMock.DeleteFunc: method is nil but SessionStorage.Delete was just called")
+// Song calls SongFunc.
+func (mock *StorageServiceMock) Song(contextMoqParam context.Context) radio.SongStorage {
+	if mock.SongFunc == nil {
+		panic("StorageServiceMock.SongFunc: method is nil but StorageService.Song was just called")
 	}
 	callInfo := struct {
-		SessionToken radio.SessionToken
+		ContextMoqParam context.Context
 	}{
-		SessionToken: sessionToken,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockDelete.Lock()
-	mock.calls.Delete = append(mock.calls.Delete, callInfo)
-	mock.lockDelete.Unlock()
-	return mock.DeleteFunc(sessionToken)
+	mock.lockSong.Lock()
+	mock.calls.Song = append(mock.calls.Song, callInfo)
+	mock.lockSong.Unlock()
+	return mock.SongFunc(contextMoqParam)
 }
 
-// DeleteCalls gets all the calls that were made to Delete.
+// SongCalls gets all the calls that were made to Song.
 // Check the length with:
 //
-//	len(mockedSessionStorage.DeleteCalls())
-func (mock *SessionStorageMock) DeleteCalls() []struct {
-	SessionToken radio.SessionToken
+//	len(mockedStorageService.SongCalls())
+func (mock *StorageServiceMock) SongCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		SessionToken radio.SessionToken
+		ContextMoqParam context.Context
 	}
-	mock.lockDelete.RLock()
-	calls = mock.calls.Delete
-	mock.lockDelete.RUnlock()
+	mock.lockSong.RLock()
+	calls = mock.calls.Song
+	mock.lockSong.RUnlock()
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *SessionStorageMock) Get(sessionToken radio.SessionToken) (radio.Session, error) {
-	if mock.GetFunc == nil {
-		panic("SessionStorageMock.GetFunc: method is nil but SessionStorage.Get was just called")
+// SongTx calls SongTxFunc.
+func (mock *StorageServiceMock) SongTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
+	if mock.SongTxFunc == nil {
+		panic("StorageServiceMock.SongTxFunc: method is nil but StorageService.SongTx was just called")
 	}
 	callInfo := struct {
-		SessionToken radio.SessionToken
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		SessionToken: sessionToken,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	return mock.GetFunc(sessionToken)
+	mock.lockSongTx.Lock()
+	mock.calls.SongTx = append(mock.calls.SongTx, callInfo)
+	mock.lockSongTx.Unlock()
+	return mock.SongTxFunc(contextMoqParam, storageTx)
 }
 
-// GetCalls gets all the calls that were made to Get.
+// SongTxCalls gets all the calls that were made to SongTx.
 // Check the length with:
 //
-//	len(mockedSessionStorage.GetCalls())
-func (mock *SessionStorageMock) GetCalls() []struct {
-	SessionToken radio.SessionToken
+//	len(mockedStorageService.SongTxCalls())
+func (mock *StorageServiceMock) SongTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		SessionToken radio.SessionToken
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockSongTx.RLock()
+	calls = mock.calls.SongTx
+	mock.lockSongTx.RUnlock()
 	return calls
 }
 
-// Save calls SaveFunc.
-func (mock *SessionStorageMock) Save(session radio.Session) error {
-	if mock.SaveFunc == nil {
-		panic("SessionStorageMock.SaveFunc: method is nil but SessionStorage.Save was just called")
+// Status calls StatusFunc.
+func (mock *StorageServiceMock) Status(contextMoqParam context.Context) radio.StatusStorage {
+	if mock.StatusFunc == nil {
+		panic("StorageServiceMock.StatusFunc: method is nil but StorageService.Status was just called")
 	}
 	callInfo := struct {
-		Session radio.Session
+		ContextMoqParam context.Context
 	}{
-		Session: session,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSave.Lock()
-	mock.calls.Save = append(mock.calls.Save, callInfo)
-	mock.lockSave.Unlock()
-	return mock.SaveFunc(session)
+	mock.lockStatus.Lock()
+	mock.calls.Status = append(mock.calls.Status, callInfo)
+	mock.lockStatus.Unlock()
+	return mock.StatusFunc(contextMoqParam)
 }
 
-// SaveCalls gets all the calls that were made to Save.
+// StatusCalls gets all the calls that were made to Status.
 // Check the length with:
 //
-//	len(mockedSessionStorage.SaveCalls())
-func (mock *SessionStorageMock) SaveCalls() []struct {
-	Session radio.Session
+//	len(mockedStorageService.StatusCalls())
+func (mock *StorageServiceMock) StatusCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		Session radio.Session
+		ContextMoqParam context.Context
 	}
-	mock.lockSave.RLock()
-	calls = mock.calls.Save
-	mock.lockSave.RUnlock()
+	mock.lockStatus.RLock()
+	calls = mock.calls.Status
+	mock.lockStatus.RUnlock()
 	return calls
 }
 
-// Ensure, that QueueStorageServiceMock does implement radio.QueueStorageService.
-// If this is not the case, regenerate this file with moq.
-var _ radio.QueueStorageService = &QueueStorageServiceMock{}
-
-// QueueStorageServiceMock is a mock implementation of radio.QueueStorageService.
-//
-//	func TestSomethingThatUsesQueueStorageService(t *testing.T) {
-//
-//		// make and configure a mocked radio.QueueStorageService
-//		mockedQueueStorageService := &QueueStorageServiceMock{
-//			QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
-//				panic("mock out the Queue method")
-//			},
-//			QueueTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
-//				panic("mock out the QueueTx method")
-//			},
-//		}
-//
-//		// use mockedQueueStorageService in code that requires radio.QueueStorageService
-//		// and then make assertions.
-//
-//	}
-type QueueStorageServiceMock struct {
-	// QueueFunc mocks the Queue method.
-	QueueFunc func(contextMoqParam context.Context) radio.QueueStorage
-
-	// QueueTxFunc mocks the QueueTx method.
-	QueueTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error)
-
-	// calls tracks calls to the methods.
-	calls struct {
-		// Queue holds details about calls to the Queue method.
-		Queue []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-		}
-		// QueueTx holds details about calls to the QueueTx method.
-		QueueTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
-		}
-	}
-	lockQueue   sync.RWMutex
-	lockQueueTx sync.RWMutex
-}
-
-// Queue calls QueueFunc.
-func (mock *QueueStorageServiceMock) Queue(contextMoqParam context.Context) radio.QueueStorage {
-	if mock.QueueFunc == nil {
-		panic("QueueStorageServiceMock.QueueFunc: method is nil but QueueStorageService.Queue was just called")
+// Submissions calls SubmissionsFunc.
+func (mock *StorageServiceMock) Submissions(contextMoqParam context.Context) radio.SubmissionStorage {
+	if mock.SubmissionsFunc == nil {
+		panic("StorageServiceMock.SubmissionsFunc: method is nil but StorageService.Submissions was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockQueue.Lock()
-	mock.calls.Queue = append(mock.calls.Queue, callInfo)
-	mock.lockQueue.Unlock()
-	return mock.QueueFunc(contextMoqParam)
+	mock.lockSubmissions.Lock()
+	mock.calls.Submissions = append(mock.calls.Submissions, callInfo)
+	mock.lockSubmissions.Unlock()
+	return mock.SubmissionsFunc(contextMoqParam)
 }
 
-// QueueCalls gets all the calls that were made to Queue.
+// SubmissionsCalls gets all the calls that were made to Submissions.
 // Check the length with:
 //
-//	len(mockedQueueStorageService.QueueCalls())
-func (mock *QueueStorageServiceMock) QueueCalls() []struct {
+//	len(mockedStorageService.SubmissionsCalls())
+func (mock *StorageServiceMock) SubmissionsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockQueue.RLock()
-	calls = mock.calls.Queue
-	mock.lockQueue.RUnlock()
+	mock.lockSubmissions.RLock()
+	calls = mock.calls.Submissions
+	mock.lockSubmissions.RUnlock()
 	return calls
 }
 
-// QueueTx calls QueueTxFunc.
-func (mock *QueueStorageServiceMock) QueueTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
-	if mock.QueueTxFunc == nil {
-		panic("QueueStorageServiceMock.QueueTxFunc: method is nil but QueueStorageService.QueueTx was just called")
+// SubmissionsTx calls SubmissionsTxFunc.
+func (mock *StorageServiceMock) SubmissionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
+	if mock.SubmissionsTxFunc == nil {
+		panic("StorageServiceMock.SubmissionsTxFunc: method is nil but StorageService.SubmissionsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -2886,17 +3428,17 @@ func (mock *QueueStorageServiceMock) QueueTx(contextMoqParam context.Context, st
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockQueueTx.Lock()
-	mock.calls.QueueTx = append(mock.calls.QueueTx, callInfo)
-	mock.lockQueueTx.Unlock()
-	return mock.QueueTxFunc(contextMoqParam, storageTx)
+	mock.lockSubmissionsTx.Lock()
+	mock.calls.SubmissionsTx = append(mock.calls.SubmissionsTx, callInfo)
+	mock.lockSubmissionsTx.Unlock()
+	return mock.SubmissionsTxFunc(contextMoqParam, storageTx)
 }
 
-// QueueTxCalls gets all the calls that were made to QueueTx.
+// SubmissionsTxCalls gets all the calls that were made to SubmissionsTx.
 // Check the length with:
 //
-//	len(mockedQueueStorageService.QueueTxCalls())
-func (mock *QueueStorageServiceMock) QueueTxCalls() []struct {
+//	len(mockedStorageService.SubmissionsTxCalls())
+func (mock *StorageServiceMock) SubmissionsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -2904,212 +3446,232 @@ func (mock *QueueStorageServiceMock) QueueTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockQueueTx.RLock()
-	calls = mock.calls.QueueTx
-	mock.lockQueueTx.RUnlock()
+	mock.lockSubmissionsTx.RLock()
+	calls = mock.calls.SubmissionsTx
+	mock.lockSubmissionsTx.RUnlock()
 	return calls
 }
 
-// Ensure, that QueueStorageMock does implement radio.QueueStorage.
-// If this is not the case, regenerate this file with moq.
-var _ radio.QueueStorage = &QueueStorageMock{}
-
-// QueueStorageMock is a mock implementation of radio.QueueStorage.
-//
-//	func TestSomethingThatUsesQueueStorage(t *testing.T) {
-//
-//		// make and configure a mocked radio.QueueStorage
-//		mockedQueueStorage := &QueueStorageMock{
-//			LoadFunc: func(name string) ([]radio.QueueEntry, error) {
-//				panic("mock out the Load method")
-//			},
-//			StoreFunc: func(name string, queue []radio.QueueEntry) error {
-//				panic("mock out the Store method")
-//			},
-//		}
-//
-//		// use mockedQueueStorage in code that requires radio.QueueStorage
-//		// and then make assertions.
-//
-//	}
-type QueueStorageMock struct {
-	// LoadFunc mocks the Load method.
-	LoadFunc func(name string) ([]radio.QueueEntry, error)
-
-	// StoreFunc mocks the Store method.
-	StoreFunc func(name string, queue []radio.QueueEntry) error
-
-	// calls tracks calls to the methods.
-	calls struct {
-		// Load holds details about calls to the Load method.
-		Load []struct {
-			// Name is the name argument value.
-			Name string
-		}
-		// Store holds details about calls to the Store method.
-		Store []struct {
-			// Name is the name argument value.
-			Name string
-			// Queue is the queue argument value.
-			Queue []radio.QueueEntry
-		}
-	}
-	lockLoad  sync.RWMutex
-	lockStore sync.RWMutex
-}
-
-// Load calls LoadFunc.
-func (mock *QueueStorageMock) Load(name string) ([]radio.QueueEntry, error) {
-	if mock.LoadFunc == nil {
-		panic("QueueStorageMock.LoadFunc: method is nil but QueueStorage.Load was just called")
+// Track calls TrackFunc.
+func (mock *StorageServiceMock) Track(contextMoqParam context.Context) radio.TrackStorage {
+	if mock.TrackFunc == nil {
+		panic("StorageServiceMock.TrackFunc: method is nil but StorageService.Track was just called")
 	}
 	callInfo := struct {
-		Name string
+		ContextMoqParam context.Context
 	}{
-		Name: name,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockLoad.Lock()
-	mock.calls.Load = append(mock.calls.Load, callInfo)
-	mock.lockLoad.Unlock()
-	return mock.LoadFunc(name)
+	mock.lockTrack.Lock()
+	mock.calls.Track = append(mock.calls.Track, callInfo)
+	mock.lockTrack.Unlock()
+	return mock.TrackFunc(contextMoqParam)
 }
 
-// LoadCalls gets all the calls that were made to Load.
+// TrackCalls gets all the calls that were made to Track.
 // Check the length with:
 //
-//	len(mockedQueueStorage.LoadCalls())
-func (mock *QueueStorageMock) LoadCalls() []struct {
-	Name string
+//	len(mockedStorageService.TrackCalls())
+func (mock *StorageServiceMock) TrackCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		Name string
+		ContextMoqParam context.Context
 	}
-	mock.lockLoad.RLock()
-	calls = mock.calls.Load
-	mock.lockLoad.RUnlock()
+	mock.lockTrack.RLock()
+	calls = mock.calls.Track
+	mock.lockTrack.RUnlock()
 	return calls
 }
 
-// Store calls StoreFunc.
-func (mock *QueueStorageMock) Store(name string, queue []radio.QueueEntry) error {
-	if mock.StoreFunc == nil {
-		panic("QueueStorageMock.StoreFunc: method is nil but QueueStorage.Store was just called")
+// TrackTx calls TrackTxFunc.
+func (mock *StorageServiceMock) TrackTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+	if mock.TrackTxFunc == nil {
+		panic("StorageServiceMock.TrackTxFunc: method is nil but StorageService.TrackTx was just called")
 	}
 	callInfo := struct {
-		Name  string
-		Queue []radio.QueueEntry
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		Name:  name,
-		Queue: queue,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockStore.Lock()
-	mock.calls.Store = append(mock.calls.Store, callInfo)
-	mock.lockStore.Unlock()
-	return mock.StoreFunc(name, queue)
+	mock.lockTrackTx.Lock()
+	mock.calls.TrackTx = append(mock.calls.TrackTx, callInfo)
+	mock.lockTrackTx.Unlock()
+	return mock.TrackTxFunc(contextMoqParam, storageTx)
 }
 
-// StoreCalls gets all the calls that were made to Store.
+// TrackTxCalls gets all the calls that were made to TrackTx.
 // Check the length with:
 //
-//	len(mockedQueueStorage.StoreCalls())
-func (mock *QueueStorageMock) StoreCalls() []struct {
-	Name  string
-	Queue []radio.QueueEntry
-} {
-	var calls []struct {
-		Name  string
-		Queue []radio.QueueEntry
+//	len(mockedStorageService.TrackTxCalls())
+func (mock *StorageServiceMock) TrackTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockStore.RLock()
-	calls = mock.calls.Store
-	mock.lockStore.RUnlock()
+	mock.lockTrackTx.RLock()
+	calls = mock.calls.TrackTx
+	mock.lockTrackTx.RUnlock()
 	return calls
 }
 
-// Ensure, that SongStorageServiceMock does implement radio.SongStorageService.
+// User calls UserFunc.
+func (mock *StorageServiceMock) User(contextMoqParam context.Context) radio.UserStorage {
+	if mock.UserFunc == nil {
+		panic("StorageServiceMock.UserFunc: method is nil but StorageService.User was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockUser.Lock()
+	mock.calls.User = append(mock.calls.User, callInfo)
+	mock.lockUser.Unlock()
+	return mock.UserFunc(contextMoqParam)
+}
+
+// UserCalls gets all the calls that were made to User.
+// Check the length with:
+//
+//	len(mockedStorageService.UserCalls())
+func (mock *StorageServiceMock) UserCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockUser.RLock()
+	calls = mock.calls.User
+	mock.lockUser.RUnlock()
+	return calls
+}
+
+// UserTx calls UserTxFunc.
+func (mock *StorageServiceMock) UserTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
+	if mock.UserTxFunc == nil {
+		panic("StorageServiceMock.UserTxFunc: method is nil but StorageService.UserTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockUserTx.Lock()
+	mock.calls.UserTx = append(mock.calls.UserTx, callInfo)
+	mock.lockUserTx.Unlock()
+	return mock.UserTxFunc(contextMoqParam, storageTx)
+}
+
+// UserTxCalls gets all the calls that were made to UserTx.
+// Check the length with:
+//
+//	len(mockedStorageService.UserTxCalls())
+func (mock *StorageServiceMock) UserTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockUserTx.RLock()
+	calls = mock.calls.UserTx
+	mock.lockUserTx.RUnlock()
+	return calls
+}
+
+// Ensure, that SessionStorageServiceMock does implement radio.SessionStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.SongStorageService = &SongStorageServiceMock{}
+var _ radio.SessionStorageService = &SessionStorageServiceMock{}
 
-// SongStorageServiceMock is a mock implementation of radio.SongStorageService.
+// SessionStorageServiceMock is a mock implementation of radio.SessionStorageService.
 //
-//	func TestSomethingThatUsesSongStorageService(t *testing.T) {
+//	func TestSomethingThatUsesSessionStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.SongStorageService
-//		mockedSongStorageService := &SongStorageServiceMock{
-//			SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
-//				panic("mock out the Song method")
+//		// make and configure a mocked radio.SessionStorageService
+//		mockedSessionStorageService := &SessionStorageServiceMock{
+//			SessionsFunc: func(contextMoqParam context.Context) radio.SessionStorage {
+//				panic("mock out the Sessions method")
 //			},
-//			SongTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
-//				panic("mock out the SongTx method")
+//			SessionsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
+//				panic("mock out the SessionsTx method")
 //			},
 //		}
 //
-//		// use mockedSongStorageService in code that requires radio.SongStorageService
+//		// use mockedSessionStorageService in code that requires radio.SessionStorageService
 //		// and then make assertions.
 //
 //	}
-type SongStorageServiceMock struct {
-	// SongFunc mocks the Song method.
-	SongFunc func(contextMoqParam context.Context) radio.SongStorage
+type SessionStorageServiceMock struct {
+	// SessionsFunc mocks the Sessions method.
+	SessionsFunc func(contextMoqParam context.Context) radio.SessionStorage
 
-	// SongTxFunc mocks the SongTx method.
-	SongTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error)
+	// SessionsTxFunc mocks the SessionsTx method.
+	SessionsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Song holds details about calls to the Song method.
-		Song []struct {
+		// Sessions holds details about calls to the Sessions method.
+		Sessions []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
-		// SongTx holds details about calls to the SongTx method.
-		SongTx []struct {
+		// SessionsTx holds details about calls to the SessionsTx method.
+		SessionsTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
 	}
-	lockSong   sync.RWMutex
-	lockSongTx sync.RWMutex
+	lockSessions   sync.RWMutex
+	lockSessionsTx sync.RWMutex
 }
 
-// Song calls SongFunc.
-func (mock *SongStorageServiceMock) Song(contextMoqParam context.Context) radio.SongStorage {
-	if mock.SongFunc == nil {
-		panic("SongStorageServiceMock.SongFunc: method is nil but SongStorageService.Song was just called")
+// Sessions calls SessionsFunc.
+func (mock *SessionStorageServiceMock) Sessions(contextMoqParam context.Context) radio.SessionStorage {
+	if mock.SessionsFunc == nil {
+		panic("SessionStorageServiceMock.SessionsFunc: method is nil but SessionStorageService.Sessions was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSong.Lock()
-	mock.calls.Song = append(mock.calls.Song, callInfo)
-	mock.lockSong.Unlock()
-	return mock.SongFunc(contextMoqParam)
+	mock.lockSessions.Lock()
+	mock.calls.Sessions = append(mock.calls.Sessions, callInfo)
+	mock.lockSessions.Unlock()
+	return mock.SessionsFunc(contextMoqParam)
 }
 
-// SongCalls gets all the calls that were made to Song.
+// SessionsCalls gets all the calls that were made to Sessions.
 // Check the length with:
 //
-//	len(mockedSongStorageService.SongCalls())
-func (mock *SongStorageServiceMock) SongCalls() []struct {
+//	len(mockedSessionStorageService.SessionsCalls())
+func (mock *SessionStorageServiceMock) SessionsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockSong.RLock()
-	calls = mock.calls.Song
-	mock.lockSong.RUnlock()
+	mock.lockSessions.RLock()
+	calls = mock.calls.Sessions
+	mock.lockSessions.RUnlock()
 	return calls
 }
 
-// SongTx calls SongTxFunc.
-func (mock *SongStorageServiceMock) SongTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
-	if mock.SongTxFunc == nil {
-		panic("SongStorageServiceMock.SongTxFunc: method is nil but SongStorageService.SongTx was just called")
+// SessionsTx calls SessionsTxFunc.
+func (mock *SessionStorageServiceMock) SessionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SessionStorage, radio.StorageTx, error) {
+	if mock.SessionsTxFunc == nil {
+		panic("SessionStorageServiceMock.SessionsTxFunc: method is nil but SessionStorageService.SessionsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -3118,17 +3680,17 @@ func (mock *SongStorageServiceMock) SongTx(contextMoqParam context.Context, stor
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockSongTx.Lock()
-	mock.calls.SongTx = append(mock.calls.SongTx, callInfo)
-	mock.lockSongTx.Unlock()
-	return mock.SongTxFunc(contextMoqParam, storageTx)
+	mock.lockSessionsTx.Lock()
+	mock.calls.SessionsTx = append(mock.calls.SessionsTx, callInfo)
+	mock.lockSessionsTx.Unlock()
+	return mock.SessionsTxFunc(contextMoqParam, storageTx)
 }
 
-// SongTxCalls gets all the calls that were made to SongTx.
+// SessionsTxCalls gets all the calls that were made to SessionsTx.
 // Check the length with:
 //
-//	len(mockedSongStorageService.SongTxCalls())
-func (mock *SongStorageServiceMock) SongTxCalls() []struct {
+//	len(mockedSessionStorageService.SessionsTxCalls())
+func (mock *SessionStorageServiceMock) SessionsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -3136,347 +3698,3942 @@ func (mock *SongStorageServiceMock) SongTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockSongTx.RLock()
-	calls = mock.calls.SongTx
-	mock.lockSongTx.RUnlock()
+	mock.lockSessionsTx.RLock()
+	calls = mock.calls.SessionsTx
+	mock.lockSessionsTx.RUnlock()
 	return calls
 }
 
-// Ensure, that SongStorageMock does implement radio.SongStorage.
+// Ensure, that SessionStorageMock does implement radio.SessionStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.SongStorage = &SongStorageMock{}
+var _ radio.SessionStorage = &SessionStorageMock{}
 
-// SongStorageMock is a mock implementation of radio.SongStorage.
+// SessionStorageMock is a mock implementation of radio.SessionStorage.
 //
-//	func TestSomethingThatUsesSongStorage(t *testing.T) {
+//	func TestSomethingThatUsesSessionStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.SongStorage
-//		mockedSongStorage := &SongStorageMock{
-//			AddFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
-//				panic("mock out the AddFavorite method")
-//			},
-//			AddPlayFunc: func(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error {
-//				panic("mock out the AddPlay method")
-//			},
-//			CreateFunc: func(song radio.Song) (*radio.Song, error) {
-//				panic("mock out the Create method")
-//			},
-//			FavoriteCountFunc: func(song radio.Song) (int64, error) {
-//				panic("mock out the FavoriteCount method")
-//			},
-//			FavoritesFunc: func(song radio.Song) ([]string, error) {
-//				panic("mock out the Favorites method")
-//			},
-//			FavoritesOfFunc: func(nick string, limit int64, offset int64) ([]radio.Song, int64, error) {
-//				panic("mock out the FavoritesOf method")
-//			},
-//			FavoritesOfDatabaseFunc: func(nick string) ([]radio.Song, error) {
-//				panic("mock out the FavoritesOfDatabase method")
-//			},
-//			FromHashFunc: func(songHash radio.SongHash) (*radio.Song, error) {
-//				panic("mock out the FromHash method")
-//			},
-//			FromMetadataFunc: func(metadata string) (*radio.Song, error) {
-//				panic("mock out the FromMetadata method")
-//			},
-//			LastPlayedFunc: func(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error) {
-//				panic("mock out the LastPlayed method")
-//			},
-//			LastPlayedCountFunc: func() (int64, error) {
-//				panic("mock out the LastPlayedCount method")
-//			},
-//			LastPlayedPaginationFunc: func(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error) {
-//				panic("mock out the LastPlayedPagination method")
-//			},
-//			PlayedCountFunc: func(song radio.Song) (int64, error) {
-//				panic("mock out the PlayedCount method")
-//			},
-//			RemoveFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
-//				panic("mock out the RemoveFavorite method")
+//		// make and configure a mocked radio.SessionStorage
+//		mockedSessionStorage := &SessionStorageMock{
+//			DeleteFunc: func(sessionToken radio.SessionToken) error {
+//				panic("mock out the Delete method")
 //			},
-//			UpdateHashLinkFunc: func(old radio.SongHash, new radio.SongHash) error {
-//				panic("mock out the UpdateHashLink method")
+//			GetFunc: func(sessionToken radio.SessionToken) (radio.Session, error) {
+//				panic("mock out the Get method")
 //			},
-//			UpdateLengthFunc: func(song radio.Song, duration time.Duration) error {
-//				panic("mock out the UpdateLength method")
+//			SaveFunc: func(session radio.Session) error {
+//				panic("mock out the Save method")
 //			},
 //		}
 //
-//		// use mockedSongStorage in code that requires radio.SongStorage
+//		// use mockedSessionStorage in code that requires radio.SessionStorage
 //		// and then make assertions.
 //
 //	}
-type SongStorageMock struct {
-	// AddFavoriteFunc mocks the AddFavorite method.
-	AddFavoriteFunc func(song radio.Song, nick string) (bool, error)
+type SessionStorageMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(sessionToken radio.SessionToken) error
 
-	// AddPlayFunc mocks the AddPlay method.
-	AddPlayFunc func(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error
+	// GetFunc mocks the Get method.
+	GetFunc func(sessionToken radio.SessionToken) (radio.Session, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(session radio.Session) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// SessionToken is the sessionToken argument value.
+			SessionToken radio.SessionToken
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// SessionToken is the sessionToken argument value.
+			SessionToken radio.SessionToken
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Session is the session argument value.
+			Session radio.Session
+		}
+	}
+	lockDelete sync.RWMutex
+	lockGet    sync.RWMutex
+	lockSave   sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *SessionStorageMock) Delete(sessionToken radio.SessionToken) error {
+	if mock.DeleteFunc == nil {
+		panic("SessionStorageMock.DeleteFunc: method is nil but SessionStorage.Delete was just called")
+	}
+	callInfo := struct {
+		SessionToken radio.SessionToken
+	}{
+		SessionToken: sessionToken,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(sessionToken)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedSessionStorage.DeleteCalls())
+func (mock *SessionStorageMock) DeleteCalls() []struct {
+	SessionToken radio.SessionToken
+} {
+	var calls []struct {
+		SessionToken radio.SessionToken
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *SessionStorageMock) Get(sessionToken radio.SessionToken) (radio.Session, error) {
+	if mock.GetFunc == nil {
+		panic("SessionStorageMock.GetFunc: method is nil but SessionStorage.Get was just called")
+	}
+	callInfo := struct {
+		SessionToken radio.SessionToken
+	}{
+		SessionToken: sessionToken,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(sessionToken)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedSessionStorage.GetCalls())
+func (mock *SessionStorageMock) GetCalls() []struct {
+	SessionToken radio.SessionToken
+} {
+	var calls []struct {
+		SessionToken radio.SessionToken
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *SessionStorageMock) Save(session radio.Session) error {
+	if mock.SaveFunc == nil {
+		panic("SessionStorageMock.SaveFunc: method is nil but SessionStorage.Save was just called")
+	}
+	callInfo := struct {
+		Session radio.Session
+	}{
+		Session: session,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	return mock.SaveFunc(session)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//
+//	len(mockedSessionStorage.SaveCalls())
+func (mock *SessionStorageMock) SaveCalls() []struct {
+	Session radio.Session
+} {
+	var calls []struct {
+		Session radio.Session
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that QueueStorageServiceMock does implement radio.QueueStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.QueueStorageService = &QueueStorageServiceMock{}
+
+// QueueStorageServiceMock is a mock implementation of radio.QueueStorageService.
+//
+//	func TestSomethingThatUsesQueueStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.QueueStorageService
+//		mockedQueueStorageService := &QueueStorageServiceMock{
+//			QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
+//				panic("mock out the Queue method")
+//			},
+//			QueueTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
+//				panic("mock out the QueueTx method")
+//			},
+//		}
+//
+//		// use mockedQueueStorageService in code that requires radio.QueueStorageService
+//		// and then make assertions.
+//
+//	}
+type QueueStorageServiceMock struct {
+	// QueueFunc mocks the Queue method.
+	QueueFunc func(contextMoqParam context.Context) radio.QueueStorage
+
+	// QueueTxFunc mocks the QueueTx method.
+	QueueTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Queue holds details about calls to the Queue method.
+		Queue []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// QueueTx holds details about calls to the QueueTx method.
+		QueueTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockQueue   sync.RWMutex
+	lockQueueTx sync.RWMutex
+}
+
+// Queue calls QueueFunc.
+func (mock *QueueStorageServiceMock) Queue(contextMoqParam context.Context) radio.QueueStorage {
+	if mock.QueueFunc == nil {
+		panic("QueueStorageServiceMock.QueueFunc: method is nil but QueueStorageService.Queue was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockQueue.Lock()
+	mock.calls.Queue = append(mock.calls.Queue, callInfo)
+	mock.lockQueue.Unlock()
+	return mock.QueueFunc(contextMoqParam)
+}
+
+// QueueCalls gets all the calls that were made to Queue.
+// Check the length with:
+//
+//	len(mockedQueueStorageService.QueueCalls())
+func (mock *QueueStorageServiceMock) QueueCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockQueue.RLock()
+	calls = mock.calls.Queue
+	mock.lockQueue.RUnlock()
+	return calls
+}
+
+// QueueTx calls QueueTxFunc.
+func (mock *QueueStorageServiceMock) QueueTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
+	if mock.QueueTxFunc == nil {
+		panic("QueueStorageServiceMock.QueueTxFunc: method is nil but QueueStorageService.QueueTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockQueueTx.Lock()
+	mock.calls.QueueTx = append(mock.calls.QueueTx, callInfo)
+	mock.lockQueueTx.Unlock()
+	return mock.QueueTxFunc(contextMoqParam, storageTx)
+}
+
+// QueueTxCalls gets all the calls that were made to QueueTx.
+// Check the length with:
+//
+//	len(mockedQueueStorageService.QueueTxCalls())
+func (mock *QueueStorageServiceMock) QueueTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockQueueTx.RLock()
+	calls = mock.calls.QueueTx
+	mock.lockQueueTx.RUnlock()
+	return calls
+}
+
+// Ensure, that QueueStorageMock does implement radio.QueueStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.QueueStorage = &QueueStorageMock{}
+
+// QueueStorageMock is a mock implementation of radio.QueueStorage.
+//
+//	func TestSomethingThatUsesQueueStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.QueueStorage
+//		mockedQueueStorage := &QueueStorageMock{
+//			LoadFunc: func(name string) ([]radio.QueueEntry, error) {
+//				panic("mock out the Load method")
+//			},
+//			LoadPositionFunc: func(name string) (*radio.QueuePosition, error) {
+//				panic("mock out the LoadPosition method")
+//			},
+//			StoreFunc: func(name string, queue []radio.QueueEntry) error {
+//				panic("mock out the Store method")
+//			},
+//			StorePositionFunc: func(name string, pos *radio.QueuePosition) error {
+//				panic("mock out the StorePosition method")
+//			},
+//		}
+//
+//		// use mockedQueueStorage in code that requires radio.QueueStorage
+//		// and then make assertions.
+//
+//	}
+type QueueStorageMock struct {
+	// LoadFunc mocks the Load method.
+	LoadFunc func(name string) ([]radio.QueueEntry, error)
+
+	// LoadPositionFunc mocks the LoadPosition method.
+	LoadPositionFunc func(name string) (*radio.QueuePosition, error)
+
+	// StoreFunc mocks the Store method.
+	StoreFunc func(name string, queue []radio.QueueEntry) error
+
+	// StorePositionFunc mocks the StorePosition method.
+	StorePositionFunc func(name string, pos *radio.QueuePosition) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Load holds details about calls to the Load method.
+		Load []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// LoadPosition holds details about calls to the LoadPosition method.
+		LoadPosition []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// Name is the name argument value.
+			Name string
+			// Queue is the queue argument value.
+			Queue []radio.QueueEntry
+		}
+		// StorePosition holds details about calls to the StorePosition method.
+		StorePosition []struct {
+			// Name is the name argument value.
+			Name string
+			// Pos is the pos argument value.
+			Pos *radio.QueuePosition
+		}
+	}
+	lockLoad          sync.RWMutex
+	lockLoadPosition  sync.RWMutex
+	lockStore         sync.RWMutex
+	lockStorePosition sync.RWMutex
+}
+
+// Load calls LoadFunc.
+func (mock *QueueStorageMock) Load(name string) ([]radio.QueueEntry, error) {
+	if mock.LoadFunc == nil {
+		panic("QueueStorageMock.LoadFunc: method is nil but QueueStorage.Load was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockLoad.Lock()
+	mock.calls.Load = append(mock.calls.Load, callInfo)
+	mock.lockLoad.Unlock()
+	return mock.LoadFunc(name)
+}
+
+// LoadCalls gets all the calls that were made to Load.
+// Check the length with:
+//
+//	len(mockedQueueStorage.LoadCalls())
+func (mock *QueueStorageMock) LoadCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockLoad.RLock()
+	calls = mock.calls.Load
+	mock.lockLoad.RUnlock()
+	return calls
+}
+
+// LoadPosition calls LoadPositionFunc.
+func (mock *QueueStorageMock) LoadPosition(name string) (*radio.QueuePosition, error) {
+	if mock.LoadPositionFunc == nil {
+		panic("QueueStorageMock.LoadPositionFunc: method is nil but QueueStorage.LoadPosition was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockLoadPosition.Lock()
+	mock.calls.LoadPosition = append(mock.calls.LoadPosition, callInfo)
+	mock.lockLoadPosition.Unlock()
+	return mock.LoadPositionFunc(name)
+}
+
+// LoadPositionCalls gets all the calls that were made to LoadPosition.
+// Check the length with:
+//
+//	len(mockedQueueStorage.LoadPositionCalls())
+func (mock *QueueStorageMock) LoadPositionCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockLoadPosition.RLock()
+	calls = mock.calls.LoadPosition
+	mock.lockLoadPosition.RUnlock()
+	return calls
+}
+
+// Store calls StoreFunc.
+func (mock *QueueStorageMock) Store(name string, queue []radio.QueueEntry) error {
+	if mock.StoreFunc == nil {
+		panic("QueueStorageMock.StoreFunc: method is nil but QueueStorage.Store was just called")
+	}
+	callInfo := struct {
+		Name  string
+		Queue []radio.QueueEntry
+	}{
+		Name:  name,
+		Queue: queue,
+	}
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(name, queue)
+}
+
+// StoreCalls gets all the calls that were made to Store.
+// Check the length with:
+//
+//	len(mockedQueueStorage.StoreCalls())
+func (mock *QueueStorageMock) StoreCalls() []struct {
+	Name  string
+	Queue []radio.QueueEntry
+} {
+	var calls []struct {
+		Name  string
+		Queue []radio.QueueEntry
+	}
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
+	return calls
+}
+
+// StorePosition calls StorePositionFunc.
+func (mock *QueueStorageMock) StorePosition(name string, pos *radio.QueuePosition) error {
+	if mock.StorePositionFunc == nil {
+		panic("QueueStorageMock.StorePositionFunc: method is nil but QueueStorage.StorePosition was just called")
+	}
+	callInfo := struct {
+		Name string
+		Pos  *radio.QueuePosition
+	}{
+		Name: name,
+		Pos:  pos,
+	}
+	mock.lockStorePosition.Lock()
+	mock.calls.StorePosition = append(mock.calls.StorePosition, callInfo)
+	mock.lockStorePosition.Unlock()
+	return mock.StorePositionFunc(name, pos)
+}
+
+// StorePositionCalls gets all the calls that were made to StorePosition.
+// Check the length with:
+//
+//	len(mockedQueueStorage.StorePositionCalls())
+func (mock *QueueStorageMock) StorePositionCalls() []struct {
+	Name string
+	Pos  *radio.QueuePosition
+} {
+	var calls []struct {
+		Name string
+		Pos  *radio.QueuePosition
+	}
+	mock.lockStorePosition.RLock()
+	calls = mock.calls.StorePosition
+	mock.lockStorePosition.RUnlock()
+	return calls
+}
+
+// Ensure, that SongStorageServiceMock does implement radio.SongStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.SongStorageService = &SongStorageServiceMock{}
+
+// SongStorageServiceMock is a mock implementation of radio.SongStorageService.
+//
+//	func TestSomethingThatUsesSongStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.SongStorageService
+//		mockedSongStorageService := &SongStorageServiceMock{
+//			SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
+//				panic("mock out the Song method")
+//			},
+//			SongTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
+//				panic("mock out the SongTx method")
+//			},
+//		}
+//
+//		// use mockedSongStorageService in code that requires radio.SongStorageService
+//		// and then make assertions.
+//
+//	}
+type SongStorageServiceMock struct {
+	// SongFunc mocks the Song method.
+	SongFunc func(contextMoqParam context.Context) radio.SongStorage
+
+	// SongTxFunc mocks the SongTx method.
+	SongTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Song holds details about calls to the Song method.
+		Song []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// SongTx holds details about calls to the SongTx method.
+		SongTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockSong   sync.RWMutex
+	lockSongTx sync.RWMutex
+}
+
+// Song calls SongFunc.
+func (mock *SongStorageServiceMock) Song(contextMoqParam context.Context) radio.SongStorage {
+	if mock.SongFunc == nil {
+		panic("SongStorageServiceMock.SongFunc: method is nil but SongStorageService.Song was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockSong.Lock()
+	mock.calls.Song = append(mock.calls.Song, callInfo)
+	mock.lockSong.Unlock()
+	return mock.SongFunc(contextMoqParam)
+}
+
+// SongCalls gets all the calls that were made to Song.
+// Check the length with:
+//
+//	len(mockedSongStorageService.SongCalls())
+func (mock *SongStorageServiceMock) SongCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockSong.RLock()
+	calls = mock.calls.Song
+	mock.lockSong.RUnlock()
+	return calls
+}
+
+// SongTx calls SongTxFunc.
+func (mock *SongStorageServiceMock) SongTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SongStorage, radio.StorageTx, error) {
+	if mock.SongTxFunc == nil {
+		panic("SongStorageServiceMock.SongTxFunc: method is nil but SongStorageService.SongTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockSongTx.Lock()
+	mock.calls.SongTx = append(mock.calls.SongTx, callInfo)
+	mock.lockSongTx.Unlock()
+	return mock.SongTxFunc(contextMoqParam, storageTx)
+}
+
+// SongTxCalls gets all the calls that were made to SongTx.
+// Check the length with:
+//
+//	len(mockedSongStorageService.SongTxCalls())
+func (mock *SongStorageServiceMock) SongTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockSongTx.RLock()
+	calls = mock.calls.SongTx
+	mock.lockSongTx.RUnlock()
+	return calls
+}
+
+// Ensure, that SongStorageMock does implement radio.SongStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.SongStorage = &SongStorageMock{}
+
+// SongStorageMock is a mock implementation of radio.SongStorage.
+//
+//	func TestSomethingThatUsesSongStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.SongStorage
+//		mockedSongStorage := &SongStorageMock{
+//			AddFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
+//				panic("mock out the AddFavorite method")
+//			},
+//			AddPlayFunc: func(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error {
+//				panic("mock out the AddPlay method")
+//			},
+//			CreateFunc: func(song radio.Song) (*radio.Song, error) {
+//				panic("mock out the Create method")
+//			},
+//			FavoriteCountFunc: func(song radio.Song) (int64, error) {
+//				panic("mock out the FavoriteCount method")
+//			},
+//			FavoritesFunc: func(song radio.Song) ([]string, error) {
+//				panic("mock out the Favorites method")
+//			},
+//			FavoritesOfFunc: func(nick string, limit int64, offset int64) ([]radio.Song, int64, error) {
+//				panic("mock out the FavoritesOf method")
+//			},
+//			FavoritesOfDatabaseFunc: func(nick string) ([]radio.Song, error) {
+//				panic("mock out the FavoritesOfDatabase method")
+//			},
+//			FromHashFunc: func(songHash radio.SongHash) (*radio.Song, error) {
+//				panic("mock out the FromHash method")
+//			},
+//			FromMetadataFunc: func(metadata string) (*radio.Song, error) {
+//				panic("mock out the FromMetadata method")
+//			},
+//			LastPlayedFunc: func(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error) {
+//				panic("mock out the LastPlayed method")
+//			},
+//			LastPlayedCountFunc: func() (int64, error) {
+//				panic("mock out the LastPlayedCount method")
+//			},
+//			LastPlayedPaginationFunc: func(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error) {
+//				panic("mock out the LastPlayedPagination method")
+//			},
+//			PlayedCountFunc: func(song radio.Song) (int64, error) {
+//				panic("mock out the PlayedCount method")
+//			},
+//			RemoveFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
+//				panic("mock out the RemoveFavorite method")
+//			},
+//			UpdateHashLinkFunc: func(old radio.SongHash, new radio.SongHash) error {
+//				panic("mock out the UpdateHashLink method")
+//			},
+//			UpdateLengthFunc: func(song radio.Song, duration time.Duration) error {
+//				panic("mock out the UpdateLength method")
+//			},
+//		}
+//
+//		// use mockedSongStorage in code that requires radio.SongStorage
+//		// and then make assertions.
+//
+//	}
+type SongStorageMock struct {
+	// AddFavoriteFunc mocks the AddFavorite method.
+	AddFavoriteFunc func(song radio.Song, nick string) (bool, error)
+
+	// AddPlayFunc mocks the AddPlay method.
+	AddPlayFunc func(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(song radio.Song) (*radio.Song, error)
+
+	// FavoriteCountFunc mocks the FavoriteCount method.
+	FavoriteCountFunc func(song radio.Song) (int64, error)
+
+	// FavoritesFunc mocks the Favorites method.
+	FavoritesFunc func(song radio.Song) ([]string, error)
+
+	// FavoritesOfFunc mocks the FavoritesOf method.
+	FavoritesOfFunc func(nick string, limit int64, offset int64) ([]radio.Song, int64, error)
+
+	// FavoritesOfDatabaseFunc mocks the FavoritesOfDatabase method.
+	FavoritesOfDatabaseFunc func(nick string) ([]radio.Song, error)
+
+	// FromHashFunc mocks the FromHash method.
+	FromHashFunc func(songHash radio.SongHash) (*radio.Song, error)
+
+	// FromMetadataFunc mocks the FromMetadata method.
+	FromMetadataFunc func(metadata string) (*radio.Song, error)
+
+	// LastPlayedFunc mocks the LastPlayed method.
+	LastPlayedFunc func(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error)
+
+	// LastPlayedCountFunc mocks the LastPlayedCount method.
+	LastPlayedCountFunc func() (int64, error)
+
+	// LastPlayedPaginationFunc mocks the LastPlayedPagination method.
+	LastPlayedPaginationFunc func(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error)
+
+	// PlayedCountFunc mocks the PlayedCount method.
+	PlayedCountFunc func(song radio.Song) (int64, error)
+
+	// RemoveFavoriteFunc mocks the RemoveFavorite method.
+	RemoveFavoriteFunc func(song radio.Song, nick string) (bool, error)
+
+	// UpdateHashLinkFunc mocks the UpdateHashLink method.
+	UpdateHashLinkFunc func(old radio.SongHash, new radio.SongHash) error
+
+	// UpdateLengthFunc mocks the UpdateLength method.
+	UpdateLengthFunc func(song radio.Song, duration time.Duration) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddFavorite holds details about calls to the AddFavorite method.
+		AddFavorite []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// AddPlay holds details about calls to the AddPlay method.
+		AddPlay []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// Streamer is the streamer argument value.
+			Streamer radio.User
+			// Ldiff is the ldiff argument value.
+			Ldiff *radio.Listeners
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// FavoriteCount holds details about calls to the FavoriteCount method.
+		FavoriteCount []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// Favorites holds details about calls to the Favorites method.
+		Favorites []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// FavoritesOf holds details about calls to the FavoritesOf method.
+		FavoritesOf []struct {
+			// Nick is the nick argument value.
+			Nick string
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
+		}
+		// FavoritesOfDatabase holds details about calls to the FavoritesOfDatabase method.
+		FavoritesOfDatabase []struct {
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// FromHash holds details about calls to the FromHash method.
+		FromHash []struct {
+			// SongHash is the songHash argument value.
+			SongHash radio.SongHash
+		}
+		// FromMetadata holds details about calls to the FromMetadata method.
+		FromMetadata []struct {
+			// Metadata is the metadata argument value.
+			Metadata string
+		}
+		// LastPlayed holds details about calls to the LastPlayed method.
+		LastPlayed []struct {
+			// Key is the key argument value.
+			Key radio.LastPlayedKey
+			// AmountPerPage is the amountPerPage argument value.
+			AmountPerPage int
+		}
+		// LastPlayedCount holds details about calls to the LastPlayedCount method.
+		LastPlayedCount []struct {
+		}
+		// LastPlayedPagination holds details about calls to the LastPlayedPagination method.
+		LastPlayedPagination []struct {
+			// Key is the key argument value.
+			Key radio.LastPlayedKey
+			// AmountPerPage is the amountPerPage argument value.
+			AmountPerPage int
+			// PageCount is the pageCount argument value.
+			PageCount int
+		}
+		// PlayedCount holds details about calls to the PlayedCount method.
+		PlayedCount []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// RemoveFavorite holds details about calls to the RemoveFavorite method.
+		RemoveFavorite []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// UpdateHashLink holds details about calls to the UpdateHashLink method.
+		UpdateHashLink []struct {
+			// Old is the old argument value.
+			Old radio.SongHash
+			// New is the new argument value.
+			New radio.SongHash
+		}
+		// UpdateLength holds details about calls to the UpdateLength method.
+		UpdateLength []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// Duration is the duration argument value.
+			Duration time.Duration
+		}
+	}
+	lockAddFavorite          sync.RWMutex
+	lockAddPlay              sync.RWMutex
+	lockCreate               sync.RWMutex
+	lockFavoriteCount        sync.RWMutex
+	lockFavorites            sync.RWMutex
+	lockFavoritesOf          sync.RWMutex
+	lockFavoritesOfDatabase  sync.RWMutex
+	lockFromHash             sync.RWMutex
+	lockFromMetadata         sync.RWMutex
+	lockLastPlayed           sync.RWMutex
+	lockLastPlayedCount      sync.RWMutex
+	lockLastPlayedPagination sync.RWMutex
+	lockPlayedCount          sync.RWMutex
+	lockRemoveFavorite       sync.RWMutex
+	lockUpdateHashLink       sync.RWMutex
+	lockUpdateLength         sync.RWMutex
+}
+
+// AddFavorite calls AddFavoriteFunc.
+func (mock *SongStorageMock) AddFavorite(song radio.Song, nick string) (bool, error) {
+	if mock.AddFavoriteFunc == nil {
+		panic("SongStorageMock.AddFavoriteFunc: method is nil but SongStorage.AddFavorite was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+		Nick string
+	}{
+		Song: song,
+		Nick: nick,
+	}
+	mock.lockAddFavorite.Lock()
+	mock.calls.AddFavorite = append(mock.calls.AddFavorite, callInfo)
+	mock.lockAddFavorite.Unlock()
+	return mock.AddFavoriteFunc(song, nick)
+}
+
+// AddFavoriteCalls gets all the calls that were made to AddFavorite.
+// Check the length with:
+//
+//	len(mockedSongStorage.AddFavoriteCalls())
+func (mock *SongStorageMock) AddFavoriteCalls() []struct {
+	Song radio.Song
+	Nick string
+} {
+	var calls []struct {
+		Song radio.Song
+		Nick string
+	}
+	mock.lockAddFavorite.RLock()
+	calls = mock.calls.AddFavorite
+	mock.lockAddFavorite.RUnlock()
+	return calls
+}
+
+// AddPlay calls AddPlayFunc.
+func (mock *SongStorageMock) AddPlay(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error {
+	if mock.AddPlayFunc == nil {
+		panic("SongStorageMock.AddPlayFunc: method is nil but SongStorage.AddPlay was just called")
+	}
+	callInfo := struct {
+		Song     radio.Song
+		Streamer radio.User
+		Ldiff    *radio.Listeners
+	}{
+		Song:     song,
+		Streamer: streamer,
+		Ldiff:    ldiff,
+	}
+	mock.lockAddPlay.Lock()
+	mock.calls.AddPlay = append(mock.calls.AddPlay, callInfo)
+	mock.lockAddPlay.Unlock()
+	return mock.AddPlayFunc(song, streamer, ldiff)
+}
+
+// AddPlayCalls gets all the calls that were made to AddPlay.
+// Check the length with:
+//
+//	len(mockedSongStorage.AddPlayCalls())
+func (mock *SongStorageMock) AddPlayCalls() []struct {
+	Song     radio.Song
+	Streamer radio.User
+	Ldiff    *radio.Listeners
+} {
+	var calls []struct {
+		Song     radio.Song
+		Streamer radio.User
+		Ldiff    *radio.Listeners
+	}
+	mock.lockAddPlay.RLock()
+	calls = mock.calls.AddPlay
+	mock.lockAddPlay.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *SongStorageMock) Create(song radio.Song) (*radio.Song, error) {
+	if mock.CreateFunc == nil {
+		panic("SongStorageMock.CreateFunc: method is nil but SongStorage.Create was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(song)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedSongStorage.CreateCalls())
+func (mock *SongStorageMock) CreateCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// FavoriteCount calls FavoriteCountFunc.
+func (mock *SongStorageMock) FavoriteCount(song radio.Song) (int64, error) {
+	if mock.FavoriteCountFunc == nil {
+		panic("SongStorageMock.FavoriteCountFunc: method is nil but SongStorage.FavoriteCount was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockFavoriteCount.Lock()
+	mock.calls.FavoriteCount = append(mock.calls.FavoriteCount, callInfo)
+	mock.lockFavoriteCount.Unlock()
+	return mock.FavoriteCountFunc(song)
+}
+
+// FavoriteCountCalls gets all the calls that were made to FavoriteCount.
+// Check the length with:
+//
+//	len(mockedSongStorage.FavoriteCountCalls())
+func (mock *SongStorageMock) FavoriteCountCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockFavoriteCount.RLock()
+	calls = mock.calls.FavoriteCount
+	mock.lockFavoriteCount.RUnlock()
+	return calls
+}
+
+// Favorites calls FavoritesFunc.
+func (mock *SongStorageMock) Favorites(song radio.Song) ([]string, error) {
+	if mock.FavoritesFunc == nil {
+		panic("SongStorageMock.FavoritesFunc: method is nil but SongStorage.Favorites was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockFavorites.Lock()
+	mock.calls.Favorites = append(mock.calls.Favorites, callInfo)
+	mock.lockFavorites.Unlock()
+	return mock.FavoritesFunc(song)
+}
+
+// FavoritesCalls gets all the calls that were made to Favorites.
+// Check the length with:
+//
+//	len(mockedSongStorage.FavoritesCalls())
+func (mock *SongStorageMock) FavoritesCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockFavorites.RLock()
+	calls = mock.calls.Favorites
+	mock.lockFavorites.RUnlock()
+	return calls
+}
+
+// FavoritesOf calls FavoritesOfFunc.
+func (mock *SongStorageMock) FavoritesOf(nick string, limit int64, offset int64) ([]radio.Song, int64, error) {
+	if mock.FavoritesOfFunc == nil {
+		panic("SongStorageMock.FavoritesOfFunc: method is nil but SongStorage.FavoritesOf was just called")
+	}
+	callInfo := struct {
+		Nick   string
+		Limit  int64
+		Offset int64
+	}{
+		Nick:   nick,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockFavoritesOf.Lock()
+	mock.calls.FavoritesOf = append(mock.calls.FavoritesOf, callInfo)
+	mock.lockFavoritesOf.Unlock()
+	return mock.FavoritesOfFunc(nick, limit, offset)
+}
+
+// FavoritesOfCalls gets all the calls that were made to FavoritesOf.
+// Check the length with:
+//
+//	len(mockedSongStorage.FavoritesOfCalls())
+func (mock *SongStorageMock) FavoritesOfCalls() []struct {
+	Nick   string
+	Limit  int64
+	Offset int64
+} {
+	var calls []struct {
+		Nick   string
+		Limit  int64
+		Offset int64
+	}
+	mock.lockFavoritesOf.RLock()
+	calls = mock.calls.FavoritesOf
+	mock.lockFavoritesOf.RUnlock()
+	return calls
+}
+
+// FavoritesOfDatabase calls FavoritesOfDatabaseFunc.
+func (mock *SongStorageMock) FavoritesOfDatabase(nick string) ([]radio.Song, error) {
+	if mock.FavoritesOfDatabaseFunc == nil {
+		panic("SongStorageMock.FavoritesOfDatabaseFunc: method is nil but SongStorage.FavoritesOfDatabase was just called")
+	}
+	callInfo := struct {
+		Nick string
+	}{
+		Nick: nick,
+	}
+	mock.lockFavoritesOfDatabase.Lock()
+	mock.calls.FavoritesOfDatabase = append(mock.calls.FavoritesOfDatabase, callInfo)
+	mock.lockFavoritesOfDatabase.Unlock()
+	return mock.FavoritesOfDatabaseFunc(nick)
+}
+
+// FavoritesOfDatabaseCalls gets all the calls that were made to FavoritesOfDatabase.
+// Check the length with:
+//
+//	len(mockedSongStorage.FavoritesOfDatabaseCalls())
+func (mock *SongStorageMock) FavoritesOfDatabaseCalls() []struct {
+	Nick string
+} {
+	var calls []struct {
+		Nick string
+	}
+	mock.lockFavoritesOfDatabase.RLock()
+	calls = mock.calls.FavoritesOfDatabase
+	mock.lockFavoritesOfDatabase.RUnlock()
+	return calls
+}
+
+// FromHash calls FromHashFunc.
+func (mock *SongStorageMock) FromHash(songHash radio.SongHash) (*radio.Song, error) {
+	if mock.FromHashFunc == nil {
+		panic("SongStorageMock.FromHashFunc: method is nil but SongStorage.FromHash was just called")
+	}
+	callInfo := struct {
+		SongHash radio.SongHash
+	}{
+		SongHash: songHash,
+	}
+	mock.lockFromHash.Lock()
+	mock.calls.FromHash = append(mock.calls.FromHash, callInfo)
+	mock.lockFromHash.Unlock()
+	return mock.FromHashFunc(songHash)
+}
+
+// FromHashCalls gets all the calls that were made to FromHash.
+// Check the length with:
+//
+//	len(mockedSongStorage.FromHashCalls())
+func (mock *SongStorageMock) FromHashCalls() []struct {
+	SongHash radio.SongHash
+} {
+	var calls []struct {
+		SongHash radio.SongHash
+	}
+	mock.lockFromHash.RLock()
+	calls = mock.calls.FromHash
+	mock.lockFromHash.RUnlock()
+	return calls
+}
+
+// FromMetadata calls FromMetadataFunc.
+func (mock *SongStorageMock) FromMetadata(metadata string) (*radio.Song, error) {
+	if mock.FromMetadataFunc == nil {
+		panic("SongStorageMock.FromMetadataFunc: method is nil but SongStorage.FromMetadata was just called")
+	}
+	callInfo := struct {
+		Metadata string
+	}{
+		Metadata: metadata,
+	}
+	mock.lockFromMetadata.Lock()
+	mock.calls.FromMetadata = append(mock.calls.FromMetadata, callInfo)
+	mock.lockFromMetadata.Unlock()
+	return mock.FromMetadataFunc(metadata)
+}
+
+// FromMetadataCalls gets all the calls that were made to FromMetadata.
+// Check the length with:
+//
+//	len(mockedSongStorage.FromMetadataCalls())
+func (mock *SongStorageMock) FromMetadataCalls() []struct {
+	Metadata string
+} {
+	var calls []struct {
+		Metadata string
+	}
+	mock.lockFromMetadata.RLock()
+	calls = mock.calls.FromMetadata
+	mock.lockFromMetadata.RUnlock()
+	return calls
+}
+
+// LastPlayed calls LastPlayedFunc.
+func (mock *SongStorageMock) LastPlayed(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error) {
+	if mock.LastPlayedFunc == nil {
+		panic("SongStorageMock.LastPlayedFunc: method is nil but SongStorage.LastPlayed was just called")
+	}
+	callInfo := struct {
+		Key           radio.LastPlayedKey
+		AmountPerPage int
+	}{
+		Key:           key,
+		AmountPerPage: amountPerPage,
+	}
+	mock.lockLastPlayed.Lock()
+	mock.calls.LastPlayed = append(mock.calls.LastPlayed, callInfo)
+	mock.lockLastPlayed.Unlock()
+	return mock.LastPlayedFunc(key, amountPerPage)
+}
+
+// LastPlayedCalls gets all the calls that were made to LastPlayed.
+// Check the length with:
+//
+//	len(mockedSongStorage.LastPlayedCalls())
+func (mock *SongStorageMock) LastPlayedCalls() []struct {
+	Key           radio.LastPlayedKey
+	AmountPerPage int
+} {
+	var calls []struct {
+		Key           radio.LastPlayedKey
+		AmountPerPage int
+	}
+	mock.lockLastPlayed.RLock()
+	calls = mock.calls.LastPlayed
+	mock.lockLastPlayed.RUnlock()
+	return calls
+}
+
+// LastPlayedCount calls LastPlayedCountFunc.
+func (mock *SongStorageMock) LastPlayedCount() (int64, error) {
+	if mock.LastPlayedCountFunc == nil {
+		panic("SongStorageMock.LastPlayedCountFunc: method is nil but SongStorage.LastPlayedCount was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockLastPlayedCount.Lock()
+	mock.calls.LastPlayedCount = append(mock.calls.LastPlayedCount, callInfo)
+	mock.lockLastPlayedCount.Unlock()
+	return mock.LastPlayedCountFunc()
+}
+
+// LastPlayedCountCalls gets all the calls that were made to LastPlayedCount.
+// Check the length with:
+//
+//	len(mockedSongStorage.LastPlayedCountCalls())
+func (mock *SongStorageMock) LastPlayedCountCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockLastPlayedCount.RLock()
+	calls = mock.calls.LastPlayedCount
+	mock.lockLastPlayedCount.RUnlock()
+	return calls
+}
+
+// LastPlayedPagination calls LastPlayedPaginationFunc.
+func (mock *SongStorageMock) LastPlayedPagination(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error) {
+	if mock.LastPlayedPaginationFunc == nil {
+		panic("SongStorageMock.LastPlayedPaginationFunc: method is nil but SongStorage.LastPlayedPagination was just called")
+	}
+	callInfo := struct {
+		Key           radio.LastPlayedKey
+		AmountPerPage int
+		PageCount     int
+	}{
+		Key:           key,
+		AmountPerPage: amountPerPage,
+		PageCount:     pageCount,
+	}
+	mock.lockLastPlayedPagination.Lock()
+	mock.calls.LastPlayedPagination = append(mock.calls.LastPlayedPagination, callInfo)
+	mock.lockLastPlayedPagination.Unlock()
+	return mock.LastPlayedPaginationFunc(key, amountPerPage, pageCount)
+}
+
+// LastPlayedPaginationCalls gets all the calls that were made to LastPlayedPagination.
+// Check the length with:
+//
+//	len(mockedSongStorage.LastPlayedPaginationCalls())
+func (mock *SongStorageMock) LastPlayedPaginationCalls() []struct {
+	Key           radio.LastPlayedKey
+	AmountPerPage int
+	PageCount     int
+} {
+	var calls []struct {
+		Key           radio.LastPlayedKey
+		AmountPerPage int
+		PageCount     int
+	}
+	mock.lockLastPlayedPagination.RLock()
+	calls = mock.calls.LastPlayedPagination
+	mock.lockLastPlayedPagination.RUnlock()
+	return calls
+}
+
+// PlayedCount calls PlayedCountFunc.
+func (mock *SongStorageMock) PlayedCount(song radio.Song) (int64, error) {
+	if mock.PlayedCountFunc == nil {
+		panic("SongStorageMock.PlayedCountFunc: method is nil but SongStorage.PlayedCount was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockPlayedCount.Lock()
+	mock.calls.PlayedCount = append(mock.calls.PlayedCount, callInfo)
+	mock.lockPlayedCount.Unlock()
+	return mock.PlayedCountFunc(song)
+}
+
+// PlayedCountCalls gets all the calls that were made to PlayedCount.
+// Check the length with:
+//
+//	len(mockedSongStorage.PlayedCountCalls())
+func (mock *SongStorageMock) PlayedCountCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockPlayedCount.RLock()
+	calls = mock.calls.PlayedCount
+	mock.lockPlayedCount.RUnlock()
+	return calls
+}
+
+// RemoveFavorite calls RemoveFavoriteFunc.
+func (mock *SongStorageMock) RemoveFavorite(song radio.Song, nick string) (bool, error) {
+	if mock.RemoveFavoriteFunc == nil {
+		panic("SongStorageMock.RemoveFavoriteFunc: method is nil but SongStorage.RemoveFavorite was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+		Nick string
+	}{
+		Song: song,
+		Nick: nick,
+	}
+	mock.lockRemoveFavorite.Lock()
+	mock.calls.RemoveFavorite = append(mock.calls.RemoveFavorite, callInfo)
+	mock.lockRemoveFavorite.Unlock()
+	return mock.RemoveFavoriteFunc(song, nick)
+}
+
+// RemoveFavoriteCalls gets all the calls that were made to RemoveFavorite.
+// Check the length with:
+//
+//	len(mockedSongStorage.RemoveFavoriteCalls())
+func (mock *SongStorageMock) RemoveFavoriteCalls() []struct {
+	Song radio.Song
+	Nick string
+} {
+	var calls []struct {
+		Song radio.Song
+		Nick string
+	}
+	mock.lockRemoveFavorite.RLock()
+	calls = mock.calls.RemoveFavorite
+	mock.lockRemoveFavorite.RUnlock()
+	return calls
+}
+
+// UpdateHashLink calls UpdateHashLinkFunc.
+func (mock *SongStorageMock) UpdateHashLink(old radio.SongHash, new radio.SongHash) error {
+	if mock.UpdateHashLinkFunc == nil {
+		panic("SongStorageMock.UpdateHashLinkFunc: method is nil but SongStorage.UpdateHashLink was just called")
+	}
+	callInfo := struct {
+		Old radio.SongHash
+		New radio.SongHash
+	}{
+		Old: old,
+		New: new,
+	}
+	mock.lockUpdateHashLink.Lock()
+	mock.calls.UpdateHashLink = append(mock.calls.UpdateHashLink, callInfo)
+	mock.lockUpdateHashLink.Unlock()
+	return mock.UpdateHashLinkFunc(old, new)
+}
+
+// UpdateHashLinkCalls gets all the calls that were made to UpdateHashLink.
+// Check the length with:
+//
+//	len(mockedSongStorage.UpdateHashLinkCalls())
+func (mock *SongStorageMock) UpdateHashLinkCalls() []struct {
+	Old radio.SongHash
+	New radio.SongHash
+} {
+	var calls []struct {
+		Old radio.SongHash
+		New radio.SongHash
+	}
+	mock.lockUpdateHashLink.RLock()
+	calls = mock.calls.UpdateHashLink
+	mock.lockUpdateHashLink.RUnlock()
+	return calls
+}
+
+// UpdateLength calls UpdateLengthFunc.
+func (mock *SongStorageMock) UpdateLength(song radio.Song, duration time.Duration) error {
+	if mock.UpdateLengthFunc == nil {
+		panic("SongStorageMock.UpdateLengthFunc: method is nil but SongStorage.UpdateLength was just called")
+	}
+	callInfo := struct {
+		Song     radio.Song
+		Duration time.Duration
+	}{
+		Song:     song,
+		Duration: duration,
+	}
+	mock.lockUpdateLength.Lock()
+	mock.calls.UpdateLength = append(mock.calls.UpdateLength, callInfo)
+	mock.lockUpdateLength.Unlock()
+	return mock.UpdateLengthFunc(song, duration)
+}
+
+// UpdateLengthCalls gets all the calls that were made to UpdateLength.
+// Check the length with:
+//
+//	len(mockedSongStorage.UpdateLengthCalls())
+func (mock *SongStorageMock) UpdateLengthCalls() []struct {
+	Song     radio.Song
+	Duration time.Duration
+} {
+	var calls []struct {
+		Song     radio.Song
+		Duration time.Duration
+	}
+	mock.lockUpdateLength.RLock()
+	calls = mock.calls.UpdateLength
+	mock.lockUpdateLength.RUnlock()
+	return calls
+}
+
+// Ensure, that TrackStorageServiceMock does implement radio.TrackStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.TrackStorageService = &TrackStorageServiceMock{}
+
+// TrackStorageServiceMock is a mock implementation of radio.TrackStorageService.
+//
+//	func TestSomethingThatUsesTrackStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.TrackStorageService
+//		mockedTrackStorageService := &TrackStorageServiceMock{
+//			TrackFunc: func(contextMoqParam context.Context) radio.TrackStorage {
+//				panic("mock out the Track method")
+//			},
+//			TrackTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+//				panic("mock out the TrackTx method")
+//			},
+//		}
+//
+//		// use mockedTrackStorageService in code that requires radio.TrackStorageService
+//		// and then make assertions.
+//
+//	}
+type TrackStorageServiceMock struct {
+	// TrackFunc mocks the Track method.
+	TrackFunc func(contextMoqParam context.Context) radio.TrackStorage
+
+	// TrackTxFunc mocks the TrackTx method.
+	TrackTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Track holds details about calls to the Track method.
+		Track []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// TrackTx holds details about calls to the TrackTx method.
+		TrackTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockTrack   sync.RWMutex
+	lockTrackTx sync.RWMutex
+}
+
+// Track calls TrackFunc.
+func (mock *TrackStorageServiceMock) Track(contextMoqParam context.Context) radio.TrackStorage {
+	if mock.TrackFunc == nil {
+		panic("TrackStorageServiceMock.TrackFunc: method is nil but TrackStorageService.Track was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockTrack.Lock()
+	mock.calls.Track = append(mock.calls.Track, callInfo)
+	mock.lockTrack.Unlock()
+	return mock.TrackFunc(contextMoqParam)
+}
+
+// TrackCalls gets all the calls that were made to Track.
+// Check the length with:
+//
+//	len(mockedTrackStorageService.TrackCalls())
+func (mock *TrackStorageServiceMock) TrackCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockTrack.RLock()
+	calls = mock.calls.Track
+	mock.lockTrack.RUnlock()
+	return calls
+}
+
+// TrackTx calls TrackTxFunc.
+func (mock *TrackStorageServiceMock) TrackTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+	if mock.TrackTxFunc == nil {
+		panic("TrackStorageServiceMock.TrackTxFunc: method is nil but TrackStorageService.TrackTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockTrackTx.Lock()
+	mock.calls.TrackTx = append(mock.calls.TrackTx, callInfo)
+	mock.lockTrackTx.Unlock()
+	return mock.TrackTxFunc(contextMoqParam, storageTx)
+}
+
+// TrackTxCalls gets all the calls that were made to TrackTx.
+// Check the length with:
+//
+//	len(mockedTrackStorageService.TrackTxCalls())
+func (mock *TrackStorageServiceMock) TrackTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockTrackTx.RLock()
+	calls = mock.calls.TrackTx
+	mock.lockTrackTx.RUnlock()
+	return calls
+}
+
+// Ensure, that TrackStorageMock does implement radio.TrackStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.TrackStorage = &TrackStorageMock{}
+
+// TrackStorageMock is a mock implementation of radio.TrackStorage.
+//
+//	func TestSomethingThatUsesTrackStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.TrackStorage
+//		mockedTrackStorage := &TrackStorageMock{
+//			AllFunc: func() ([]radio.Song, error) {
+//				panic("mock out the All method")
+//			},
+//			AllRawFunc: func() ([]radio.Song, error) {
+//				panic("mock out the AllRaw method")
+//			},
+//			BeforeLastRequestedFunc: func(before time.Time) ([]radio.Song, error) {
+//				panic("mock out the BeforeLastRequested method")
+//			},
+//			DecrementRequestCountFunc: func(before time.Time) error {
+//				panic("mock out the DecrementRequestCount method")
+//			},
+//			DeleteFunc: func(trackID radio.TrackID) error {
+//				panic("mock out the Delete method")
+//			},
+//			FilterSongsFavoriteOfFunc: func(nick string, songs []radio.Song) ([]radio.Song, error) {
+//				panic("mock out the FilterSongsFavoriteOf method")
+//			},
+//			GetFunc: func(trackID radio.TrackID) (*radio.Song, error) {
+//				panic("mock out the Get method")
+//			},
+//			InsertFunc: func(song radio.Song) (radio.TrackID, error) {
+//				panic("mock out the Insert method")
+//			},
+//			NeedReplacementFunc: func() ([]radio.Song, error) {
+//				panic("mock out the NeedReplacement method")
+//			},
+//			QueueCandidatesFunc: func() ([]radio.TrackID, error) {
+//				panic("mock out the QueueCandidates method")
+//			},
+//			RandomFunc: func(limit int) ([]radio.Song, error) {
+//				panic("mock out the Random method")
+//			},
+//			RandomFavoriteOfFunc: func(nick string, limit int) ([]radio.Song, error) {
+//				panic("mock out the RandomFavoriteOf method")
+//			},
+//			UnusableFunc: func() ([]radio.Song, error) {
+//				panic("mock out the Unusable method")
+//			},
+//			UpdateLastPlayedFunc: func(trackID radio.TrackID) error {
+//				panic("mock out the UpdateLastPlayed method")
+//			},
+//			UpdateLastRequestedFunc: func(trackID radio.TrackID) error {
+//				panic("mock out the UpdateLastRequested method")
+//			},
+//			UpdateMetadataFunc: func(song radio.Song) error {
+//				panic("mock out the UpdateMetadata method")
+//			},
+//			UpdateRequestInfoFunc: func(trackID radio.TrackID) error {
+//				panic("mock out the UpdateRequestInfo method")
+//			},
+//			UpdateUsableFunc: func(song radio.Song, state radio.TrackState) error {
+//				panic("mock out the UpdateUsable method")
+//			},
+//		}
+//
+//		// use mockedTrackStorage in code that requires radio.TrackStorage
+//		// and then make assertions.
+//
+//	}
+type TrackStorageMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func() ([]radio.Song, error)
+
+	// AllRawFunc mocks the AllRaw method.
+	AllRawFunc func() ([]radio.Song, error)
+
+	// BeforeLastRequestedFunc mocks the BeforeLastRequested method.
+	BeforeLastRequestedFunc func(before time.Time) ([]radio.Song, error)
+
+	// DecrementRequestCountFunc mocks the DecrementRequestCount method.
+	DecrementRequestCountFunc func(before time.Time) error
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(trackID radio.TrackID) error
+
+	// FilterSongsFavoriteOfFunc mocks the FilterSongsFavoriteOf method.
+	FilterSongsFavoriteOfFunc func(nick string, songs []radio.Song) ([]radio.Song, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(trackID radio.TrackID) (*radio.Song, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(song radio.Song) (radio.TrackID, error)
+
+	// NeedReplacementFunc mocks the NeedReplacement method.
+	NeedReplacementFunc func() ([]radio.Song, error)
+
+	// QueueCandidatesFunc mocks the QueueCandidates method.
+	QueueCandidatesFunc func() ([]radio.TrackID, error)
+
+	// RandomFunc mocks the Random method.
+	RandomFunc func(limit int) ([]radio.Song, error)
+
+	// RandomFavoriteOfFunc mocks the RandomFavoriteOf method.
+	RandomFavoriteOfFunc func(nick string, limit int) ([]radio.Song, error)
+
+	// UnusableFunc mocks the Unusable method.
+	UnusableFunc func() ([]radio.Song, error)
+
+	// UpdateLastPlayedFunc mocks the UpdateLastPlayed method.
+	UpdateLastPlayedFunc func(trackID radio.TrackID) error
+
+	// UpdateLastRequestedFunc mocks the UpdateLastRequested method.
+	UpdateLastRequestedFunc func(trackID radio.TrackID) error
+
+	// UpdateMetadataFunc mocks the UpdateMetadata method.
+	UpdateMetadataFunc func(song radio.Song) error
+
+	// UpdateRequestInfoFunc mocks the UpdateRequestInfo method.
+	UpdateRequestInfoFunc func(trackID radio.TrackID) error
+
+	// UpdateUsableFunc mocks the UpdateUsable method.
+	UpdateUsableFunc func(song radio.Song, state radio.TrackState) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+		}
+		// AllRaw holds details about calls to the AllRaw method.
+		AllRaw []struct {
+		}
+		// BeforeLastRequested holds details about calls to the BeforeLastRequested method.
+		BeforeLastRequested []struct {
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// DecrementRequestCount holds details about calls to the DecrementRequestCount method.
+		DecrementRequestCount []struct {
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// FilterSongsFavoriteOf holds details about calls to the FilterSongsFavoriteOf method.
+		FilterSongsFavoriteOf []struct {
+			// Nick is the nick argument value.
+			Nick string
+			// Songs is the songs argument value.
+			Songs []radio.Song
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// NeedReplacement holds details about calls to the NeedReplacement method.
+		NeedReplacement []struct {
+		}
+		// QueueCandidates holds details about calls to the QueueCandidates method.
+		QueueCandidates []struct {
+		}
+		// Random holds details about calls to the Random method.
+		Random []struct {
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// RandomFavoriteOf holds details about calls to the RandomFavoriteOf method.
+		RandomFavoriteOf []struct {
+			// Nick is the nick argument value.
+			Nick string
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Unusable holds details about calls to the Unusable method.
+		Unusable []struct {
+		}
+		// UpdateLastPlayed holds details about calls to the UpdateLastPlayed method.
+		UpdateLastPlayed []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// UpdateLastRequested holds details about calls to the UpdateLastRequested method.
+		UpdateLastRequested []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// UpdateMetadata holds details about calls to the UpdateMetadata method.
+		UpdateMetadata []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// UpdateRequestInfo holds details about calls to the UpdateRequestInfo method.
+		UpdateRequestInfo []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// UpdateUsable holds details about calls to the UpdateUsable method.
+		UpdateUsable []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// State is the state argument value.
+			State radio.TrackState
+		}
+	}
+	lockAll                   sync.RWMutex
+	lockAllRaw                sync.RWMutex
+	lockBeforeLastRequested   sync.RWMutex
+	lockDecrementRequestCount sync.RWMutex
+	lockDelete                sync.RWMutex
+	lockFilterSongsFavoriteOf sync.RWMutex
+	lockGet                   sync.RWMutex
+	lockInsert                sync.RWMutex
+	lockNeedReplacement       sync.RWMutex
+	lockQueueCandidates       sync.RWMutex
+	lockRandom                sync.RWMutex
+	lockRandomFavoriteOf      sync.RWMutex
+	lockUnusable              sync.RWMutex
+	lockUpdateLastPlayed      sync.RWMutex
+	lockUpdateLastRequested   sync.RWMutex
+	lockUpdateMetadata        sync.RWMutex
+	lockUpdateRequestInfo     sync.RWMutex
+	lockUpdateUsable          sync.RWMutex
+}
+
+// All calls AllFunc.
+func (mock *TrackStorageMock) All() ([]radio.Song, error) {
+	if mock.AllFunc == nil {
+		panic("TrackStorageMock.AllFunc: method is nil but TrackStorage.All was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc()
+}
+
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedTrackStorage.AllCalls())
+func (mock *TrackStorageMock) AllCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
+}
+
+// AllRaw calls AllRawFunc.
+func (mock *TrackStorageMock) AllRaw() ([]radio.Song, error) {
+	if mock.AllRawFunc == nil {
+		panic("TrackStorageMock.AllRawFunc: method is nil but TrackStorage.AllRaw was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAllRaw.Lock()
+	mock.calls.AllRaw = append(mock.calls.AllRaw, callInfo)
+	mock.lockAllRaw.Unlock()
+	return mock.AllRawFunc()
+}
+
+// AllRawCalls gets all the calls that were made to AllRaw.
+// Check the length with:
+//
+//	len(mockedTrackStorage.AllRawCalls())
+func (mock *TrackStorageMock) AllRawCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAllRaw.RLock()
+	calls = mock.calls.AllRaw
+	mock.lockAllRaw.RUnlock()
+	return calls
+}
+
+// BeforeLastRequested calls BeforeLastRequestedFunc.
+func (mock *TrackStorageMock) BeforeLastRequested(before time.Time) ([]radio.Song, error) {
+	if mock.BeforeLastRequestedFunc == nil {
+		panic("TrackStorageMock.BeforeLastRequestedFunc: method is nil but TrackStorage.BeforeLastRequested was just called")
+	}
+	callInfo := struct {
+		Before time.Time
+	}{
+		Before: before,
+	}
+	mock.lockBeforeLastRequested.Lock()
+	mock.calls.BeforeLastRequested = append(mock.calls.BeforeLastRequested, callInfo)
+	mock.lockBeforeLastRequested.Unlock()
+	return mock.BeforeLastRequestedFunc(before)
+}
+
+// BeforeLastRequestedCalls gets all the calls that were made to BeforeLastRequested.
+// Check the length with:
+//
+//	len(mockedTrackStorage.BeforeLastRequestedCalls())
+func (mock *TrackStorageMock) BeforeLastRequestedCalls() []struct {
+	Before time.Time
+} {
+	var calls []struct {
+		Before time.Time
+	}
+	mock.lockBeforeLastRequested.RLock()
+	calls = mock.calls.BeforeLastRequested
+	mock.lockBeforeLastRequested.RUnlock()
+	return calls
+}
+
+// DecrementRequestCount calls DecrementRequestCountFunc.
+func (mock *TrackStorageMock) DecrementRequestCount(before time.Time) error {
+	if mock.DecrementRequestCountFunc == nil {
+		panic("TrackStorageMock.DecrementRequestCountFunc: method is nil but TrackStorage.DecrementRequestCount was just called")
+	}
+	callInfo := struct {
+		Before time.Time
+	}{
+		Before: before,
+	}
+	mock.lockDecrementRequestCount.Lock()
+	mock.calls.DecrementRequestCount = append(mock.calls.DecrementRequestCount, callInfo)
+	mock.lockDecrementRequestCount.Unlock()
+	return mock.DecrementRequestCountFunc(before)
+}
+
+// DecrementRequestCountCalls gets all the calls that were made to DecrementRequestCount.
+// Check the length with:
+//
+//	len(mockedTrackStorage.DecrementRequestCountCalls())
+func (mock *TrackStorageMock) DecrementRequestCountCalls() []struct {
+	Before time.Time
+} {
+	var calls []struct {
+		Before time.Time
+	}
+	mock.lockDecrementRequestCount.RLock()
+	calls = mock.calls.DecrementRequestCount
+	mock.lockDecrementRequestCount.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *TrackStorageMock) Delete(trackID radio.TrackID) error {
+	if mock.DeleteFunc == nil {
+		panic("TrackStorageMock.DeleteFunc: method is nil but TrackStorage.Delete was just called")
+	}
+	callInfo := struct {
+		TrackID radio.TrackID
+	}{
+		TrackID: trackID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(trackID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedTrackStorage.DeleteCalls())
+func (mock *TrackStorageMock) DeleteCalls() []struct {
+	TrackID radio.TrackID
+} {
+	var calls []struct {
+		TrackID radio.TrackID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// FilterSongsFavoriteOf calls FilterSongsFavoriteOfFunc.
+func (mock *TrackStorageMock) FilterSongsFavoriteOf(nick string, songs []radio.Song) ([]radio.Song, error) {
+	if mock.FilterSongsFavoriteOfFunc == nil {
+		panic("TrackStorageMock.FilterSongsFavoriteOfFunc: method is nil but TrackStorage.FilterSongsFavoriteOf was just called")
+	}
+	callInfo := struct {
+		Nick  string
+		Songs []radio.Song
+	}{
+		Nick:  nick,
+		Songs: songs,
+	}
+	mock.lockFilterSongsFavoriteOf.Lock()
+	mock.calls.FilterSongsFavoriteOf = append(mock.calls.FilterSongsFavoriteOf, callInfo)
+	mock.lockFilterSongsFavoriteOf.Unlock()
+	return mock.FilterSongsFavoriteOfFunc(nick, songs)
+}
+
+// FilterSongsFavoriteOfCalls gets all the calls that were made to FilterSongsFavoriteOf.
+// Check the length with:
+//
+//	len(mockedTrackStorage.FilterSongsFavoriteOfCalls())
+func (mock *TrackStorageMock) FilterSongsFavoriteOfCalls() []struct {
+	Nick  string
+	Songs []radio.Song
+} {
+	var calls []struct {
+		Nick  string
+		Songs []radio.Song
+	}
+	mock.lockFilterSongsFavoriteOf.RLock()
+	calls = mock.calls.FilterSongsFavoriteOf
+	mock.lockFilterSongsFavoriteOf.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *TrackStorageMock) Get(trackID radio.TrackID) (*radio.Song, error) {
+	if mock.GetFunc == nil {
+		panic("TrackStorageMock.GetFunc: method is nil but TrackStorage.Get was just called")
+	}
+	callInfo := struct {
+		TrackID radio.TrackID
+	}{
+		TrackID: trackID,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(trackID)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedTrackStorage.GetCalls())
+func (mock *TrackStorageMock) GetCalls() []struct {
+	TrackID radio.TrackID
+} {
+	var calls []struct {
+		TrackID radio.TrackID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *TrackStorageMock) Insert(song radio.Song) (radio.TrackID, error) {
+	if mock.InsertFunc == nil {
+		panic("TrackStorageMock.InsertFunc: method is nil but TrackStorage.Insert was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(song)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedTrackStorage.InsertCalls())
+func (mock *TrackStorageMock) InsertCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// NeedReplacement calls NeedReplacementFunc.
+func (mock *TrackStorageMock) NeedReplacement() ([]radio.Song, error) {
+	if mock.NeedReplacementFunc == nil {
+		panic("TrackStorageMock.NeedReplacementFunc: method is nil but TrackStorage.NeedReplacement was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockNeedReplacement.Lock()
+	mock.calls.NeedReplacement = append(mock.calls.NeedReplacement, callInfo)
+	mock.lockNeedReplacement.Unlock()
+	return mock.NeedReplacementFunc()
+}
+
+// NeedReplacementCalls gets all the calls that were made to NeedReplacement.
+// Check the length with:
+//
+//	len(mockedTrackStorage.NeedReplacementCalls())
+func (mock *TrackStorageMock) NeedReplacementCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockNeedReplacement.RLock()
+	calls = mock.calls.NeedReplacement
+	mock.lockNeedReplacement.RUnlock()
+	return calls
+}
+
+// QueueCandidates calls QueueCandidatesFunc.
+func (mock *TrackStorageMock) QueueCandidates() ([]radio.TrackID, error) {
+	if mock.QueueCandidatesFunc == nil {
+		panic("TrackStorageMock.QueueCandidatesFunc: method is nil but TrackStorage.QueueCandidates was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockQueueCandidates.Lock()
+	mock.calls.QueueCandidates = append(mock.calls.QueueCandidates, callInfo)
+	mock.lockQueueCandidates.Unlock()
+	return mock.QueueCandidatesFunc()
+}
+
+// QueueCandidatesCalls gets all the calls that were made to QueueCandidates.
+// Check the length with:
+//
+//	len(mockedTrackStorage.QueueCandidatesCalls())
+func (mock *TrackStorageMock) QueueCandidatesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockQueueCandidates.RLock()
+	calls = mock.calls.QueueCandidates
+	mock.lockQueueCandidates.RUnlock()
+	return calls
+}
+
+// Random calls RandomFunc.
+func (mock *TrackStorageMock) Random(limit int) ([]radio.Song, error) {
+	if mock.RandomFunc == nil {
+		panic("TrackStorageMock.RandomFunc: method is nil but TrackStorage.Random was just called")
+	}
+	callInfo := struct {
+		Limit int
+	}{
+		Limit: limit,
+	}
+	mock.lockRandom.Lock()
+	mock.calls.Random = append(mock.calls.Random, callInfo)
+	mock.lockRandom.Unlock()
+	return mock.RandomFunc(limit)
+}
+
+// RandomCalls gets all the calls that were made to Random.
+// Check the length with:
+//
+//	len(mockedTrackStorage.RandomCalls())
+func (mock *TrackStorageMock) RandomCalls() []struct {
+	Limit int
+} {
+	var calls []struct {
+		Limit int
+	}
+	mock.lockRandom.RLock()
+	calls = mock.calls.Random
+	mock.lockRandom.RUnlock()
+	return calls
+}
+
+// RandomFavoriteOf calls RandomFavoriteOfFunc.
+func (mock *TrackStorageMock) RandomFavoriteOf(nick string, limit int) ([]radio.Song, error) {
+	if mock.RandomFavoriteOfFunc == nil {
+		panic("TrackStorageMock.RandomFavoriteOfFunc: method is nil but TrackStorage.RandomFavoriteOf was just called")
+	}
+	callInfo := struct {
+		Nick  string
+		Limit int
+	}{
+		Nick:  nick,
+		Limit: limit,
+	}
+	mock.lockRandomFavoriteOf.Lock()
+	mock.calls.RandomFavoriteOf = append(mock.calls.RandomFavoriteOf, callInfo)
+	mock.lockRandomFavoriteOf.Unlock()
+	return mock.RandomFavoriteOfFunc(nick, limit)
+}
+
+// RandomFavoriteOfCalls gets all the calls that were made to RandomFavoriteOf.
+// Check the length with:
+//
+//	len(mockedTrackStorage.RandomFavoriteOfCalls())
+func (mock *TrackStorageMock) RandomFavoriteOfCalls() []struct {
+	Nick  string
+	Limit int
+} {
+	var calls []struct {
+		Nick  string
+		Limit int
+	}
+	mock.lockRandomFavoriteOf.RLock()
+	calls = mock.calls.RandomFavoriteOf
+	mock.lockRandomFavoriteOf.RUnlock()
+	return calls
+}
+
+// Unusable calls UnusableFunc.
+func (mock *TrackStorageMock) Unusable() ([]radio.Song, error) {
+	if mock.UnusableFunc == nil {
+		panic("TrackStorageMock.UnusableFunc: method is nil but TrackStorage.Unusable was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockUnusable.Lock()
+	mock.calls.Unusable = append(mock.calls.Unusable, callInfo)
+	mock.lockUnusable.Unlock()
+	return mock.UnusableFunc()
+}
+
+// UnusableCalls gets all the calls that were made to Unusable.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UnusableCalls())
+func (mock *TrackStorageMock) UnusableCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockUnusable.RLock()
+	calls = mock.calls.Unusable
+	mock.lockUnusable.RUnlock()
+	return calls
+}
+
+// UpdateLastPlayed calls UpdateLastPlayedFunc.
+func (mock *TrackStorageMock) UpdateLastPlayed(trackID radio.TrackID) error {
+	if mock.UpdateLastPlayedFunc == nil {
+		panic("TrackStorageMock.UpdateLastPlayedFunc: method is nil but TrackStorage.UpdateLastPlayed was just called")
+	}
+	callInfo := struct {
+		TrackID radio.TrackID
+	}{
+		TrackID: trackID,
+	}
+	mock.lockUpdateLastPlayed.Lock()
+	mock.calls.UpdateLastPlayed = append(mock.calls.UpdateLastPlayed, callInfo)
+	mock.lockUpdateLastPlayed.Unlock()
+	return mock.UpdateLastPlayedFunc(trackID)
+}
+
+// UpdateLastPlayedCalls gets all the calls that were made to UpdateLastPlayed.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateLastPlayedCalls())
+func (mock *TrackStorageMock) UpdateLastPlayedCalls() []struct {
+	TrackID radio.TrackID
+} {
+	var calls []struct {
+		TrackID radio.TrackID
+	}
+	mock.lockUpdateLastPlayed.RLock()
+	calls = mock.calls.UpdateLastPlayed
+	mock.lockUpdateLastPlayed.RUnlock()
+	return calls
+}
+
+// UpdateLastRequested calls UpdateLastRequestedFunc.
+func (mock *TrackStorageMock) UpdateLastRequested(trackID radio.TrackID) error {
+	if mock.UpdateLastRequestedFunc == nil {
+		panic("TrackStorageMock.UpdateLastRequestedFunc: method is nil but TrackStorage.UpdateLastRequested was just called")
+	}
+	callInfo := struct {
+		TrackID radio.TrackID
+	}{
+		TrackID: trackID,
+	}
+	mock.lockUpdateLastRequested.Lock()
+	mock.calls.UpdateLastRequested = append(mock.calls.UpdateLastRequested, callInfo)
+	mock.lockUpdateLastRequested.Unlock()
+	return mock.UpdateLastRequestedFunc(trackID)
+}
+
+// UpdateLastRequestedCalls gets all the calls that were made to UpdateLastRequested.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateLastRequestedCalls())
+func (mock *TrackStorageMock) UpdateLastRequestedCalls() []struct {
+	TrackID radio.TrackID
+} {
+	var calls []struct {
+		TrackID radio.TrackID
+	}
+	mock.lockUpdateLastRequested.RLock()
+	calls = mock.calls.UpdateLastRequested
+	mock.lockUpdateLastRequested.RUnlock()
+	return calls
+}
+
+// UpdateMetadata calls UpdateMetadataFunc.
+func (mock *TrackStorageMock) UpdateMetadata(song radio.Song) error {
+	if mock.UpdateMetadataFunc == nil {
+		panic("TrackStorageMock.UpdateMetadataFunc: method is nil but TrackStorage.UpdateMetadata was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockUpdateMetadata.Lock()
+	mock.calls.UpdateMetadata = append(mock.calls.UpdateMetadata, callInfo)
+	mock.lockUpdateMetadata.Unlock()
+	return mock.UpdateMetadataFunc(song)
+}
+
+// UpdateMetadataCalls gets all the calls that were made to UpdateMetadata.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateMetadataCalls())
+func (mock *TrackStorageMock) UpdateMetadataCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockUpdateMetadata.RLock()
+	calls = mock.calls.UpdateMetadata
+	mock.lockUpdateMetadata.RUnlock()
+	return calls
+}
+
+// UpdateRequestInfo calls UpdateRequestInfoFunc.
+func (mock *TrackStorageMock) UpdateRequestInfo(trackID radio.TrackID) error {
+	if mock.UpdateRequestInfoFunc == nil {
+		panic("TrackStorageMock.UpdateRequestInfoFunc: method is nil but TrackStorage.UpdateRequestInfo was just called")
+	}
+	callInfo := struct {
+		TrackID radio.TrackID
+	}{
+		TrackID: trackID,
+	}
+	mock.lockUpdateRequestInfo.Lock()
+	mock.calls.UpdateRequestInfo = append(mock.calls.UpdateRequestInfo, callInfo)
+	mock.lockUpdateRequestInfo.Unlock()
+	return mock.UpdateRequestInfoFunc(trackID)
+}
+
+// UpdateRequestInfoCalls gets all the calls that were made to UpdateRequestInfo.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateRequestInfoCalls())
+func (mock *TrackStorageMock) UpdateRequestInfoCalls() []struct {
+	TrackID radio.TrackID
+} {
+	var calls []struct {
+		TrackID radio.TrackID
+	}
+	mock.lockUpdateRequestInfo.RLock()
+	calls = mock.calls.UpdateRequestInfo
+	mock.lockUpdateRequestInfo.RUnlock()
+	return calls
+}
+
+// UpdateUsable calls UpdateUsableFunc.
+func (mock *TrackStorageMock) UpdateUsable(song radio.Song, state radio.TrackState) error {
+	if mock.UpdateUsableFunc == nil {
+		panic("TrackStorageMock.UpdateUsableFunc: method is nil but TrackStorage.UpdateUsable was just called")
+	}
+	callInfo := struct {
+		Song  radio.Song
+		State radio.TrackState
+	}{
+		Song:  song,
+		State: state,
+	}
+	mock.lockUpdateUsable.Lock()
+	mock.calls.UpdateUsable = append(mock.calls.UpdateUsable, callInfo)
+	mock.lockUpdateUsable.Unlock()
+	return mock.UpdateUsableFunc(song, state)
+}
+
+// UpdateUsableCalls gets all the calls that were made to UpdateUsable.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateUsableCalls())
+func (mock *TrackStorageMock) UpdateUsableCalls() []struct {
+	Song  radio.Song
+	State radio.TrackState
+} {
+	var calls []struct {
+		Song  radio.Song
+		State radio.TrackState
+	}
+	mock.lockUpdateUsable.RLock()
+	calls = mock.calls.UpdateUsable
+	mock.lockUpdateUsable.RUnlock()
+	return calls
+}
+
+// Ensure, that RequestStorageServiceMock does implement radio.RequestStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.RequestStorageService = &RequestStorageServiceMock{}
+
+// RequestStorageServiceMock is a mock implementation of radio.RequestStorageService.
+//
+//	func TestSomethingThatUsesRequestStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.RequestStorageService
+//		mockedRequestStorageService := &RequestStorageServiceMock{
+//			RequestFunc: func(contextMoqParam context.Context) radio.RequestStorage {
+//				panic("mock out the Request method")
+//			},
+//			RequestTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
+//				panic("mock out the RequestTx method")
+//			},
+//		}
+//
+//		// use mockedRequestStorageService in code that requires radio.RequestStorageService
+//		// and then make assertions.
+//
+//	}
+type RequestStorageServiceMock struct {
+	// RequestFunc mocks the Request method.
+	RequestFunc func(contextMoqParam context.Context) radio.RequestStorage
+
+	// RequestTxFunc mocks the RequestTx method.
+	RequestTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Request holds details about calls to the Request method.
+		Request []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// RequestTx holds details about calls to the RequestTx method.
+		RequestTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockRequest   sync.RWMutex
+	lockRequestTx sync.RWMutex
+}
+
+// Request calls RequestFunc.
+func (mock *RequestStorageServiceMock) Request(contextMoqParam context.Context) radio.RequestStorage {
+	if mock.RequestFunc == nil {
+		panic("RequestStorageServiceMock.RequestFunc: method is nil but RequestStorageService.Request was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockRequest.Lock()
+	mock.calls.Request = append(mock.calls.Request, callInfo)
+	mock.lockRequest.Unlock()
+	return mock.RequestFunc(contextMoqParam)
+}
+
+// RequestCalls gets all the calls that were made to Request.
+// Check the length with:
+//
+//	len(mockedRequestStorageService.RequestCalls())
+func (mock *RequestStorageServiceMock) RequestCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockRequest.RLock()
+	calls = mock.calls.Request
+	mock.lockRequest.RUnlock()
+	return calls
+}
+
+// RequestTx calls RequestTxFunc.
+func (mock *RequestStorageServiceMock) RequestTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
+	if mock.RequestTxFunc == nil {
+		panic("RequestStorageServiceMock.RequestTxFunc: method is nil but RequestStorageService.RequestTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockRequestTx.Lock()
+	mock.calls.RequestTx = append(mock.calls.RequestTx, callInfo)
+	mock.lockRequestTx.Unlock()
+	return mock.RequestTxFunc(contextMoqParam, storageTx)
+}
+
+// RequestTxCalls gets all the calls that were made to RequestTx.
+// Check the length with:
+//
+//	len(mockedRequestStorageService.RequestTxCalls())
+func (mock *RequestStorageServiceMock) RequestTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockRequestTx.RLock()
+	calls = mock.calls.RequestTx
+	mock.lockRequestTx.RUnlock()
+	return calls
+}
+
+// Ensure, that RequestStorageMock does implement radio.RequestStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.RequestStorage = &RequestStorageMock{}
+
+// RequestStorageMock is a mock implementation of radio.RequestStorage.
+//
+//	func TestSomethingThatUsesRequestStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.RequestStorage
+//		mockedRequestStorage := &RequestStorageMock{
+//			LastRequestFunc: func(identifier string) (time.Time, error) {
+//				panic("mock out the LastRequest method")
+//			},
+//			UpdateLastRequestFunc: func(identifier string) error {
+//				panic("mock out the UpdateLastRequest method")
+//			},
+//		}
+//
+//		// use mockedRequestStorage in code that requires radio.RequestStorage
+//		// and then make assertions.
+//
+//	}
+type RequestStorageMock struct {
+	// LastRequestFunc mocks the LastRequest method.
+	LastRequestFunc func(identifier string) (time.Time, error)
+
+	// UpdateLastRequestFunc mocks the UpdateLastRequest method.
+	UpdateLastRequestFunc func(identifier string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// LastRequest holds details about calls to the LastRequest method.
+		LastRequest []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
+		// UpdateLastRequest holds details about calls to the UpdateLastRequest method.
+		UpdateLastRequest []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
+	}
+	lockLastRequest       sync.RWMutex
+	lockUpdateLastRequest sync.RWMutex
+}
+
+// LastRequest calls LastRequestFunc.
+func (mock *RequestStorageMock) LastRequest(identifier string) (time.Time, error) {
+	if mock.LastRequestFunc == nil {
+		panic("RequestStorageMock.LastRequestFunc: method is nil but RequestStorage.LastRequest was just called")
+	}
+	callInfo := struct {
+		Identifier string
+	}{
+		Identifier: identifier,
+	}
+	mock.lockLastRequest.Lock()
+	mock.calls.LastRequest = append(mock.calls.LastRequest, callInfo)
+	mock.lockLastRequest.Unlock()
+	return mock.LastRequestFunc(identifier)
+}
+
+// LastRequestCalls gets all the calls that were made to LastRequest.
+// Check the length with:
+//
+//	len(mockedRequestStorage.LastRequestCalls())
+func (mock *RequestStorageMock) LastRequestCalls() []struct {
+	Identifier string
+} {
+	var calls []struct {
+		Identifier string
+	}
+	mock.lockLastRequest.RLock()
+	calls = mock.calls.LastRequest
+	mock.lockLastRequest.RUnlock()
+	return calls
+}
+
+// UpdateLastRequest calls UpdateLastRequestFunc.
+func (mock *RequestStorageMock) UpdateLastRequest(identifier string) error {
+	if mock.UpdateLastRequestFunc == nil {
+		panic("RequestStorageMock.UpdateLastRequestFunc: method is nil but RequestStorage.UpdateLastRequest was just called")
+	}
+	callInfo := struct {
+		Identifier string
+	}{
+		Identifier: identifier,
+	}
+	mock.lockUpdateLastRequest.Lock()
+	mock.calls.UpdateLastRequest = append(mock.calls.UpdateLastRequest, callInfo)
+	mock.lockUpdateLastRequest.Unlock()
+	return mock.UpdateLastRequestFunc(identifier)
+}
+
+// UpdateLastRequestCalls gets all the calls that were made to UpdateLastRequest.
+// Check the length with:
+//
+//	len(mockedRequestStorage.UpdateLastRequestCalls())
+func (mock *RequestStorageMock) UpdateLastRequestCalls() []struct {
+	Identifier string
+} {
+	var calls []struct {
+		Identifier string
+	}
+	mock.lockUpdateLastRequest.RLock()
+	calls = mock.calls.UpdateLastRequest
+	mock.lockUpdateLastRequest.RUnlock()
+	return calls
+}
+
+// Ensure, that UserStorageServiceMock does implement radio.UserStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.UserStorageService = &UserStorageServiceMock{}
+
+// UserStorageServiceMock is a mock implementation of radio.UserStorageService.
+//
+//	func TestSomethingThatUsesUserStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.UserStorageService
+//		mockedUserStorageService := &UserStorageServiceMock{
+//			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+//				panic("mock out the User method")
+//			},
+//			UserTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
+//				panic("mock out the UserTx method")
+//			},
+//		}
+//
+//		// use mockedUserStorageService in code that requires radio.UserStorageService
+//		// and then make assertions.
+//
+//	}
+type UserStorageServiceMock struct {
+	// UserFunc mocks the User method.
+	UserFunc func(contextMoqParam context.Context) radio.UserStorage
+
+	// UserTxFunc mocks the UserTx method.
+	UserTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// User holds details about calls to the User method.
+		User []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// UserTx holds details about calls to the UserTx method.
+		UserTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockUser   sync.RWMutex
+	lockUserTx sync.RWMutex
+}
+
+// User calls UserFunc.
+func (mock *UserStorageServiceMock) User(contextMoqParam context.Context) radio.UserStorage {
+	if mock.UserFunc == nil {
+		panic("UserStorageServiceMock.UserFunc: method is nil but UserStorageService.User was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockUser.Lock()
+	mock.calls.User = append(mock.calls.User, callInfo)
+	mock.lockUser.Unlock()
+	return mock.UserFunc(contextMoqParam)
+}
+
+// UserCalls gets all the calls that were made to User.
+// Check the length with:
+//
+//	len(mockedUserStorageService.UserCalls())
+func (mock *UserStorageServiceMock) UserCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockUser.RLock()
+	calls = mock.calls.User
+	mock.lockUser.RUnlock()
+	return calls
+}
+
+// UserTx calls UserTxFunc.
+func (mock *UserStorageServiceMock) UserTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
+	if mock.UserTxFunc == nil {
+		panic("UserStorageServiceMock.UserTxFunc: method is nil but UserStorageService.UserTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockUserTx.Lock()
+	mock.calls.UserTx = append(mock.calls.UserTx, callInfo)
+	mock.lockUserTx.Unlock()
+	return mock.UserTxFunc(contextMoqParam, storageTx)
+}
+
+// UserTxCalls gets all the calls that were made to UserTx.
+// Check the length with:
+//
+//	len(mockedUserStorageService.UserTxCalls())
+func (mock *UserStorageServiceMock) UserTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockUserTx.RLock()
+	calls = mock.calls.UserTx
+	mock.lockUserTx.RUnlock()
+	return calls
+}
+
+// Ensure, that UserStorageMock does implement radio.UserStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.UserStorage = &UserStorageMock{}
+
+// UserStorageMock is a mock implementation of radio.UserStorage.
+//
+//	func TestSomethingThatUsesUserStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.UserStorage
+//		mockedUserStorage := &UserStorageMock{
+//			AllFunc: func() ([]radio.User, error) {
+//				panic("mock out the All method")
+//			},
+//			ByNickFunc: func(nick string) (*radio.User, error) {
+//				panic("mock out the ByNick method")
+//			},
+//			CreateFunc: func(user radio.User) (radio.UserID, error) {
+//				panic("mock out the Create method")
+//			},
+//			CreateDJFunc: func(user radio.User, dJ radio.DJ) (radio.DJID, error) {
+//				panic("mock out the CreateDJ method")
+//			},
+//			DeleteTwoFactorFunc: func(userID radio.UserID) error {
+//				panic("mock out the DeleteTwoFactor method")
+//			},
+//			GetFunc: func(name string) (*radio.User, error) {
+//				panic("mock out the Get method")
+//			},
+//			GetByDJIDFunc: func(dJID radio.DJID) (*radio.User, error) {
+//				panic("mock out the GetByDJID method")
+//			},
+//			GetByIDFunc: func(userID radio.UserID) (*radio.User, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			LookupNameFunc: func(name string) (*radio.User, error) {
+//				panic("mock out the LookupName method")
+//			},
+//			PermissionsFunc: func() ([]radio.UserPermission, error) {
+//				panic("mock out the Permissions method")
+//			},
+//			RecordListenersFunc: func(v radio.Listeners, user radio.User) error {
+//				panic("mock out the RecordListeners method")
+//			},
+//			ReplaceRecoveryCodesFunc: func(id radio.UserID, old []string, new []string) (bool, error) {
+//				panic("mock out the ReplaceRecoveryCodes method")
+//			},
+//			TwoFactorFunc: func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+//				panic("mock out the TwoFactor method")
+//			},
+//			UpdateFunc: func(user radio.User) (radio.User, error) {
+//				panic("mock out the Update method")
+//			},
+//			UpdateTwoFactorFunc: func(userTwoFactor radio.UserTwoFactor) error {
+//				panic("mock out the UpdateTwoFactor method")
+//			},
+//			UseTwoFactorStepFunc: func(userID radio.UserID, n uint64) (bool, error) {
+//				panic("mock out the UseTwoFactorStep method")
+//			},
+//		}
+//
+//		// use mockedUserStorage in code that requires radio.UserStorage
+//		// and then make assertions.
+//
+//	}
+type UserStorageMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func() ([]radio.User, error)
+
+	// ByNickFunc mocks the ByNick method.
+	ByNickFunc func(nick string) (*radio.User, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(user radio.User) (radio.UserID, error)
+
+	// CreateDJFunc mocks the CreateDJ method.
+	CreateDJFunc func(user radio.User, dJ radio.DJ) (radio.DJID, error)
+
+	// DeleteTwoFactorFunc mocks the DeleteTwoFactor method.
+	DeleteTwoFactorFunc func(userID radio.UserID) error
+
+	// GetFunc mocks the Get method.
+	GetFunc func(name string) (*radio.User, error)
+
+	// GetByDJIDFunc mocks the GetByDJID method.
+	GetByDJIDFunc func(dJID radio.DJID) (*radio.User, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(userID radio.UserID) (*radio.User, error)
+
+	// LookupNameFunc mocks the LookupName method.
+	LookupNameFunc func(name string) (*radio.User, error)
+
+	// PermissionsFunc mocks the Permissions method.
+	PermissionsFunc func() ([]radio.UserPermission, error)
+
+	// RecordListenersFunc mocks the RecordListeners method.
+	RecordListenersFunc func(v radio.Listeners, user radio.User) error
+
+	// ReplaceRecoveryCodesFunc mocks the ReplaceRecoveryCodes method.
+	ReplaceRecoveryCodesFunc func(id radio.UserID, old []string, new []string) (bool, error)
+
+	// TwoFactorFunc mocks the TwoFactor method.
+	TwoFactorFunc func(userID radio.UserID) (*radio.UserTwoFactor, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(user radio.User) (radio.User, error)
+
+	// UpdateTwoFactorFunc mocks the UpdateTwoFactor method.
+	UpdateTwoFactorFunc func(userTwoFactor radio.UserTwoFactor) error
+
+	// UseTwoFactorStepFunc mocks the UseTwoFactorStep method.
+	UseTwoFactorStepFunc func(userID radio.UserID, n uint64) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+		}
+		// ByNick holds details about calls to the ByNick method.
+		ByNick []struct {
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// User is the user argument value.
+			User radio.User
+		}
+		// CreateDJ holds details about calls to the CreateDJ method.
+		CreateDJ []struct {
+			// User is the user argument value.
+			User radio.User
+			// DJ is the dJ argument value.
+			DJ radio.DJ
+		}
+		// DeleteTwoFactor holds details about calls to the DeleteTwoFactor method.
+		DeleteTwoFactor []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// GetByDJID holds details about calls to the GetByDJID method.
+		GetByDJID []struct {
+			// DJID is the dJID argument value.
+			DJID radio.DJID
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+		}
+		// LookupName holds details about calls to the LookupName method.
+		LookupName []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// Permissions holds details about calls to the Permissions method.
+		Permissions []struct {
+		}
+		// RecordListeners holds details about calls to the RecordListeners method.
+		RecordListeners []struct {
+			// V is the v argument value.
+			V radio.Listeners
+			// User is the user argument value.
+			User radio.User
+		}
+		// ReplaceRecoveryCodes holds details about calls to the ReplaceRecoveryCodes method.
+		ReplaceRecoveryCodes []struct {
+			// Id is the id argument value.
+			Id radio.UserID
+			// Old is the old argument value.
+			Old []string
+			// New is the new argument value.
+			New []string
+		}
+		// TwoFactor holds details about calls to the TwoFactor method.
+		TwoFactor []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// User is the user argument value.
+			User radio.User
+		}
+		// UpdateTwoFactor holds details about calls to the UpdateTwoFactor method.
+		UpdateTwoFactor []struct {
+			// UserTwoFactor is the userTwoFactor argument value.
+			UserTwoFactor radio.UserTwoFactor
+		}
+		// UseTwoFactorStep holds details about calls to the UseTwoFactorStep method.
+		UseTwoFactorStep []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+			// N is the n argument value.
+			N uint64
+		}
+	}
+	lockAll                  sync.RWMutex
+	lockByNick               sync.RWMutex
+	lockCreate               sync.RWMutex
+	lockCreateDJ             sync.RWMutex
+	lockDeleteTwoFactor      sync.RWMutex
+	lockGet                  sync.RWMutex
+	lockGetByDJID            sync.RWMutex
+	lockGetByID              sync.RWMutex
+	lockLookupName           sync.RWMutex
+	lockPermissions          sync.RWMutex
+	lockRecordListeners      sync.RWMutex
+	lockReplaceRecoveryCodes sync.RWMutex
+	lockTwoFactor            sync.RWMutex
+	lockUpdate               sync.RWMutex
+	lockUpdateTwoFactor      sync.RWMutex
+	lockUseTwoFactorStep     sync.RWMutex
+}
+
+// All calls AllFunc.
+func (mock *UserStorageMock) All() ([]radio.User, error) {
+	if mock.AllFunc == nil {
+		panic("UserStorageMock.AllFunc: method is nil but UserStorage.All was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc()
+}
+
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedUserStorage.AllCalls())
+func (mock *UserStorageMock) AllCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
+}
+
+// ByNick calls ByNickFunc.
+func (mock *UserStorageMock) ByNick(nick string) (*radio.User, error) {
+	if mock.ByNickFunc == nil {
+		panic("UserStorageMock.ByNickFunc: method is nil but UserStorage.ByNick was just called")
+	}
+	callInfo := struct {
+		Nick string
+	}{
+		Nick: nick,
+	}
+	mock.lockByNick.Lock()
+	mock.calls.ByNick = append(mock.calls.ByNick, callInfo)
+	mock.lockByNick.Unlock()
+	return mock.ByNickFunc(nick)
+}
+
+// ByNickCalls gets all the calls that were made to ByNick.
+// Check the length with:
+//
+//	len(mockedUserStorage.ByNickCalls())
+func (mock *UserStorageMock) ByNickCalls() []struct {
+	Nick string
+} {
+	var calls []struct {
+		Nick string
+	}
+	mock.lockByNick.RLock()
+	calls = mock.calls.ByNick
+	mock.lockByNick.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *UserStorageMock) Create(user radio.User) (radio.UserID, error) {
+	if mock.CreateFunc == nil {
+		panic("UserStorageMock.CreateFunc: method is nil but UserStorage.Create was just called")
+	}
+	callInfo := struct {
+		User radio.User
+	}{
+		User: user,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(user)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedUserStorage.CreateCalls())
+func (mock *UserStorageMock) CreateCalls() []struct {
+	User radio.User
+} {
+	var calls []struct {
+		User radio.User
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// CreateDJ calls CreateDJFunc.
+func (mock *UserStorageMock) CreateDJ(user radio.User, dJ radio.DJ) (radio.DJID, error) {
+	if mock.CreateDJFunc == nil {
+		panic("UserStorageMock.CreateDJFunc: method is nil but UserStorage.CreateDJ was just called")
+	}
+	callInfo := struct {
+		User radio.User
+		DJ   radio.DJ
+	}{
+		User: user,
+		DJ:   dJ,
+	}
+	mock.lockCreateDJ.Lock()
+	mock.calls.CreateDJ = append(mock.calls.CreateDJ, callInfo)
+	mock.lockCreateDJ.Unlock()
+	return mock.CreateDJFunc(user, dJ)
+}
+
+// CreateDJCalls gets all the calls that were made to CreateDJ.
+// Check the length with:
+//
+//	len(mockedUserStorage.CreateDJCalls())
+func (mock *UserStorageMock) CreateDJCalls() []struct {
+	User radio.User
+	DJ   radio.DJ
+} {
+	var calls []struct {
+		User radio.User
+		DJ   radio.DJ
+	}
+	mock.lockCreateDJ.RLock()
+	calls = mock.calls.CreateDJ
+	mock.lockCreateDJ.RUnlock()
+	return calls
+}
+
+// DeleteTwoFactor calls DeleteTwoFactorFunc.
+func (mock *UserStorageMock) DeleteTwoFactor(userID radio.UserID) error {
+	if mock.DeleteTwoFactorFunc == nil {
+		panic("UserStorageMock.DeleteTwoFactorFunc: method is nil but UserStorage.DeleteTwoFactor was just called")
+	}
+	callInfo := struct {
+		UserID radio.UserID
+	}{
+		UserID: userID,
+	}
+	mock.lockDeleteTwoFactor.Lock()
+	mock.calls.DeleteTwoFactor = append(mock.calls.DeleteTwoFactor, callInfo)
+	mock.lockDeleteTwoFactor.Unlock()
+	return mock.DeleteTwoFactorFunc(userID)
+}
+
+// DeleteTwoFactorCalls gets all the calls that were made to DeleteTwoFactor.
+// Check the length with:
+//
+//	len(mockedUserStorage.DeleteTwoFactorCalls())
+func (mock *UserStorageMock) DeleteTwoFactorCalls() []struct {
+	UserID radio.UserID
+} {
+	var calls []struct {
+		UserID radio.UserID
+	}
+	mock.lockDeleteTwoFactor.RLock()
+	calls = mock.calls.DeleteTwoFactor
+	mock.lockDeleteTwoFactor.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *UserStorageMock) Get(name string) (*radio.User, error) {
+	if mock.GetFunc == nil {
+		panic("UserStorageMock.GetFunc: method is nil but UserStorage.Get was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(name)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedUserStorage.GetCalls())
+func (mock *UserStorageMock) GetCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetByDJID calls GetByDJIDFunc.
+func (mock *UserStorageMock) GetByDJID(dJID radio.DJID) (*radio.User, error) {
+	if mock.GetByDJIDFunc == nil {
+		panic("UserStorageMock.GetByDJIDFunc: method is nil but UserStorage.GetByDJID was just called")
+	}
+	callInfo := struct {
+		DJID radio.DJID
+	}{
+		DJID: dJID,
+	}
+	mock.lockGetByDJID.Lock()
+	mock.calls.GetByDJID = append(mock.calls.GetByDJID, callInfo)
+	mock.lockGetByDJID.Unlock()
+	return mock.GetByDJIDFunc(dJID)
+}
+
+// GetByDJIDCalls gets all the calls that were made to GetByDJID.
+// Check the length with:
+//
+//	len(mockedUserStorage.GetByDJIDCalls())
+func (mock *UserStorageMock) GetByDJIDCalls() []struct {
+	DJID radio.DJID
+} {
+	var calls []struct {
+		DJID radio.DJID
+	}
+	mock.lockGetByDJID.RLock()
+	calls = mock.calls.GetByDJID
+	mock.lockGetByDJID.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *UserStorageMock) GetByID(userID radio.UserID) (*radio.User, error) {
+	if mock.GetByIDFunc == nil {
+		panic("UserStorageMock.GetByIDFunc: method is nil but UserStorage.GetByID was just called")
+	}
+	callInfo := struct {
+		UserID radio.UserID
+	}{
+		UserID: userID,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(userID)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedUserStorage.GetByIDCalls())
+func (mock *UserStorageMock) GetByIDCalls() []struct {
+	UserID radio.UserID
+} {
+	var calls []struct {
+		UserID radio.UserID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// LookupName calls LookupNameFunc.
+func (mock *UserStorageMock) LookupName(name string) (*radio.User, error) {
+	if mock.LookupNameFunc == nil {
+		panic("UserStorageMock.LookupNameFunc: method is nil but UserStorage.LookupName was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockLookupName.Lock()
+	mock.calls.LookupName = append(mock.calls.LookupName, callInfo)
+	mock.lockLookupName.Unlock()
+	return mock.LookupNameFunc(name)
+}
+
+// LookupNameCalls gets all the calls that were made to LookupName.
+// Check the length with:
+//
+//	len(mockedUserStorage.LookupNameCalls())
+func (mock *UserStorageMock) LookupNameCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockLookupName.RLock()
+	calls = mock.calls.LookupName
+	mock.lockLookupName.RUnlock()
+	return calls
+}
+
+// Permissions calls PermissionsFunc.
+func (mock *UserStorageMock) Permissions() ([]radio.UserPermission, error) {
+	if mock.PermissionsFunc == nil {
+		panic("UserStorageMock.PermissionsFunc: method is nil but UserStorage.Permissions was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockPermissions.Lock()
+	mock.calls.Permissions = append(mock.calls.Permissions, callInfo)
+	mock.lockPermissions.Unlock()
+	return mock.PermissionsFunc()
+}
+
+// PermissionsCalls gets all the calls that were made to Permissions.
+// Check the length with:
+//
+//	len(mockedUserStorage.PermissionsCalls())
+func (mock *UserStorageMock) PermissionsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockPermissions.RLock()
+	calls = mock.calls.Permissions
+	mock.lockPermissions.RUnlock()
+	return calls
+}
+
+// RecordListeners calls RecordListenersFunc.
+func (mock *UserStorageMock) RecordListeners(v radio.Listeners, user radio.User) error {
+	if mock.RecordListenersFunc == nil {
+		panic("UserStorageMock.RecordListenersFunc: method is nil but UserStorage.RecordListeners was just called")
+	}
+	callInfo := struct {
+		V    radio.Listeners
+		User radio.User
+	}{
+		V:    v,
+		User: user,
+	}
+	mock.lockRecordListeners.Lock()
+	mock.calls.RecordListeners = append(mock.calls.RecordListeners, callInfo)
+	mock.lockRecordListeners.Unlock()
+	return mock.RecordListenersFunc(v, user)
+}
+
+// RecordListenersCalls gets all the calls that were made to RecordListeners.
+// Check the length with:
+//
+//	len(mockedUserStorage.RecordListenersCalls())
+func (mock *UserStorageMock) RecordListenersCalls() []struct {
+	V    radio.Listeners
+	User radio.User
+} {
+	var calls []struct {
+		V    radio.Listeners
+		User radio.User
+	}
+	mock.lockRecordListeners.RLock()
+	calls = mock.calls.RecordListeners
+	mock.lockRecordListeners.RUnlock()
+	return calls
+}
+
+// ReplaceRecoveryCodes calls ReplaceRecoveryCodesFunc.
+func (mock *UserStorageMock) ReplaceRecoveryCodes(id radio.UserID, old []string, new []string) (bool, error) {
+	if mock.ReplaceRecoveryCodesFunc == nil {
+		panic("UserStorageMock.ReplaceRecoveryCodesFunc: method is nil but UserStorage.ReplaceRecoveryCodes was just called")
+	}
+	callInfo := struct {
+		Id  radio.UserID
+		Old []string
+		New []string
+	}{
+		Id:  id,
+		Old: old,
+		New: new,
+	}
+	mock.lockReplaceRecoveryCodes.Lock()
+	mock.calls.ReplaceRecoveryCodes = append(mock.calls.ReplaceRecoveryCodes, callInfo)
+	mock.lockReplaceRecoveryCodes.Unlock()
+	return mock.ReplaceRecoveryCodesFunc(id, old, new)
+}
+
+// ReplaceRecoveryCodesCalls gets all the calls that were made to ReplaceRecoveryCodes.
+// Check the length with:
+//
+//	len(mockedUserStorage.ReplaceRecoveryCodesCalls())
+func (mock *UserStorageMock) ReplaceRecoveryCodesCalls() []struct {
+	Id  radio.UserID
+	Old []string
+	New []string
+} {
+	var calls []struct {
+		Id  radio.UserID
+		Old []string
+		New []string
+	}
+	mock.lockReplaceRecoveryCodes.RLock()
+	calls = mock.calls.ReplaceRecoveryCodes
+	mock.lockReplaceRecoveryCodes.RUnlock()
+	return calls
+}
+
+// TwoFactor calls TwoFactorFunc.
+func (mock *UserStorageMock) TwoFactor(userID radio.UserID) (*radio.UserTwoFactor, error) {
+	if mock.TwoFactorFunc == nil {
+		panic("UserStorageMock.TwoFactorFunc: method is nil but UserStorage.TwoFactor was just called")
+	}
+	callInfo := struct {
+		UserID radio.UserID
+	}{
+		UserID: userID,
+	}
+	mock.lockTwoFactor.Lock()
+	mock.calls.TwoFactor = append(mock.calls.TwoFactor, callInfo)
+	mock.lockTwoFactor.Unlock()
+	return mock.TwoFactorFunc(userID)
+}
+
+// TwoFactorCalls gets all the calls that were made to TwoFactor.
+// Check the length with:
+//
+//	len(mockedUserStorage.TwoFactorCalls())
+func (mock *UserStorageMock) TwoFactorCalls() []struct {
+	UserID radio.UserID
+} {
+	var calls []struct {
+		UserID radio.UserID
+	}
+	mock.lockTwoFactor.RLock()
+	calls = mock.calls.TwoFactor
+	mock.lockTwoFactor.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *UserStorageMock) Update(user radio.User) (radio.User, error) {
+	if mock.UpdateFunc == nil {
+		panic("UserStorageMock.UpdateFunc: method is nil but UserStorage.Update was just called")
+	}
+	callInfo := struct {
+		User radio.User
+	}{
+		User: user,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(user)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedUserStorage.UpdateCalls())
+func (mock *UserStorageMock) UpdateCalls() []struct {
+	User radio.User
+} {
+	var calls []struct {
+		User radio.User
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
+
+// UpdateTwoFactor calls UpdateTwoFactorFunc.
+func (mock *UserStorageMock) UpdateTwoFactor(userTwoFactor radio.UserTwoFactor) error {
+	if mock.UpdateTwoFactorFunc == nil {
+		panic("UserStorageMock.UpdateTwoFactorFunc: method is nil but UserStorage.UpdateTwoFactor was just called")
+	}
+	callInfo := struct {
+		UserTwoFactor radio.UserTwoFactor
+	}{
+		UserTwoFactor: userTwoFactor,
+	}
+	mock.lockUpdateTwoFactor.Lock()
+	mock.calls.UpdateTwoFactor = append(mock.calls.UpdateTwoFactor, callInfo)
+	mock.lockUpdateTwoFactor.Unlock()
+	return mock.UpdateTwoFactorFunc(userTwoFactor)
+}
+
+// UpdateTwoFactorCalls gets all the calls that were made to UpdateTwoFactor.
+// Check the length with:
+//
+//	len(mockedUserStorage.UpdateTwoFactorCalls())
+func (mock *UserStorageMock) UpdateTwoFactorCalls() []struct {
+	UserTwoFactor radio.UserTwoFactor
+} {
+	var calls []struct {
+		UserTwoFactor radio.UserTwoFactor
+	}
+	mock.lockUpdateTwoFactor.RLock()
+	calls = mock.calls.UpdateTwoFactor
+	mock.lockUpdateTwoFactor.RUnlock()
+	return calls
+}
+
+// UseTwoFactorStep calls UseTwoFactorStepFunc.
+func (mock *UserStorageMock) UseTwoFactorStep(userID radio.UserID, n uint64) (bool, error) {
+	if mock.UseTwoFactorStepFunc == nil {
+		panic("UserStorageMock.UseTwoFactorStepFunc: method is nil but UserStorage.UseTwoFactorStep was just called")
+	}
+	callInfo := struct {
+		UserID radio.UserID
+		N      uint64
+	}{
+		UserID: userID,
+		N:      n,
+	}
+	mock.lockUseTwoFactorStep.Lock()
+	mock.calls.UseTwoFactorStep = append(mock.calls.UseTwoFactorStep, callInfo)
+	mock.lockUseTwoFactorStep.Unlock()
+	return mock.UseTwoFactorStepFunc(userID, n)
+}
+
+// UseTwoFactorStepCalls gets all the calls that were made to UseTwoFactorStep.
+// Check the length with:
+//
+//	len(mockedUserStorage.UseTwoFactorStepCalls())
+func (mock *UserStorageMock) UseTwoFactorStepCalls() []struct {
+	UserID radio.UserID
+	N      uint64
+} {
+	var calls []struct {
+		UserID radio.UserID
+		N      uint64
+	}
+	mock.lockUseTwoFactorStep.RLock()
+	calls = mock.calls.UseTwoFactorStep
+	mock.lockUseTwoFactorStep.RUnlock()
+	return calls
+}
+
+// Ensure, that StatusStorageServiceMock does implement radio.StatusStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.StatusStorageService = &StatusStorageServiceMock{}
+
+// StatusStorageServiceMock is a mock implementation of radio.StatusStorageService.
+//
+//	func TestSomethingThatUsesStatusStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.StatusStorageService
+//		mockedStatusStorageService := &StatusStorageServiceMock{
+//			StatusFunc: func(contextMoqParam context.Context) radio.StatusStorage {
+//				panic("mock out the Status method")
+//			},
+//		}
+//
+//		// use mockedStatusStorageService in code that requires radio.StatusStorageService
+//		// and then make assertions.
+//
+//	}
+type StatusStorageServiceMock struct {
+	// StatusFunc mocks the Status method.
+	StatusFunc func(contextMoqParam context.Context) radio.StatusStorage
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Status holds details about calls to the Status method.
+		Status []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+	}
+	lockStatus sync.RWMutex
+}
+
+// Status calls StatusFunc.
+func (mock *StatusStorageServiceMock) Status(contextMoqParam context.Context) radio.StatusStorage {
+	if mock.StatusFunc == nil {
+		panic("StatusStorageServiceMock.StatusFunc: method is nil but StatusStorageService.Status was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockStatus.Lock()
+	mock.calls.Status = append(mock.calls.Status, callInfo)
+	mock.lockStatus.Unlock()
+	return mock.StatusFunc(contextMoqParam)
+}
+
+// StatusCalls gets all the calls that were made to Status.
+// Check the length with:
+//
+//	len(mockedStatusStorageService.StatusCalls())
+func (mock *StatusStorageServiceMock) StatusCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockStatus.RLock()
+	calls = mock.calls.Status
+	mock.lockStatus.RUnlock()
+	return calls
+}
 
-	// CreateFunc mocks the Create method.
-	CreateFunc func(song radio.Song) (*radio.Song, error)
+// Ensure, that StatusStorageMock does implement radio.StatusStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.StatusStorage = &StatusStorageMock{}
 
-	// FavoriteCountFunc mocks the FavoriteCount method.
-	FavoriteCountFunc func(song radio.Song) (int64, error)
+// StatusStorageMock is a mock implementation of radio.StatusStorage.
+//
+//	func TestSomethingThatUsesStatusStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.StatusStorage
+//		mockedStatusStorage := &StatusStorageMock{
+//			LoadFunc: func() (*radio.Status, error) {
+//				panic("mock out the Load method")
+//			},
+//			StoreFunc: func(status radio.Status) error {
+//				panic("mock out the Store method")
+//			},
+//		}
+//
+//		// use mockedStatusStorage in code that requires radio.StatusStorage
+//		// and then make assertions.
+//
+//	}
+type StatusStorageMock struct {
+	// LoadFunc mocks the Load method.
+	LoadFunc func() (*radio.Status, error)
 
-	// FavoritesFunc mocks the Favorites method.
-	FavoritesFunc func(song radio.Song) ([]string, error)
+	// StoreFunc mocks the Store method.
+	StoreFunc func(status radio.Status) error
 
-	// FavoritesOfFunc mocks the FavoritesOf method.
-	FavoritesOfFunc func(nick string, limit int64, offset int64) ([]radio.Song, int64, error)
+	// calls tracks calls to the methods.
+	calls struct {
+		// Load holds details about calls to the Load method.
+		Load []struct {
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// Status is the status argument value.
+			Status radio.Status
+		}
+	}
+	lockLoad  sync.RWMutex
+	lockStore sync.RWMutex
+}
 
-	// FavoritesOfDatabaseFunc mocks the FavoritesOfDatabase method.
-	FavoritesOfDatabaseFunc func(nick string) ([]radio.Song, error)
+// Load calls LoadFunc.
+func (mock *StatusStorageMock) Load() (*radio.Status, error) {
+	if mock.LoadFunc == nil {
+		panic("StatusStorageMock.LoadFunc: method is nil but StatusStorage.Load was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockLoad.Lock()
+	mock.calls.Load = append(mock.calls.Load, callInfo)
+	mock.lockLoad.Unlock()
+	return mock.LoadFunc()
+}
+
+// LoadCalls gets all the calls that were made to Load.
+// Check the length with:
+//
+//	len(mockedStatusStorage.LoadCalls())
+func (mock *StatusStorageMock) LoadCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockLoad.RLock()
+	calls = mock.calls.Load
+	mock.lockLoad.RUnlock()
+	return calls
+}
+
+// Store calls StoreFunc.
+func (mock *StatusStorageMock) Store(status radio.Status) error {
+	if mock.StoreFunc == nil {
+		panic("StatusStorageMock.StoreFunc: method is nil but StatusStorage.Store was just called")
+	}
+	callInfo := struct {
+		Status radio.Status
+	}{
+		Status: status,
+	}
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(status)
+}
+
+// StoreCalls gets all the calls that were made to Store.
+// Check the length with:
+//
+//	len(mockedStatusStorage.StoreCalls())
+func (mock *StatusStorageMock) StoreCalls() []struct {
+	Status radio.Status
+} {
+	var calls []struct {
+		Status radio.Status
+	}
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
+	return calls
+}
+
+// Ensure, that NewsStorageServiceMock does implement radio.NewsStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.NewsStorageService = &NewsStorageServiceMock{}
+
+// NewsStorageServiceMock is a mock implementation of radio.NewsStorageService.
+//
+//	func TestSomethingThatUsesNewsStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.NewsStorageService
+//		mockedNewsStorageService := &NewsStorageServiceMock{
+//			NewsFunc: func(contextMoqParam context.Context) radio.NewsStorage {
+//				panic("mock out the News method")
+//			},
+//			NewsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
+//				panic("mock out the NewsTx method")
+//			},
+//		}
+//
+//		// use mockedNewsStorageService in code that requires radio.NewsStorageService
+//		// and then make assertions.
+//
+//	}
+type NewsStorageServiceMock struct {
+	// NewsFunc mocks the News method.
+	NewsFunc func(contextMoqParam context.Context) radio.NewsStorage
+
+	// NewsTxFunc mocks the NewsTx method.
+	NewsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// News holds details about calls to the News method.
+		News []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// NewsTx holds details about calls to the NewsTx method.
+		NewsTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockNews   sync.RWMutex
+	lockNewsTx sync.RWMutex
+}
+
+// News calls NewsFunc.
+func (mock *NewsStorageServiceMock) News(contextMoqParam context.Context) radio.NewsStorage {
+	if mock.NewsFunc == nil {
+		panic("NewsStorageServiceMock.NewsFunc: method is nil but NewsStorageService.News was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockNews.Lock()
+	mock.calls.News = append(mock.calls.News, callInfo)
+	mock.lockNews.Unlock()
+	return mock.NewsFunc(contextMoqParam)
+}
+
+// NewsCalls gets all the calls that were made to News.
+// Check the length with:
+//
+//	len(mockedNewsStorageService.NewsCalls())
+func (mock *NewsStorageServiceMock) NewsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockNews.RLock()
+	calls = mock.calls.News
+	mock.lockNews.RUnlock()
+	return calls
+}
+
+// NewsTx calls NewsTxFunc.
+func (mock *NewsStorageServiceMock) NewsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
+	if mock.NewsTxFunc == nil {
+		panic("NewsStorageServiceMock.NewsTxFunc: method is nil but NewsStorageService.NewsTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockNewsTx.Lock()
+	mock.calls.NewsTx = append(mock.calls.NewsTx, callInfo)
+	mock.lockNewsTx.Unlock()
+	return mock.NewsTxFunc(contextMoqParam, storageTx)
+}
+
+// NewsTxCalls gets all the calls that were made to NewsTx.
+// Check the length with:
+//
+//	len(mockedNewsStorageService.NewsTxCalls())
+func (mock *NewsStorageServiceMock) NewsTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockNewsTx.RLock()
+	calls = mock.calls.NewsTx
+	mock.lockNewsTx.RUnlock()
+	return calls
+}
+
+// Ensure, that NewsStorageMock does implement radio.NewsStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.NewsStorage = &NewsStorageMock{}
+
+// NewsStorageMock is a mock implementation of radio.NewsStorage.
+//
+//	func TestSomethingThatUsesNewsStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.NewsStorage
+//		mockedNewsStorage := &NewsStorageMock{
+//			AddCommentFunc: func(newsComment radio.NewsComment) (radio.NewsCommentID, error) {
+//				panic("mock out the AddComment method")
+//			},
+//			CommentsFunc: func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
+//				panic("mock out the Comments method")
+//			},
+//			CommentsPublicFunc: func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
+//				panic("mock out the CommentsPublic method")
+//			},
+//			CreateFunc: func(newsPost radio.NewsPost) (radio.NewsPostID, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(newsPostID radio.NewsPostID) error {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteCommentFunc: func(newsCommentID radio.NewsCommentID) error {
+//				panic("mock out the DeleteComment method")
+//			},
+//			GetFunc: func(newsPostID radio.NewsPostID) (*radio.NewsPost, error) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(limit int64, offset int64) (radio.NewsList, error) {
+//				panic("mock out the List method")
+//			},
+//			ListPublicFunc: func(limit int64, offset int64) (radio.NewsList, error) {
+//				panic("mock out the ListPublic method")
+//			},
+//			UpdateFunc: func(newsPost radio.NewsPost) error {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedNewsStorage in code that requires radio.NewsStorage
+//		// and then make assertions.
+//
+//	}
+type NewsStorageMock struct {
+	// AddCommentFunc mocks the AddComment method.
+	AddCommentFunc func(newsComment radio.NewsComment) (radio.NewsCommentID, error)
 
-	// FromHashFunc mocks the FromHash method.
-	FromHashFunc func(songHash radio.SongHash) (*radio.Song, error)
+	// CommentsFunc mocks the Comments method.
+	CommentsFunc func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error)
 
-	// FromMetadataFunc mocks the FromMetadata method.
-	FromMetadataFunc func(metadata string) (*radio.Song, error)
+	// CommentsPublicFunc mocks the CommentsPublic method.
+	CommentsPublicFunc func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error)
 
-	// LastPlayedFunc mocks the LastPlayed method.
-	LastPlayedFunc func(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error)
+	// CreateFunc mocks the Create method.
+	CreateFunc func(newsPost radio.NewsPost) (radio.NewsPostID, error)
 
-	// LastPlayedCountFunc mocks the LastPlayedCount method.
-	LastPlayedCountFunc func() (int64, error)
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(newsPostID radio.NewsPostID) error
 
-	// LastPlayedPaginationFunc mocks the LastPlayedPagination method.
-	LastPlayedPaginationFunc func(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error)
+	// DeleteCommentFunc mocks the DeleteComment method.
+	DeleteCommentFunc func(newsCommentID radio.NewsCommentID) error
 
-	// PlayedCountFunc mocks the PlayedCount method.
-	PlayedCountFunc func(song radio.Song) (int64, error)
+	// GetFunc mocks the Get method.
+	GetFunc func(newsPostID radio.NewsPostID) (*radio.NewsPost, error)
 
-	// RemoveFavoriteFunc mocks the RemoveFavorite method.
-	RemoveFavoriteFunc func(song radio.Song, nick string) (bool, error)
+	// ListFunc mocks the List method.
+	ListFunc func(limit int64, offset int64) (radio.NewsList, error)
 
-	// UpdateHashLinkFunc mocks the UpdateHashLink method.
-	UpdateHashLinkFunc func(old radio.SongHash, new radio.SongHash) error
+	// ListPublicFunc mocks the ListPublic method.
+	ListPublicFunc func(limit int64, offset int64) (radio.NewsList, error)
 
-	// UpdateLengthFunc mocks the UpdateLength method.
-	UpdateLengthFunc func(song radio.Song, duration time.Duration) error
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(newsPost radio.NewsPost) error
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// AddFavorite holds details about calls to the AddFavorite method.
-		AddFavorite []struct {
-			// Song is the song argument value.
-			Song radio.Song
-			// Nick is the nick argument value.
-			Nick string
+		// AddComment holds details about calls to the AddComment method.
+		AddComment []struct {
+			// NewsComment is the newsComment argument value.
+			NewsComment radio.NewsComment
 		}
-		// AddPlay holds details about calls to the AddPlay method.
-		AddPlay []struct {
-			// Song is the song argument value.
-			Song radio.Song
-			// Streamer is the streamer argument value.
-			Streamer radio.User
-			// Ldiff is the ldiff argument value.
-			Ldiff *radio.Listeners
+		// Comments holds details about calls to the Comments method.
+		Comments []struct {
+			// NewsPostID is the newsPostID argument value.
+			NewsPostID radio.NewsPostID
+		}
+		// CommentsPublic holds details about calls to the CommentsPublic method.
+		CommentsPublic []struct {
+			// NewsPostID is the newsPostID argument value.
+			NewsPostID radio.NewsPostID
 		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
-			// Song is the song argument value.
-			Song radio.Song
+			// NewsPost is the newsPost argument value.
+			NewsPost radio.NewsPost
 		}
-		// FavoriteCount holds details about calls to the FavoriteCount method.
-		FavoriteCount []struct {
-			// Song is the song argument value.
-			Song radio.Song
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// NewsPostID is the newsPostID argument value.
+			NewsPostID radio.NewsPostID
 		}
-		// Favorites holds details about calls to the Favorites method.
-		Favorites []struct {
-			// Song is the song argument value.
-			Song radio.Song
+		// DeleteComment holds details about calls to the DeleteComment method.
+		DeleteComment []struct {
+			// NewsCommentID is the newsCommentID argument value.
+			NewsCommentID radio.NewsCommentID
 		}
-		// FavoritesOf holds details about calls to the FavoritesOf method.
-		FavoritesOf []struct {
-			// Nick is the nick argument value.
-			Nick string
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// NewsPostID is the newsPostID argument value.
+			NewsPostID radio.NewsPostID
+		}
+		// List holds details about calls to the List method.
+		List []struct {
 			// Limit is the limit argument value.
 			Limit int64
 			// Offset is the offset argument value.
 			Offset int64
 		}
-		// FavoritesOfDatabase holds details about calls to the FavoritesOfDatabase method.
-		FavoritesOfDatabase []struct {
-			// Nick is the nick argument value.
-			Nick string
-		}
-		// FromHash holds details about calls to the FromHash method.
-		FromHash []struct {
-			// SongHash is the songHash argument value.
-			SongHash radio.SongHash
-		}
-		// FromMetadata holds details about calls to the FromMetadata method.
-		FromMetadata []struct {
-			// Metadata is the metadata argument value.
-			Metadata string
-		}
-		// LastPlayed holds details about calls to the LastPlayed method.
-		LastPlayed []struct {
-			// Key is the key argument value.
-			Key radio.LastPlayedKey
-			// AmountPerPage is the amountPerPage argument value.
-			AmountPerPage int
-		}
-		// LastPlayedCount holds details about calls to the LastPlayedCount method.
-		LastPlayedCount []struct {
-		}
-		// LastPlayedPagination holds details about calls to the LastPlayedPagination method.
-		LastPlayedPagination []struct {
-			// Key is the key argument value.
-			Key radio.LastPlayedKey
-			// AmountPerPage is the amountPerPage argument value.
-			AmountPerPage int
-			// PageCount is the pageCount argument value.
-			PageCount int
-		}
-		// PlayedCount holds details about calls to the PlayedCount method.
-		PlayedCount []struct {
-			// Song is the song argument value.
-			Song radio.Song
-		}
-		// RemoveFavorite holds details about calls to the RemoveFavorite method.
-		RemoveFavorite []struct {
-			// Song is the song argument value.
-			Song radio.Song
-			// Nick is the nick argument value.
-			Nick string
-		}
-		// UpdateHashLink holds details about calls to the UpdateHashLink method.
-		UpdateHashLink []struct {
-			// Old is the old argument value.
-			Old radio.SongHash
-			// New is the new argument value.
-			New radio.SongHash
+		// ListPublic holds details about calls to the ListPublic method.
+		ListPublic []struct {
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
 		}
-		// UpdateLength holds details about calls to the UpdateLength method.
-		UpdateLength []struct {
-			// Song is the song argument value.
-			Song radio.Song
-			// Duration is the duration argument value.
-			Duration time.Duration
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// NewsPost is the newsPost argument value.
+			NewsPost radio.NewsPost
 		}
 	}
-	lockAddFavorite          sync.RWMutex
-	lockAddPlay              sync.RWMutex
-	lockCreate               sync.RWMutex
-	lockFavoriteCount        sync.RWMutex
-	lockFavorites            sync.RWMutex
-	lockFavoritesOf          sync.RWMutex
-	lockFavoritesOfDatabase  sync.RWMutex
-	lockFromHash             sync.RWMutex
-	lockFromMetadata         sync.RWMutex
-	lockLastPlayed           sync.RWMutex
-	lockLastPlayedCount      sync.RWMutex
-	lockLastPlayedPagination sync.RWMutex
-	lockPlayedCount          sync.RWMutex
-	lockRemoveFavorite       sync.RWMutex
-	lockUpdateHashLink       sync.RWMutex
-	lockUpdateLength         sync.RWMutex
+	lockAddComment     sync.RWMutex
+	lockComments       sync.RWMutex
+	lockCommentsPublic sync.RWMutex
+	lockCreate         sync.RWMutex
+	lockDelete         sync.RWMutex
+	lockDeleteComment  sync.RWMutex
+	lockGet            sync.RWMutex
+	lockList           sync.RWMutex
+	lockListPublic     sync.RWMutex
+	lockUpdate         sync.RWMutex
 }
 
-// AddFavorite calls AddFavoriteFunc.
-func (mock *SongStorageMock) AddFavorite(song radio.Song, nick string) (bool, error) {
-	if mock.AddFavoriteFunc == nil {
-		panic("SongStorageMock.AddFavoriteFunc: method is nil but SongStorage.AddFavorite was just called")
+// AddComment calls AddCommentFunc.
+func (mock *NewsStorageMock) AddComment(newsComment radio.NewsComment) (radio.NewsCommentID, error) {
+	if mock.AddCommentFunc == nil {
+		panic("NewsStorageMock.AddCommentFunc: method is nil but NewsStorage.AddComment was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
-		Nick string
+		NewsComment radio.NewsComment
 	}{
-		Song: song,
-		Nick: nick,
+		NewsComment: newsComment,
 	}
-	mock.lockAddFavorite.Lock()
-	mock.calls.AddFavorite = append(mock.calls.AddFavorite, callInfo)
-	mock.lockAddFavorite.Unlock()
-	return mock.AddFavoriteFunc(song, nick)
+	mock.lockAddComment.Lock()
+	mock.calls.AddComment = append(mock.calls.AddComment, callInfo)
+	mock.lockAddComment.Unlock()
+	return mock.AddCommentFunc(newsComment)
+}
+
+// AddCommentCalls gets all the calls that were made to AddComment.
+// Check the length with:
+//
+//	len(mockedNewsStorage.AddCommentCalls())
+func (mock *NewsStorageMock) AddCommentCalls() []struct {
+	NewsComment radio.NewsComment
+} {
+	var calls []struct {
+		NewsComment radio.NewsComment
+	}
+	mock.lockAddComment.RLock()
+	calls = mock.calls.AddComment
+	mock.lockAddComment.RUnlock()
+	return calls
+}
+
+// Comments calls CommentsFunc.
+func (mock *NewsStorageMock) Comments(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
+	if mock.CommentsFunc == nil {
+		panic("NewsStorageMock.CommentsFunc: method is nil but NewsStorage.Comments was just called")
+	}
+	callInfo := struct {
+		NewsPostID radio.NewsPostID
+	}{
+		NewsPostID: newsPostID,
+	}
+	mock.lockComments.Lock()
+	mock.calls.Comments = append(mock.calls.Comments, callInfo)
+	mock.lockComments.Unlock()
+	return mock.CommentsFunc(newsPostID)
 }
 
-// AddFavoriteCalls gets all the calls that were made to AddFavorite.
+// CommentsCalls gets all the calls that were made to Comments.
 // Check the length with:
 //
-//	len(mockedSongStorage.AddFavoriteCalls())
-func (mock *SongStorageMock) AddFavoriteCalls() []struct {
-	Song radio.Song
-	Nick string
+//	len(mockedNewsStorage.CommentsCalls())
+func (mock *NewsStorageMock) CommentsCalls() []struct {
+	NewsPostID radio.NewsPostID
 } {
 	var calls []struct {
-		Song radio.Song
-		Nick string
+		NewsPostID radio.NewsPostID
 	}
-	mock.lockAddFavorite.RLock()
-	calls = mock.calls.AddFavorite
-	mock.lockAddFavorite.RUnlock()
+	mock.lockComments.RLock()
+	calls = mock.calls.Comments
+	mock.lockComments.RUnlock()
 	return calls
 }
 
-// AddPlay calls AddPlayFunc.
-func (mock *SongStorageMock) AddPlay(song radio.Song, streamer radio.User, ldiff *radio.Listeners) error {
-	if mock.AddPlayFunc == nil {
-		panic("SongStorageMock.AddPlayFunc: method is nil but SongStorage.AddPlay was just called")
+// CommentsPublic calls CommentsPublicFunc.
+func (mock *NewsStorageMock) CommentsPublic(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
+	if mock.CommentsPublicFunc == nil {
+		panic("NewsStorageMock.CommentsPublicFunc: method is nil but NewsStorage.CommentsPublic was just called")
 	}
 	callInfo := struct {
-		Song     radio.Song
-		Streamer radio.User
-		Ldiff    *radio.Listeners
+		NewsPostID radio.NewsPostID
 	}{
-		Song:     song,
-		Streamer: streamer,
-		Ldiff:    ldiff,
+		NewsPostID: newsPostID,
 	}
-	mock.lockAddPlay.Lock()
-	mock.calls.AddPlay = append(mock.calls.AddPlay, callInfo)
-	mock.lockAddPlay.Unlock()
-	return mock.AddPlayFunc(song, streamer, ldiff)
+	mock.lockCommentsPublic.Lock()
+	mock.calls.CommentsPublic = append(mock.calls.CommentsPublic, callInfo)
+	mock.lockCommentsPublic.Unlock()
+	return mock.CommentsPublicFunc(newsPostID)
 }
 
-// AddPlayCalls gets all the calls that were made to AddPlay.
+// CommentsPublicCalls gets all the calls that were made to CommentsPublic.
 // Check the length with:
 //
-//	len(mockedSongStorage.AddPlayCalls())
-func (mock *SongStorageMock) AddPlayCalls() []struct {
-	Song     radio.Song
-	Streamer radio.User
-	Ldiff    *radio.Listeners
+//	len(mockedNewsStorage.CommentsPublicCalls())
+func (mock *NewsStorageMock) CommentsPublicCalls() []struct {
+	NewsPostID radio.NewsPostID
 } {
 	var calls []struct {
-		Song     radio.Song
-		Streamer radio.User
-		Ldiff    *radio.Listeners
+		NewsPostID radio.NewsPostID
 	}
-	mock.lockAddPlay.RLock()
-	calls = mock.calls.AddPlay
-	mock.lockAddPlay.RUnlock()
+	mock.lockCommentsPublic.RLock()
+	calls = mock.calls.CommentsPublic
+	mock.lockCommentsPublic.RUnlock()
 	return calls
 }
 
 // Create calls CreateFunc.
-func (mock *SongStorageMock) Create(song radio.Song) (*radio.Song, error) {
+func (mock *NewsStorageMock) Create(newsPost radio.NewsPost) (radio.NewsPostID, error) {
 	if mock.CreateFunc == nil {
-		panic("SongStorageMock.CreateFunc: method is nil but SongStorage.Create was just called")
+		panic("NewsStorageMock.CreateFunc: method is nil but NewsStorage.Create was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		NewsPost radio.NewsPost
 	}{
-		Song: song,
+		NewsPost: newsPost,
 	}
 	mock.lockCreate.Lock()
 	mock.calls.Create = append(mock.calls.Create, callInfo)
 	mock.lockCreate.Unlock()
-	return mock.CreateFunc(song)
+	return mock.CreateFunc(newsPost)
 }
 
 // CreateCalls gets all the calls that were made to Create.
 // Check the length with:
 //
-//	len(mockedSongStorage.CreateCalls())
-func (mock *SongStorageMock) CreateCalls() []struct {
-	Song radio.Song
+//	len(mockedNewsStorage.CreateCalls())
+func (mock *NewsStorageMock) CreateCalls() []struct {
+	NewsPost radio.NewsPost
 } {
 	var calls []struct {
-		Song radio.Song
+		NewsPost radio.NewsPost
 	}
 	mock.lockCreate.RLock()
 	calls = mock.calls.Create
@@ -3484,803 +7641,818 @@ func (mock *SongStorageMock) CreateCalls() []struct {
 	return calls
 }
 
-// FavoriteCount calls FavoriteCountFunc.
-func (mock *SongStorageMock) FavoriteCount(song radio.Song) (int64, error) {
-	if mock.FavoriteCountFunc == nil {
-		panic("SongStorageMock.FavoriteCountFunc: method is nil but SongStorage.FavoriteCount was just called")
+// Delete calls DeleteFunc.
+func (mock *NewsStorageMock) Delete(newsPostID radio.NewsPostID) error {
+	if mock.DeleteFunc == nil {
+		panic("NewsStorageMock.DeleteFunc: method is nil but NewsStorage.Delete was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		NewsPostID radio.NewsPostID
 	}{
-		Song: song,
+		NewsPostID: newsPostID,
 	}
-	mock.lockFavoriteCount.Lock()
-	mock.calls.FavoriteCount = append(mock.calls.FavoriteCount, callInfo)
-	mock.lockFavoriteCount.Unlock()
-	return mock.FavoriteCountFunc(song)
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(newsPostID)
 }
 
-// FavoriteCountCalls gets all the calls that were made to FavoriteCount.
+// DeleteCalls gets all the calls that were made to Delete.
 // Check the length with:
 //
-//	len(mockedSongStorage.FavoriteCountCalls())
-func (mock *SongStorageMock) FavoriteCountCalls() []struct {
-	Song radio.Song
+//	len(mockedNewsStorage.DeleteCalls())
+func (mock *NewsStorageMock) DeleteCalls() []struct {
+	NewsPostID radio.NewsPostID
 } {
 	var calls []struct {
-		Song radio.Song
+		NewsPostID radio.NewsPostID
 	}
-	mock.lockFavoriteCount.RLock()
-	calls = mock.calls.FavoriteCount
-	mock.lockFavoriteCount.RUnlock()
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
 	return calls
 }
 
-// Favorites calls FavoritesFunc.
-func (mock *SongStorageMock) Favorites(song radio.Song) ([]string, error) {
-	if mock.FavoritesFunc == nil {
-		panic("SongStorageMock.FavoritesFunc: method is nil but SongStorage.Favorites was just called")
+// DeleteComment calls DeleteCommentFunc.
+func (mock *NewsStorageMock) DeleteComment(newsCommentID radio.NewsCommentID) error {
+	if mock.DeleteCommentFunc == nil {
+		panic("NewsStorageMock.DeleteCommentFunc: method is nil but NewsStorage.DeleteComment was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		NewsCommentID radio.NewsCommentID
 	}{
-		Song: song,
+		NewsCommentID: newsCommentID,
 	}
-	mock.lockFavorites.Lock()
-	mock.calls.Favorites = append(mock.calls.Favorites, callInfo)
-	mock.lockFavorites.Unlock()
-	return mock.FavoritesFunc(song)
+	mock.lockDeleteComment.Lock()
+	mock.calls.DeleteComment = append(mock.calls.DeleteComment, callInfo)
+	mock.lockDeleteComment.Unlock()
+	return mock.DeleteCommentFunc(newsCommentID)
 }
 
-// FavoritesCalls gets all the calls that were made to Favorites.
+// DeleteCommentCalls gets all the calls that were made to DeleteComment.
 // Check the length with:
 //
-//	len(mockedSongStorage.FavoritesCalls())
-func (mock *SongStorageMock) FavoritesCalls() []struct {
-	Song radio.Song
+//	len(mockedNewsStorage.DeleteCommentCalls())
+func (mock *NewsStorageMock) DeleteCommentCalls() []struct {
+	NewsCommentID radio.NewsCommentID
 } {
 	var calls []struct {
-		Song radio.Song
+		NewsCommentID radio.NewsCommentID
 	}
-	mock.lockFavorites.RLock()
-	calls = mock.calls.Favorites
-	mock.lockFavorites.RUnlock()
+	mock.lockDeleteComment.RLock()
+	calls = mock.calls.DeleteComment
+	mock.lockDeleteComment.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *NewsStorageMock) Get(newsPostID radio.NewsPostID) (*radio.NewsPost, error) {
+	if mock.GetFunc == nil {
+		panic("NewsStorageMock.GetFunc: method is nil but NewsStorage.Get was just called")
+	}
+	callInfo := struct {
+		NewsPostID radio.NewsPostID
+	}{
+		NewsPostID: newsPostID,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(newsPostID)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedNewsStorage.GetCalls())
+func (mock *NewsStorageMock) GetCalls() []struct {
+	NewsPostID radio.NewsPostID
+} {
+	var calls []struct {
+		NewsPostID radio.NewsPostID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *NewsStorageMock) List(limit int64, offset int64) (radio.NewsList, error) {
+	if mock.ListFunc == nil {
+		panic("NewsStorageMock.ListFunc: method is nil but NewsStorage.List was just called")
+	}
+	callInfo := struct {
+		Limit  int64
+		Offset int64
+	}{
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(limit, offset)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedNewsStorage.ListCalls())
+func (mock *NewsStorageMock) ListCalls() []struct {
+	Limit  int64
+	Offset int64
+} {
+	var calls []struct {
+		Limit  int64
+		Offset int64
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListPublic calls ListPublicFunc.
+func (mock *NewsStorageMock) ListPublic(limit int64, offset int64) (radio.NewsList, error) {
+	if mock.ListPublicFunc == nil {
+		panic("NewsStorageMock.ListPublicFunc: method is nil but NewsStorage.ListPublic was just called")
+	}
+	callInfo := struct {
+		Limit  int64
+		Offset int64
+	}{
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockListPublic.Lock()
+	mock.calls.ListPublic = append(mock.calls.ListPublic, callInfo)
+	mock.lockListPublic.Unlock()
+	return mock.ListPublicFunc(limit, offset)
+}
+
+// ListPublicCalls gets all the calls that were made to ListPublic.
+// Check the length with:
+//
+//	len(mockedNewsStorage.ListPublicCalls())
+func (mock *NewsStorageMock) ListPublicCalls() []struct {
+	Limit  int64
+	Offset int64
+} {
+	var calls []struct {
+		Limit  int64
+		Offset int64
+	}
+	mock.lockListPublic.RLock()
+	calls = mock.calls.ListPublic
+	mock.lockListPublic.RUnlock()
 	return calls
 }
 
-// FavoritesOf calls FavoritesOfFunc.
-func (mock *SongStorageMock) FavoritesOf(nick string, limit int64, offset int64) ([]radio.Song, int64, error) {
-	if mock.FavoritesOfFunc == nil {
-		panic("SongStorageMock.FavoritesOfFunc: method is nil but SongStorage.FavoritesOf was just called")
+// Update calls UpdateFunc.
+func (mock *NewsStorageMock) Update(newsPost radio.NewsPost) error {
+	if mock.UpdateFunc == nil {
+		panic("NewsStorageMock.UpdateFunc: method is nil but NewsStorage.Update was just called")
 	}
 	callInfo := struct {
-		Nick   string
-		Limit  int64
-		Offset int64
+		NewsPost radio.NewsPost
 	}{
-		Nick:   nick,
-		Limit:  limit,
-		Offset: offset,
+		NewsPost: newsPost,
 	}
-	mock.lockFavoritesOf.Lock()
-	mock.calls.FavoritesOf = append(mock.calls.FavoritesOf, callInfo)
-	mock.lockFavoritesOf.Unlock()
-	return mock.FavoritesOfFunc(nick, limit, offset)
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(newsPost)
 }
 
-// FavoritesOfCalls gets all the calls that were made to FavoritesOf.
+// UpdateCalls gets all the calls that were made to Update.
 // Check the length with:
 //
-//	len(mockedSongStorage.FavoritesOfCalls())
-func (mock *SongStorageMock) FavoritesOfCalls() []struct {
-	Nick   string
-	Limit  int64
-	Offset int64
+//	len(mockedNewsStorage.UpdateCalls())
+func (mock *NewsStorageMock) UpdateCalls() []struct {
+	NewsPost radio.NewsPost
 } {
 	var calls []struct {
-		Nick   string
-		Limit  int64
-		Offset int64
+		NewsPost radio.NewsPost
 	}
-	mock.lockFavoritesOf.RLock()
-	calls = mock.calls.FavoritesOf
-	mock.lockFavoritesOf.RUnlock()
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
 	return calls
 }
 
-// FavoritesOfDatabase calls FavoritesOfDatabaseFunc.
-func (mock *SongStorageMock) FavoritesOfDatabase(nick string) ([]radio.Song, error) {
-	if mock.FavoritesOfDatabaseFunc == nil {
-		panic("SongStorageMock.FavoritesOfDatabaseFunc: method is nil but SongStorage.FavoritesOfDatabase was just called")
+// Ensure, that SubmissionStorageServiceMock does implement radio.SubmissionStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.SubmissionStorageService = &SubmissionStorageServiceMock{}
+
+// SubmissionStorageServiceMock is a mock implementation of radio.SubmissionStorageService.
+//
+//	func TestSomethingThatUsesSubmissionStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.SubmissionStorageService
+//		mockedSubmissionStorageService := &SubmissionStorageServiceMock{
+//			SubmissionsFunc: func(contextMoqParam context.Context) radio.SubmissionStorage {
+//				panic("mock out the Submissions method")
+//			},
+//			SubmissionsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
+//				panic("mock out the SubmissionsTx method")
+//			},
+//		}
+//
+//		// use mockedSubmissionStorageService in code that requires radio.SubmissionStorageService
+//		// and then make assertions.
+//
+//	}
+type SubmissionStorageServiceMock struct {
+	// SubmissionsFunc mocks the Submissions method.
+	SubmissionsFunc func(contextMoqParam context.Context) radio.SubmissionStorage
+
+	// SubmissionsTxFunc mocks the SubmissionsTx method.
+	SubmissionsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Submissions holds details about calls to the Submissions method.
+		Submissions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// SubmissionsTx holds details about calls to the SubmissionsTx method.
+		SubmissionsTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockSubmissions   sync.RWMutex
+	lockSubmissionsTx sync.RWMutex
+}
+
+// Submissions calls SubmissionsFunc.
+func (mock *SubmissionStorageServiceMock) Submissions(contextMoqParam context.Context) radio.SubmissionStorage {
+	if mock.SubmissionsFunc == nil {
+		panic("SubmissionStorageServiceMock.SubmissionsFunc: method is nil but SubmissionStorageService.Submissions was just called")
 	}
 	callInfo := struct {
-		Nick string
+		ContextMoqParam context.Context
 	}{
-		Nick: nick,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockFavoritesOfDatabase.Lock()
-	mock.calls.FavoritesOfDatabase = append(mock.calls.FavoritesOfDatabase, callInfo)
-	mock.lockFavoritesOfDatabase.Unlock()
-	return mock.FavoritesOfDatabaseFunc(nick)
+	mock.lockSubmissions.Lock()
+	mock.calls.Submissions = append(mock.calls.Submissions, callInfo)
+	mock.lockSubmissions.Unlock()
+	return mock.SubmissionsFunc(contextMoqParam)
 }
 
-// FavoritesOfDatabaseCalls gets all the calls that were made to FavoritesOfDatabase.
+// SubmissionsCalls gets all the calls that were made to Submissions.
 // Check the length with:
 //
-//	len(mockedSongStorage.FavoritesOfDatabaseCalls())
-func (mock *SongStorageMock) FavoritesOfDatabaseCalls() []struct {
-	Nick string
+//	len(mockedSubmissionStorageService.SubmissionsCalls())
+func (mock *SubmissionStorageServiceMock) SubmissionsCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		Nick string
+		ContextMoqParam context.Context
 	}
-	mock.lockFavoritesOfDatabase.RLock()
-	calls = mock.calls.FavoritesOfDatabase
-	mock.lockFavoritesOfDatabase.RUnlock()
+	mock.lockSubmissions.RLock()
+	calls = mock.calls.Submissions
+	mock.lockSubmissions.RUnlock()
 	return calls
 }
 
-// FromHash calls FromHashFunc.
-func (mock *SongStorageMock) FromHash(songHash radio.SongHash) (*radio.Song, error) {
-	if mock.FromHashFunc == nil {
-		panic("SongStorageMock.FromHashFunc: method is nil but SongStorage.FromHash was just called")
+// SubmissionsTx calls SubmissionsTxFunc.
+func (mock *SubmissionStorageServiceMock) SubmissionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
+	if mock.SubmissionsTxFunc == nil {
+		panic("SubmissionStorageServiceMock.SubmissionsTxFunc: method is nil but SubmissionStorageService.SubmissionsTx was just called")
 	}
 	callInfo := struct {
-		SongHash radio.SongHash
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		SongHash: songHash,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockFromHash.Lock()
-	mock.calls.FromHash = append(mock.calls.FromHash, callInfo)
-	mock.lockFromHash.Unlock()
-	return mock.FromHashFunc(songHash)
+	mock.lockSubmissionsTx.Lock()
+	mock.calls.SubmissionsTx = append(mock.calls.SubmissionsTx, callInfo)
+	mock.lockSubmissionsTx.Unlock()
+	return mock.SubmissionsTxFunc(contextMoqParam, storageTx)
 }
 
-// FromHashCalls gets all the calls that were made to FromHash.
+// SubmissionsTxCalls gets all the calls that were made to SubmissionsTx.
 // Check the length with:
 //
-//	len(mockedSongStorage.FromHashCalls())
-func (mock *SongStorageMock) FromHashCalls() []struct {
-	SongHash radio.SongHash
+//	len(mockedSubmissionStorageService.SubmissionsTxCalls())
+func (mock *SubmissionStorageServiceMock) SubmissionsTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		SongHash radio.SongHash
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockSubmissionsTx.RLock()
+	calls = mock.calls.SubmissionsTx
+	mock.lockSubmissionsTx.RUnlock()
+	return calls
+}
+
+// Ensure, that SubmissionStorageMock does implement radio.SubmissionStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.SubmissionStorage = &SubmissionStorageMock{}
+
+// SubmissionStorageMock is a mock implementation of radio.SubmissionStorage.
+//
+//	func TestSomethingThatUsesSubmissionStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.SubmissionStorage
+//		mockedSubmissionStorage := &SubmissionStorageMock{
+//			AllFunc: func() ([]radio.PendingSong, error) {
+//				panic("mock out the All method")
+//			},
+//			GetSubmissionFunc: func(submissionID radio.SubmissionID) (*radio.PendingSong, error) {
+//				panic("mock out the GetSubmission method")
+//			},
+//			InsertPostPendingFunc: func(pendingSong radio.PendingSong) error {
+//				panic("mock out the InsertPostPending method")
+//			},
+//			InsertSubmissionFunc: func(pendingSong radio.PendingSong) error {
+//				panic("mock out the InsertSubmission method")
+//			},
+//			LastSubmissionTimeFunc: func(identifier string) (time.Time, error) {
+//				panic("mock out the LastSubmissionTime method")
+//			},
+//			RemoveSubmissionFunc: func(submissionID radio.SubmissionID) error {
+//				panic("mock out the RemoveSubmission method")
+//			},
+//			SubmissionStatsFunc: func(identifier string) (radio.SubmissionStats, error) {
+//				panic("mock out the SubmissionStats method")
+//			},
+//			UncheckedSubmissionsFunc: func() ([]radio.PendingSong, error) {
+//				panic("mock out the UncheckedSubmissions method")
+//			},
+//			UpdateSubmissionCheckFunc: func(pendingSong radio.PendingSong) error {
+//				panic("mock out the UpdateSubmissionCheck method")
+//			},
+//			UpdateSubmissionTimeFunc: func(identifier string) error {
+//				panic("mock out the UpdateSubmissionTime method")
+//			},
+//		}
+//
+//		// use mockedSubmissionStorage in code that requires radio.SubmissionStorage
+//		// and then make assertions.
+//
+//	}
+type SubmissionStorageMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func() ([]radio.PendingSong, error)
+
+	// GetSubmissionFunc mocks the GetSubmission method.
+	GetSubmissionFunc func(submissionID radio.SubmissionID) (*radio.PendingSong, error)
+
+	// InsertPostPendingFunc mocks the InsertPostPending method.
+	InsertPostPendingFunc func(pendingSong radio.PendingSong) error
+
+	// InsertSubmissionFunc mocks the InsertSubmission method.
+	InsertSubmissionFunc func(pendingSong radio.PendingSong) error
+
+	// LastSubmissionTimeFunc mocks the LastSubmissionTime method.
+	LastSubmissionTimeFunc func(identifier string) (time.Time, error)
+
+	// RemoveSubmissionFunc mocks the RemoveSubmission method.
+	RemoveSubmissionFunc func(submissionID radio.SubmissionID) error
+
+	// SubmissionStatsFunc mocks the SubmissionStats method.
+	SubmissionStatsFunc func(identifier string) (radio.SubmissionStats, error)
+
+	// UncheckedSubmissionsFunc mocks the UncheckedSubmissions method.
+	UncheckedSubmissionsFunc func() ([]radio.PendingSong, error)
+
+	// UpdateSubmissionCheckFunc mocks the UpdateSubmissionCheck method.
+	UpdateSubmissionCheckFunc func(pendingSong radio.PendingSong) error
+
+	// UpdateSubmissionTimeFunc mocks the UpdateSubmissionTime method.
+	UpdateSubmissionTimeFunc func(identifier string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+		}
+		// GetSubmission holds details about calls to the GetSubmission method.
+		GetSubmission []struct {
+			// SubmissionID is the submissionID argument value.
+			SubmissionID radio.SubmissionID
+		}
+		// InsertPostPending holds details about calls to the InsertPostPending method.
+		InsertPostPending []struct {
+			// PendingSong is the pendingSong argument value.
+			PendingSong radio.PendingSong
+		}
+		// InsertSubmission holds details about calls to the InsertSubmission method.
+		InsertSubmission []struct {
+			// PendingSong is the pendingSong argument value.
+			PendingSong radio.PendingSong
+		}
+		// LastSubmissionTime holds details about calls to the LastSubmissionTime method.
+		LastSubmissionTime []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
+		// RemoveSubmission holds details about calls to the RemoveSubmission method.
+		RemoveSubmission []struct {
+			// SubmissionID is the submissionID argument value.
+			SubmissionID radio.SubmissionID
+		}
+		// SubmissionStats holds details about calls to the SubmissionStats method.
+		SubmissionStats []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
+		// UncheckedSubmissions holds details about calls to the UncheckedSubmissions method.
+		UncheckedSubmissions []struct {
+		}
+		// UpdateSubmissionCheck holds details about calls to the UpdateSubmissionCheck method.
+		UpdateSubmissionCheck []struct {
+			// PendingSong is the pendingSong argument value.
+			PendingSong radio.PendingSong
+		}
+		// UpdateSubmissionTime holds details about calls to the UpdateSubmissionTime method.
+		UpdateSubmissionTime []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
 	}
-	mock.lockFromHash.RLock()
-	calls = mock.calls.FromHash
-	mock.lockFromHash.RUnlock()
-	return calls
+	lockAll                   sync.RWMutex
+	lockGetSubmission         sync.RWMutex
+	lockInsertPostPending     sync.RWMutex
+	lockInsertSubmission      sync.RWMutex
+	lockLastSubmissionTime    sync.RWMutex
+	lockRemoveSubmission      sync.RWMutex
+	lockSubmissionStats       sync.RWMutex
+	lockUncheckedSubmissions  sync.RWMutex
+	lockUpdateSubmissionCheck sync.RWMutex
+	lockUpdateSubmissionTime  sync.RWMutex
 }
 
-// FromMetadata calls FromMetadataFunc.
-func (mock *SongStorageMock) FromMetadata(metadata string) (*radio.Song, error) {
-	if mock.FromMetadataFunc == nil {
-		panic("SongStorageMock.FromMetadataFunc: method is nil but SongStorage.FromMetadata was just called")
+// All calls AllFunc.
+func (mock *SubmissionStorageMock) All() ([]radio.PendingSong, error) {
+	if mock.AllFunc == nil {
+		panic("SubmissionStorageMock.AllFunc: method is nil but SubmissionStorage.All was just called")
 	}
 	callInfo := struct {
-		Metadata string
-	}{
-		Metadata: metadata,
-	}
-	mock.lockFromMetadata.Lock()
-	mock.calls.FromMetadata = append(mock.calls.FromMetadata, callInfo)
-	mock.lockFromMetadata.Unlock()
-	return mock.FromMetadataFunc(metadata)
+	}{}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc()
 }
 
-// FromMetadataCalls gets all the calls that were made to FromMetadata.
+// AllCalls gets all the calls that were made to All.
 // Check the length with:
 //
-//	len(mockedSongStorage.FromMetadataCalls())
-func (mock *SongStorageMock) FromMetadataCalls() []struct {
-	Metadata string
+//	len(mockedSubmissionStorage.AllCalls())
+func (mock *SubmissionStorageMock) AllCalls() []struct {
 } {
 	var calls []struct {
-		Metadata string
 	}
-	mock.lockFromMetadata.RLock()
-	calls = mock.calls.FromMetadata
-	mock.lockFromMetadata.RUnlock()
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
 	return calls
 }
 
-// LastPlayed calls LastPlayedFunc.
-func (mock *SongStorageMock) LastPlayed(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error) {
-	if mock.LastPlayedFunc == nil {
-		panic("SongStorageMock.LastPlayedFunc: method is nil but SongStorage.LastPlayed was just called")
+// GetSubmission calls GetSubmissionFunc.
+func (mock *SubmissionStorageMock) GetSubmission(submissionID radio.SubmissionID) (*radio.PendingSong, error) {
+	if mock.GetSubmissionFunc == nil {
+		panic("SubmissionStorageMock.GetSubmissionFunc: method is nil but SubmissionStorage.GetSubmission was just called")
 	}
 	callInfo := struct {
-		Key           radio.LastPlayedKey
-		AmountPerPage int
+		SubmissionID radio.SubmissionID
 	}{
-		Key:           key,
-		AmountPerPage: amountPerPage,
-	}
-	mock.lockLastPlayed.Lock()
-	mock.calls.LastPlayed = append(mock.calls.LastPlayed, callInfo)
-	mock.lockLastPlayed.Unlock()
-	return mock.LastPlayedFunc(key, amountPerPage)
-}
-
-// LastPlayedCalls gets all the calls that were made to LastPlayed.
-// Check the length with:
-//
-//	len(mockedSongStorage.LastPlayedCalls())
-func (mock *SongStorageMock) LastPlayedCalls() []struct {
-	Key           radio.LastPlayedKey
-	AmountPerPage int
-} {
-	var calls []struct {
-		Key           radio.LastPlayedKey
-		AmountPerPage int
-	}
-	mock.lockLastPlayed.RLock()
-	calls = mock.calls.LastPlayed
-	mock.lockLastPlayed.RUnlock()
-	return calls
-}
-
-// LastPlayedCount calls LastPlayedCountFunc.
-func (mock *SongStorageMock) LastPlayedCount() (int64, error) {
-	if mock.LastPlayedCountFunc == nil {
-		panic("SongStorageMock.LastPlayedCountFunc: method is nil but SongStorage.LastPlayedCount was just called")
+		SubmissionID: submissionID,
 	}
-	callInfo := struct {
-	}{}
-	mock.lockLastPlayedCount.Lock()
-	mock.calls.LastPlayedCount = append(mock.calls.LastPlayedCount, callInfo)
-	mock.lockLastPlayedCount.Unlock()
-	return mock.LastPlayedCountFunc()
+	mock.lockGetSubmission.Lock()
+	mock.calls.GetSubmission = append(mock.calls.GetSubmission, callInfo)
+	mock.lockGetSubmission.Unlock()
+	return mock.GetSubmissionFunc(submissionID)
 }
 
-// LastPlayedCountCalls gets all the calls that were made to LastPlayedCount.
+// GetSubmissionCalls gets all the calls that were made to GetSubmission.
 // Check the length with:
 //
-//	len(mockedSongStorage.LastPlayedCountCalls())
-func (mock *SongStorageMock) LastPlayedCountCalls() []struct {
+//	len(mockedSubmissionStorage.GetSubmissionCalls())
+func (mock *SubmissionStorageMock) GetSubmissionCalls() []struct {
+	SubmissionID radio.SubmissionID
 } {
 	var calls []struct {
+		SubmissionID radio.SubmissionID
 	}
-	mock.lockLastPlayedCount.RLock()
-	calls = mock.calls.LastPlayedCount
-	mock.lockLastPlayedCount.RUnlock()
+	mock.lockGetSubmission.RLock()
+	calls = mock.calls.GetSubmission
+	mock.lockGetSubmission.RUnlock()
 	return calls
 }
 
-// LastPlayedPagination calls LastPlayedPaginationFunc.
-func (mock *SongStorageMock) LastPlayedPagination(key radio.LastPlayedKey, amountPerPage int, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error) {
-	if mock.LastPlayedPaginationFunc == nil {
-		panic("SongStorageMock.LastPlayedPaginationFunc: method is nil but SongStorage.LastPlayedPagination was just called")
+// InsertPostPending calls InsertPostPendingFunc.
+func (mock *SubmissionStorageMock) InsertPostPending(pendingSong radio.PendingSong) error {
+	if mock.InsertPostPendingFunc == nil {
+		panic("SubmissionStorageMock.InsertPostPendingFunc: method is nil but SubmissionStorage.InsertPostPending was just called")
 	}
 	callInfo := struct {
-		Key           radio.LastPlayedKey
-		AmountPerPage int
-		PageCount     int
+		PendingSong radio.PendingSong
 	}{
-		Key:           key,
-		AmountPerPage: amountPerPage,
-		PageCount:     pageCount,
+		PendingSong: pendingSong,
 	}
-	mock.lockLastPlayedPagination.Lock()
-	mock.calls.LastPlayedPagination = append(mock.calls.LastPlayedPagination, callInfo)
-	mock.lockLastPlayedPagination.Unlock()
-	return mock.LastPlayedPaginationFunc(key, amountPerPage, pageCount)
+	mock.lockInsertPostPending.Lock()
+	mock.calls.InsertPostPending = append(mock.calls.InsertPostPending, callInfo)
+	mock.lockInsertPostPending.Unlock()
+	return mock.InsertPostPendingFunc(pendingSong)
 }
 
-// LastPlayedPaginationCalls gets all the calls that were made to LastPlayedPagination.
+// InsertPostPendingCalls gets all the calls that were made to InsertPostPending.
 // Check the length with:
 //
-//	len(mockedSongStorage.LastPlayedPaginationCalls())
-func (mock *SongStorageMock) LastPlayedPaginationCalls() []struct {
-	Key           radio.LastPlayedKey
-	AmountPerPage int
-	PageCount     int
+//	len(mockedSubmissionStorage.InsertPostPendingCalls())
+func (mock *SubmissionStorageMock) InsertPostPendingCalls() []struct {
+	PendingSong radio.PendingSong
 } {
 	var calls []struct {
-		Key           radio.LastPlayedKey
-		AmountPerPage int
-		PageCount     int
+		PendingSong radio.PendingSong
 	}
-	mock.lockLastPlayedPagination.RLock()
-	calls = mock.calls.LastPlayedPagination
-	mock.lockLastPlayedPagination.RUnlock()
+	mock.lockInsertPostPending.RLock()
+	calls = mock.calls.InsertPostPending
+	mock.lockInsertPostPending.RUnlock()
 	return calls
 }
 
-// PlayedCount calls PlayedCountFunc.
-func (mock *SongStorageMock) PlayedCount(song radio.Song) (int64, error) {
-	if mock.PlayedCountFunc == nil {
-		panic("SongStorageMock.PlayedCountFunc: method is nil but SongStorage.PlayedCount was just called")
+// InsertSubmission calls InsertSubmissionFunc.
+func (mock *SubmissionStorageMock) InsertSubmission(pendingSong radio.PendingSong) error {
+	if mock.InsertSubmissionFunc == nil {
+		panic("SubmissionStorageMock.InsertSubmissionFunc: method is nil but SubmissionStorage.InsertSubmission was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		PendingSong radio.PendingSong
 	}{
-		Song: song,
+		PendingSong: pendingSong,
 	}
-	mock.lockPlayedCount.Lock()
-	mock.calls.PlayedCount = append(mock.calls.PlayedCount, callInfo)
-	mock.lockPlayedCount.Unlock()
-	return mock.PlayedCountFunc(song)
+	mock.lockInsertSubmission.Lock()
+	mock.calls.InsertSubmission = append(mock.calls.InsertSubmission, callInfo)
+	mock.lockInsertSubmission.Unlock()
+	return mock.InsertSubmissionFunc(pendingSong)
 }
 
-// PlayedCountCalls gets all the calls that were made to PlayedCount.
+// InsertSubmissionCalls gets all the calls that were made to InsertSubmission.
 // Check the length with:
 //
-//	len(mockedSongStorage.PlayedCountCalls())
-func (mock *SongStorageMock) PlayedCountCalls() []struct {
-	Song radio.Song
+//	len(mockedSubmissionStorage.InsertSubmissionCalls())
+func (mock *SubmissionStorageMock) InsertSubmissionCalls() []struct {
+	PendingSong radio.PendingSong
 } {
 	var calls []struct {
-		Song radio.Song
+		PendingSong radio.PendingSong
 	}
-	mock.lockPlayedCount.RLock()
-	calls = mock.calls.PlayedCount
-	mock.lockPlayedCount.RUnlock()
+	mock.lockInsertSubmission.RLock()
+	calls = mock.calls.InsertSubmission
+	mock.lockInsertSubmission.RUnlock()
 	return calls
 }
 
-// RemoveFavorite calls RemoveFavoriteFunc.
-func (mock *SongStorageMock) RemoveFavorite(song radio.Song, nick string) (bool, error) {
-	if mock.RemoveFavoriteFunc == nil {
-		panic("SongStorageMock.RemoveFavoriteFunc: method is nil but SongStorage.RemoveFavorite was just called")
+// LastSubmissionTime calls LastSubmissionTimeFunc.
+func (mock *SubmissionStorageMock) LastSubmissionTime(identifier string) (time.Time, error) {
+	if mock.LastSubmissionTimeFunc == nil {
+		panic("SubmissionStorageMock.LastSubmissionTimeFunc: method is nil but SubmissionStorage.LastSubmissionTime was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
-		Nick string
+		Identifier string
 	}{
-		Song: song,
-		Nick: nick,
+		Identifier: identifier,
 	}
-	mock.lockRemoveFavorite.Lock()
-	mock.calls.RemoveFavorite = append(mock.calls.RemoveFavorite, callInfo)
-	mock.lockRemoveFavorite.Unlock()
-	return mock.RemoveFavoriteFunc(song, nick)
+	mock.lockLastSubmissionTime.Lock()
+	mock.calls.LastSubmissionTime = append(mock.calls.LastSubmissionTime, callInfo)
+	mock.lockLastSubmissionTime.Unlock()
+	return mock.LastSubmissionTimeFunc(identifier)
 }
 
-// RemoveFavoriteCalls gets all the calls that were made to RemoveFavorite.
+// LastSubmissionTimeCalls gets all the calls that were made to LastSubmissionTime.
 // Check the length with:
 //
-//	len(mockedSongStorage.RemoveFavoriteCalls())
-func (mock *SongStorageMock) RemoveFavoriteCalls() []struct {
-	Song radio.Song
-	Nick string
+//	len(mockedSubmissionStorage.LastSubmissionTimeCalls())
+func (mock *SubmissionStorageMock) LastSubmissionTimeCalls() []struct {
+	Identifier string
 } {
 	var calls []struct {
-		Song radio.Song
-		Nick string
+		Identifier string
 	}
-	mock.lockRemoveFavorite.RLock()
-	calls = mock.calls.RemoveFavorite
-	mock.lockRemoveFavorite.RUnlock()
+	mock.lockLastSubmissionTime.RLock()
+	calls = mock.calls.LastSubmissionTime
+	mock.lockLastSubmissionTime.RUnlock()
 	return calls
 }
 
-// UpdateHashLink calls UpdateHashLinkFunc.
-func (mock *SongStorageMock) UpdateHashLink(old radio.SongHash, new radio.SongHash) error {
-	if mock.UpdateHashLinkFunc == nil {
-		panic("SongStorageMock.UpdateHashLinkFunc: method is nil but SongStorage.UpdateHashLink was just called")
+// RemoveSubmission calls RemoveSubmissionFunc.
+func (mock *SubmissionStorageMock) RemoveSubmission(submissionID radio.SubmissionID) error {
+	if mock.RemoveSubmissionFunc == nil {
+		panic("SubmissionStorageMock.RemoveSubmissionFunc: method is nil but SubmissionStorage.RemoveSubmission was just called")
 	}
 	callInfo := struct {
-		Old radio.SongHash
-		New radio.SongHash
+		SubmissionID radio.SubmissionID
 	}{
-		Old: old,
-		New: new,
+		SubmissionID: submissionID,
 	}
-	mock.lockUpdateHashLink.Lock()
-	mock.calls.UpdateHashLink = append(mock.calls.UpdateHashLink, callInfo)
-	mock.lockUpdateHashLink.Unlock()
-	return mock.UpdateHashLinkFunc(old, new)
+	mock.lockRemoveSubmission.Lock()
+	mock.calls.RemoveSubmission = append(mock.calls.RemoveSubmission, callInfo)
+	mock.lockRemoveSubmission.Unlock()
+	return mock.RemoveSubmissionFunc(submissionID)
 }
 
-// UpdateHashLinkCalls gets all the calls that were made to UpdateHashLink.
+// RemoveSubmissionCalls gets all the calls that were made to RemoveSubmission.
 // Check the length with:
 //
-//	len(mockedSongStorage.UpdateHashLinkCalls())
-func (mock *SongStorageMock) UpdateHashLinkCalls() []struct {
-	Old radio.SongHash
-	New radio.SongHash
+//	len(mockedSubmissionStorage.RemoveSubmissionCalls())
+func (mock *SubmissionStorageMock) RemoveSubmissionCalls() []struct {
+	SubmissionID radio.SubmissionID
 } {
 	var calls []struct {
-		Old radio.SongHash
-		New radio.SongHash
+		SubmissionID radio.SubmissionID
 	}
-	mock.lockUpdateHashLink.RLock()
-	calls = mock.calls.UpdateHashLink
-	mock.lockUpdateHashLink.RUnlock()
+	mock.lockRemoveSubmission.RLock()
+	calls = mock.calls.RemoveSubmission
+	mock.lockRemoveSubmission.RUnlock()
 	return calls
 }
 
-// UpdateLength calls UpdateLengthFunc.
-func (mock *SongStorageMock) UpdateLength(song radio.Song, duration time.Duration) error {
-	if mock.UpdateLengthFunc == nil {
-		panic("SongStorageMock.UpdateLengthFunc: method is nil but SongStorage.UpdateLength was just called")
+// SubmissionStats calls SubmissionStatsFunc.
+func (mock *SubmissionStorageMock) SubmissionStats(identifier string) (radio.SubmissionStats, error) {
+	if mock.SubmissionStatsFunc == nil {
+		panic("SubmissionStorageMock.SubmissionStatsFunc: method is nil but SubmissionStorage.SubmissionStats was just called")
 	}
 	callInfo := struct {
-		Song     radio.Song
-		Duration time.Duration
+		Identifier string
 	}{
-		Song:     song,
-		Duration: duration,
+		Identifier: identifier,
 	}
-	mock.lockUpdateLength.Lock()
-	mock.calls.UpdateLength = append(mock.calls.UpdateLength, callInfo)
-	mock.lockUpdateLength.Unlock()
-	return mock.UpdateLengthFunc(song, duration)
+	mock.lockSubmissionStats.Lock()
+	mock.calls.SubmissionStats = append(mock.calls.SubmissionStats, callInfo)
+	mock.lockSubmissionStats.Unlock()
+	return mock.SubmissionStatsFunc(identifier)
 }
 
-// UpdateLengthCalls gets all the calls that were made to UpdateLength.
+// SubmissionStatsCalls gets all the calls that were made to SubmissionStats.
 // Check the length with:
 //
-//	len(mockedSongStorage.UpdateLengthCalls())
-func (mock *SongStorageMock) UpdateLengthCalls() []struct {
-	Song     radio.Song
-	Duration time.Duration
+//	len(mockedSubmissionStorage.SubmissionStatsCalls())
+func (mock *SubmissionStorageMock) SubmissionStatsCalls() []struct {
+	Identifier string
 } {
 	var calls []struct {
-		Song     radio.Song
-		Duration time.Duration
+		Identifier string
 	}
-	mock.lockUpdateLength.RLock()
-	calls = mock.calls.UpdateLength
-	mock.lockUpdateLength.RUnlock()
+	mock.lockSubmissionStats.RLock()
+	calls = mock.calls.SubmissionStats
+	mock.lockSubmissionStats.RUnlock()
 	return calls
 }
 
-// Ensure, that TrackStorageServiceMock does implement radio.TrackStorageService.
-// If this is not the case, regenerate this file with moq.
-var _ radio.TrackStorageService = &TrackStorageServiceMock{}
+// UncheckedSubmissions calls UncheckedSubmissionsFunc.
+func (mock *SubmissionStorageMock) UncheckedSubmissions() ([]radio.PendingSong, error) {
+	if mock.UncheckedSubmissionsFunc == nil {
+		panic("SubmissionStorageMock.UncheckedSubmissionsFunc: method is nil but SubmissionStorage.UncheckedSubmissions was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockUncheckedSubmissions.Lock()
+	mock.calls.UncheckedSubmissions = append(mock.calls.UncheckedSubmissions, callInfo)
+	mock.lockUncheckedSubmissions.Unlock()
+	return mock.UncheckedSubmissionsFunc()
+}
 
-// TrackStorageServiceMock is a mock implementation of radio.TrackStorageService.
-//
-//	func TestSomethingThatUsesTrackStorageService(t *testing.T) {
-//
-//		// make and configure a mocked radio.TrackStorageService
-//		mockedTrackStorageService := &TrackStorageServiceMock{
-//			TrackFunc: func(contextMoqParam context.Context) radio.TrackStorage {
-//				panic("mock out the Track method")
-//			},
-//			TrackTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
-//				panic("mock out the TrackTx method")
-//			},
-//		}
-//
-//		// use mockedTrackStorageService in code that requires radio.TrackStorageService
-//		// and then make assertions.
+// UncheckedSubmissionsCalls gets all the calls that were made to UncheckedSubmissions.
+// Check the length with:
 //
-//	}
-type TrackStorageServiceMock struct {
-	// TrackFunc mocks the Track method.
-	TrackFunc func(contextMoqParam context.Context) radio.TrackStorage
-
-	// TrackTxFunc mocks the TrackTx method.
-	TrackTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error)
-
-	// calls tracks calls to the methods.
-	calls struct {
-		// Track holds details about calls to the Track method.
-		Track []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-		}
-		// TrackTx holds details about calls to the TrackTx method.
-		TrackTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
-		}
+//	len(mockedSubmissionStorage.UncheckedSubmissionsCalls())
+func (mock *SubmissionStorageMock) UncheckedSubmissionsCalls() []struct {
+} {
+	var calls []struct {
 	}
-	lockTrack   sync.RWMutex
-	lockTrackTx sync.RWMutex
+	mock.lockUncheckedSubmissions.RLock()
+	calls = mock.calls.UncheckedSubmissions
+	mock.lockUncheckedSubmissions.RUnlock()
+	return calls
 }
 
-// Track calls TrackFunc.
-func (mock *TrackStorageServiceMock) Track(contextMoqParam context.Context) radio.TrackStorage {
-	if mock.TrackFunc == nil {
-		panic("TrackStorageServiceMock.TrackFunc: method is nil but TrackStorageService.Track was just called")
+// UpdateSubmissionCheck calls UpdateSubmissionCheckFunc.
+func (mock *SubmissionStorageMock) UpdateSubmissionCheck(pendingSong radio.PendingSong) error {
+	if mock.UpdateSubmissionCheckFunc == nil {
+		panic("SubmissionStorageMock.UpdateSubmissionCheckFunc: method is nil but SubmissionStorage.UpdateSubmissionCheck was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
+		PendingSong radio.PendingSong
 	}{
-		ContextMoqParam: contextMoqParam,
+		PendingSong: pendingSong,
 	}
-	mock.lockTrack.Lock()
-	mock.calls.Track = append(mock.calls.Track, callInfo)
-	mock.lockTrack.Unlock()
-	return mock.TrackFunc(contextMoqParam)
+	mock.lockUpdateSubmissionCheck.Lock()
+	mock.calls.UpdateSubmissionCheck = append(mock.calls.UpdateSubmissionCheck, callInfo)
+	mock.lockUpdateSubmissionCheck.Unlock()
+	return mock.UpdateSubmissionCheckFunc(pendingSong)
 }
 
-// TrackCalls gets all the calls that were made to Track.
+// UpdateSubmissionCheckCalls gets all the calls that were made to UpdateSubmissionCheck.
 // Check the length with:
 //
-//	len(mockedTrackStorageService.TrackCalls())
-func (mock *TrackStorageServiceMock) TrackCalls() []struct {
-	ContextMoqParam context.Context
+//	len(mockedSubmissionStorage.UpdateSubmissionCheckCalls())
+func (mock *SubmissionStorageMock) UpdateSubmissionCheckCalls() []struct {
+	PendingSong radio.PendingSong
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
+		PendingSong radio.PendingSong
 	}
-	mock.lockTrack.RLock()
-	calls = mock.calls.Track
-	mock.lockTrack.RUnlock()
+	mock.lockUpdateSubmissionCheck.RLock()
+	calls = mock.calls.UpdateSubmissionCheck
+	mock.lockUpdateSubmissionCheck.RUnlock()
 	return calls
 }
 
-// TrackTx calls TrackTxFunc.
-func (mock *TrackStorageServiceMock) TrackTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
-	if mock.TrackTxFunc == nil {
-		panic("TrackStorageServiceMock.TrackTxFunc: method is nil but TrackStorageService.TrackTx was just called")
+// UpdateSubmissionTime calls UpdateSubmissionTimeFunc.
+func (mock *SubmissionStorageMock) UpdateSubmissionTime(identifier string) error {
+	if mock.UpdateSubmissionTimeFunc == nil {
+		panic("SubmissionStorageMock.UpdateSubmissionTimeFunc: method is nil but SubmissionStorage.UpdateSubmissionTime was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Identifier string
 	}{
-		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
+		Identifier: identifier,
 	}
-	mock.lockTrackTx.Lock()
-	mock.calls.TrackTx = append(mock.calls.TrackTx, callInfo)
-	mock.lockTrackTx.Unlock()
-	return mock.TrackTxFunc(contextMoqParam, storageTx)
+	mock.lockUpdateSubmissionTime.Lock()
+	mock.calls.UpdateSubmissionTime = append(mock.calls.UpdateSubmissionTime, callInfo)
+	mock.lockUpdateSubmissionTime.Unlock()
+	return mock.UpdateSubmissionTimeFunc(identifier)
 }
 
-// TrackTxCalls gets all the calls that were made to TrackTx.
+// UpdateSubmissionTimeCalls gets all the calls that were made to UpdateSubmissionTime.
 // Check the length with:
 //
-//	len(mockedTrackStorageService.TrackTxCalls())
-func (mock *TrackStorageServiceMock) TrackTxCalls() []struct {
-	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
+//	len(mockedSubmissionStorage.UpdateSubmissionTimeCalls())
+func (mock *SubmissionStorageMock) UpdateSubmissionTimeCalls() []struct {
+	Identifier string
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Identifier string
 	}
-	mock.lockTrackTx.RLock()
-	calls = mock.calls.TrackTx
-	mock.lockTrackTx.RUnlock()
+	mock.lockUpdateSubmissionTime.RLock()
+	calls = mock.calls.UpdateSubmissionTime
+	mock.lockUpdateSubmissionTime.RUnlock()
 	return calls
 }
 
-// Ensure, that TrackStorageMock does implement radio.TrackStorage.
+// Ensure, that RelayStorageMock does implement radio.RelayStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.TrackStorage = &TrackStorageMock{}
+var _ radio.RelayStorage = &RelayStorageMock{}
 
-// TrackStorageMock is a mock implementation of radio.TrackStorage.
+// RelayStorageMock is a mock implementation of radio.RelayStorage.
 //
-//	func TestSomethingThatUsesTrackStorage(t *testing.T) {
+//	func TestSomethingThatUsesRelayStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.TrackStorage
-//		mockedTrackStorage := &TrackStorageMock{
-//			AllFunc: func() ([]radio.Song, error) {
-//				panic("mock out the All method")
-//			},
-//			AllRawFunc: func() ([]radio.Song, error) {
-//				panic("mock out the AllRaw method")
-//			},
-//			BeforeLastRequestedFunc: func(before time.Time) ([]radio.Song, error) {
-//				panic("mock out the BeforeLastRequested method")
-//			},
-//			DecrementRequestCountFunc: func(before time.Time) error {
-//				panic("mock out the DecrementRequestCount method")
-//			},
-//			DeleteFunc: func(trackID radio.TrackID) error {
-//				panic("mock out the Delete method")
-//			},
-//			FilterSongsFavoriteOfFunc: func(nick string, songs []radio.Song) ([]radio.Song, error) {
-//				panic("mock out the FilterSongsFavoriteOf method")
-//			},
-//			GetFunc: func(trackID radio.TrackID) (*radio.Song, error) {
-//				panic("mock out the Get method")
-//			},
-//			InsertFunc: func(song radio.Song) (radio.TrackID, error) {
-//				panic("mock out the Insert method")
-//			},
-//			NeedReplacementFunc: func() ([]radio.Song, error) {
-//				panic("mock out the NeedReplacement method")
-//			},
-//			QueueCandidatesFunc: func() ([]radio.TrackID, error) {
-//				panic("mock out the QueueCandidates method")
-//			},
-//			RandomFunc: func(limit int) ([]radio.Song, error) {
-//				panic("mock out the Random method")
-//			},
-//			RandomFavoriteOfFunc: func(nick string, limit int) ([]radio.Song, error) {
-//				panic("mock out the RandomFavoriteOf method")
-//			},
-//			UnusableFunc: func() ([]radio.Song, error) {
-//				panic("mock out the Unusable method")
-//			},
-//			UpdateLastPlayedFunc: func(trackID radio.TrackID) error {
-//				panic("mock out the UpdateLastPlayed method")
-//			},
-//			UpdateLastRequestedFunc: func(trackID radio.TrackID) error {
-//				panic("mock out the UpdateLastRequested method")
-//			},
-//			UpdateMetadataFunc: func(song radio.Song) error {
-//				panic("mock out the UpdateMetadata method")
-//			},
-//			UpdateRequestInfoFunc: func(trackID radio.TrackID) error {
-//				panic("mock out the UpdateRequestInfo method")
+//		// make and configure a mocked radio.RelayStorage
+//		mockedRelayStorage := &RelayStorageMock{
+//			AllFunc: func() ([]radio.Relay, error) {
+//				panic("mock out the All method")
 //			},
-//			UpdateUsableFunc: func(song radio.Song, state radio.TrackState) error {
-//				panic("mock out the UpdateUsable method")
+//			UpdateFunc: func(r radio.Relay) error {
+//				panic("mock out the Update method")
 //			},
 //		}
 //
-//		// use mockedTrackStorage in code that requires radio.TrackStorage
+//		// use mockedRelayStorage in code that requires radio.RelayStorage
 //		// and then make assertions.
 //
 //	}
-type TrackStorageMock struct {
+type RelayStorageMock struct {
 	// AllFunc mocks the All method.
-	AllFunc func() ([]radio.Song, error)
-
-	// AllRawFunc mocks the AllRaw method.
-	AllRawFunc func() ([]radio.Song, error)
-
-	// BeforeLastRequestedFunc mocks the BeforeLastRequested method.
-	BeforeLastRequestedFunc func(before time.Time) ([]radio.Song, error)
-
-	// DecrementRequestCountFunc mocks the DecrementRequestCount method.
-	DecrementRequestCountFunc func(before time.Time) error
-
-	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(trackID radio.TrackID) error
-
-	// FilterSongsFavoriteOfFunc mocks the FilterSongsFavoriteOf method.
-	FilterSongsFavoriteOfFunc func(nick string, songs []radio.Song) ([]radio.Song, error)
-
-	// GetFunc mocks the Get method.
-	GetFunc func(trackID radio.TrackID) (*radio.Song, error)
-
-	// InsertFunc mocks the Insert method.
-	InsertFunc func(song radio.Song) (radio.TrackID, error)
-
-	// NeedReplacementFunc mocks the NeedReplacement method.
-	NeedReplacementFunc func() ([]radio.Song, error)
-
-	// QueueCandidatesFunc mocks the QueueCandidates method.
-	QueueCandidatesFunc func() ([]radio.TrackID, error)
-
-	// RandomFunc mocks the Random method.
-	RandomFunc func(limit int) ([]radio.Song, error)
-
-	// RandomFavoriteOfFunc mocks the RandomFavoriteOf method.
-	RandomFavoriteOfFunc func(nick string, limit int) ([]radio.Song, error)
-
-	// UnusableFunc mocks the Unusable method.
-	UnusableFunc func() ([]radio.Song, error)
-
-	// UpdateLastPlayedFunc mocks the UpdateLastPlayed method.
-	UpdateLastPlayedFunc func(trackID radio.TrackID) error
-
-	// UpdateLastRequestedFunc mocks the UpdateLastRequested method.
-	UpdateLastRequestedFunc func(trackID radio.TrackID) error
-
-	// UpdateMetadataFunc mocks the UpdateMetadata method.
-	UpdateMetadataFunc func(song radio.Song) error
-
-	// UpdateRequestInfoFunc mocks the UpdateRequestInfo method.
-	UpdateRequestInfoFunc func(trackID radio.TrackID) error
+	AllFunc func() ([]radio.Relay, error)
 
-	// UpdateUsableFunc mocks the UpdateUsable method.
-	UpdateUsableFunc func(song radio.Song, state radio.TrackState) error
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(r radio.Relay) error
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// All holds details about calls to the All method.
 		All []struct {
 		}
-		// AllRaw holds details about calls to the AllRaw method.
-		AllRaw []struct {
-		}
-		// BeforeLastRequested holds details about calls to the BeforeLastRequested method.
-		BeforeLastRequested []struct {
-			// Before is the before argument value.
-			Before time.Time
-		}
-		// DecrementRequestCount holds details about calls to the DecrementRequestCount method.
-		DecrementRequestCount []struct {
-			// Before is the before argument value.
-			Before time.Time
-		}
-		// Delete holds details about calls to the Delete method.
-		Delete []struct {
-			// TrackID is the trackID argument value.
-			TrackID radio.TrackID
-		}
-		// FilterSongsFavoriteOf holds details about calls to the FilterSongsFavoriteOf method.
-		FilterSongsFavoriteOf []struct {
-			// Nick is the nick argument value.
-			Nick string
-			// Songs is the songs argument value.
-			Songs []radio.Song
-		}
-		// Get holds details about calls to the Get method.
-		Get []struct {
-			// TrackID is the trackID argument value.
-			TrackID radio.TrackID
-		}
-		// Insert holds details about calls to the Insert method.
-		Insert []struct {
-			// Song is the song argument value.
-			Song radio.Song
-		}
-		// NeedReplacement holds details about calls to the NeedReplacement method.
-		NeedReplacement []struct {
-		}
-		// QueueCandidates holds details about calls to the QueueCandidates method.
-		QueueCandidates []struct {
-		}
-		// Random holds details about calls to the Random method.
-		Random []struct {
-			// Limit is the limit argument value.
-			Limit int
-		}
-		// RandomFavoriteOf holds details about calls to the RandomFavoriteOf method.
-		RandomFavoriteOf []struct {
-			// Nick is the nick argument value.
-			Nick string
-			// Limit is the limit argument value.
-			Limit int
-		}
-		// Unusable holds details about calls to the Unusable method.
-		Unusable []struct {
-		}
-		// UpdateLastPlayed holds details about calls to the UpdateLastPlayed method.
-		UpdateLastPlayed []struct {
-			// TrackID is the trackID argument value.
-			TrackID radio.TrackID
-		}
-		// UpdateLastRequested holds details about calls to the UpdateLastRequested method.
-		UpdateLastRequested []struct {
-			// TrackID is the trackID argument value.
-			TrackID radio.TrackID
-		}
-		// UpdateMetadata holds details about calls to the UpdateMetadata method.
-		UpdateMetadata []struct {
-			// Song is the song argument value.
-			Song radio.Song
-		}
-		// UpdateRequestInfo holds details about calls to the UpdateRequestInfo method.
-		UpdateRequestInfo []struct {
-			// TrackID is the trackID argument value.
-			TrackID radio.TrackID
-		}
-		// UpdateUsable holds details about calls to the UpdateUsable method.
-		UpdateUsable []struct {
-			// Song is the song argument value.
-			Song radio.Song
-			// State is the state argument value.
-			State radio.TrackState
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// R is the r argument value.
+			R radio.Relay
 		}
 	}
-	lockAll                   sync.RWMutex
-	lockAllRaw                sync.RWMutex
-	lockBeforeLastRequested   sync.RWMutex
-	lockDecrementRequestCount sync.RWMutex
-	lockDelete                sync.RWMutex
-	lockFilterSongsFavoriteOf sync.RWMutex
-	lockGet                   sync.RWMutex
-	lockInsert                sync.RWMutex
-	lockNeedReplacement       sync.RWMutex
-	lockQueueCandidates       sync.RWMutex
-	lockRandom                sync.RWMutex
-	lockRandomFavoriteOf      sync.RWMutex
-	lockUnusable              sync.RWMutex
-	lockUpdateLastPlayed      sync.RWMutex
-	lockUpdateLastRequested   sync.RWMutex
-	lockUpdateMetadata        sync.RWMutex
-	lockUpdateRequestInfo     sync.RWMutex
-	lockUpdateUsable          sync.RWMutex
+	lockAll    sync.RWMutex
+	lockUpdate sync.RWMutex
 }
 
 // All calls AllFunc.
-func (mock *TrackStorageMock) All() ([]radio.Song, error) {
+func (mock *RelayStorageMock) All() ([]radio.Relay, error) {
 	if mock.AllFunc == nil {
-		panic("TrackStorageMock.AllFunc: method is nil but TrackStorage.All was just called")
+		panic("RelayStorageMock.AllFunc: method is nil but RelayStorage.All was just called")
 	}
 	callInfo := struct {
 	}{}
@@ -4293,8 +8465,8 @@ func (mock *TrackStorageMock) All() ([]radio.Song, error) {
 // AllCalls gets all the calls that were made to All.
 // Check the length with:
 //
-//	len(mockedTrackStorage.AllCalls())
-func (mock *TrackStorageMock) AllCalls() []struct {
+//	len(mockedRelayStorage.AllCalls())
+func (mock *RelayStorageMock) AllCalls() []struct {
 } {
 	var calls []struct {
 	}
@@ -4304,626 +8476,877 @@ func (mock *TrackStorageMock) AllCalls() []struct {
 	return calls
 }
 
-// AllRaw calls AllRawFunc.
-func (mock *TrackStorageMock) AllRaw() ([]radio.Song, error) {
-	if mock.AllRawFunc == nil {
-		panic("TrackStorageMock.AllRawFunc: method is nil but TrackStorage.AllRaw was just called")
+// Update calls UpdateFunc.
+func (mock *RelayStorageMock) Update(r radio.Relay) error {
+	if mock.UpdateFunc == nil {
+		panic("RelayStorageMock.UpdateFunc: method is nil but RelayStorage.Update was just called")
+	}
+	callInfo := struct {
+		R radio.Relay
+	}{
+		R: r,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(r)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedRelayStorage.UpdateCalls())
+func (mock *RelayStorageMock) UpdateCalls() []struct {
+	R radio.Relay
+} {
+	var calls []struct {
+		R radio.Relay
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
+
+// Ensure, that RelayStorageServiceMock does implement radio.RelayStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.RelayStorageService = &RelayStorageServiceMock{}
+
+// RelayStorageServiceMock is a mock implementation of radio.RelayStorageService.
+//
+//	func TestSomethingThatUsesRelayStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.RelayStorageService
+//		mockedRelayStorageService := &RelayStorageServiceMock{
+//			RelayFunc: func(contextMoqParam context.Context) radio.RelayStorage {
+//				panic("mock out the Relay method")
+//			},
+//			RelayTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
+//				panic("mock out the RelayTx method")
+//			},
+//		}
+//
+//		// use mockedRelayStorageService in code that requires radio.RelayStorageService
+//		// and then make assertions.
+//
+//	}
+type RelayStorageServiceMock struct {
+	// RelayFunc mocks the Relay method.
+	RelayFunc func(contextMoqParam context.Context) radio.RelayStorage
+
+	// RelayTxFunc mocks the RelayTx method.
+	RelayTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Relay holds details about calls to the Relay method.
+		Relay []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// RelayTx holds details about calls to the RelayTx method.
+		RelayTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockRelay   sync.RWMutex
+	lockRelayTx sync.RWMutex
+}
+
+// Relay calls RelayFunc.
+func (mock *RelayStorageServiceMock) Relay(contextMoqParam context.Context) radio.RelayStorage {
+	if mock.RelayFunc == nil {
+		panic("RelayStorageServiceMock.RelayFunc: method is nil but RelayStorageService.Relay was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockAllRaw.Lock()
-	mock.calls.AllRaw = append(mock.calls.AllRaw, callInfo)
-	mock.lockAllRaw.Unlock()
-	return mock.AllRawFunc()
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockRelay.Lock()
+	mock.calls.Relay = append(mock.calls.Relay, callInfo)
+	mock.lockRelay.Unlock()
+	return mock.RelayFunc(contextMoqParam)
 }
 
-// AllRawCalls gets all the calls that were made to AllRaw.
+// RelayCalls gets all the calls that were made to Relay.
 // Check the length with:
 //
-//	len(mockedTrackStorage.AllRawCalls())
-func (mock *TrackStorageMock) AllRawCalls() []struct {
+//	len(mockedRelayStorageService.RelayCalls())
+func (mock *RelayStorageServiceMock) RelayCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
+		ContextMoqParam context.Context
 	}
-	mock.lockAllRaw.RLock()
-	calls = mock.calls.AllRaw
-	mock.lockAllRaw.RUnlock()
+	mock.lockRelay.RLock()
+	calls = mock.calls.Relay
+	mock.lockRelay.RUnlock()
 	return calls
 }
 
-// BeforeLastRequested calls BeforeLastRequestedFunc.
-func (mock *TrackStorageMock) BeforeLastRequested(before time.Time) ([]radio.Song, error) {
-	if mock.BeforeLastRequestedFunc == nil {
-		panic("TrackStorageMock.BeforeLastRequestedFunc: method is nil but TrackStorage.BeforeLastRequested was just called")
+// RelayTx calls RelayTxFunc.
+func (mock *RelayStorageServiceMock) RelayTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
+	if mock.RelayTxFunc == nil {
+		panic("RelayStorageServiceMock.RelayTxFunc: method is nil but RelayStorageService.RelayTx was just called")
 	}
 	callInfo := struct {
-		Before time.Time
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		Before: before,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockBeforeLastRequested.Lock()
-	mock.calls.BeforeLastRequested = append(mock.calls.BeforeLastRequested, callInfo)
-	mock.lockBeforeLastRequested.Unlock()
-	return mock.BeforeLastRequestedFunc(before)
+	mock.lockRelayTx.Lock()
+	mock.calls.RelayTx = append(mock.calls.RelayTx, callInfo)
+	mock.lockRelayTx.Unlock()
+	return mock.RelayTxFunc(contextMoqParam, storageTx)
 }
 
-// BeforeLastRequestedCalls gets all the calls that were made to BeforeLastRequested.
+// RelayTxCalls gets all the calls that were made to RelayTx.
 // Check the length with:
 //
-//	len(mockedTrackStorage.BeforeLastRequestedCalls())
-func (mock *TrackStorageMock) BeforeLastRequestedCalls() []struct {
-	Before time.Time
+//	len(mockedRelayStorageService.RelayTxCalls())
+func (mock *RelayStorageServiceMock) RelayTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		Before time.Time
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockBeforeLastRequested.RLock()
-	calls = mock.calls.BeforeLastRequested
-	mock.lockBeforeLastRequested.RUnlock()
+	mock.lockRelayTx.RLock()
+	calls = mock.calls.RelayTx
+	mock.lockRelayTx.RUnlock()
 	return calls
 }
 
-// DecrementRequestCount calls DecrementRequestCountFunc.
-func (mock *TrackStorageMock) DecrementRequestCount(before time.Time) error {
-	if mock.DecrementRequestCountFunc == nil {
-		panic("TrackStorageMock.DecrementRequestCountFunc: method is nil but TrackStorage.DecrementRequestCount was just called")
+// Ensure, that ScheduleStorageServiceMock does implement radio.ScheduleStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.ScheduleStorageService = &ScheduleStorageServiceMock{}
+
+// ScheduleStorageServiceMock is a mock implementation of radio.ScheduleStorageService.
+//
+//	func TestSomethingThatUsesScheduleStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.ScheduleStorageService
+//		mockedScheduleStorageService := &ScheduleStorageServiceMock{
+//			ScheduleFunc: func(contextMoqParam context.Context) radio.ScheduleStorage {
+//				panic("mock out the Schedule method")
+//			},
+//			ScheduleTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
+//				panic("mock out the ScheduleTx method")
+//			},
+//		}
+//
+//		// use mockedScheduleStorageService in code that requires radio.ScheduleStorageService
+//		// and then make assertions.
+//
+//	}
+type ScheduleStorageServiceMock struct {
+	// ScheduleFunc mocks the Schedule method.
+	ScheduleFunc func(contextMoqParam context.Context) radio.ScheduleStorage
+
+	// ScheduleTxFunc mocks the ScheduleTx method.
+	ScheduleTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Schedule holds details about calls to the Schedule method.
+		Schedule []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ScheduleTx holds details about calls to the ScheduleTx method.
+		ScheduleTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockSchedule   sync.RWMutex
+	lockScheduleTx sync.RWMutex
+}
+
+// Schedule calls ScheduleFunc.
+func (mock *ScheduleStorageServiceMock) Schedule(contextMoqParam context.Context) radio.ScheduleStorage {
+	if mock.ScheduleFunc == nil {
+		panic("ScheduleStorageServiceMock.ScheduleFunc: method is nil but ScheduleStorageService.Schedule was just called")
 	}
 	callInfo := struct {
-		Before time.Time
+		ContextMoqParam context.Context
 	}{
-		Before: before,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockDecrementRequestCount.Lock()
-	mock.calls.DecrementRequestCount = append(mock.calls.DecrementRequestCount, callInfo)
-	mock.lockDecrementRequestCount.Unlock()
-	return mock.DecrementRequestCountFunc(before)
+	mock.lockSchedule.Lock()
+	mock.calls.Schedule = append(mock.calls.Schedule, callInfo)
+	mock.lockSchedule.Unlock()
+	return mock.ScheduleFunc(contextMoqParam)
 }
 
-// DecrementRequestCountCalls gets all the calls that were made to DecrementRequestCount.
+// ScheduleCalls gets all the calls that were made to Schedule.
 // Check the length with:
 //
-//	len(mockedTrackStorage.DecrementRequestCountCalls())
-func (mock *TrackStorageMock) DecrementRequestCountCalls() []struct {
-	Before time.Time
+//	len(mockedScheduleStorageService.ScheduleCalls())
+func (mock *ScheduleStorageServiceMock) ScheduleCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		Before time.Time
+		ContextMoqParam context.Context
 	}
-	mock.lockDecrementRequestCount.RLock()
-	calls = mock.calls.DecrementRequestCount
-	mock.lockDecrementRequestCount.RUnlock()
+	mock.lockSchedule.RLock()
+	calls = mock.calls.Schedule
+	mock.lockSchedule.RUnlock()
 	return calls
 }
 
-// Delete calls DeleteFunc.
-func (mock *TrackStorageMock) Delete(trackID radio.TrackID) error {
-	if mock.DeleteFunc == nil {
-		panic("TrackStorageMock.DeleteFunc: method is nil but TrackStorage.Delete was just called")
+// ScheduleTx calls ScheduleTxFunc.
+func (mock *ScheduleStorageServiceMock) ScheduleTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
+	if mock.ScheduleTxFunc == nil {
+		panic("ScheduleStorageServiceMock.ScheduleTxFunc: method is nil but ScheduleStorageService.ScheduleTx was just called")
 	}
 	callInfo := struct {
-		TrackID radio.TrackID
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		TrackID: trackID,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockDelete.Lock()
-	mock.calls.Delete = append(mock.calls.Delete, callInfo)
-	mock.lockDelete.Unlock()
-	return mock.DeleteFunc(trackID)
+	mock.lockScheduleTx.Lock()
+	mock.calls.ScheduleTx = append(mock.calls.ScheduleTx, callInfo)
+	mock.lockScheduleTx.Unlock()
+	return mock.ScheduleTxFunc(contextMoqParam, storageTx)
 }
 
-// DeleteCalls gets all the calls that were made to Delete.
+// ScheduleTxCalls gets all the calls that were made to ScheduleTx.
 // Check the length with:
 //
-//	len(mockedTrackStorage.DeleteCalls())
-func (mock *TrackStorageMock) DeleteCalls() []struct {
-	TrackID radio.TrackID
+//	len(mockedScheduleStorageService.ScheduleTxCalls())
+func (mock *ScheduleStorageServiceMock) ScheduleTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		TrackID radio.TrackID
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockDelete.RLock()
-	calls = mock.calls.Delete
-	mock.lockDelete.RUnlock()
+	mock.lockScheduleTx.RLock()
+	calls = mock.calls.ScheduleTx
+	mock.lockScheduleTx.RUnlock()
 	return calls
 }
 
-// FilterSongsFavoriteOf calls FilterSongsFavoriteOfFunc.
-func (mock *TrackStorageMock) FilterSongsFavoriteOf(nick string, songs []radio.Song) ([]radio.Song, error) {
-	if mock.FilterSongsFavoriteOfFunc == nil {
-		panic("TrackStorageMock.FilterSongsFavoriteOfFunc: method is nil but TrackStorage.FilterSongsFavoriteOf was just called")
+// Ensure, that ScheduleStorageMock does implement radio.ScheduleStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.ScheduleStorage = &ScheduleStorageMock{}
+
+// ScheduleStorageMock is a mock implementation of radio.ScheduleStorage.
+//
+//	func TestSomethingThatUsesScheduleStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.ScheduleStorage
+//		mockedScheduleStorage := &ScheduleStorageMock{
+//			HistoryFunc: func(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error) {
+//				panic("mock out the History method")
+//			},
+//			LatestFunc: func() ([]*radio.ScheduleEntry, error) {
+//				panic("mock out the Latest method")
+//			},
+//			UpdateFunc: func(scheduleEntry radio.ScheduleEntry) error {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedScheduleStorage in code that requires radio.ScheduleStorage
+//		// and then make assertions.
+//
+//	}
+type ScheduleStorageMock struct {
+	// HistoryFunc mocks the History method.
+	HistoryFunc func(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error)
+
+	// LatestFunc mocks the Latest method.
+	LatestFunc func() ([]*radio.ScheduleEntry, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(scheduleEntry radio.ScheduleEntry) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// History holds details about calls to the History method.
+		History []struct {
+			// Day is the day argument value.
+			Day radio.ScheduleDay
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
+		}
+		// Latest holds details about calls to the Latest method.
+		Latest []struct {
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// ScheduleEntry is the scheduleEntry argument value.
+			ScheduleEntry radio.ScheduleEntry
+		}
+	}
+	lockHistory sync.RWMutex
+	lockLatest  sync.RWMutex
+	lockUpdate  sync.RWMutex
+}
+
+// History calls HistoryFunc.
+func (mock *ScheduleStorageMock) History(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error) {
+	if mock.HistoryFunc == nil {
+		panic("ScheduleStorageMock.HistoryFunc: method is nil but ScheduleStorage.History was just called")
 	}
 	callInfo := struct {
-		Nick  string
-		Songs []radio.Song
+		Day    radio.ScheduleDay
+		Limit  int64
+		Offset int64
 	}{
-		Nick:  nick,
-		Songs: songs,
+		Day:    day,
+		Limit:  limit,
+		Offset: offset,
 	}
-	mock.lockFilterSongsFavoriteOf.Lock()
-	mock.calls.FilterSongsFavoriteOf = append(mock.calls.FilterSongsFavoriteOf, callInfo)
-	mock.lockFilterSongsFavoriteOf.Unlock()
-	return mock.FilterSongsFavoriteOfFunc(nick, songs)
+	mock.lockHistory.Lock()
+	mock.calls.History = append(mock.calls.History, callInfo)
+	mock.lockHistory.Unlock()
+	return mock.HistoryFunc(day, limit, offset)
 }
 
-// FilterSongsFavoriteOfCalls gets all the calls that were made to FilterSongsFavoriteOf.
+// HistoryCalls gets all the calls that were made to History.
 // Check the length with:
 //
-//	len(mockedTrackStorage.FilterSongsFavoriteOfCalls())
-func (mock *TrackStorageMock) FilterSongsFavoriteOfCalls() []struct {
-	Nick  string
-	Songs []radio.Song
+//	len(mockedScheduleStorage.HistoryCalls())
+func (mock *ScheduleStorageMock) HistoryCalls() []struct {
+	Day    radio.ScheduleDay
+	Limit  int64
+	Offset int64
 } {
 	var calls []struct {
-		Nick  string
-		Songs []radio.Song
+		Day    radio.ScheduleDay
+		Limit  int64
+		Offset int64
 	}
-	mock.lockFilterSongsFavoriteOf.RLock()
-	calls = mock.calls.FilterSongsFavoriteOf
-	mock.lockFilterSongsFavoriteOf.RUnlock()
+	mock.lockHistory.RLock()
+	calls = mock.calls.History
+	mock.lockHistory.RUnlock()
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *TrackStorageMock) Get(trackID radio.TrackID) (*radio.Song, error) {
-	if mock.GetFunc == nil {
-		panic("TrackStorageMock.GetFunc: method is nil but TrackStorage.Get was just called")
+// Latest calls LatestFunc.
+func (mock *ScheduleStorageMock) Latest() ([]*radio.ScheduleEntry, error) {
+	if mock.LatestFunc == nil {
+		panic("ScheduleStorageMock.LatestFunc: method is nil but ScheduleStorage.Latest was just called")
 	}
 	callInfo := struct {
-		TrackID radio.TrackID
-	}{
-		TrackID: trackID,
-	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	return mock.GetFunc(trackID)
+	}{}
+	mock.lockLatest.Lock()
+	mock.calls.Latest = append(mock.calls.Latest, callInfo)
+	mock.lockLatest.Unlock()
+	return mock.LatestFunc()
 }
 
-// GetCalls gets all the calls that were made to Get.
+// LatestCalls gets all the calls that were made to Latest.
 // Check the length with:
 //
-//	len(mockedTrackStorage.GetCalls())
-func (mock *TrackStorageMock) GetCalls() []struct {
-	TrackID radio.TrackID
+//	len(mockedScheduleStorage.LatestCalls())
+func (mock *ScheduleStorageMock) LatestCalls() []struct {
 } {
 	var calls []struct {
-		TrackID radio.TrackID
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockLatest.RLock()
+	calls = mock.calls.Latest
+	mock.lockLatest.RUnlock()
 	return calls
 }
 
-// Insert calls InsertFunc.
-func (mock *TrackStorageMock) Insert(song radio.Song) (radio.TrackID, error) {
-	if mock.InsertFunc == nil {
-		panic("TrackStorageMock.InsertFunc: method is nil but TrackStorage.Insert was just called")
+// Update calls UpdateFunc.
+func (mock *ScheduleStorageMock) Update(scheduleEntry radio.ScheduleEntry) error {
+	if mock.UpdateFunc == nil {
+		panic("ScheduleStorageMock.UpdateFunc: method is nil but ScheduleStorage.Update was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		ScheduleEntry radio.ScheduleEntry
 	}{
-		Song: song,
+		ScheduleEntry: scheduleEntry,
 	}
-	mock.lockInsert.Lock()
-	mock.calls.Insert = append(mock.calls.Insert, callInfo)
-	mock.lockInsert.Unlock()
-	return mock.InsertFunc(song)
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(scheduleEntry)
 }
 
-// InsertCalls gets all the calls that were made to Insert.
+// UpdateCalls gets all the calls that were made to Update.
 // Check the length with:
 //
-//	len(mockedTrackStorage.InsertCalls())
-func (mock *TrackStorageMock) InsertCalls() []struct {
-	Song radio.Song
+//	len(mockedScheduleStorage.UpdateCalls())
+func (mock *ScheduleStorageMock) UpdateCalls() []struct {
+	ScheduleEntry radio.ScheduleEntry
 } {
 	var calls []struct {
-		Song radio.Song
+		ScheduleEntry radio.ScheduleEntry
 	}
-	mock.lockInsert.RLock()
-	calls = mock.calls.Insert
-	mock.lockInsert.RUnlock()
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
 	return calls
 }
 
-// NeedReplacement calls NeedReplacementFunc.
-func (mock *TrackStorageMock) NeedReplacement() ([]radio.Song, error) {
-	if mock.NeedReplacementFunc == nil {
-		panic("TrackStorageMock.NeedReplacementFunc: method is nil but TrackStorage.NeedReplacement was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockNeedReplacement.Lock()
-	mock.calls.NeedReplacement = append(mock.calls.NeedReplacement, callInfo)
-	mock.lockNeedReplacement.Unlock()
-	return mock.NeedReplacementFunc()
-}
+// Ensure, that APITokenStorageServiceMock does implement radio.APITokenStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.APITokenStorageService = &APITokenStorageServiceMock{}
 
-// NeedReplacementCalls gets all the calls that were made to NeedReplacement.
-// Check the length with:
+// APITokenStorageServiceMock is a mock implementation of radio.APITokenStorageService.
 //
-//	len(mockedTrackStorage.NeedReplacementCalls())
-func (mock *TrackStorageMock) NeedReplacementCalls() []struct {
-} {
-	var calls []struct {
-	}
-	mock.lockNeedReplacement.RLock()
-	calls = mock.calls.NeedReplacement
-	mock.lockNeedReplacement.RUnlock()
-	return calls
-}
+//	func TestSomethingThatUsesAPITokenStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.APITokenStorageService
+//		mockedAPITokenStorageService := &APITokenStorageServiceMock{
+//			APITokenFunc: func(contextMoqParam context.Context) radio.APITokenStorage {
+//				panic("mock out the APIToken method")
+//			},
+//			APITokenTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error) {
+//				panic("mock out the APITokenTx method")
+//			},
+//		}
+//
+//		// use mockedAPITokenStorageService in code that requires radio.APITokenStorageService
+//		// and then make assertions.
+//
+//	}
+type APITokenStorageServiceMock struct {
+	// APITokenFunc mocks the APIToken method.
+	APITokenFunc func(contextMoqParam context.Context) radio.APITokenStorage
 
-// QueueCandidates calls QueueCandidatesFunc.
-func (mock *TrackStorageMock) QueueCandidates() ([]radio.TrackID, error) {
-	if mock.QueueCandidatesFunc == nil {
-		panic("TrackStorageMock.QueueCandidatesFunc: method is nil but TrackStorage.QueueCandidates was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockQueueCandidates.Lock()
-	mock.calls.QueueCandidates = append(mock.calls.QueueCandidates, callInfo)
-	mock.lockQueueCandidates.Unlock()
-	return mock.QueueCandidatesFunc()
-}
+	// APITokenTxFunc mocks the APITokenTx method.
+	APITokenTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error)
 
-// QueueCandidatesCalls gets all the calls that were made to QueueCandidates.
-// Check the length with:
-//
-//	len(mockedTrackStorage.QueueCandidatesCalls())
-func (mock *TrackStorageMock) QueueCandidatesCalls() []struct {
-} {
-	var calls []struct {
+	// calls tracks calls to the methods.
+	calls struct {
+		// APIToken holds details about calls to the APIToken method.
+		APIToken []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// APITokenTx holds details about calls to the APITokenTx method.
+		APITokenTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 	}
-	mock.lockQueueCandidates.RLock()
-	calls = mock.calls.QueueCandidates
-	mock.lockQueueCandidates.RUnlock()
-	return calls
+	lockAPIToken   sync.RWMutex
+	lockAPITokenTx sync.RWMutex
 }
 
-// Random calls RandomFunc.
-func (mock *TrackStorageMock) Random(limit int) ([]radio.Song, error) {
-	if mock.RandomFunc == nil {
-		panic("TrackStorageMock.RandomFunc: method is nil but TrackStorage.Random was just called")
+// APIToken calls APITokenFunc.
+func (mock *APITokenStorageServiceMock) APIToken(contextMoqParam context.Context) radio.APITokenStorage {
+	if mock.APITokenFunc == nil {
+		panic("APITokenStorageServiceMock.APITokenFunc: method is nil but APITokenStorageService.APIToken was just called")
 	}
 	callInfo := struct {
-		Limit int
+		ContextMoqParam context.Context
 	}{
-		Limit: limit,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockRandom.Lock()
-	mock.calls.Random = append(mock.calls.Random, callInfo)
-	mock.lockRandom.Unlock()
-	return mock.RandomFunc(limit)
+	mock.lockAPIToken.Lock()
+	mock.calls.APIToken = append(mock.calls.APIToken, callInfo)
+	mock.lockAPIToken.Unlock()
+	return mock.APITokenFunc(contextMoqParam)
 }
 
-// RandomCalls gets all the calls that were made to Random.
+// APITokenCalls gets all the calls that were made to APIToken.
 // Check the length with:
 //
-//	len(mockedTrackStorage.RandomCalls())
-func (mock *TrackStorageMock) RandomCalls() []struct {
-	Limit int
+//	len(mockedAPITokenStorageService.APITokenCalls())
+func (mock *APITokenStorageServiceMock) APITokenCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		Limit int
+		ContextMoqParam context.Context
 	}
-	mock.lockRandom.RLock()
-	calls = mock.calls.Random
-	mock.lockRandom.RUnlock()
+	mock.lockAPIToken.RLock()
+	calls = mock.calls.APIToken
+	mock.lockAPIToken.RUnlock()
 	return calls
 }
 
-// RandomFavoriteOf calls RandomFavoriteOfFunc.
-func (mock *TrackStorageMock) RandomFavoriteOf(nick string, limit int) ([]radio.Song, error) {
-	if mock.RandomFavoriteOfFunc == nil {
-		panic("TrackStorageMock.RandomFavoriteOfFunc: method is nil but TrackStorage.RandomFavoriteOf was just called")
+// APITokenTx calls APITokenTxFunc.
+func (mock *APITokenStorageServiceMock) APITokenTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error) {
+	if mock.APITokenTxFunc == nil {
+		panic("APITokenStorageServiceMock.APITokenTxFunc: method is nil but APITokenStorageService.APITokenTx was just called")
 	}
 	callInfo := struct {
-		Nick  string
-		Limit int
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		Nick:  nick,
-		Limit: limit,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockRandomFavoriteOf.Lock()
-	mock.calls.RandomFavoriteOf = append(mock.calls.RandomFavoriteOf, callInfo)
-	mock.lockRandomFavoriteOf.Unlock()
-	return mock.RandomFavoriteOfFunc(nick, limit)
+	mock.lockAPITokenTx.Lock()
+	mock.calls.APITokenTx = append(mock.calls.APITokenTx, callInfo)
+	mock.lockAPITokenTx.Unlock()
+	return mock.APITokenTxFunc(contextMoqParam, storageTx)
 }
 
-// RandomFavoriteOfCalls gets all the calls that were made to RandomFavoriteOf.
+// APITokenTxCalls gets all the calls that were made to APITokenTx.
 // Check the length with:
 //
-//	len(mockedTrackStorage.RandomFavoriteOfCalls())
-func (mock *TrackStorageMock) RandomFavoriteOfCalls() []struct {
-	Nick  string
-	Limit int
+//	len(mockedAPITokenStorageService.APITokenTxCalls())
+func (mock *APITokenStorageServiceMock) APITokenTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		Nick  string
-		Limit int
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockRandomFavoriteOf.RLock()
-	calls = mock.calls.RandomFavoriteOf
-	mock.lockRandomFavoriteOf.RUnlock()
+	mock.lockAPITokenTx.RLock()
+	calls = mock.calls.APITokenTx
+	mock.lockAPITokenTx.RUnlock()
 	return calls
 }
 
-// Unusable calls UnusableFunc.
-func (mock *TrackStorageMock) Unusable() ([]radio.Song, error) {
-	if mock.UnusableFunc == nil {
-		panic("TrackStorageMock.UnusableFunc: method is nil but TrackStorage.Unusable was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockUnusable.Lock()
-	mock.calls.Unusable = append(mock.calls.Unusable, callInfo)
-	mock.lockUnusable.Unlock()
-	return mock.UnusableFunc()
-}
+// Ensure, that APITokenStorageMock does implement radio.APITokenStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.APITokenStorage = &APITokenStorageMock{}
 
-// UnusableCalls gets all the calls that were made to Unusable.
-// Check the length with:
+// APITokenStorageMock is a mock implementation of radio.APITokenStorage.
 //
-//	len(mockedTrackStorage.UnusableCalls())
-func (mock *TrackStorageMock) UnusableCalls() []struct {
-} {
-	var calls []struct {
+//	func TestSomethingThatUsesAPITokenStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.APITokenStorage
+//		mockedAPITokenStorage := &APITokenStorageMock{
+//			CreateFunc: func(apiToken radio.APIToken) (radio.APITokenID, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(userID radio.UserID, apiTokenID radio.APITokenID) error {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(apiTokenHash radio.APITokenHash) (*radio.APIToken, error) {
+//				panic("mock out the Get method")
+//			},
+//			ListByUserFunc: func(userID radio.UserID) ([]radio.APIToken, error) {
+//				panic("mock out the ListByUser method")
+//			},
+//			UpdateLastUsedFunc: func(apiTokenID radio.APITokenID) error {
+//				panic("mock out the UpdateLastUsed method")
+//			},
+//		}
+//
+//		// use mockedAPITokenStorage in code that requires radio.APITokenStorage
+//		// and then make assertions.
+//
+//	}
+type APITokenStorageMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(apiToken radio.APIToken) (radio.APITokenID, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(userID radio.UserID, apiTokenID radio.APITokenID) error
+
+	// GetFunc mocks the Get method.
+	GetFunc func(apiTokenHash radio.APITokenHash) (*radio.APIToken, error)
+
+	// ListByUserFunc mocks the ListByUser method.
+	ListByUserFunc func(userID radio.UserID) ([]radio.APIToken, error)
+
+	// UpdateLastUsedFunc mocks the UpdateLastUsed method.
+	UpdateLastUsedFunc func(apiTokenID radio.APITokenID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// ApiToken is the apiToken argument value.
+			ApiToken radio.APIToken
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+			// ApiTokenID is the apiTokenID argument value.
+			ApiTokenID radio.APITokenID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ApiTokenHash is the apiTokenHash argument value.
+			ApiTokenHash radio.APITokenHash
+		}
+		// ListByUser holds details about calls to the ListByUser method.
+		ListByUser []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+		}
+		// UpdateLastUsed holds details about calls to the UpdateLastUsed method.
+		UpdateLastUsed []struct {
+			// ApiTokenID is the apiTokenID argument value.
+			ApiTokenID radio.APITokenID
+		}
 	}
-	mock.lockUnusable.RLock()
-	calls = mock.calls.Unusable
-	mock.lockUnusable.RUnlock()
-	return calls
+	lockCreate         sync.RWMutex
+	lockDelete         sync.RWMutex
+	lockGet            sync.RWMutex
+	lockListByUser     sync.RWMutex
+	lockUpdateLastUsed sync.RWMutex
 }
 
-// UpdateLastPlayed calls UpdateLastPlayedFunc.
-func (mock *TrackStorageMock) UpdateLastPlayed(trackID radio.TrackID) error {
-	if mock.UpdateLastPlayedFunc == nil {
-		panic("TrackStorageMock.UpdateLastPlayedFunc: method is nil but TrackStorage.UpdateLastPlayed was just called")
+// Create calls CreateFunc.
+func (mock *APITokenStorageMock) Create(apiToken radio.APIToken) (radio.APITokenID, error) {
+	if mock.CreateFunc == nil {
+		panic("APITokenStorageMock.CreateFunc: method is nil but APITokenStorage.Create was just called")
 	}
 	callInfo := struct {
-		TrackID radio.TrackID
+		ApiToken radio.APIToken
 	}{
-		TrackID: trackID,
+		ApiToken: apiToken,
 	}
-	mock.lockUpdateLastPlayed.Lock()
-	mock.calls.UpdateLastPlayed = append(mock.calls.UpdateLastPlayed, callInfo)
-	mock.lockUpdateLastPlayed.Unlock()
-	return mock.UpdateLastPlayedFunc(trackID)
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(apiToken)
 }
 
-// UpdateLastPlayedCalls gets all the calls that were made to UpdateLastPlayed.
+// CreateCalls gets all the calls that were made to Create.
 // Check the length with:
 //
-//	len(mockedTrackStorage.UpdateLastPlayedCalls())
-func (mock *TrackStorageMock) UpdateLastPlayedCalls() []struct {
-	TrackID radio.TrackID
+//	len(mockedAPITokenStorage.CreateCalls())
+func (mock *APITokenStorageMock) CreateCalls() []struct {
+	ApiToken radio.APIToken
 } {
 	var calls []struct {
-		TrackID radio.TrackID
+		ApiToken radio.APIToken
 	}
-	mock.lockUpdateLastPlayed.RLock()
-	calls = mock.calls.UpdateLastPlayed
-	mock.lockUpdateLastPlayed.RUnlock()
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
 	return calls
 }
 
-// UpdateLastRequested calls UpdateLastRequestedFunc.
-func (mock *TrackStorageMock) UpdateLastRequested(trackID radio.TrackID) error {
-	if mock.UpdateLastRequestedFunc == nil {
-		panic("TrackStorageMock.UpdateLastRequestedFunc: method is nil but TrackStorage.UpdateLastRequested was just called")
+// Delete calls DeleteFunc.
+func (mock *APITokenStorageMock) Delete(userID radio.UserID, apiTokenID radio.APITokenID) error {
+	if mock.DeleteFunc == nil {
+		panic("APITokenStorageMock.DeleteFunc: method is nil but APITokenStorage.Delete was just called")
 	}
 	callInfo := struct {
-		TrackID radio.TrackID
+		UserID     radio.UserID
+		ApiTokenID radio.APITokenID
 	}{
-		TrackID: trackID,
+		UserID:     userID,
+		ApiTokenID: apiTokenID,
 	}
-	mock.lockUpdateLastRequested.Lock()
-	mock.calls.UpdateLastRequested = append(mock.calls.UpdateLastRequested, callInfo)
-	mock.lockUpdateLastRequested.Unlock()
-	return mock.UpdateLastRequestedFunc(trackID)
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(userID, apiTokenID)
 }
 
-// UpdateLastRequestedCalls gets all the calls that were made to UpdateLastRequested.
+// DeleteCalls gets all the calls that were made to Delete.
 // Check the length with:
 //
-//	len(mockedTrackStorage.UpdateLastRequestedCalls())
-func (mock *TrackStorageMock) UpdateLastRequestedCalls() []struct {
-	TrackID radio.TrackID
+//	len(mockedAPITokenStorage.DeleteCalls())
+func (mock *APITokenStorageMock) DeleteCalls() []struct {
+	UserID     radio.UserID
+	ApiTokenID radio.APITokenID
 } {
 	var calls []struct {
-		TrackID radio.TrackID
+		UserID     radio.UserID
+		ApiTokenID radio.APITokenID
 	}
-	mock.lockUpdateLastRequested.RLock()
-	calls = mock.calls.UpdateLastRequested
-	mock.lockUpdateLastRequested.RUnlock()
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
 	return calls
 }
 
-// UpdateMetadata calls UpdateMetadataFunc.
-func (mock *TrackStorageMock) UpdateMetadata(song radio.Song) error {
-	if mock.UpdateMetadataFunc == nil {
-		panic("TrackStorageMock.UpdateMetadataFunc: method is nil but TrackStorage.UpdateMetadata was just called")
+// Get calls GetFunc.
+func (mock *APITokenStorageMock) Get(apiTokenHash radio.APITokenHash) (*radio.APIToken, error) {
+	if mock.GetFunc == nil {
+		panic("APITokenStorageMock.GetFunc: method is nil but APITokenStorage.Get was just called")
 	}
 	callInfo := struct {
-		Song radio.Song
+		ApiTokenHash radio.APITokenHash
 	}{
-		Song: song,
+		ApiTokenHash: apiTokenHash,
 	}
-	mock.lockUpdateMetadata.Lock()
-	mock.calls.UpdateMetadata = append(mock.calls.UpdateMetadata, callInfo)
-	mock.lockUpdateMetadata.Unlock()
-	return mock.UpdateMetadataFunc(song)
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(apiTokenHash)
 }
 
-// UpdateMetadataCalls gets all the calls that were made to UpdateMetadata.
+// GetCalls gets all the calls that were made to Get.
 // Check the length with:
 //
-//	len(mockedTrackStorage.UpdateMetadataCalls())
-func (mock *TrackStorageMock) UpdateMetadataCalls() []struct {
-	Song radio.Song
+//	len(mockedAPITokenStorage.GetCalls())
+func (mock *APITokenStorageMock) GetCalls() []struct {
+	ApiTokenHash radio.APITokenHash
 } {
 	var calls []struct {
-		Song radio.Song
+		ApiTokenHash radio.APITokenHash
 	}
-	mock.lockUpdateMetadata.RLock()
-	calls = mock.calls.UpdateMetadata
-	mock.lockUpdateMetadata.RUnlock()
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
 	return calls
 }
 
-// UpdateRequestInfo calls UpdateRequestInfoFunc.
-func (mock *TrackStorageMock) UpdateRequestInfo(trackID radio.TrackID) error {
-	if mock.UpdateRequestInfoFunc == nil {
-		panic("TrackStorageMock.UpdateRequestInfoFunc: method is nil but TrackStorage.UpdateRequestInfo was just called")
+// ListByUser calls ListByUserFunc.
+func (mock *APITokenStorageMock) ListByUser(userID radio.UserID) ([]radio.APIToken, error) {
+	if mock.ListByUserFunc == nil {
+		panic("APITokenStorageMock.ListByUserFunc: method is nil but APITokenStorage.ListByUser was just called")
 	}
 	callInfo := struct {
-		TrackID radio.TrackID
+		UserID radio.UserID
 	}{
-		TrackID: trackID,
+		UserID: userID,
 	}
-	mock.lockUpdateRequestInfo.Lock()
-	mock.calls.UpdateRequestInfo = append(mock.calls.UpdateRequestInfo, callInfo)
-	mock.lockUpdateRequestInfo.Unlock()
-	return mock.UpdateRequestInfoFunc(trackID)
+	mock.lockListByUser.Lock()
+	mock.calls.ListByUser = append(mock.calls.ListByUser, callInfo)
+	mock.lockListByUser.Unlock()
+	return mock.ListByUserFunc(userID)
 }
 
-// UpdateRequestInfoCalls gets all the calls that were made to UpdateRequestInfo.
+// ListByUserCalls gets all the calls that were made to ListByUser.
 // Check the length with:
 //
-//	len(mockedTrackStorage.UpdateRequestInfoCalls())
-func (mock *TrackStorageMock) UpdateRequestInfoCalls() []struct {
-	TrackID radio.TrackID
+//	len(mockedAPITokenStorage.ListByUserCalls())
+func (mock *APITokenStorageMock) ListByUserCalls() []struct {
+	UserID radio.UserID
 } {
 	var calls []struct {
-		TrackID radio.TrackID
+		UserID radio.UserID
 	}
-	mock.lockUpdateRequestInfo.RLock()
-	calls = mock.calls.UpdateRequestInfo
-	mock.lockUpdateRequestInfo.RUnlock()
+	mock.lockListByUser.RLock()
+	calls = mock.calls.ListByUser
+	mock.lockListByUser.RUnlock()
 	return calls
 }
 
-// UpdateUsable calls UpdateUsableFunc.
-func (mock *TrackStorageMock) UpdateUsable(song radio.Song, state radio.TrackState) error {
-	if mock.UpdateUsableFunc == nil {
-		panic("TrackStorageMock.UpdateUsableFunc: method is nil but TrackStorage.UpdateUsable was just called")
+// UpdateLastUsed calls UpdateLastUsedFunc.
+func (mock *APITokenStorageMock) UpdateLastUsed(apiTokenID radio.APITokenID) error {
+	if mock.UpdateLastUsedFunc == nil {
+		panic("APITokenStorageMock.UpdateLastUsedFunc: method is nil but APITokenStorage.UpdateLastUsed was just called")
 	}
 	callInfo := struct {
-		Song  radio.Song
-		State radio.TrackState
+		ApiTokenID radio.APITokenID
 	}{
-		Song:  song,
-		State: state,
+		ApiTokenID: apiTokenID,
 	}
-	mock.lockUpdateUsable.Lock()
-	mock.calls.UpdateUsable = append(mock.calls.UpdateUsable, callInfo)
-	mock.lockUpdateUsable.Unlock()
-	return mock.UpdateUsableFunc(song, state)
+	mock.lockUpdateLastUsed.Lock()
+	mock.calls.UpdateLastUsed = append(mock.calls.UpdateLastUsed, callInfo)
+	mock.lockUpdateLastUsed.Unlock()
+	return mock.UpdateLastUsedFunc(apiTokenID)
 }
 
-// UpdateUsableCalls gets all the calls that were made to UpdateUsable.
+// UpdateLastUsedCalls gets all the calls that were made to UpdateLastUsed.
 // Check the length with:
 //
-//	len(mockedTrackStorage.UpdateUsableCalls())
-func (mock *TrackStorageMock) UpdateUsableCalls() []struct {
-	Song  radio.Song
-	State radio.TrackState
+//	len(mockedAPITokenStorage.UpdateLastUsedCalls())
+func (mock *APITokenStorageMock) UpdateLastUsedCalls() []struct {
+	ApiTokenID radio.APITokenID
 } {
 	var calls []struct {
-		Song  radio.Song
-		State radio.TrackState
+		ApiTokenID radio.APITokenID
 	}
-	mock.lockUpdateUsable.RLock()
-	calls = mock.calls.UpdateUsable
-	mock.lockUpdateUsable.RUnlock()
+	mock.lockUpdateLastUsed.RLock()
+	calls = mock.calls.UpdateLastUsed
+	mock.lockUpdateLastUsed.RUnlock()
 	return calls
 }
 
-// Ensure, that RequestStorageServiceMock does implement radio.RequestStorageService.
+// Ensure, that AuditStorageServiceMock does implement radio.AuditStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.RequestStorageService = &RequestStorageServiceMock{}
+var _ radio.AuditStorageService = &AuditStorageServiceMock{}
 
-// RequestStorageServiceMock is a mock implementation of radio.RequestStorageService.
+// AuditStorageServiceMock is a mock implementation of radio.AuditStorageService.
 //
-//	func TestSomethingThatUsesRequestStorageService(t *testing.T) {
+//	func TestSomethingThatUsesAuditStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.RequestStorageService
-//		mockedRequestStorageService := &RequestStorageServiceMock{
-//			RequestFunc: func(contextMoqParam context.Context) radio.RequestStorage {
-//				panic("mock out the Request method")
+//		// make and configure a mocked radio.AuditStorageService
+//		mockedAuditStorageService := &AuditStorageServiceMock{
+//			AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+//				panic("mock out the Audit method")
 //			},
-//			RequestTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
-//				panic("mock out the RequestTx method")
+//			AuditTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error) {
+//				panic("mock out the AuditTx method")
 //			},
 //		}
 //
-//		// use mockedRequestStorageService in code that requires radio.RequestStorageService
+//		// use mockedAuditStorageService in code that requires radio.AuditStorageService
 //		// and then make assertions.
 //
 //	}
-type RequestStorageServiceMock struct {
-	// RequestFunc mocks the Request method.
-	RequestFunc func(contextMoqParam context.Context) radio.RequestStorage
+type AuditStorageServiceMock struct {
+	// AuditFunc mocks the Audit method.
+	AuditFunc func(contextMoqParam context.Context) radio.AuditStorage
 
-	// RequestTxFunc mocks the RequestTx method.
-	RequestTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error)
+	// AuditTxFunc mocks the AuditTx method.
+	AuditTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Request holds details about calls to the Request method.
-		Request []struct {
+		// Audit holds details about calls to the Audit method.
+		Audit []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
-		// RequestTx holds details about calls to the RequestTx method.
-		RequestTx []struct {
+		// AuditTx holds details about calls to the AuditTx method.
+		AuditTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
 	}
-	lockRequest   sync.RWMutex
-	lockRequestTx sync.RWMutex
+	lockAudit   sync.RWMutex
+	lockAuditTx sync.RWMutex
 }
 
-// Request calls RequestFunc.
-func (mock *RequestStorageServiceMock) Request(contextMoqParam context.Context) radio.RequestStorage {
-	if mock.RequestFunc == nil {
-		panic("RequestStorageServiceMock.RequestFunc: method is nil but RequestStorageService.Request was just called")
+// Audit calls AuditFunc.
+func (mock *AuditStorageServiceMock) Audit(contextMoqParam context.Context) radio.AuditStorage {
+	if mock.AuditFunc == nil {
+		panic("AuditStorageServiceMock.AuditFunc: method is nil but AuditStorageService.Audit was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockRequest.Lock()
-	mock.calls.Request = append(mock.calls.Request, callInfo)
-	mock.lockRequest.Unlock()
-	return mock.RequestFunc(contextMoqParam)
+	mock.lockAudit.Lock()
+	mock.calls.Audit = append(mock.calls.Audit, callInfo)
+	mock.lockAudit.Unlock()
+	return mock.AuditFunc(contextMoqParam)
 }
 
-// RequestCalls gets all the calls that were made to Request.
+// AuditCalls gets all the calls that were made to Audit.
 // Check the length with:
 //
-//	len(mockedRequestStorageService.RequestCalls())
-func (mock *RequestStorageServiceMock) RequestCalls() []struct {
+//	len(mockedAuditStorageService.AuditCalls())
+func (mock *AuditStorageServiceMock) AuditCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockRequest.RLock()
-	calls = mock.calls.Request
-	mock.lockRequest.RUnlock()
+	mock.lockAudit.RLock()
+	calls = mock.calls.Audit
+	mock.lockAudit.RUnlock()
 	return calls
 }
 
-// RequestTx calls RequestTxFunc.
-func (mock *RequestStorageServiceMock) RequestTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
-	if mock.RequestTxFunc == nil {
-		panic("RequestStorageServiceMock.RequestTxFunc: method is nil but RequestStorageService.RequestTx was just called")
+// AuditTx calls AuditTxFunc.
+func (mock *AuditStorageServiceMock) AuditTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error) {
+	if mock.AuditTxFunc == nil {
+		panic("AuditStorageServiceMock.AuditTxFunc: method is nil but AuditStorageService.AuditTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -4932,17 +9355,17 @@ func (mock *RequestStorageServiceMock) RequestTx(contextMoqParam context.Context
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockRequestTx.Lock()
-	mock.calls.RequestTx = append(mock.calls.RequestTx, callInfo)
-	mock.lockRequestTx.Unlock()
-	return mock.RequestTxFunc(contextMoqParam, storageTx)
+	mock.lockAuditTx.Lock()
+	mock.calls.AuditTx = append(mock.calls.AuditTx, callInfo)
+	mock.lockAuditTx.Unlock()
+	return mock.AuditTxFunc(contextMoqParam, storageTx)
 }
 
-// RequestTxCalls gets all the calls that were made to RequestTx.
+// AuditTxCalls gets all the calls that were made to AuditTx.
 // Check the length with:
 //
-//	len(mockedRequestStorageService.RequestTxCalls())
-func (mock *RequestStorageServiceMock) RequestTxCalls() []struct {
+//	len(mockedAuditStorageService.AuditTxCalls())
+func (mock *AuditStorageServiceMock) AuditTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -4950,206 +9373,218 @@ func (mock *RequestStorageServiceMock) RequestTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockRequestTx.RLock()
-	calls = mock.calls.RequestTx
-	mock.lockRequestTx.RUnlock()
+	mock.lockAuditTx.RLock()
+	calls = mock.calls.AuditTx
+	mock.lockAuditTx.RUnlock()
 	return calls
 }
 
-// Ensure, that RequestStorageMock does implement radio.RequestStorage.
+// Ensure, that AuditStorageMock does implement radio.AuditStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.RequestStorage = &RequestStorageMock{}
+var _ radio.AuditStorage = &AuditStorageMock{}
 
-// RequestStorageMock is a mock implementation of radio.RequestStorage.
+// AuditStorageMock is a mock implementation of radio.AuditStorage.
 //
-//	func TestSomethingThatUsesRequestStorage(t *testing.T) {
+//	func TestSomethingThatUsesAuditStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.RequestStorage
-//		mockedRequestStorage := &RequestStorageMock{
-//			LastRequestFunc: func(identifier string) (time.Time, error) {
-//				panic("mock out the LastRequest method")
+//		// make and configure a mocked radio.AuditStorage
+//		mockedAuditStorage := &AuditStorageMock{
+//			AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+//				panic("mock out the Add method")
 //			},
-//			UpdateLastRequestFunc: func(identifier string) error {
-//				panic("mock out the UpdateLastRequest method")
+//			SearchFunc: func(filter radio.AuditFilter, limit int64, offset int64) (radio.AuditList, error) {
+//				panic("mock out the Search method")
 //			},
 //		}
 //
-//		// use mockedRequestStorage in code that requires radio.RequestStorage
+//		// use mockedAuditStorage in code that requires radio.AuditStorage
 //		// and then make assertions.
 //
 //	}
-type RequestStorageMock struct {
-	// LastRequestFunc mocks the LastRequest method.
-	LastRequestFunc func(identifier string) (time.Time, error)
+type AuditStorageMock struct {
+	// AddFunc mocks the Add method.
+	AddFunc func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error)
 
-	// UpdateLastRequestFunc mocks the UpdateLastRequest method.
-	UpdateLastRequestFunc func(identifier string) error
+	// SearchFunc mocks the Search method.
+	SearchFunc func(filter radio.AuditFilter, limit int64, offset int64) (radio.AuditList, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// LastRequest holds details about calls to the LastRequest method.
-		LastRequest []struct {
-			// Identifier is the identifier argument value.
-			Identifier string
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// AuditEntry is the auditEntry argument value.
+			AuditEntry radio.AuditEntry
 		}
-		// UpdateLastRequest holds details about calls to the UpdateLastRequest method.
-		UpdateLastRequest []struct {
-			// Identifier is the identifier argument value.
-			Identifier string
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Filter is the filter argument value.
+			Filter radio.AuditFilter
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
 		}
 	}
-	lockLastRequest       sync.RWMutex
-	lockUpdateLastRequest sync.RWMutex
+	lockAdd    sync.RWMutex
+	lockSearch sync.RWMutex
 }
 
-// LastRequest calls LastRequestFunc.
-func (mock *RequestStorageMock) LastRequest(identifier string) (time.Time, error) {
-	if mock.LastRequestFunc == nil {
-		panic("RequestStorageMock.LastRequestFunc: method is nil but RequestStorage.LastRequest was just called")
+// Add calls AddFunc.
+func (mock *AuditStorageMock) Add(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+	if mock.AddFunc == nil {
+		panic("AuditStorageMock.AddFunc: method is nil but AuditStorage.Add was just called")
 	}
 	callInfo := struct {
-		Identifier string
+		AuditEntry radio.AuditEntry
 	}{
-		Identifier: identifier,
+		AuditEntry: auditEntry,
 	}
-	mock.lockLastRequest.Lock()
-	mock.calls.LastRequest = append(mock.calls.LastRequest, callInfo)
-	mock.lockLastRequest.Unlock()
-	return mock.LastRequestFunc(identifier)
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(auditEntry)
 }
 
-// LastRequestCalls gets all the calls that were made to LastRequest.
+// AddCalls gets all the calls that were made to Add.
 // Check the length with:
 //
-//	len(mockedRequestStorage.LastRequestCalls())
-func (mock *RequestStorageMock) LastRequestCalls() []struct {
-	Identifier string
+//	len(mockedAuditStorage.AddCalls())
+func (mock *AuditStorageMock) AddCalls() []struct {
+	AuditEntry radio.AuditEntry
 } {
 	var calls []struct {
-		Identifier string
+		AuditEntry radio.AuditEntry
 	}
-	mock.lockLastRequest.RLock()
-	calls = mock.calls.LastRequest
-	mock.lockLastRequest.RUnlock()
+	mock.lockAdd.RLock()
+	calls = mock.calls.Add
+	mock.lockAdd.RUnlock()
 	return calls
 }
 
-// UpdateLastRequest calls UpdateLastRequestFunc.
-func (mock *RequestStorageMock) UpdateLastRequest(identifier string) error {
-	if mock.UpdateLastRequestFunc == nil {
-		panic("RequestStorageMock.UpdateLastRequestFunc: method is nil but RequestStorage.UpdateLastRequest was just called")
+// Search calls SearchFunc.
+func (mock *AuditStorageMock) Search(filter radio.AuditFilter, limit int64, offset int64) (radio.AuditList, error) {
+	if mock.SearchFunc == nil {
+		panic("AuditStorageMock.SearchFunc: method is nil but AuditStorage.Search was just called")
 	}
 	callInfo := struct {
-		Identifier string
+		Filter radio.AuditFilter
+		Limit  int64
+		Offset int64
 	}{
-		Identifier: identifier,
+		Filter: filter,
+		Limit:  limit,
+		Offset: offset,
 	}
-	mock.lockUpdateLastRequest.Lock()
-	mock.calls.UpdateLastRequest = append(mock.calls.UpdateLastRequest, callInfo)
-	mock.lockUpdateLastRequest.Unlock()
-	return mock.UpdateLastRequestFunc(identifier)
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(filter, limit, offset)
 }
 
-// UpdateLastRequestCalls gets all the calls that were made to UpdateLastRequest.
+// SearchCalls gets all the calls that were made to Search.
 // Check the length with:
 //
-//	len(mockedRequestStorage.UpdateLastRequestCalls())
-func (mock *RequestStorageMock) UpdateLastRequestCalls() []struct {
-	Identifier string
+//	len(mockedAuditStorage.SearchCalls())
+func (mock *AuditStorageMock) SearchCalls() []struct {
+	Filter radio.AuditFilter
+	Limit  int64
+	Offset int64
 } {
 	var calls []struct {
-		Identifier string
+		Filter radio.AuditFilter
+		Limit  int64
+		Offset int64
 	}
-	mock.lockUpdateLastRequest.RLock()
-	calls = mock.calls.UpdateLastRequest
-	mock.lockUpdateLastRequest.RUnlock()
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
 	return calls
 }
 
-// Ensure, that UserStorageServiceMock does implement radio.UserStorageService.
+// Ensure, that ListenerAccountStorageServiceMock does implement radio.ListenerAccountStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.UserStorageService = &UserStorageServiceMock{}
+var _ radio.ListenerAccountStorageService = &ListenerAccountStorageServiceMock{}
 
-// UserStorageServiceMock is a mock implementation of radio.UserStorageService.
+// ListenerAccountStorageServiceMock is a mock implementation of radio.ListenerAccountStorageService.
 //
-//	func TestSomethingThatUsesUserStorageService(t *testing.T) {
+//	func TestSomethingThatUsesListenerAccountStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.UserStorageService
-//		mockedUserStorageService := &UserStorageServiceMock{
-//			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
-//				panic("mock out the User method")
+//		// make and configure a mocked radio.ListenerAccountStorageService
+//		mockedListenerAccountStorageService := &ListenerAccountStorageServiceMock{
+//			ListenerAccountFunc: func(contextMoqParam context.Context) radio.ListenerAccountStorage {
+//				panic("mock out the ListenerAccount method")
 //			},
-//			UserTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
-//				panic("mock out the UserTx method")
+//			ListenerAccountTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error) {
+//				panic("mock out the ListenerAccountTx method")
 //			},
 //		}
 //
-//		// use mockedUserStorageService in code that requires radio.UserStorageService
+//		// use mockedListenerAccountStorageService in code that requires radio.ListenerAccountStorageService
 //		// and then make assertions.
 //
 //	}
-type UserStorageServiceMock struct {
-	// UserFunc mocks the User method.
-	UserFunc func(contextMoqParam context.Context) radio.UserStorage
+type ListenerAccountStorageServiceMock struct {
+	// ListenerAccountFunc mocks the ListenerAccount method.
+	ListenerAccountFunc func(contextMoqParam context.Context) radio.ListenerAccountStorage
 
-	// UserTxFunc mocks the UserTx method.
-	UserTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error)
+	// ListenerAccountTxFunc mocks the ListenerAccountTx method.
+	ListenerAccountTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// User holds details about calls to the User method.
-		User []struct {
+		// ListenerAccount holds details about calls to the ListenerAccount method.
+		ListenerAccount []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
-		// UserTx holds details about calls to the UserTx method.
-		UserTx []struct {
+		// ListenerAccountTx holds details about calls to the ListenerAccountTx method.
+		ListenerAccountTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
 	}
-	lockUser   sync.RWMutex
-	lockUserTx sync.RWMutex
+	lockListenerAccount   sync.RWMutex
+	lockListenerAccountTx sync.RWMutex
 }
 
-// User calls UserFunc.
-func (mock *UserStorageServiceMock) User(contextMoqParam context.Context) radio.UserStorage {
-	if mock.UserFunc == nil {
-		panic("UserStorageServiceMock.UserFunc: method is nil but UserStorageService.User was just called")
+// ListenerAccount calls ListenerAccountFunc.
+func (mock *ListenerAccountStorageServiceMock) ListenerAccount(contextMoqParam context.Context) radio.ListenerAccountStorage {
+	if mock.ListenerAccountFunc == nil {
+		panic("ListenerAccountStorageServiceMock.ListenerAccountFunc: method is nil but ListenerAccountStorageService.ListenerAccount was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockUser.Lock()
-	mock.calls.User = append(mock.calls.User, callInfo)
-	mock.lockUser.Unlock()
-	return mock.UserFunc(contextMoqParam)
+	mock.lockListenerAccount.Lock()
+	mock.calls.ListenerAccount = append(mock.calls.ListenerAccount, callInfo)
+	mock.lockListenerAccount.Unlock()
+	return mock.ListenerAccountFunc(contextMoqParam)
 }
 
-// UserCalls gets all the calls that were made to User.
+// ListenerAccountCalls gets all the calls that were made to ListenerAccount.
 // Check the length with:
 //
-//	len(mockedUserStorageService.UserCalls())
-func (mock *UserStorageServiceMock) UserCalls() []struct {
+//	len(mockedListenerAccountStorageService.ListenerAccountCalls())
+func (mock *ListenerAccountStorageServiceMock) ListenerAccountCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockUser.RLock()
-	calls = mock.calls.User
-	mock.lockUser.RUnlock()
+	mock.lockListenerAccount.RLock()
+	calls = mock.calls.ListenerAccount
+	mock.lockListenerAccount.RUnlock()
 	return calls
 }
 
-// UserTx calls UserTxFunc.
-func (mock *UserStorageServiceMock) UserTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
-	if mock.UserTxFunc == nil {
-		panic("UserStorageServiceMock.UserTxFunc: method is nil but UserStorageService.UserTx was just called")
+// ListenerAccountTx calls ListenerAccountTxFunc.
+func (mock *ListenerAccountStorageServiceMock) ListenerAccountTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error) {
+	if mock.ListenerAccountTxFunc == nil {
+		panic("ListenerAccountStorageServiceMock.ListenerAccountTxFunc: method is nil but ListenerAccountStorageService.ListenerAccountTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -5158,17 +9593,17 @@ func (mock *UserStorageServiceMock) UserTx(contextMoqParam context.Context, stor
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockUserTx.Lock()
-	mock.calls.UserTx = append(mock.calls.UserTx, callInfo)
-	mock.lockUserTx.Unlock()
-	return mock.UserTxFunc(contextMoqParam, storageTx)
+	mock.lockListenerAccountTx.Lock()
+	mock.calls.ListenerAccountTx = append(mock.calls.ListenerAccountTx, callInfo)
+	mock.lockListenerAccountTx.Unlock()
+	return mock.ListenerAccountTxFunc(contextMoqParam, storageTx)
 }
 
-// UserTxCalls gets all the calls that were made to UserTx.
+// ListenerAccountTxCalls gets all the calls that were made to ListenerAccountTx.
 // Check the length with:
 //
-//	len(mockedUserStorageService.UserTxCalls())
-func (mock *UserStorageServiceMock) UserTxCalls() []struct {
+//	len(mockedListenerAccountStorageService.ListenerAccountTxCalls())
+func (mock *ListenerAccountStorageServiceMock) ListenerAccountTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -5176,250 +9611,241 @@ func (mock *UserStorageServiceMock) UserTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockUserTx.RLock()
-	calls = mock.calls.UserTx
-	mock.lockUserTx.RUnlock()
+	mock.lockListenerAccountTx.RLock()
+	calls = mock.calls.ListenerAccountTx
+	mock.lockListenerAccountTx.RUnlock()
 	return calls
 }
 
-// Ensure, that UserStorageMock does implement radio.UserStorage.
+// Ensure, that ListenerAccountStorageMock does implement radio.ListenerAccountStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.UserStorage = &UserStorageMock{}
+var _ radio.ListenerAccountStorage = &ListenerAccountStorageMock{}
 
-// UserStorageMock is a mock implementation of radio.UserStorage.
+// ListenerAccountStorageMock is a mock implementation of radio.ListenerAccountStorage.
 //
-//	func TestSomethingThatUsesUserStorage(t *testing.T) {
+//	func TestSomethingThatUsesListenerAccountStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.UserStorage
-//		mockedUserStorage := &UserStorageMock{
-//			AllFunc: func() ([]radio.User, error) {
-//				panic("mock out the All method")
+//		// make and configure a mocked radio.ListenerAccountStorage
+//		mockedListenerAccountStorage := &ListenerAccountStorageMock{
+//			AddRequestFunc: func(listenerAccountID radio.ListenerAccountID, trackID radio.TrackID) error {
+//				panic("mock out the AddRequest method")
 //			},
-//			ByNickFunc: func(nick string) (*radio.User, error) {
-//				panic("mock out the ByNick method")
+//			ClaimNickFunc: func(code string, nick string) (*radio.ListenerAccount, error) {
+//				panic("mock out the ClaimNick method")
 //			},
-//			CreateFunc: func(user radio.User) (radio.UserID, error) {
+//			CreateFunc: func(listenerAccount radio.ListenerAccount) (radio.ListenerAccountID, error) {
 //				panic("mock out the Create method")
 //			},
-//			CreateDJFunc: func(user radio.User, dJ radio.DJ) (radio.DJID, error) {
-//				panic("mock out the CreateDJ method")
-//			},
-//			GetFunc: func(name string) (*radio.User, error) {
+//			GetFunc: func(username string) (*radio.ListenerAccount, error) {
 //				panic("mock out the Get method")
 //			},
-//			GetByDJIDFunc: func(dJID radio.DJID) (*radio.User, error) {
-//				panic("mock out the GetByDJID method")
-//			},
-//			GetByIDFunc: func(userID radio.UserID) (*radio.User, error) {
-//				panic("mock out the GetByID method")
-//			},
-//			LookupNameFunc: func(name string) (*radio.User, error) {
-//				panic("mock out the LookupName method")
+//			GetByNickFunc: func(nick string) (*radio.ListenerAccount, error) {
+//				panic("mock out the GetByNick method")
 //			},
-//			PermissionsFunc: func() ([]radio.UserPermission, error) {
-//				panic("mock out the Permissions method")
+//			RequestsFunc: func(id radio.ListenerAccountID, limit int64, offset int64) (radio.ListenerRequestList, error) {
+//				panic("mock out the Requests method")
 //			},
-//			RecordListenersFunc: func(v radio.Listeners, user radio.User) error {
-//				panic("mock out the RecordListeners method")
+//			SetClaimCodeFunc: func(id radio.ListenerAccountID, code string, expires time.Time) error {
+//				panic("mock out the SetClaimCode method")
 //			},
-//			UpdateFunc: func(user radio.User) (radio.User, error) {
-//				panic("mock out the Update method")
+//			TopSongsFunc: func(id radio.ListenerAccountID, limit int64) ([]radio.ListenerSongCount, error) {
+//				panic("mock out the TopSongs method")
 //			},
 //		}
 //
-//		// use mockedUserStorage in code that requires radio.UserStorage
+//		// use mockedListenerAccountStorage in code that requires radio.ListenerAccountStorage
 //		// and then make assertions.
 //
 //	}
-type UserStorageMock struct {
-	// AllFunc mocks the All method.
-	AllFunc func() ([]radio.User, error)
+type ListenerAccountStorageMock struct {
+	// AddRequestFunc mocks the AddRequest method.
+	AddRequestFunc func(listenerAccountID radio.ListenerAccountID, trackID radio.TrackID) error
 
-	// ByNickFunc mocks the ByNick method.
-	ByNickFunc func(nick string) (*radio.User, error)
+	// ClaimNickFunc mocks the ClaimNick method.
+	ClaimNickFunc func(code string, nick string) (*radio.ListenerAccount, error)
 
 	// CreateFunc mocks the Create method.
-	CreateFunc func(user radio.User) (radio.UserID, error)
-
-	// CreateDJFunc mocks the CreateDJ method.
-	CreateDJFunc func(user radio.User, dJ radio.DJ) (radio.DJID, error)
+	CreateFunc func(listenerAccount radio.ListenerAccount) (radio.ListenerAccountID, error)
 
 	// GetFunc mocks the Get method.
-	GetFunc func(name string) (*radio.User, error)
-
-	// GetByDJIDFunc mocks the GetByDJID method.
-	GetByDJIDFunc func(dJID radio.DJID) (*radio.User, error)
-
-	// GetByIDFunc mocks the GetByID method.
-	GetByIDFunc func(userID radio.UserID) (*radio.User, error)
+	GetFunc func(username string) (*radio.ListenerAccount, error)
 
-	// LookupNameFunc mocks the LookupName method.
-	LookupNameFunc func(name string) (*radio.User, error)
+	// GetByNickFunc mocks the GetByNick method.
+	GetByNickFunc func(nick string) (*radio.ListenerAccount, error)
 
-	// PermissionsFunc mocks the Permissions method.
-	PermissionsFunc func() ([]radio.UserPermission, error)
+	// RequestsFunc mocks the Requests method.
+	RequestsFunc func(id radio.ListenerAccountID, limit int64, offset int64) (radio.ListenerRequestList, error)
 
-	// RecordListenersFunc mocks the RecordListeners method.
-	RecordListenersFunc func(v radio.Listeners, user radio.User) error
+	// SetClaimCodeFunc mocks the SetClaimCode method.
+	SetClaimCodeFunc func(id radio.ListenerAccountID, code string, expires time.Time) error
 
-	// UpdateFunc mocks the Update method.
-	UpdateFunc func(user radio.User) (radio.User, error)
+	// TopSongsFunc mocks the TopSongs method.
+	TopSongsFunc func(id radio.ListenerAccountID, limit int64) ([]radio.ListenerSongCount, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// All holds details about calls to the All method.
-		All []struct {
+		// AddRequest holds details about calls to the AddRequest method.
+		AddRequest []struct {
+			// ListenerAccountID is the listenerAccountID argument value.
+			ListenerAccountID radio.ListenerAccountID
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
 		}
-		// ByNick holds details about calls to the ByNick method.
-		ByNick []struct {
+		// ClaimNick holds details about calls to the ClaimNick method.
+		ClaimNick []struct {
+			// Code is the code argument value.
+			Code string
 			// Nick is the nick argument value.
 			Nick string
 		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
-			// User is the user argument value.
-			User radio.User
-		}
-		// CreateDJ holds details about calls to the CreateDJ method.
-		CreateDJ []struct {
-			// User is the user argument value.
-			User radio.User
-			// DJ is the dJ argument value.
-			DJ radio.DJ
+			// ListenerAccount is the listenerAccount argument value.
+			ListenerAccount radio.ListenerAccount
 		}
 		// Get holds details about calls to the Get method.
 		Get []struct {
-			// Name is the name argument value.
-			Name string
-		}
-		// GetByDJID holds details about calls to the GetByDJID method.
-		GetByDJID []struct {
-			// DJID is the dJID argument value.
-			DJID radio.DJID
-		}
-		// GetByID holds details about calls to the GetByID method.
-		GetByID []struct {
-			// UserID is the userID argument value.
-			UserID radio.UserID
-		}
-		// LookupName holds details about calls to the LookupName method.
-		LookupName []struct {
-			// Name is the name argument value.
-			Name string
+			// Username is the username argument value.
+			Username string
 		}
-		// Permissions holds details about calls to the Permissions method.
-		Permissions []struct {
+		// GetByNick holds details about calls to the GetByNick method.
+		GetByNick []struct {
+			// Nick is the nick argument value.
+			Nick string
 		}
-		// RecordListeners holds details about calls to the RecordListeners method.
-		RecordListeners []struct {
-			// V is the v argument value.
-			V radio.Listeners
-			// User is the user argument value.
-			User radio.User
+		// Requests holds details about calls to the Requests method.
+		Requests []struct {
+			// Id is the id argument value.
+			Id radio.ListenerAccountID
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
 		}
-		// Update holds details about calls to the Update method.
-		Update []struct {
-			// User is the user argument value.
-			User radio.User
+		// SetClaimCode holds details about calls to the SetClaimCode method.
+		SetClaimCode []struct {
+			// Id is the id argument value.
+			Id radio.ListenerAccountID
+			// Code is the code argument value.
+			Code string
+			// Expires is the expires argument value.
+			Expires time.Time
+		}
+		// TopSongs holds details about calls to the TopSongs method.
+		TopSongs []struct {
+			// Id is the id argument value.
+			Id radio.ListenerAccountID
+			// Limit is the limit argument value.
+			Limit int64
 		}
 	}
-	lockAll             sync.RWMutex
-	lockByNick          sync.RWMutex
-	lockCreate          sync.RWMutex
-	lockCreateDJ        sync.RWMutex
-	lockGet             sync.RWMutex
-	lockGetByDJID       sync.RWMutex
-	lockGetByID         sync.RWMutex
-	lockLookupName      sync.RWMutex
-	lockPermissions     sync.RWMutex
-	lockRecordListeners sync.RWMutex
-	lockUpdate          sync.RWMutex
+	lockAddRequest   sync.RWMutex
+	lockClaimNick    sync.RWMutex
+	lockCreate       sync.RWMutex
+	lockGet          sync.RWMutex
+	lockGetByNick    sync.RWMutex
+	lockRequests     sync.RWMutex
+	lockSetClaimCode sync.RWMutex
+	lockTopSongs     sync.RWMutex
 }
 
-// All calls AllFunc.
-func (mock *UserStorageMock) All() ([]radio.User, error) {
-	if mock.AllFunc == nil {
-		panic("UserStorageMock.AllFunc: method is nil but UserStorage.All was just called")
+// AddRequest calls AddRequestFunc.
+func (mock *ListenerAccountStorageMock) AddRequest(listenerAccountID radio.ListenerAccountID, trackID radio.TrackID) error {
+	if mock.AddRequestFunc == nil {
+		panic("ListenerAccountStorageMock.AddRequestFunc: method is nil but ListenerAccountStorage.AddRequest was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockAll.Lock()
-	mock.calls.All = append(mock.calls.All, callInfo)
-	mock.lockAll.Unlock()
-	return mock.AllFunc()
+		ListenerAccountID radio.ListenerAccountID
+		TrackID           radio.TrackID
+	}{
+		ListenerAccountID: listenerAccountID,
+		TrackID:           trackID,
+	}
+	mock.lockAddRequest.Lock()
+	mock.calls.AddRequest = append(mock.calls.AddRequest, callInfo)
+	mock.lockAddRequest.Unlock()
+	return mock.AddRequestFunc(listenerAccountID, trackID)
 }
 
-// AllCalls gets all the calls that were made to All.
+// AddRequestCalls gets all the calls that were made to AddRequest.
 // Check the length with:
 //
-//	len(mockedUserStorage.AllCalls())
-func (mock *UserStorageMock) AllCalls() []struct {
+//	len(mockedListenerAccountStorage.AddRequestCalls())
+func (mock *ListenerAccountStorageMock) AddRequestCalls() []struct {
+	ListenerAccountID radio.ListenerAccountID
+	TrackID           radio.TrackID
 } {
 	var calls []struct {
+		ListenerAccountID radio.ListenerAccountID
+		TrackID           radio.TrackID
 	}
-	mock.lockAll.RLock()
-	calls = mock.calls.All
-	mock.lockAll.RUnlock()
+	mock.lockAddRequest.RLock()
+	calls = mock.calls.AddRequest
+	mock.lockAddRequest.RUnlock()
 	return calls
 }
 
-// ByNick calls ByNickFunc.
-func (mock *UserStorageMock) ByNick(nick string) (*radio.User, error) {
-	if mock.ByNickFunc == nil {
-		panic("UserStorageMock.ByNickFunc: method is nil but UserStorage.ByNick was just called")
+// ClaimNick calls ClaimNickFunc.
+func (mock *ListenerAccountStorageMock) ClaimNick(code string, nick string) (*radio.ListenerAccount, error) {
+	if mock.ClaimNickFunc == nil {
+		panic("ListenerAccountStorageMock.ClaimNickFunc: method is nil but ListenerAccountStorage.ClaimNick was just called")
 	}
 	callInfo := struct {
+		Code string
 		Nick string
 	}{
+		Code: code,
 		Nick: nick,
 	}
-	mock.lockByNick.Lock()
-	mock.calls.ByNick = append(mock.calls.ByNick, callInfo)
-	mock.lockByNick.Unlock()
-	return mock.ByNickFunc(nick)
+	mock.lockClaimNick.Lock()
+	mock.calls.ClaimNick = append(mock.calls.ClaimNick, callInfo)
+	mock.lockClaimNick.Unlock()
+	return mock.ClaimNickFunc(code, nick)
 }
 
-// ByNickCalls gets all the calls that were made to ByNick.
+// ClaimNickCalls gets all the calls that were made to ClaimNick.
 // Check the length with:
 //
-//	len(mockedUserStorage.ByNickCalls())
-func (mock *UserStorageMock) ByNickCalls() []struct {
+//	len(mockedListenerAccountStorage.ClaimNickCalls())
+func (mock *ListenerAccountStorageMock) ClaimNickCalls() []struct {
+	Code string
 	Nick string
 } {
 	var calls []struct {
+		Code string
 		Nick string
 	}
-	mock.lockByNick.RLock()
-	calls = mock.calls.ByNick
-	mock.lockByNick.RUnlock()
+	mock.lockClaimNick.RLock()
+	calls = mock.calls.ClaimNick
+	mock.lockClaimNick.RUnlock()
 	return calls
 }
 
 // Create calls CreateFunc.
-func (mock *UserStorageMock) Create(user radio.User) (radio.UserID, error) {
+func (mock *ListenerAccountStorageMock) Create(listenerAccount radio.ListenerAccount) (radio.ListenerAccountID, error) {
 	if mock.CreateFunc == nil {
-		panic("UserStorageMock.CreateFunc: method is nil but UserStorage.Create was just called")
+		panic("ListenerAccountStorageMock.CreateFunc: method is nil but ListenerAccountStorage.Create was just called")
 	}
 	callInfo := struct {
-		User radio.User
+		ListenerAccount radio.ListenerAccount
 	}{
-		User: user,
+		ListenerAccount: listenerAccount,
 	}
 	mock.lockCreate.Lock()
 	mock.calls.Create = append(mock.calls.Create, callInfo)
 	mock.lockCreate.Unlock()
-	return mock.CreateFunc(user)
+	return mock.CreateFunc(listenerAccount)
 }
 
 // CreateCalls gets all the calls that were made to Create.
 // Check the length with:
 //
-//	len(mockedUserStorage.CreateCalls())
-func (mock *UserStorageMock) CreateCalls() []struct {
-	User radio.User
+//	len(mockedListenerAccountStorage.CreateCalls())
+func (mock *ListenerAccountStorageMock) CreateCalls() []struct {
+	ListenerAccount radio.ListenerAccount
 } {
 	var calls []struct {
-		User radio.User
+		ListenerAccount radio.ListenerAccount
 	}
 	mock.lockCreate.RLock()
 	calls = mock.calls.Create
@@ -5427,1810 +9853,2357 @@ func (mock *UserStorageMock) CreateCalls() []struct {
 	return calls
 }
 
-// CreateDJ calls CreateDJFunc.
-func (mock *UserStorageMock) CreateDJ(user radio.User, dJ radio.DJ) (radio.DJID, error) {
-	if mock.CreateDJFunc == nil {
-		panic("UserStorageMock.CreateDJFunc: method is nil but UserStorage.CreateDJ was just called")
+// Get calls GetFunc.
+func (mock *ListenerAccountStorageMock) Get(username string) (*radio.ListenerAccount, error) {
+	if mock.GetFunc == nil {
+		panic("ListenerAccountStorageMock.GetFunc: method is nil but ListenerAccountStorage.Get was just called")
 	}
 	callInfo := struct {
-		User radio.User
-		DJ   radio.DJ
+		Username string
 	}{
-		User: user,
-		DJ:   dJ,
+		Username: username,
 	}
-	mock.lockCreateDJ.Lock()
-	mock.calls.CreateDJ = append(mock.calls.CreateDJ, callInfo)
-	mock.lockCreateDJ.Unlock()
-	return mock.CreateDJFunc(user, dJ)
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(username)
 }
 
-// CreateDJCalls gets all the calls that were made to CreateDJ.
+// GetCalls gets all the calls that were made to Get.
 // Check the length with:
 //
-//	len(mockedUserStorage.CreateDJCalls())
-func (mock *UserStorageMock) CreateDJCalls() []struct {
-	User radio.User
-	DJ   radio.DJ
+//	len(mockedListenerAccountStorage.GetCalls())
+func (mock *ListenerAccountStorageMock) GetCalls() []struct {
+	Username string
 } {
 	var calls []struct {
-		User radio.User
-		DJ   radio.DJ
+		Username string
 	}
-	mock.lockCreateDJ.RLock()
-	calls = mock.calls.CreateDJ
-	mock.lockCreateDJ.RUnlock()
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *UserStorageMock) Get(name string) (*radio.User, error) {
-	if mock.GetFunc == nil {
-		panic("UserStorageMock.GetFunc: method is nil but UserStorage.Get was just called")
+// GetByNick calls GetByNickFunc.
+func (mock *ListenerAccountStorageMock) GetByNick(nick string) (*radio.ListenerAccount, error) {
+	if mock.GetByNickFunc == nil {
+		panic("ListenerAccountStorageMock.GetByNickFunc: method is nil but ListenerAccountStorage.GetByNick was just called")
 	}
 	callInfo := struct {
-		Name string
+		Nick string
 	}{
-		Name: name,
+		Nick: nick,
 	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	return mock.GetFunc(name)
+	mock.lockGetByNick.Lock()
+	mock.calls.GetByNick = append(mock.calls.GetByNick, callInfo)
+	mock.lockGetByNick.Unlock()
+	return mock.GetByNickFunc(nick)
 }
 
-// GetCalls gets all the calls that were made to Get.
+// GetByNickCalls gets all the calls that were made to GetByNick.
 // Check the length with:
 //
-//	len(mockedUserStorage.GetCalls())
-func (mock *UserStorageMock) GetCalls() []struct {
-	Name string
+//	len(mockedListenerAccountStorage.GetByNickCalls())
+func (mock *ListenerAccountStorageMock) GetByNickCalls() []struct {
+	Nick string
 } {
 	var calls []struct {
-		Name string
+		Nick string
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockGetByNick.RLock()
+	calls = mock.calls.GetByNick
+	mock.lockGetByNick.RUnlock()
 	return calls
 }
 
-// GetByDJID calls GetByDJIDFunc.
-func (mock *UserStorageMock) GetByDJID(dJID radio.DJID) (*radio.User, error) {
-	if mock.GetByDJIDFunc == nil {
-		panic("UserStorageMock.GetByDJIDFunc: method is nil but UserStorage.GetByDJID was just called")
+// Requests calls RequestsFunc.
+func (mock *ListenerAccountStorageMock) Requests(id radio.ListenerAccountID, limit int64, offset int64) (radio.ListenerRequestList, error) {
+	if mock.RequestsFunc == nil {
+		panic("ListenerAccountStorageMock.RequestsFunc: method is nil but ListenerAccountStorage.Requests was just called")
 	}
 	callInfo := struct {
-		DJID radio.DJID
+		Id     radio.ListenerAccountID
+		Limit  int64
+		Offset int64
 	}{
-		DJID: dJID,
+		Id:     id,
+		Limit:  limit,
+		Offset: offset,
 	}
-	mock.lockGetByDJID.Lock()
-	mock.calls.GetByDJID = append(mock.calls.GetByDJID, callInfo)
-	mock.lockGetByDJID.Unlock()
-	return mock.GetByDJIDFunc(dJID)
+	mock.lockRequests.Lock()
+	mock.calls.Requests = append(mock.calls.Requests, callInfo)
+	mock.lockRequests.Unlock()
+	return mock.RequestsFunc(id, limit, offset)
 }
 
-// GetByDJIDCalls gets all the calls that were made to GetByDJID.
+// RequestsCalls gets all the calls that were made to Requests.
 // Check the length with:
 //
-//	len(mockedUserStorage.GetByDJIDCalls())
-func (mock *UserStorageMock) GetByDJIDCalls() []struct {
-	DJID radio.DJID
+//	len(mockedListenerAccountStorage.RequestsCalls())
+func (mock *ListenerAccountStorageMock) RequestsCalls() []struct {
+	Id     radio.ListenerAccountID
+	Limit  int64
+	Offset int64
 } {
 	var calls []struct {
-		DJID radio.DJID
+		Id     radio.ListenerAccountID
+		Limit  int64
+		Offset int64
 	}
-	mock.lockGetByDJID.RLock()
-	calls = mock.calls.GetByDJID
-	mock.lockGetByDJID.RUnlock()
+	mock.lockRequests.RLock()
+	calls = mock.calls.Requests
+	mock.lockRequests.RUnlock()
 	return calls
 }
 
-// GetByID calls GetByIDFunc.
-func (mock *UserStorageMock) GetByID(userID radio.UserID) (*radio.User, error) {
-	if mock.GetByIDFunc == nil {
-		panic("UserStorageMock.GetByIDFunc: method is nil but UserStorage.GetByID was just called")
+// SetClaimCode calls SetClaimCodeFunc.
+func (mock *ListenerAccountStorageMock) SetClaimCode(id radio.ListenerAccountID, code string, expires time.Time) error {
+	if mock.SetClaimCodeFunc == nil {
+		panic("ListenerAccountStorageMock.SetClaimCodeFunc: method is nil but ListenerAccountStorage.SetClaimCode was just called")
 	}
 	callInfo := struct {
-		UserID radio.UserID
+		Id      radio.ListenerAccountID
+		Code    string
+		Expires time.Time
 	}{
-		UserID: userID,
+		Id:      id,
+		Code:    code,
+		Expires: expires,
 	}
-	mock.lockGetByID.Lock()
-	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
-	mock.lockGetByID.Unlock()
-	return mock.GetByIDFunc(userID)
+	mock.lockSetClaimCode.Lock()
+	mock.calls.SetClaimCode = append(mock.calls.SetClaimCode, callInfo)
+	mock.lockSetClaimCode.Unlock()
+	return mock.SetClaimCodeFunc(id, code, expires)
 }
 
-// GetByIDCalls gets all the calls that were made to GetByID.
+// SetClaimCodeCalls gets all the calls that were made to SetClaimCode.
 // Check the length with:
 //
-//	len(mockedUserStorage.GetByIDCalls())
-func (mock *UserStorageMock) GetByIDCalls() []struct {
-	UserID radio.UserID
+//	len(mockedListenerAccountStorage.SetClaimCodeCalls())
+func (mock *ListenerAccountStorageMock) SetClaimCodeCalls() []struct {
+	Id      radio.ListenerAccountID
+	Code    string
+	Expires time.Time
 } {
 	var calls []struct {
-		UserID radio.UserID
+		Id      radio.ListenerAccountID
+		Code    string
+		Expires time.Time
 	}
-	mock.lockGetByID.RLock()
-	calls = mock.calls.GetByID
-	mock.lockGetByID.RUnlock()
+	mock.lockSetClaimCode.RLock()
+	calls = mock.calls.SetClaimCode
+	mock.lockSetClaimCode.RUnlock()
 	return calls
 }
 
-// LookupName calls LookupNameFunc.
-func (mock *UserStorageMock) LookupName(name string) (*radio.User, error) {
-	if mock.LookupNameFunc == nil {
-		panic("UserStorageMock.LookupNameFunc: method is nil but UserStorage.LookupName was just called")
+// TopSongs calls TopSongsFunc.
+func (mock *ListenerAccountStorageMock) TopSongs(id radio.ListenerAccountID, limit int64) ([]radio.ListenerSongCount, error) {
+	if mock.TopSongsFunc == nil {
+		panic("ListenerAccountStorageMock.TopSongsFunc: method is nil but ListenerAccountStorage.TopSongs was just called")
 	}
 	callInfo := struct {
-		Name string
+		Id    radio.ListenerAccountID
+		Limit int64
 	}{
-		Name: name,
+		Id:    id,
+		Limit: limit,
 	}
-	mock.lockLookupName.Lock()
-	mock.calls.LookupName = append(mock.calls.LookupName, callInfo)
-	mock.lockLookupName.Unlock()
-	return mock.LookupNameFunc(name)
+	mock.lockTopSongs.Lock()
+	mock.calls.TopSongs = append(mock.calls.TopSongs, callInfo)
+	mock.lockTopSongs.Unlock()
+	return mock.TopSongsFunc(id, limit)
 }
 
-// LookupNameCalls gets all the calls that were made to LookupName.
+// TopSongsCalls gets all the calls that were made to TopSongs.
 // Check the length with:
 //
-//	len(mockedUserStorage.LookupNameCalls())
-func (mock *UserStorageMock) LookupNameCalls() []struct {
-	Name string
+//	len(mockedListenerAccountStorage.TopSongsCalls())
+func (mock *ListenerAccountStorageMock) TopSongsCalls() []struct {
+	Id    radio.ListenerAccountID
+	Limit int64
 } {
 	var calls []struct {
-		Name string
+		Id    radio.ListenerAccountID
+		Limit int64
 	}
-	mock.lockLookupName.RLock()
-	calls = mock.calls.LookupName
-	mock.lockLookupName.RUnlock()
+	mock.lockTopSongs.RLock()
+	calls = mock.calls.TopSongs
+	mock.lockTopSongs.RUnlock()
 	return calls
 }
 
-// Permissions calls PermissionsFunc.
-func (mock *UserStorageMock) Permissions() ([]radio.UserPermission, error) {
-	if mock.PermissionsFunc == nil {
-		panic("UserStorageMock.PermissionsFunc: method is nil but UserStorage.Permissions was just called")
+// Ensure, that FingerprintStorageServiceMock does implement radio.FingerprintStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.FingerprintStorageService = &FingerprintStorageServiceMock{}
+
+// FingerprintStorageServiceMock is a mock implementation of radio.FingerprintStorageService.
+//
+//	func TestSomethingThatUsesFingerprintStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.FingerprintStorageService
+//		mockedFingerprintStorageService := &FingerprintStorageServiceMock{
+//			FingerprintFunc: func(contextMoqParam context.Context) radio.FingerprintStorage {
+//				panic("mock out the Fingerprint method")
+//			},
+//			FingerprintTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+//				panic("mock out the FingerprintTx method")
+//			},
+//		}
+//
+//		// use mockedFingerprintStorageService in code that requires radio.FingerprintStorageService
+//		// and then make assertions.
+//
+//	}
+type FingerprintStorageServiceMock struct {
+	// FingerprintFunc mocks the Fingerprint method.
+	FingerprintFunc func(contextMoqParam context.Context) radio.FingerprintStorage
+
+	// FingerprintTxFunc mocks the FingerprintTx method.
+	FingerprintTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Fingerprint holds details about calls to the Fingerprint method.
+		Fingerprint []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// FingerprintTx holds details about calls to the FingerprintTx method.
+		FingerprintTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockFingerprint   sync.RWMutex
+	lockFingerprintTx sync.RWMutex
+}
+
+// Fingerprint calls FingerprintFunc.
+func (mock *FingerprintStorageServiceMock) Fingerprint(contextMoqParam context.Context) radio.FingerprintStorage {
+	if mock.FingerprintFunc == nil {
+		panic("FingerprintStorageServiceMock.FingerprintFunc: method is nil but FingerprintStorageService.Fingerprint was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockPermissions.Lock()
-	mock.calls.Permissions = append(mock.calls.Permissions, callInfo)
-	mock.lockPermissions.Unlock()
-	return mock.PermissionsFunc()
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockFingerprint.Lock()
+	mock.calls.Fingerprint = append(mock.calls.Fingerprint, callInfo)
+	mock.lockFingerprint.Unlock()
+	return mock.FingerprintFunc(contextMoqParam)
 }
 
-// PermissionsCalls gets all the calls that were made to Permissions.
+// FingerprintCalls gets all the calls that were made to Fingerprint.
 // Check the length with:
 //
-//	len(mockedUserStorage.PermissionsCalls())
-func (mock *UserStorageMock) PermissionsCalls() []struct {
+//	len(mockedFingerprintStorageService.FingerprintCalls())
+func (mock *FingerprintStorageServiceMock) FingerprintCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
+		ContextMoqParam context.Context
 	}
-	mock.lockPermissions.RLock()
-	calls = mock.calls.Permissions
-	mock.lockPermissions.RUnlock()
+	mock.lockFingerprint.RLock()
+	calls = mock.calls.Fingerprint
+	mock.lockFingerprint.RUnlock()
 	return calls
 }
 
-// RecordListeners calls RecordListenersFunc.
-func (mock *UserStorageMock) RecordListeners(v radio.Listeners, user radio.User) error {
-	if mock.RecordListenersFunc == nil {
-		panic("UserStorageMock.RecordListenersFunc: method is nil but UserStorage.RecordListeners was just called")
+// FingerprintTx calls FingerprintTxFunc.
+func (mock *FingerprintStorageServiceMock) FingerprintTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+	if mock.FingerprintTxFunc == nil {
+		panic("FingerprintStorageServiceMock.FingerprintTxFunc: method is nil but FingerprintStorageService.FingerprintTx was just called")
 	}
 	callInfo := struct {
-		V    radio.Listeners
-		User radio.User
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		V:    v,
-		User: user,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockRecordListeners.Lock()
-	mock.calls.RecordListeners = append(mock.calls.RecordListeners, callInfo)
-	mock.lockRecordListeners.Unlock()
-	return mock.RecordListenersFunc(v, user)
+	mock.lockFingerprintTx.Lock()
+	mock.calls.FingerprintTx = append(mock.calls.FingerprintTx, callInfo)
+	mock.lockFingerprintTx.Unlock()
+	return mock.FingerprintTxFunc(contextMoqParam, storageTx)
 }
 
-// RecordListenersCalls gets all the calls that were made to RecordListeners.
+// FingerprintTxCalls gets all the calls that were made to FingerprintTx.
 // Check the length with:
 //
-//	len(mockedUserStorage.RecordListenersCalls())
-func (mock *UserStorageMock) RecordListenersCalls() []struct {
-	V    radio.Listeners
-	User radio.User
+//	len(mockedFingerprintStorageService.FingerprintTxCalls())
+func (mock *FingerprintStorageServiceMock) FingerprintTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockFingerprintTx.RLock()
+	calls = mock.calls.FingerprintTx
+	mock.lockFingerprintTx.RUnlock()
+	return calls
+}
+
+// Ensure, that FingerprintStorageMock does implement radio.FingerprintStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.FingerprintStorage = &FingerprintStorageMock{}
+
+// FingerprintStorageMock is a mock implementation of radio.FingerprintStorage.
+//
+//	func TestSomethingThatUsesFingerprintStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.FingerprintStorage
+//		mockedFingerprintStorage := &FingerprintStorageMock{
+//			CandidatesFunc: func(length time.Duration, margin time.Duration) ([]radio.TrackFingerprint, error) {
+//				panic("mock out the Candidates method")
+//			},
+//			MissingFunc: func() ([]radio.TrackID, error) {
+//				panic("mock out the Missing method")
+//			},
+//			StoreFunc: func(trackFingerprint radio.TrackFingerprint) error {
+//				panic("mock out the Store method")
+//			},
+//		}
+//
+//		// use mockedFingerprintStorage in code that requires radio.FingerprintStorage
+//		// and then make assertions.
+//
+//	}
+type FingerprintStorageMock struct {
+	// CandidatesFunc mocks the Candidates method.
+	CandidatesFunc func(length time.Duration, margin time.Duration) ([]radio.TrackFingerprint, error)
+
+	// MissingFunc mocks the Missing method.
+	MissingFunc func() ([]radio.TrackID, error)
+
+	// StoreFunc mocks the Store method.
+	StoreFunc func(trackFingerprint radio.TrackFingerprint) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Candidates holds details about calls to the Candidates method.
+		Candidates []struct {
+			// Length is the length argument value.
+			Length time.Duration
+			// Margin is the margin argument value.
+			Margin time.Duration
+		}
+		// Missing holds details about calls to the Missing method.
+		Missing []struct {
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// TrackFingerprint is the trackFingerprint argument value.
+			TrackFingerprint radio.TrackFingerprint
+		}
+	}
+	lockCandidates sync.RWMutex
+	lockMissing    sync.RWMutex
+	lockStore      sync.RWMutex
+}
+
+// Candidates calls CandidatesFunc.
+func (mock *FingerprintStorageMock) Candidates(length time.Duration, margin time.Duration) ([]radio.TrackFingerprint, error) {
+	if mock.CandidatesFunc == nil {
+		panic("FingerprintStorageMock.CandidatesFunc: method is nil but FingerprintStorage.Candidates was just called")
+	}
+	callInfo := struct {
+		Length time.Duration
+		Margin time.Duration
+	}{
+		Length: length,
+		Margin: margin,
+	}
+	mock.lockCandidates.Lock()
+	mock.calls.Candidates = append(mock.calls.Candidates, callInfo)
+	mock.lockCandidates.Unlock()
+	return mock.CandidatesFunc(length, margin)
+}
+
+// CandidatesCalls gets all the calls that were made to Candidates.
+// Check the length with:
+//
+//	len(mockedFingerprintStorage.CandidatesCalls())
+func (mock *FingerprintStorageMock) CandidatesCalls() []struct {
+	Length time.Duration
+	Margin time.Duration
+} {
+	var calls []struct {
+		Length time.Duration
+		Margin time.Duration
+	}
+	mock.lockCandidates.RLock()
+	calls = mock.calls.Candidates
+	mock.lockCandidates.RUnlock()
+	return calls
+}
+
+// Missing calls MissingFunc.
+func (mock *FingerprintStorageMock) Missing() ([]radio.TrackID, error) {
+	if mock.MissingFunc == nil {
+		panic("FingerprintStorageMock.MissingFunc: method is nil but FingerprintStorage.Missing was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockMissing.Lock()
+	mock.calls.Missing = append(mock.calls.Missing, callInfo)
+	mock.lockMissing.Unlock()
+	return mock.MissingFunc()
+}
+
+// MissingCalls gets all the calls that were made to Missing.
+// Check the length with:
+//
+//	len(mockedFingerprintStorage.MissingCalls())
+func (mock *FingerprintStorageMock) MissingCalls() []struct {
 } {
 	var calls []struct {
-		V    radio.Listeners
-		User radio.User
 	}
-	mock.lockRecordListeners.RLock()
-	calls = mock.calls.RecordListeners
-	mock.lockRecordListeners.RUnlock()
+	mock.lockMissing.RLock()
+	calls = mock.calls.Missing
+	mock.lockMissing.RUnlock()
 	return calls
 }
 
-// Update calls UpdateFunc.
-func (mock *UserStorageMock) Update(user radio.User) (radio.User, error) {
-	if mock.UpdateFunc == nil {
-		panic("UserStorageMock.UpdateFunc: method is nil but UserStorage.Update was just called")
+// Store calls StoreFunc.
+func (mock *FingerprintStorageMock) Store(trackFingerprint radio.TrackFingerprint) error {
+	if mock.StoreFunc == nil {
+		panic("FingerprintStorageMock.StoreFunc: method is nil but FingerprintStorage.Store was just called")
 	}
 	callInfo := struct {
-		User radio.User
+		TrackFingerprint radio.TrackFingerprint
 	}{
-		User: user,
+		TrackFingerprint: trackFingerprint,
 	}
-	mock.lockUpdate.Lock()
-	mock.calls.Update = append(mock.calls.Update, callInfo)
-	mock.lockUpdate.Unlock()
-	return mock.UpdateFunc(user)
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(trackFingerprint)
 }
 
-// UpdateCalls gets all the calls that were made to Update.
+// StoreCalls gets all the calls that were made to Store.
 // Check the length with:
 //
-//	len(mockedUserStorage.UpdateCalls())
-func (mock *UserStorageMock) UpdateCalls() []struct {
-	User radio.User
+//	len(mockedFingerprintStorage.StoreCalls())
+func (mock *FingerprintStorageMock) StoreCalls() []struct {
+	TrackFingerprint radio.TrackFingerprint
 } {
 	var calls []struct {
-		User radio.User
+		TrackFingerprint radio.TrackFingerprint
 	}
-	mock.lockUpdate.RLock()
-	calls = mock.calls.Update
-	mock.lockUpdate.RUnlock()
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
 	return calls
 }
 
-// Ensure, that StatusStorageServiceMock does implement radio.StatusStorageService.
+// Ensure, that RecommendationStorageServiceMock does implement radio.RecommendationStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.StatusStorageService = &StatusStorageServiceMock{}
+var _ radio.RecommendationStorageService = &RecommendationStorageServiceMock{}
 
-// StatusStorageServiceMock is a mock implementation of radio.StatusStorageService.
+// RecommendationStorageServiceMock is a mock implementation of radio.RecommendationStorageService.
 //
-//	func TestSomethingThatUsesStatusStorageService(t *testing.T) {
+//	func TestSomethingThatUsesRecommendationStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.StatusStorageService
-//		mockedStatusStorageService := &StatusStorageServiceMock{
-//			StatusFunc: func(contextMoqParam context.Context) radio.StatusStorage {
-//				panic("mock out the Status method")
+//		// make and configure a mocked radio.RecommendationStorageService
+//		mockedRecommendationStorageService := &RecommendationStorageServiceMock{
+//			RecommendationFunc: func(contextMoqParam context.Context) radio.RecommendationStorage {
+//				panic("mock out the Recommendation method")
+//			},
+//			RecommendationTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error) {
+//				panic("mock out the RecommendationTx method")
 //			},
 //		}
 //
-//		// use mockedStatusStorageService in code that requires radio.StatusStorageService
+//		// use mockedRecommendationStorageService in code that requires radio.RecommendationStorageService
 //		// and then make assertions.
 //
 //	}
-type StatusStorageServiceMock struct {
-	// StatusFunc mocks the Status method.
-	StatusFunc func(contextMoqParam context.Context) radio.StatusStorage
+type RecommendationStorageServiceMock struct {
+	// RecommendationFunc mocks the Recommendation method.
+	RecommendationFunc func(contextMoqParam context.Context) radio.RecommendationStorage
+
+	// RecommendationTxFunc mocks the RecommendationTx method.
+	RecommendationTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Status holds details about calls to the Status method.
-		Status []struct {
+		// Recommendation holds details about calls to the Recommendation method.
+		Recommendation []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// RecommendationTx holds details about calls to the RecommendationTx method.
+		RecommendationTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
 		}
 	}
-	lockStatus sync.RWMutex
+	lockRecommendation   sync.RWMutex
+	lockRecommendationTx sync.RWMutex
 }
 
-// Status calls StatusFunc.
-func (mock *StatusStorageServiceMock) Status(contextMoqParam context.Context) radio.StatusStorage {
-	if mock.StatusFunc == nil {
-		panic("StatusStorageServiceMock.StatusFunc: method is nil but StatusStorageService.Status was just called")
+// Recommendation calls RecommendationFunc.
+func (mock *RecommendationStorageServiceMock) Recommendation(contextMoqParam context.Context) radio.RecommendationStorage {
+	if mock.RecommendationFunc == nil {
+		panic("RecommendationStorageServiceMock.RecommendationFunc: method is nil but RecommendationStorageService.Recommendation was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockStatus.Lock()
-	mock.calls.Status = append(mock.calls.Status, callInfo)
-	mock.lockStatus.Unlock()
-	return mock.StatusFunc(contextMoqParam)
+	mock.lockRecommendation.Lock()
+	mock.calls.Recommendation = append(mock.calls.Recommendation, callInfo)
+	mock.lockRecommendation.Unlock()
+	return mock.RecommendationFunc(contextMoqParam)
 }
 
-// StatusCalls gets all the calls that were made to Status.
+// RecommendationCalls gets all the calls that were made to Recommendation.
 // Check the length with:
 //
-//	len(mockedStatusStorageService.StatusCalls())
-func (mock *StatusStorageServiceMock) StatusCalls() []struct {
+//	len(mockedRecommendationStorageService.RecommendationCalls())
+func (mock *RecommendationStorageServiceMock) RecommendationCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockStatus.RLock()
-	calls = mock.calls.Status
-	mock.lockStatus.RUnlock()
+	mock.lockRecommendation.RLock()
+	calls = mock.calls.Recommendation
+	mock.lockRecommendation.RUnlock()
 	return calls
 }
 
-// Ensure, that StatusStorageMock does implement radio.StatusStorage.
+// RecommendationTx calls RecommendationTxFunc.
+func (mock *RecommendationStorageServiceMock) RecommendationTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error) {
+	if mock.RecommendationTxFunc == nil {
+		panic("RecommendationStorageServiceMock.RecommendationTxFunc: method is nil but RecommendationStorageService.RecommendationTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockRecommendationTx.Lock()
+	mock.calls.RecommendationTx = append(mock.calls.RecommendationTx, callInfo)
+	mock.lockRecommendationTx.Unlock()
+	return mock.RecommendationTxFunc(contextMoqParam, storageTx)
+}
+
+// RecommendationTxCalls gets all the calls that were made to RecommendationTx.
+// Check the length with:
+//
+//	len(mockedRecommendationStorageService.RecommendationTxCalls())
+func (mock *RecommendationStorageServiceMock) RecommendationTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockRecommendationTx.RLock()
+	calls = mock.calls.RecommendationTx
+	mock.lockRecommendationTx.RUnlock()
+	return calls
+}
+
+// Ensure, that RecommendationStorageMock does implement radio.RecommendationStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.StatusStorage = &StatusStorageMock{}
+var _ radio.RecommendationStorage = &RecommendationStorageMock{}
 
-// StatusStorageMock is a mock implementation of radio.StatusStorage.
+// RecommendationStorageMock is a mock implementation of radio.RecommendationStorage.
 //
-//	func TestSomethingThatUsesStatusStorage(t *testing.T) {
+//	func TestSomethingThatUsesRecommendationStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.StatusStorage
-//		mockedStatusStorage := &StatusStorageMock{
-//			LoadFunc: func() (*radio.Status, error) {
-//				panic("mock out the Load method")
+//		// make and configure a mocked radio.RecommendationStorage
+//		mockedRecommendationStorage := &RecommendationStorageMock{
+//			FavoritesFunc: func() ([]radio.TrackFavorite, error) {
+//				panic("mock out the Favorites method")
 //			},
-//			StoreFunc: func(status radio.Status) error {
-//				panic("mock out the Store method")
+//			ReplaceFunc: func(trackSimilaritys []radio.TrackSimilarity) error {
+//				panic("mock out the Replace method")
+//			},
+//			RequestsFunc: func(since time.Time) ([]radio.AccountRequest, error) {
+//				panic("mock out the Requests method")
+//			},
+//			SimilarFunc: func(id radio.TrackID, limit int) ([]radio.SimilarSong, error) {
+//				panic("mock out the Similar method")
 //			},
 //		}
 //
-//		// use mockedStatusStorage in code that requires radio.StatusStorage
+//		// use mockedRecommendationStorage in code that requires radio.RecommendationStorage
 //		// and then make assertions.
 //
 //	}
-type StatusStorageMock struct {
-	// LoadFunc mocks the Load method.
-	LoadFunc func() (*radio.Status, error)
+type RecommendationStorageMock struct {
+	// FavoritesFunc mocks the Favorites method.
+	FavoritesFunc func() ([]radio.TrackFavorite, error)
 
-	// StoreFunc mocks the Store method.
-	StoreFunc func(status radio.Status) error
+	// ReplaceFunc mocks the Replace method.
+	ReplaceFunc func(trackSimilaritys []radio.TrackSimilarity) error
+
+	// RequestsFunc mocks the Requests method.
+	RequestsFunc func(since time.Time) ([]radio.AccountRequest, error)
+
+	// SimilarFunc mocks the Similar method.
+	SimilarFunc func(id radio.TrackID, limit int) ([]radio.SimilarSong, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Load holds details about calls to the Load method.
-		Load []struct {
+		// Favorites holds details about calls to the Favorites method.
+		Favorites []struct {
 		}
-		// Store holds details about calls to the Store method.
-		Store []struct {
-			// Status is the status argument value.
-			Status radio.Status
+		// Replace holds details about calls to the Replace method.
+		Replace []struct {
+			// TrackSimilaritys is the trackSimilaritys argument value.
+			TrackSimilaritys []radio.TrackSimilarity
+		}
+		// Requests holds details about calls to the Requests method.
+		Requests []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// Similar holds details about calls to the Similar method.
+		Similar []struct {
+			// Id is the id argument value.
+			Id radio.TrackID
+			// Limit is the limit argument value.
+			Limit int
 		}
 	}
-	lockLoad  sync.RWMutex
-	lockStore sync.RWMutex
+	lockFavorites sync.RWMutex
+	lockReplace   sync.RWMutex
+	lockRequests  sync.RWMutex
+	lockSimilar   sync.RWMutex
 }
 
-// Load calls LoadFunc.
-func (mock *StatusStorageMock) Load() (*radio.Status, error) {
-	if mock.LoadFunc == nil {
-		panic("StatusStorageMock.LoadFunc: method is nil but StatusStorage.Load was just called")
+// Favorites calls FavoritesFunc.
+func (mock *RecommendationStorageMock) Favorites() ([]radio.TrackFavorite, error) {
+	if mock.FavoritesFunc == nil {
+		panic("RecommendationStorageMock.FavoritesFunc: method is nil but RecommendationStorage.Favorites was just called")
 	}
 	callInfo := struct {
 	}{}
-	mock.lockLoad.Lock()
-	mock.calls.Load = append(mock.calls.Load, callInfo)
-	mock.lockLoad.Unlock()
-	return mock.LoadFunc()
+	mock.lockFavorites.Lock()
+	mock.calls.Favorites = append(mock.calls.Favorites, callInfo)
+	mock.lockFavorites.Unlock()
+	return mock.FavoritesFunc()
 }
 
-// LoadCalls gets all the calls that were made to Load.
+// FavoritesCalls gets all the calls that were made to Favorites.
 // Check the length with:
 //
-//	len(mockedStatusStorage.LoadCalls())
-func (mock *StatusStorageMock) LoadCalls() []struct {
+//	len(mockedRecommendationStorage.FavoritesCalls())
+func (mock *RecommendationStorageMock) FavoritesCalls() []struct {
 } {
 	var calls []struct {
 	}
-	mock.lockLoad.RLock()
-	calls = mock.calls.Load
-	mock.lockLoad.RUnlock()
+	mock.lockFavorites.RLock()
+	calls = mock.calls.Favorites
+	mock.lockFavorites.RUnlock()
 	return calls
 }
 
-// Store calls StoreFunc.
-func (mock *StatusStorageMock) Store(status radio.Status) error {
-	if mock.StoreFunc == nil {
-		panic("StatusStorageMock.StoreFunc: method is nil but StatusStorage.Store was just called")
+// Replace calls ReplaceFunc.
+func (mock *RecommendationStorageMock) Replace(trackSimilaritys []radio.TrackSimilarity) error {
+	if mock.ReplaceFunc == nil {
+		panic("RecommendationStorageMock.ReplaceFunc: method is nil but RecommendationStorage.Replace was just called")
 	}
 	callInfo := struct {
-		Status radio.Status
+		TrackSimilaritys []radio.TrackSimilarity
 	}{
-		Status: status,
+		TrackSimilaritys: trackSimilaritys,
 	}
-	mock.lockStore.Lock()
-	mock.calls.Store = append(mock.calls.Store, callInfo)
-	mock.lockStore.Unlock()
-	return mock.StoreFunc(status)
+	mock.lockReplace.Lock()
+	mock.calls.Replace = append(mock.calls.Replace, callInfo)
+	mock.lockReplace.Unlock()
+	return mock.ReplaceFunc(trackSimilaritys)
 }
 
-// StoreCalls gets all the calls that were made to Store.
+// ReplaceCalls gets all the calls that were made to Replace.
 // Check the length with:
 //
-//	len(mockedStatusStorage.StoreCalls())
-func (mock *StatusStorageMock) StoreCalls() []struct {
-	Status radio.Status
+//	len(mockedRecommendationStorage.ReplaceCalls())
+func (mock *RecommendationStorageMock) ReplaceCalls() []struct {
+	TrackSimilaritys []radio.TrackSimilarity
 } {
 	var calls []struct {
-		Status radio.Status
+		TrackSimilaritys []radio.TrackSimilarity
 	}
-	mock.lockStore.RLock()
-	calls = mock.calls.Store
-	mock.lockStore.RUnlock()
+	mock.lockReplace.RLock()
+	calls = mock.calls.Replace
+	mock.lockReplace.RUnlock()
 	return calls
 }
 
-// Ensure, that NewsStorageServiceMock does implement radio.NewsStorageService.
+// Requests calls RequestsFunc.
+func (mock *RecommendationStorageMock) Requests(since time.Time) ([]radio.AccountRequest, error) {
+	if mock.RequestsFunc == nil {
+		panic("RecommendationStorageMock.RequestsFunc: method is nil but RecommendationStorage.Requests was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockRequests.Lock()
+	mock.calls.Requests = append(mock.calls.Requests, callInfo)
+	mock.lockRequests.Unlock()
+	return mock.RequestsFunc(since)
+}
+
+// RequestsCalls gets all the calls that were made to Requests.
+// Check the length with:
+//
+//	len(mockedRecommendationStorage.RequestsCalls())
+func (mock *RecommendationStorageMock) RequestsCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockRequests.RLock()
+	calls = mock.calls.Requests
+	mock.lockRequests.RUnlock()
+	return calls
+}
+
+// Similar calls SimilarFunc.
+func (mock *RecommendationStorageMock) Similar(id radio.TrackID, limit int) ([]radio.SimilarSong, error) {
+	if mock.SimilarFunc == nil {
+		panic("RecommendationStorageMock.SimilarFunc: method is nil but RecommendationStorage.Similar was just called")
+	}
+	callInfo := struct {
+		Id    radio.TrackID
+		Limit int
+	}{
+		Id:    id,
+		Limit: limit,
+	}
+	mock.lockSimilar.Lock()
+	mock.calls.Similar = append(mock.calls.Similar, callInfo)
+	mock.lockSimilar.Unlock()
+	return mock.SimilarFunc(id, limit)
+}
+
+// SimilarCalls gets all the calls that were made to Similar.
+// Check the length with:
+//
+//	len(mockedRecommendationStorage.SimilarCalls())
+func (mock *RecommendationStorageMock) SimilarCalls() []struct {
+	Id    radio.TrackID
+	Limit int
+} {
+	var calls []struct {
+		Id    radio.TrackID
+		Limit int
+	}
+	mock.lockSimilar.RLock()
+	calls = mock.calls.Similar
+	mock.lockSimilar.RUnlock()
+	return calls
+}
+
+// Ensure, that LeaseStorageServiceMock does implement radio.LeaseStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.NewsStorageService = &NewsStorageServiceMock{}
+var _ radio.LeaseStorageService = &LeaseStorageServiceMock{}
 
-// NewsStorageServiceMock is a mock implementation of radio.NewsStorageService.
+// LeaseStorageServiceMock is a mock implementation of radio.LeaseStorageService.
 //
-//	func TestSomethingThatUsesNewsStorageService(t *testing.T) {
+//	func TestSomethingThatUsesLeaseStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.NewsStorageService
-//		mockedNewsStorageService := &NewsStorageServiceMock{
-//			NewsFunc: func(contextMoqParam context.Context) radio.NewsStorage {
-//				panic("mock out the News method")
-//			},
-//			NewsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
-//				panic("mock out the NewsTx method")
+//		// make and configure a mocked radio.LeaseStorageService
+//		mockedLeaseStorageService := &LeaseStorageServiceMock{
+//			LeaseFunc: func(contextMoqParam context.Context) radio.LeaseStorage {
+//				panic("mock out the Lease method")
 //			},
 //		}
 //
-//		// use mockedNewsStorageService in code that requires radio.NewsStorageService
+//		// use mockedLeaseStorageService in code that requires radio.LeaseStorageService
 //		// and then make assertions.
 //
 //	}
-type NewsStorageServiceMock struct {
-	// NewsFunc mocks the News method.
-	NewsFunc func(contextMoqParam context.Context) radio.NewsStorage
-
-	// NewsTxFunc mocks the NewsTx method.
-	NewsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error)
+type LeaseStorageServiceMock struct {
+	// LeaseFunc mocks the Lease method.
+	LeaseFunc func(contextMoqParam context.Context) radio.LeaseStorage
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// News holds details about calls to the News method.
-		News []struct {
+		// Lease holds details about calls to the Lease method.
+		Lease []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
-		// NewsTx holds details about calls to the NewsTx method.
-		NewsTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
+	}
+	lockLease sync.RWMutex
+}
+
+// Lease calls LeaseFunc.
+func (mock *LeaseStorageServiceMock) Lease(contextMoqParam context.Context) radio.LeaseStorage {
+	if mock.LeaseFunc == nil {
+		panic("LeaseStorageServiceMock.LeaseFunc: method is nil but LeaseStorageService.Lease was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockLease.Lock()
+	mock.calls.Lease = append(mock.calls.Lease, callInfo)
+	mock.lockLease.Unlock()
+	return mock.LeaseFunc(contextMoqParam)
+}
+
+// LeaseCalls gets all the calls that were made to Lease.
+// Check the length with:
+//
+//	len(mockedLeaseStorageService.LeaseCalls())
+func (mock *LeaseStorageServiceMock) LeaseCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockLease.RLock()
+	calls = mock.calls.Lease
+	mock.lockLease.RUnlock()
+	return calls
+}
+
+// Ensure, that LeaseStorageMock does implement radio.LeaseStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.LeaseStorage = &LeaseStorageMock{}
+
+// LeaseStorageMock is a mock implementation of radio.LeaseStorage.
+//
+//	func TestSomethingThatUsesLeaseStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.LeaseStorage
+//		mockedLeaseStorage := &LeaseStorageMock{
+//			AcquireFunc: func(name string, holder string, ttl time.Duration) (string, error) {
+//				panic("mock out the Acquire method")
+//			},
+//			ReleaseFunc: func(name string, holder string) error {
+//				panic("mock out the Release method")
+//			},
+//		}
+//
+//		// use mockedLeaseStorage in code that requires radio.LeaseStorage
+//		// and then make assertions.
+//
+//	}
+type LeaseStorageMock struct {
+	// AcquireFunc mocks the Acquire method.
+	AcquireFunc func(name string, holder string, ttl time.Duration) (string, error)
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(name string, holder string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Acquire holds details about calls to the Acquire method.
+		Acquire []struct {
+			// Name is the name argument value.
+			Name string
+			// Holder is the holder argument value.
+			Holder string
+			// Ttl is the ttl argument value.
+			Ttl time.Duration
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// Name is the name argument value.
+			Name string
+			// Holder is the holder argument value.
+			Holder string
 		}
 	}
-	lockNews   sync.RWMutex
-	lockNewsTx sync.RWMutex
+	lockAcquire sync.RWMutex
+	lockRelease sync.RWMutex
 }
 
-// News calls NewsFunc.
-func (mock *NewsStorageServiceMock) News(contextMoqParam context.Context) radio.NewsStorage {
-	if mock.NewsFunc == nil {
-		panic("NewsStorageServiceMock.NewsFunc: method is nil but NewsStorageService.News was just called")
+// Acquire calls AcquireFunc.
+func (mock *LeaseStorageMock) Acquire(name string, holder string, ttl time.Duration) (string, error) {
+	if mock.AcquireFunc == nil {
+		panic("LeaseStorageMock.AcquireFunc: method is nil but LeaseStorage.Acquire was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
+		Name   string
+		Holder string
+		Ttl    time.Duration
 	}{
-		ContextMoqParam: contextMoqParam,
+		Name:   name,
+		Holder: holder,
+		Ttl:    ttl,
 	}
-	mock.lockNews.Lock()
-	mock.calls.News = append(mock.calls.News, callInfo)
-	mock.lockNews.Unlock()
-	return mock.NewsFunc(contextMoqParam)
+	mock.lockAcquire.Lock()
+	mock.calls.Acquire = append(mock.calls.Acquire, callInfo)
+	mock.lockAcquire.Unlock()
+	return mock.AcquireFunc(name, holder, ttl)
 }
 
-// NewsCalls gets all the calls that were made to News.
+// AcquireCalls gets all the calls that were made to Acquire.
 // Check the length with:
 //
-//	len(mockedNewsStorageService.NewsCalls())
-func (mock *NewsStorageServiceMock) NewsCalls() []struct {
-	ContextMoqParam context.Context
+//	len(mockedLeaseStorage.AcquireCalls())
+func (mock *LeaseStorageMock) AcquireCalls() []struct {
+	Name   string
+	Holder string
+	Ttl    time.Duration
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
+		Name   string
+		Holder string
+		Ttl    time.Duration
 	}
-	mock.lockNews.RLock()
-	calls = mock.calls.News
-	mock.lockNews.RUnlock()
+	mock.lockAcquire.RLock()
+	calls = mock.calls.Acquire
+	mock.lockAcquire.RUnlock()
 	return calls
 }
 
-// NewsTx calls NewsTxFunc.
-func (mock *NewsStorageServiceMock) NewsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
-	if mock.NewsTxFunc == nil {
-		panic("NewsStorageServiceMock.NewsTxFunc: method is nil but NewsStorageService.NewsTx was just called")
+// Release calls ReleaseFunc.
+func (mock *LeaseStorageMock) Release(name string, holder string) error {
+	if mock.ReleaseFunc == nil {
+		panic("LeaseStorageMock.ReleaseFunc: method is nil but LeaseStorage.Release was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Name   string
+		Holder string
 	}{
-		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
+		Name:   name,
+		Holder: holder,
 	}
-	mock.lockNewsTx.Lock()
-	mock.calls.NewsTx = append(mock.calls.NewsTx, callInfo)
-	mock.lockNewsTx.Unlock()
-	return mock.NewsTxFunc(contextMoqParam, storageTx)
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(name, holder)
 }
 
-// NewsTxCalls gets all the calls that were made to NewsTx.
+// ReleaseCalls gets all the calls that were made to Release.
 // Check the length with:
 //
-//	len(mockedNewsStorageService.NewsTxCalls())
-func (mock *NewsStorageServiceMock) NewsTxCalls() []struct {
-	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
+//	len(mockedLeaseStorage.ReleaseCalls())
+func (mock *LeaseStorageMock) ReleaseCalls() []struct {
+	Name   string
+	Holder string
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Name   string
+		Holder string
 	}
-	mock.lockNewsTx.RLock()
-	calls = mock.calls.NewsTx
-	mock.lockNewsTx.RUnlock()
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
 	return calls
 }
 
-// Ensure, that NewsStorageMock does implement radio.NewsStorage.
+// Ensure, that JournalStorageServiceMock does implement radio.JournalStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.NewsStorage = &NewsStorageMock{}
+var _ radio.JournalStorageService = &JournalStorageServiceMock{}
 
-// NewsStorageMock is a mock implementation of radio.NewsStorage.
+// JournalStorageServiceMock is a mock implementation of radio.JournalStorageService.
 //
-//	func TestSomethingThatUsesNewsStorage(t *testing.T) {
+//	func TestSomethingThatUsesJournalStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.NewsStorage
-//		mockedNewsStorage := &NewsStorageMock{
-//			AddCommentFunc: func(newsComment radio.NewsComment) (radio.NewsCommentID, error) {
-//				panic("mock out the AddComment method")
-//			},
-//			CommentsFunc: func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
-//				panic("mock out the Comments method")
-//			},
-//			CommentsPublicFunc: func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
-//				panic("mock out the CommentsPublic method")
-//			},
-//			CreateFunc: func(newsPost radio.NewsPost) (radio.NewsPostID, error) {
-//				panic("mock out the Create method")
-//			},
-//			DeleteFunc: func(newsPostID radio.NewsPostID) error {
-//				panic("mock out the Delete method")
-//			},
-//			DeleteCommentFunc: func(newsCommentID radio.NewsCommentID) error {
-//				panic("mock out the DeleteComment method")
-//			},
-//			GetFunc: func(newsPostID radio.NewsPostID) (*radio.NewsPost, error) {
-//				panic("mock out the Get method")
-//			},
-//			ListFunc: func(limit int64, offset int64) (radio.NewsList, error) {
-//				panic("mock out the List method")
-//			},
-//			ListPublicFunc: func(limit int64, offset int64) (radio.NewsList, error) {
-//				panic("mock out the ListPublic method")
-//			},
-//			UpdateFunc: func(newsPost radio.NewsPost) error {
-//				panic("mock out the Update method")
+//		// make and configure a mocked radio.JournalStorageService
+//		mockedJournalStorageService := &JournalStorageServiceMock{
+//			JournalFunc: func(contextMoqParam context.Context) radio.JournalStorage {
+//				panic("mock out the Journal method")
 //			},
 //		}
 //
-//		// use mockedNewsStorage in code that requires radio.NewsStorage
+//		// use mockedJournalStorageService in code that requires radio.JournalStorageService
 //		// and then make assertions.
 //
 //	}
-type NewsStorageMock struct {
-	// AddCommentFunc mocks the AddComment method.
-	AddCommentFunc func(newsComment radio.NewsComment) (radio.NewsCommentID, error)
-
-	// CommentsFunc mocks the Comments method.
-	CommentsFunc func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error)
-
-	// CommentsPublicFunc mocks the CommentsPublic method.
-	CommentsPublicFunc func(newsPostID radio.NewsPostID) ([]radio.NewsComment, error)
-
-	// CreateFunc mocks the Create method.
-	CreateFunc func(newsPost radio.NewsPost) (radio.NewsPostID, error)
-
-	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(newsPostID radio.NewsPostID) error
-
-	// DeleteCommentFunc mocks the DeleteComment method.
-	DeleteCommentFunc func(newsCommentID radio.NewsCommentID) error
-
-	// GetFunc mocks the Get method.
-	GetFunc func(newsPostID radio.NewsPostID) (*radio.NewsPost, error)
-
-	// ListFunc mocks the List method.
-	ListFunc func(limit int64, offset int64) (radio.NewsList, error)
-
-	// ListPublicFunc mocks the ListPublic method.
-	ListPublicFunc func(limit int64, offset int64) (radio.NewsList, error)
-
-	// UpdateFunc mocks the Update method.
-	UpdateFunc func(newsPost radio.NewsPost) error
+type JournalStorageServiceMock struct {
+	// JournalFunc mocks the Journal method.
+	JournalFunc func(contextMoqParam context.Context) radio.JournalStorage
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// AddComment holds details about calls to the AddComment method.
-		AddComment []struct {
-			// NewsComment is the newsComment argument value.
-			NewsComment radio.NewsComment
-		}
-		// Comments holds details about calls to the Comments method.
-		Comments []struct {
-			// NewsPostID is the newsPostID argument value.
-			NewsPostID radio.NewsPostID
-		}
-		// CommentsPublic holds details about calls to the CommentsPublic method.
-		CommentsPublic []struct {
-			// NewsPostID is the newsPostID argument value.
-			NewsPostID radio.NewsPostID
-		}
-		// Create holds details about calls to the Create method.
-		Create []struct {
-			// NewsPost is the newsPost argument value.
-			NewsPost radio.NewsPost
-		}
-		// Delete holds details about calls to the Delete method.
-		Delete []struct {
-			// NewsPostID is the newsPostID argument value.
-			NewsPostID radio.NewsPostID
-		}
-		// DeleteComment holds details about calls to the DeleteComment method.
-		DeleteComment []struct {
-			// NewsCommentID is the newsCommentID argument value.
-			NewsCommentID radio.NewsCommentID
-		}
-		// Get holds details about calls to the Get method.
-		Get []struct {
-			// NewsPostID is the newsPostID argument value.
-			NewsPostID radio.NewsPostID
-		}
-		// List holds details about calls to the List method.
-		List []struct {
-			// Limit is the limit argument value.
-			Limit int64
-			// Offset is the offset argument value.
-			Offset int64
-		}
-		// ListPublic holds details about calls to the ListPublic method.
-		ListPublic []struct {
-			// Limit is the limit argument value.
-			Limit int64
-			// Offset is the offset argument value.
-			Offset int64
-		}
-		// Update holds details about calls to the Update method.
-		Update []struct {
-			// NewsPost is the newsPost argument value.
-			NewsPost radio.NewsPost
+		// Journal holds details about calls to the Journal method.
+		Journal []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
 		}
 	}
-	lockAddComment     sync.RWMutex
-	lockComments       sync.RWMutex
-	lockCommentsPublic sync.RWMutex
-	lockCreate         sync.RWMutex
-	lockDelete         sync.RWMutex
-	lockDeleteComment  sync.RWMutex
-	lockGet            sync.RWMutex
-	lockList           sync.RWMutex
-	lockListPublic     sync.RWMutex
-	lockUpdate         sync.RWMutex
+	lockJournal sync.RWMutex
 }
 
-// AddComment calls AddCommentFunc.
-func (mock *NewsStorageMock) AddComment(newsComment radio.NewsComment) (radio.NewsCommentID, error) {
-	if mock.AddCommentFunc == nil {
-		panic("NewsStorageMock.AddCommentFunc: method is nil but NewsStorage.AddComment was just called")
+// Journal calls JournalFunc.
+func (mock *JournalStorageServiceMock) Journal(contextMoqParam context.Context) radio.JournalStorage {
+	if mock.JournalFunc == nil {
+		panic("JournalStorageServiceMock.JournalFunc: method is nil but JournalStorageService.Journal was just called")
 	}
 	callInfo := struct {
-		NewsComment radio.NewsComment
+		ContextMoqParam context.Context
 	}{
-		NewsComment: newsComment,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockAddComment.Lock()
-	mock.calls.AddComment = append(mock.calls.AddComment, callInfo)
-	mock.lockAddComment.Unlock()
-	return mock.AddCommentFunc(newsComment)
+	mock.lockJournal.Lock()
+	mock.calls.Journal = append(mock.calls.Journal, callInfo)
+	mock.lockJournal.Unlock()
+	return mock.JournalFunc(contextMoqParam)
 }
 
-// AddCommentCalls gets all the calls that were made to AddComment.
+// JournalCalls gets all the calls that were made to Journal.
 // Check the length with:
 //
-//	len(mockedNewsStorage.AddCommentCalls())
-func (mock *NewsStorageMock) AddCommentCalls() []struct {
-	NewsComment radio.NewsComment
+//	len(mockedJournalStorageService.JournalCalls())
+func (mock *JournalStorageServiceMock) JournalCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		NewsComment radio.NewsComment
+		ContextMoqParam context.Context
 	}
-	mock.lockAddComment.RLock()
-	calls = mock.calls.AddComment
-	mock.lockAddComment.RUnlock()
+	mock.lockJournal.RLock()
+	calls = mock.calls.Journal
+	mock.lockJournal.RUnlock()
 	return calls
 }
 
-// Comments calls CommentsFunc.
-func (mock *NewsStorageMock) Comments(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
-	if mock.CommentsFunc == nil {
-		panic("NewsStorageMock.CommentsFunc: method is nil but NewsStorage.Comments was just called")
+// Ensure, that JournalStorageMock does implement radio.JournalStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.JournalStorage = &JournalStorageMock{}
+
+// JournalStorageMock is a mock implementation of radio.JournalStorage.
+//
+//	func TestSomethingThatUsesJournalStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.JournalStorage
+//		mockedJournalStorage := &JournalStorageMock{
+//			AppendFunc: func(journalEntry radio.JournalEntry) error {
+//				panic("mock out the Append method")
+//			},
+//			LastFunc: func(before time.Time) ([]radio.JournalEntry, error) {
+//				panic("mock out the Last method")
+//			},
+//			PruneFunc: func(before time.Time) (int64, error) {
+//				panic("mock out the Prune method")
+//			},
+//			RangeFunc: func(from time.Time, to time.Time) ([]radio.JournalEntry, error) {
+//				panic("mock out the Range method")
+//			},
+//		}
+//
+//		// use mockedJournalStorage in code that requires radio.JournalStorage
+//		// and then make assertions.
+//
+//	}
+type JournalStorageMock struct {
+	// AppendFunc mocks the Append method.
+	AppendFunc func(journalEntry radio.JournalEntry) error
+
+	// LastFunc mocks the Last method.
+	LastFunc func(before time.Time) ([]radio.JournalEntry, error)
+
+	// PruneFunc mocks the Prune method.
+	PruneFunc func(before time.Time) (int64, error)
+
+	// RangeFunc mocks the Range method.
+	RangeFunc func(from time.Time, to time.Time) ([]radio.JournalEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Append holds details about calls to the Append method.
+		Append []struct {
+			// JournalEntry is the journalEntry argument value.
+			JournalEntry radio.JournalEntry
+		}
+		// Last holds details about calls to the Last method.
+		Last []struct {
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Prune holds details about calls to the Prune method.
+		Prune []struct {
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Range holds details about calls to the Range method.
+		Range []struct {
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+	}
+	lockAppend sync.RWMutex
+	lockLast   sync.RWMutex
+	lockPrune  sync.RWMutex
+	lockRange  sync.RWMutex
+}
+
+// Append calls AppendFunc.
+func (mock *JournalStorageMock) Append(journalEntry radio.JournalEntry) error {
+	if mock.AppendFunc == nil {
+		panic("JournalStorageMock.AppendFunc: method is nil but JournalStorage.Append was just called")
 	}
 	callInfo := struct {
-		NewsPostID radio.NewsPostID
+		JournalEntry radio.JournalEntry
 	}{
-		NewsPostID: newsPostID,
+		JournalEntry: journalEntry,
 	}
-	mock.lockComments.Lock()
-	mock.calls.Comments = append(mock.calls.Comments, callInfo)
-	mock.lockComments.Unlock()
-	return mock.CommentsFunc(newsPostID)
+	mock.lockAppend.Lock()
+	mock.calls.Append = append(mock.calls.Append, callInfo)
+	mock.lockAppend.Unlock()
+	return mock.AppendFunc(journalEntry)
 }
 
-// CommentsCalls gets all the calls that were made to Comments.
+// AppendCalls gets all the calls that were made to Append.
 // Check the length with:
 //
-//	len(mockedNewsStorage.CommentsCalls())
-func (mock *NewsStorageMock) CommentsCalls() []struct {
-	NewsPostID radio.NewsPostID
+//	len(mockedJournalStorage.AppendCalls())
+func (mock *JournalStorageMock) AppendCalls() []struct {
+	JournalEntry radio.JournalEntry
 } {
 	var calls []struct {
-		NewsPostID radio.NewsPostID
+		JournalEntry radio.JournalEntry
 	}
-	mock.lockComments.RLock()
-	calls = mock.calls.Comments
-	mock.lockComments.RUnlock()
+	mock.lockAppend.RLock()
+	calls = mock.calls.Append
+	mock.lockAppend.RUnlock()
 	return calls
 }
 
-// CommentsPublic calls CommentsPublicFunc.
-func (mock *NewsStorageMock) CommentsPublic(newsPostID radio.NewsPostID) ([]radio.NewsComment, error) {
-	if mock.CommentsPublicFunc == nil {
-		panic("NewsStorageMock.CommentsPublicFunc: method is nil but NewsStorage.CommentsPublic was just called")
+// Last calls LastFunc.
+func (mock *JournalStorageMock) Last(before time.Time) ([]radio.JournalEntry, error) {
+	if mock.LastFunc == nil {
+		panic("JournalStorageMock.LastFunc: method is nil but JournalStorage.Last was just called")
 	}
 	callInfo := struct {
-		NewsPostID radio.NewsPostID
+		Before time.Time
 	}{
-		NewsPostID: newsPostID,
+		Before: before,
 	}
-	mock.lockCommentsPublic.Lock()
-	mock.calls.CommentsPublic = append(mock.calls.CommentsPublic, callInfo)
-	mock.lockCommentsPublic.Unlock()
-	return mock.CommentsPublicFunc(newsPostID)
+	mock.lockLast.Lock()
+	mock.calls.Last = append(mock.calls.Last, callInfo)
+	mock.lockLast.Unlock()
+	return mock.LastFunc(before)
 }
 
-// CommentsPublicCalls gets all the calls that were made to CommentsPublic.
+// LastCalls gets all the calls that were made to Last.
 // Check the length with:
 //
-//	len(mockedNewsStorage.CommentsPublicCalls())
-func (mock *NewsStorageMock) CommentsPublicCalls() []struct {
-	NewsPostID radio.NewsPostID
+//	len(mockedJournalStorage.LastCalls())
+func (mock *JournalStorageMock) LastCalls() []struct {
+	Before time.Time
 } {
 	var calls []struct {
-		NewsPostID radio.NewsPostID
+		Before time.Time
 	}
-	mock.lockCommentsPublic.RLock()
-	calls = mock.calls.CommentsPublic
-	mock.lockCommentsPublic.RUnlock()
+	mock.lockLast.RLock()
+	calls = mock.calls.Last
+	mock.lockLast.RUnlock()
 	return calls
 }
 
-// Create calls CreateFunc.
-func (mock *NewsStorageMock) Create(newsPost radio.NewsPost) (radio.NewsPostID, error) {
-	if mock.CreateFunc == nil {
-		panic("NewsStorageMock.CreateFunc: method is nil but NewsStorage.Create was just called")
+// Prune calls PruneFunc.
+func (mock *JournalStorageMock) Prune(before time.Time) (int64, error) {
+	if mock.PruneFunc == nil {
+		panic("JournalStorageMock.PruneFunc: method is nil but JournalStorage.Prune was just called")
 	}
 	callInfo := struct {
-		NewsPost radio.NewsPost
+		Before time.Time
 	}{
-		NewsPost: newsPost,
+		Before: before,
 	}
-	mock.lockCreate.Lock()
-	mock.calls.Create = append(mock.calls.Create, callInfo)
-	mock.lockCreate.Unlock()
-	return mock.CreateFunc(newsPost)
+	mock.lockPrune.Lock()
+	mock.calls.Prune = append(mock.calls.Prune, callInfo)
+	mock.lockPrune.Unlock()
+	return mock.PruneFunc(before)
 }
 
-// CreateCalls gets all the calls that were made to Create.
+// PruneCalls gets all the calls that were made to Prune.
 // Check the length with:
 //
-//	len(mockedNewsStorage.CreateCalls())
-func (mock *NewsStorageMock) CreateCalls() []struct {
-	NewsPost radio.NewsPost
+//	len(mockedJournalStorage.PruneCalls())
+func (mock *JournalStorageMock) PruneCalls() []struct {
+	Before time.Time
 } {
 	var calls []struct {
-		NewsPost radio.NewsPost
+		Before time.Time
 	}
-	mock.lockCreate.RLock()
-	calls = mock.calls.Create
-	mock.lockCreate.RUnlock()
+	mock.lockPrune.RLock()
+	calls = mock.calls.Prune
+	mock.lockPrune.RUnlock()
 	return calls
 }
 
-// Delete calls DeleteFunc.
-func (mock *NewsStorageMock) Delete(newsPostID radio.NewsPostID) error {
-	if mock.DeleteFunc == nil {
-		panic("NewsStorageMock.DeleteFunc: method is nil but NewsStorage.Delete was just called")
+// Range calls RangeFunc.
+func (mock *JournalStorageMock) Range(from time.Time, to time.Time) ([]radio.JournalEntry, error) {
+	if mock.RangeFunc == nil {
+		panic("JournalStorageMock.RangeFunc: method is nil but JournalStorage.Range was just called")
 	}
 	callInfo := struct {
-		NewsPostID radio.NewsPostID
+		From time.Time
+		To   time.Time
 	}{
-		NewsPostID: newsPostID,
+		From: from,
+		To:   to,
 	}
-	mock.lockDelete.Lock()
-	mock.calls.Delete = append(mock.calls.Delete, callInfo)
-	mock.lockDelete.Unlock()
-	return mock.DeleteFunc(newsPostID)
+	mock.lockRange.Lock()
+	mock.calls.Range = append(mock.calls.Range, callInfo)
+	mock.lockRange.Unlock()
+	return mock.RangeFunc(from, to)
 }
 
-// DeleteCalls gets all the calls that were made to Delete.
+// RangeCalls gets all the calls that were made to Range.
 // Check the length with:
 //
-//	len(mockedNewsStorage.DeleteCalls())
-func (mock *NewsStorageMock) DeleteCalls() []struct {
-	NewsPostID radio.NewsPostID
+//	len(mockedJournalStorage.RangeCalls())
+func (mock *JournalStorageMock) RangeCalls() []struct {
+	From time.Time
+	To   time.Time
 } {
 	var calls []struct {
-		NewsPostID radio.NewsPostID
+		From time.Time
+		To   time.Time
 	}
-	mock.lockDelete.RLock()
-	calls = mock.calls.Delete
-	mock.lockDelete.RUnlock()
+	mock.lockRange.RLock()
+	calls = mock.calls.Range
+	mock.lockRange.RUnlock()
 	return calls
 }
 
-// DeleteComment calls DeleteCommentFunc.
-func (mock *NewsStorageMock) DeleteComment(newsCommentID radio.NewsCommentID) error {
-	if mock.DeleteCommentFunc == nil {
-		panic("NewsStorageMock.DeleteCommentFunc: method is nil but NewsStorage.DeleteComment was just called")
+// Ensure, that GuestStorageServiceMock does implement radio.GuestStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.GuestStorageService = &GuestStorageServiceMock{}
+
+// GuestStorageServiceMock is a mock implementation of radio.GuestStorageService.
+//
+//	func TestSomethingThatUsesGuestStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.GuestStorageService
+//		mockedGuestStorageService := &GuestStorageServiceMock{
+//			GuestFunc: func(contextMoqParam context.Context) radio.GuestStorage {
+//				panic("mock out the Guest method")
+//			},
+//		}
+//
+//		// use mockedGuestStorageService in code that requires radio.GuestStorageService
+//		// and then make assertions.
+//
+//	}
+type GuestStorageServiceMock struct {
+	// GuestFunc mocks the Guest method.
+	GuestFunc func(contextMoqParam context.Context) radio.GuestStorage
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Guest holds details about calls to the Guest method.
+		Guest []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+	}
+	lockGuest sync.RWMutex
+}
+
+// Guest calls GuestFunc.
+func (mock *GuestStorageServiceMock) Guest(contextMoqParam context.Context) radio.GuestStorage {
+	if mock.GuestFunc == nil {
+		panic("GuestStorageServiceMock.GuestFunc: method is nil but GuestStorageService.Guest was just called")
 	}
 	callInfo := struct {
-		NewsCommentID radio.NewsCommentID
+		ContextMoqParam context.Context
 	}{
-		NewsCommentID: newsCommentID,
+		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockDeleteComment.Lock()
-	mock.calls.DeleteComment = append(mock.calls.DeleteComment, callInfo)
-	mock.lockDeleteComment.Unlock()
-	return mock.DeleteCommentFunc(newsCommentID)
+	mock.lockGuest.Lock()
+	mock.calls.Guest = append(mock.calls.Guest, callInfo)
+	mock.lockGuest.Unlock()
+	return mock.GuestFunc(contextMoqParam)
 }
 
-// DeleteCommentCalls gets all the calls that were made to DeleteComment.
+// GuestCalls gets all the calls that were made to Guest.
 // Check the length with:
 //
-//	len(mockedNewsStorage.DeleteCommentCalls())
-func (mock *NewsStorageMock) DeleteCommentCalls() []struct {
-	NewsCommentID radio.NewsCommentID
+//	len(mockedGuestStorageService.GuestCalls())
+func (mock *GuestStorageServiceMock) GuestCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
-		NewsCommentID radio.NewsCommentID
+		ContextMoqParam context.Context
 	}
-	mock.lockDeleteComment.RLock()
-	calls = mock.calls.DeleteComment
-	mock.lockDeleteComment.RUnlock()
+	mock.lockGuest.RLock()
+	calls = mock.calls.Guest
+	mock.lockGuest.RUnlock()
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *NewsStorageMock) Get(newsPostID radio.NewsPostID) (*radio.NewsPost, error) {
-	if mock.GetFunc == nil {
-		panic("NewsStorageMock.GetFunc: method is nil but NewsStorage.Get was just called")
+// Ensure, that GuestStorageMock does implement radio.GuestStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.GuestStorage = &GuestStorageMock{}
+
+// GuestStorageMock is a mock implementation of radio.GuestStorage.
+//
+//	func TestSomethingThatUsesGuestStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.GuestStorage
+//		mockedGuestStorage := &GuestStorageMock{
+//			AuthsFunc: func() ([]radio.GuestAuth, error) {
+//				panic("mock out the Auths method")
+//			},
+//			CreateInviteFunc: func(guestInvite radio.GuestInvite) (radio.GuestInviteID, error) {
+//				panic("mock out the CreateInvite method")
+//			},
+//			DeleteAuthFunc: func(nick string) error {
+//				panic("mock out the DeleteAuth method")
+//			},
+//			DeleteInviteFunc: func(guestInviteID radio.GuestInviteID) error {
+//				panic("mock out the DeleteInvite method")
+//			},
+//			InvitesFunc: func() ([]radio.GuestInvite, error) {
+//				panic("mock out the Invites method")
+//			},
+//			RedeemInviteFunc: func(code string, nick string) (*radio.GuestInvite, error) {
+//				panic("mock out the RedeemInvite method")
+//			},
+//			SaveAuthFunc: func(guestAuth radio.GuestAuth) error {
+//				panic("mock out the SaveAuth method")
+//			},
+//		}
+//
+//		// use mockedGuestStorage in code that requires radio.GuestStorage
+//		// and then make assertions.
+//
+//	}
+type GuestStorageMock struct {
+	// AuthsFunc mocks the Auths method.
+	AuthsFunc func() ([]radio.GuestAuth, error)
+
+	// CreateInviteFunc mocks the CreateInvite method.
+	CreateInviteFunc func(guestInvite radio.GuestInvite) (radio.GuestInviteID, error)
+
+	// DeleteAuthFunc mocks the DeleteAuth method.
+	DeleteAuthFunc func(nick string) error
+
+	// DeleteInviteFunc mocks the DeleteInvite method.
+	DeleteInviteFunc func(guestInviteID radio.GuestInviteID) error
+
+	// InvitesFunc mocks the Invites method.
+	InvitesFunc func() ([]radio.GuestInvite, error)
+
+	// RedeemInviteFunc mocks the RedeemInvite method.
+	RedeemInviteFunc func(code string, nick string) (*radio.GuestInvite, error)
+
+	// SaveAuthFunc mocks the SaveAuth method.
+	SaveAuthFunc func(guestAuth radio.GuestAuth) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Auths holds details about calls to the Auths method.
+		Auths []struct {
+		}
+		// CreateInvite holds details about calls to the CreateInvite method.
+		CreateInvite []struct {
+			// GuestInvite is the guestInvite argument value.
+			GuestInvite radio.GuestInvite
+		}
+		// DeleteAuth holds details about calls to the DeleteAuth method.
+		DeleteAuth []struct {
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// DeleteInvite holds details about calls to the DeleteInvite method.
+		DeleteInvite []struct {
+			// GuestInviteID is the guestInviteID argument value.
+			GuestInviteID radio.GuestInviteID
+		}
+		// Invites holds details about calls to the Invites method.
+		Invites []struct {
+		}
+		// RedeemInvite holds details about calls to the RedeemInvite method.
+		RedeemInvite []struct {
+			// Code is the code argument value.
+			Code string
+			// Nick is the nick argument value.
+			Nick string
+		}
+		// SaveAuth holds details about calls to the SaveAuth method.
+		SaveAuth []struct {
+			// GuestAuth is the guestAuth argument value.
+			GuestAuth radio.GuestAuth
+		}
 	}
-	callInfo := struct {
-		NewsPostID radio.NewsPostID
-	}{
-		NewsPostID: newsPostID,
+	lockAuths        sync.RWMutex
+	lockCreateInvite sync.RWMutex
+	lockDeleteAuth   sync.RWMutex
+	lockDeleteInvite sync.RWMutex
+	lockInvites      sync.RWMutex
+	lockRedeemInvite sync.RWMutex
+	lockSaveAuth     sync.RWMutex
+}
+
+// Auths calls AuthsFunc.
+func (mock *GuestStorageMock) Auths() ([]radio.GuestAuth, error) {
+	if mock.AuthsFunc == nil {
+		panic("GuestStorageMock.AuthsFunc: method is nil but GuestStorage.Auths was just called")
 	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	return mock.GetFunc(newsPostID)
+	callInfo := struct {
+	}{}
+	mock.lockAuths.Lock()
+	mock.calls.Auths = append(mock.calls.Auths, callInfo)
+	mock.lockAuths.Unlock()
+	return mock.AuthsFunc()
 }
 
-// GetCalls gets all the calls that were made to Get.
+// AuthsCalls gets all the calls that were made to Auths.
 // Check the length with:
 //
-//	len(mockedNewsStorage.GetCalls())
-func (mock *NewsStorageMock) GetCalls() []struct {
-	NewsPostID radio.NewsPostID
+//	len(mockedGuestStorage.AuthsCalls())
+func (mock *GuestStorageMock) AuthsCalls() []struct {
 } {
 	var calls []struct {
-		NewsPostID radio.NewsPostID
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockAuths.RLock()
+	calls = mock.calls.Auths
+	mock.lockAuths.RUnlock()
 	return calls
 }
 
-// List calls ListFunc.
-func (mock *NewsStorageMock) List(limit int64, offset int64) (radio.NewsList, error) {
-	if mock.ListFunc == nil {
-		panic("NewsStorageMock.ListFunc: method is nil but NewsStorage.List was just called")
+// CreateInvite calls CreateInviteFunc.
+func (mock *GuestStorageMock) CreateInvite(guestInvite radio.GuestInvite) (radio.GuestInviteID, error) {
+	if mock.CreateInviteFunc == nil {
+		panic("GuestStorageMock.CreateInviteFunc: method is nil but GuestStorage.CreateInvite was just called")
 	}
 	callInfo := struct {
-		Limit  int64
-		Offset int64
+		GuestInvite radio.GuestInvite
 	}{
-		Limit:  limit,
-		Offset: offset,
+		GuestInvite: guestInvite,
 	}
-	mock.lockList.Lock()
-	mock.calls.List = append(mock.calls.List, callInfo)
-	mock.lockList.Unlock()
-	return mock.ListFunc(limit, offset)
+	mock.lockCreateInvite.Lock()
+	mock.calls.CreateInvite = append(mock.calls.CreateInvite, callInfo)
+	mock.lockCreateInvite.Unlock()
+	return mock.CreateInviteFunc(guestInvite)
 }
 
-// ListCalls gets all the calls that were made to List.
+// CreateInviteCalls gets all the calls that were made to CreateInvite.
 // Check the length with:
 //
-//	len(mockedNewsStorage.ListCalls())
-func (mock *NewsStorageMock) ListCalls() []struct {
-	Limit  int64
-	Offset int64
+//	len(mockedGuestStorage.CreateInviteCalls())
+func (mock *GuestStorageMock) CreateInviteCalls() []struct {
+	GuestInvite radio.GuestInvite
 } {
 	var calls []struct {
-		Limit  int64
-		Offset int64
+		GuestInvite radio.GuestInvite
 	}
-	mock.lockList.RLock()
-	calls = mock.calls.List
-	mock.lockList.RUnlock()
+	mock.lockCreateInvite.RLock()
+	calls = mock.calls.CreateInvite
+	mock.lockCreateInvite.RUnlock()
 	return calls
 }
 
-// ListPublic calls ListPublicFunc.
-func (mock *NewsStorageMock) ListPublic(limit int64, offset int64) (radio.NewsList, error) {
-	if mock.ListPublicFunc == nil {
-		panic("NewsStorageMock.ListPublicFunc: method is nil but NewsStorage.ListPublic was just called")
+// DeleteAuth calls DeleteAuthFunc.
+func (mock *GuestStorageMock) DeleteAuth(nick string) error {
+	if mock.DeleteAuthFunc == nil {
+		panic("GuestStorageMock.DeleteAuthFunc: method is nil but GuestStorage.DeleteAuth was just called")
 	}
 	callInfo := struct {
-		Limit  int64
-		Offset int64
+		Nick string
 	}{
-		Limit:  limit,
-		Offset: offset,
+		Nick: nick,
 	}
-	mock.lockListPublic.Lock()
-	mock.calls.ListPublic = append(mock.calls.ListPublic, callInfo)
-	mock.lockListPublic.Unlock()
-	return mock.ListPublicFunc(limit, offset)
+	mock.lockDeleteAuth.Lock()
+	mock.calls.DeleteAuth = append(mock.calls.DeleteAuth, callInfo)
+	mock.lockDeleteAuth.Unlock()
+	return mock.DeleteAuthFunc(nick)
 }
 
-// ListPublicCalls gets all the calls that were made to ListPublic.
+// DeleteAuthCalls gets all the calls that were made to DeleteAuth.
 // Check the length with:
 //
-//	len(mockedNewsStorage.ListPublicCalls())
-func (mock *NewsStorageMock) ListPublicCalls() []struct {
-	Limit  int64
-	Offset int64
+//	len(mockedGuestStorage.DeleteAuthCalls())
+func (mock *GuestStorageMock) DeleteAuthCalls() []struct {
+	Nick string
 } {
 	var calls []struct {
-		Limit  int64
-		Offset int64
+		Nick string
 	}
-	mock.lockListPublic.RLock()
-	calls = mock.calls.ListPublic
-	mock.lockListPublic.RUnlock()
+	mock.lockDeleteAuth.RLock()
+	calls = mock.calls.DeleteAuth
+	mock.lockDeleteAuth.RUnlock()
 	return calls
 }
 
-// Update calls UpdateFunc.
-func (mock *NewsStorageMock) Update(newsPost radio.NewsPost) error {
-	if mock.UpdateFunc == nil {
-		panic("NewsStorageMock.UpdateFunc: method is nil but NewsStorage.Update was just called")
+// DeleteInvite calls DeleteInviteFunc.
+func (mock *GuestStorageMock) DeleteInvite(guestInviteID radio.GuestInviteID) error {
+	if mock.DeleteInviteFunc == nil {
+		panic("GuestStorageMock.DeleteInviteFunc: method is nil but GuestStorage.DeleteInvite was just called")
 	}
 	callInfo := struct {
-		NewsPost radio.NewsPost
+		GuestInviteID radio.GuestInviteID
 	}{
-		NewsPost: newsPost,
+		GuestInviteID: guestInviteID,
 	}
-	mock.lockUpdate.Lock()
-	mock.calls.Update = append(mock.calls.Update, callInfo)
-	mock.lockUpdate.Unlock()
-	return mock.UpdateFunc(newsPost)
+	mock.lockDeleteInvite.Lock()
+	mock.calls.DeleteInvite = append(mock.calls.DeleteInvite, callInfo)
+	mock.lockDeleteInvite.Unlock()
+	return mock.DeleteInviteFunc(guestInviteID)
 }
 
-// UpdateCalls gets all the calls that were made to Update.
+// DeleteInviteCalls gets all the calls that were made to DeleteInvite.
 // Check the length with:
 //
-//	len(mockedNewsStorage.UpdateCalls())
-func (mock *NewsStorageMock) UpdateCalls() []struct {
-	NewsPost radio.NewsPost
+//	len(mockedGuestStorage.DeleteInviteCalls())
+func (mock *GuestStorageMock) DeleteInviteCalls() []struct {
+	GuestInviteID radio.GuestInviteID
 } {
 	var calls []struct {
-		NewsPost radio.NewsPost
+		GuestInviteID radio.GuestInviteID
 	}
-	mock.lockUpdate.RLock()
-	calls = mock.calls.Update
-	mock.lockUpdate.RUnlock()
+	mock.lockDeleteInvite.RLock()
+	calls = mock.calls.DeleteInvite
+	mock.lockDeleteInvite.RUnlock()
 	return calls
 }
 
-// Ensure, that SubmissionStorageServiceMock does implement radio.SubmissionStorageService.
-// If this is not the case, regenerate this file with moq.
-var _ radio.SubmissionStorageService = &SubmissionStorageServiceMock{}
-
-// SubmissionStorageServiceMock is a mock implementation of radio.SubmissionStorageService.
-//
-//	func TestSomethingThatUsesSubmissionStorageService(t *testing.T) {
-//
-//		// make and configure a mocked radio.SubmissionStorageService
-//		mockedSubmissionStorageService := &SubmissionStorageServiceMock{
-//			SubmissionsFunc: func(contextMoqParam context.Context) radio.SubmissionStorage {
-//				panic("mock out the Submissions method")
-//			},
-//			SubmissionsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
-//				panic("mock out the SubmissionsTx method")
-//			},
-//		}
-//
-//		// use mockedSubmissionStorageService in code that requires radio.SubmissionStorageService
-//		// and then make assertions.
-//
-//	}
-type SubmissionStorageServiceMock struct {
-	// SubmissionsFunc mocks the Submissions method.
-	SubmissionsFunc func(contextMoqParam context.Context) radio.SubmissionStorage
-
-	// SubmissionsTxFunc mocks the SubmissionsTx method.
-	SubmissionsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error)
+// Invites calls InvitesFunc.
+func (mock *GuestStorageMock) Invites() ([]radio.GuestInvite, error) {
+	if mock.InvitesFunc == nil {
+		panic("GuestStorageMock.InvitesFunc: method is nil but GuestStorage.Invites was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockInvites.Lock()
+	mock.calls.Invites = append(mock.calls.Invites, callInfo)
+	mock.lockInvites.Unlock()
+	return mock.InvitesFunc()
+}
 
-	// calls tracks calls to the methods.
-	calls struct {
-		// Submissions holds details about calls to the Submissions method.
-		Submissions []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-		}
-		// SubmissionsTx holds details about calls to the SubmissionsTx method.
-		SubmissionsTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
-		}
+// InvitesCalls gets all the calls that were made to Invites.
+// Check the length with:
+//
+//	len(mockedGuestStorage.InvitesCalls())
+func (mock *GuestStorageMock) InvitesCalls() []struct {
+} {
+	var calls []struct {
 	}
-	lockSubmissions   sync.RWMutex
-	lockSubmissionsTx sync.RWMutex
+	mock.lockInvites.RLock()
+	calls = mock.calls.Invites
+	mock.lockInvites.RUnlock()
+	return calls
 }
 
-// Submissions calls SubmissionsFunc.
-func (mock *SubmissionStorageServiceMock) Submissions(contextMoqParam context.Context) radio.SubmissionStorage {
-	if mock.SubmissionsFunc == nil {
-		panic("SubmissionStorageServiceMock.SubmissionsFunc: method is nil but SubmissionStorageService.Submissions was just called")
+// RedeemInvite calls RedeemInviteFunc.
+func (mock *GuestStorageMock) RedeemInvite(code string, nick string) (*radio.GuestInvite, error) {
+	if mock.RedeemInviteFunc == nil {
+		panic("GuestStorageMock.RedeemInviteFunc: method is nil but GuestStorage.RedeemInvite was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
+		Code string
+		Nick string
 	}{
-		ContextMoqParam: contextMoqParam,
+		Code: code,
+		Nick: nick,
 	}
-	mock.lockSubmissions.Lock()
-	mock.calls.Submissions = append(mock.calls.Submissions, callInfo)
-	mock.lockSubmissions.Unlock()
-	return mock.SubmissionsFunc(contextMoqParam)
+	mock.lockRedeemInvite.Lock()
+	mock.calls.RedeemInvite = append(mock.calls.RedeemInvite, callInfo)
+	mock.lockRedeemInvite.Unlock()
+	return mock.RedeemInviteFunc(code, nick)
 }
 
-// SubmissionsCalls gets all the calls that were made to Submissions.
+// RedeemInviteCalls gets all the calls that were made to RedeemInvite.
 // Check the length with:
 //
-//	len(mockedSubmissionStorageService.SubmissionsCalls())
-func (mock *SubmissionStorageServiceMock) SubmissionsCalls() []struct {
-	ContextMoqParam context.Context
+//	len(mockedGuestStorage.RedeemInviteCalls())
+func (mock *GuestStorageMock) RedeemInviteCalls() []struct {
+	Code string
+	Nick string
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
+		Code string
+		Nick string
 	}
-	mock.lockSubmissions.RLock()
-	calls = mock.calls.Submissions
-	mock.lockSubmissions.RUnlock()
+	mock.lockRedeemInvite.RLock()
+	calls = mock.calls.RedeemInvite
+	mock.lockRedeemInvite.RUnlock()
 	return calls
 }
 
-// SubmissionsTx calls SubmissionsTxFunc.
-func (mock *SubmissionStorageServiceMock) SubmissionsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.SubmissionStorage, radio.StorageTx, error) {
-	if mock.SubmissionsTxFunc == nil {
-		panic("SubmissionStorageServiceMock.SubmissionsTxFunc: method is nil but SubmissionStorageService.SubmissionsTx was just called")
+// SaveAuth calls SaveAuthFunc.
+func (mock *GuestStorageMock) SaveAuth(guestAuth radio.GuestAuth) error {
+	if mock.SaveAuthFunc == nil {
+		panic("GuestStorageMock.SaveAuthFunc: method is nil but GuestStorage.SaveAuth was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		GuestAuth radio.GuestAuth
 	}{
-		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
+		GuestAuth: guestAuth,
 	}
-	mock.lockSubmissionsTx.Lock()
-	mock.calls.SubmissionsTx = append(mock.calls.SubmissionsTx, callInfo)
-	mock.lockSubmissionsTx.Unlock()
-	return mock.SubmissionsTxFunc(contextMoqParam, storageTx)
+	mock.lockSaveAuth.Lock()
+	mock.calls.SaveAuth = append(mock.calls.SaveAuth, callInfo)
+	mock.lockSaveAuth.Unlock()
+	return mock.SaveAuthFunc(guestAuth)
 }
 
-// SubmissionsTxCalls gets all the calls that were made to SubmissionsTx.
+// SaveAuthCalls gets all the calls that were made to SaveAuth.
 // Check the length with:
 //
-//	len(mockedSubmissionStorageService.SubmissionsTxCalls())
-func (mock *SubmissionStorageServiceMock) SubmissionsTxCalls() []struct {
-	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
+//	len(mockedGuestStorage.SaveAuthCalls())
+func (mock *GuestStorageMock) SaveAuthCalls() []struct {
+	GuestAuth radio.GuestAuth
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		GuestAuth radio.GuestAuth
 	}
-	mock.lockSubmissionsTx.RLock()
-	calls = mock.calls.SubmissionsTx
-	mock.lockSubmissionsTx.RUnlock()
+	mock.lockSaveAuth.RLock()
+	calls = mock.calls.SaveAuth
+	mock.lockSaveAuth.RUnlock()
 	return calls
 }
 
-// Ensure, that SubmissionStorageMock does implement radio.SubmissionStorage.
+// Ensure, that GuestServiceMock does implement radio.GuestService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.SubmissionStorage = &SubmissionStorageMock{}
+var _ radio.GuestService = &GuestServiceMock{}
 
-// SubmissionStorageMock is a mock implementation of radio.SubmissionStorage.
+// GuestServiceMock is a mock implementation of radio.GuestService.
 //
-//	func TestSomethingThatUsesSubmissionStorage(t *testing.T) {
+//	func TestSomethingThatUsesGuestService(t *testing.T) {
 //
-//		// make and configure a mocked radio.SubmissionStorage
-//		mockedSubmissionStorage := &SubmissionStorageMock{
-//			AllFunc: func() ([]radio.PendingSong, error) {
-//				panic("mock out the All method")
-//			},
-//			GetSubmissionFunc: func(submissionID radio.SubmissionID) (*radio.PendingSong, error) {
-//				panic("mock out the GetSubmission method")
+//		// make and configure a mocked radio.GuestService
+//		mockedGuestService := &GuestServiceMock{
+//			AuthFunc: func(ctx context.Context, nick string) (*radio.User, error) {
+//				panic("mock out the Auth method")
 //			},
-//			InsertPostPendingFunc: func(pendingSong radio.PendingSong) error {
-//				panic("mock out the InsertPostPending method")
-//			},
-//			InsertSubmissionFunc: func(pendingSong radio.PendingSong) error {
-//				panic("mock out the InsertSubmission method")
+//			CanDoFunc: func(ctx context.Context, nick string, can radio.GuestAction) (bool, error) {
+//				panic("mock out the CanDo method")
 //			},
-//			LastSubmissionTimeFunc: func(identifier string) (time.Time, error) {
-//				panic("mock out the LastSubmissionTime method")
+//			CreateFunc: func(ctx context.Context, nick string) (*radio.User, string, error) {
+//				panic("mock out the Create method")
 //			},
-//			RemoveSubmissionFunc: func(submissionID radio.SubmissionID) error {
-//				panic("mock out the RemoveSubmission method")
+//			DeauthFunc: func(ctx context.Context, nick string) error {
+//				panic("mock out the Deauth method")
 //			},
-//			SubmissionStatsFunc: func(identifier string) (radio.SubmissionStats, error) {
-//				panic("mock out the SubmissionStats method")
+//			DoFunc: func(ctx context.Context, nick string, can radio.GuestAction) (bool, error) {
+//				panic("mock out the Do method")
 //			},
-//			UpdateSubmissionTimeFunc: func(identifier string) error {
-//				panic("mock out the UpdateSubmissionTime method")
+//			RedeemFunc: func(ctx context.Context, code string, nick string) (*radio.User, error) {
+//				panic("mock out the Redeem method")
 //			},
 //		}
 //
-//		// use mockedSubmissionStorage in code that requires radio.SubmissionStorage
+//		// use mockedGuestService in code that requires radio.GuestService
 //		// and then make assertions.
 //
 //	}
-type SubmissionStorageMock struct {
-	// AllFunc mocks the All method.
-	AllFunc func() ([]radio.PendingSong, error)
-
-	// GetSubmissionFunc mocks the GetSubmission method.
-	GetSubmissionFunc func(submissionID radio.SubmissionID) (*radio.PendingSong, error)
-
-	// InsertPostPendingFunc mocks the InsertPostPending method.
-	InsertPostPendingFunc func(pendingSong radio.PendingSong) error
+type GuestServiceMock struct {
+	// AuthFunc mocks the Auth method.
+	AuthFunc func(ctx context.Context, nick string) (*radio.User, error)
 
-	// InsertSubmissionFunc mocks the InsertSubmission method.
-	InsertSubmissionFunc func(pendingSong radio.PendingSong) error
+	// CanDoFunc mocks the CanDo method.
+	CanDoFunc func(ctx context.Context, nick string, can radio.GuestAction) (bool, error)
 
-	// LastSubmissionTimeFunc mocks the LastSubmissionTime method.
-	LastSubmissionTimeFunc func(identifier string) (time.Time, error)
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, nick string) (*radio.User, string, error)
 
-	// RemoveSubmissionFunc mocks the RemoveSubmission method.
-	RemoveSubmissionFunc func(submissionID radio.SubmissionID) error
+	// DeauthFunc mocks the Deauth method.
+	DeauthFunc func(ctx context.Context, nick string) error
 
-	// SubmissionStatsFunc mocks the SubmissionStats method.
-	SubmissionStatsFunc func(identifier string) (radio.SubmissionStats, error)
+	// DoFunc mocks the Do method.
+	DoFunc func(ctx context.Context, nick string, can radio.GuestAction) (bool, error)
 
-	// UpdateSubmissionTimeFunc mocks the UpdateSubmissionTime method.
-	UpdateSubmissionTimeFunc func(identifier string) error
+	// RedeemFunc mocks the Redeem method.
+	RedeemFunc func(ctx context.Context, code string, nick string) (*radio.User, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// All holds details about calls to the All method.
-		All []struct {
-		}
-		// GetSubmission holds details about calls to the GetSubmission method.
-		GetSubmission []struct {
-			// SubmissionID is the submissionID argument value.
-			SubmissionID radio.SubmissionID
-		}
-		// InsertPostPending holds details about calls to the InsertPostPending method.
-		InsertPostPending []struct {
-			// PendingSong is the pendingSong argument value.
-			PendingSong radio.PendingSong
+		// Auth holds details about calls to the Auth method.
+		Auth []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nick is the nick argument value.
+			Nick string
 		}
-		// InsertSubmission holds details about calls to the InsertSubmission method.
-		InsertSubmission []struct {
-			// PendingSong is the pendingSong argument value.
-			PendingSong radio.PendingSong
+		// CanDo holds details about calls to the CanDo method.
+		CanDo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nick is the nick argument value.
+			Nick string
+			// Can is the can argument value.
+			Can radio.GuestAction
 		}
-		// LastSubmissionTime holds details about calls to the LastSubmissionTime method.
-		LastSubmissionTime []struct {
-			// Identifier is the identifier argument value.
-			Identifier string
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nick is the nick argument value.
+			Nick string
 		}
-		// RemoveSubmission holds details about calls to the RemoveSubmission method.
-		RemoveSubmission []struct {
-			// SubmissionID is the submissionID argument value.
-			SubmissionID radio.SubmissionID
+		// Deauth holds details about calls to the Deauth method.
+		Deauth []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nick is the nick argument value.
+			Nick string
 		}
-		// SubmissionStats holds details about calls to the SubmissionStats method.
-		SubmissionStats []struct {
-			// Identifier is the identifier argument value.
-			Identifier string
+		// Do holds details about calls to the Do method.
+		Do []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nick is the nick argument value.
+			Nick string
+			// Can is the can argument value.
+			Can radio.GuestAction
 		}
-		// UpdateSubmissionTime holds details about calls to the UpdateSubmissionTime method.
-		UpdateSubmissionTime []struct {
-			// Identifier is the identifier argument value.
-			Identifier string
+		// Redeem holds details about calls to the Redeem method.
+		Redeem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+			// Nick is the nick argument value.
+			Nick string
 		}
 	}
-	lockAll                  sync.RWMutex
-	lockGetSubmission        sync.RWMutex
-	lockInsertPostPending    sync.RWMutex
-	lockInsertSubmission     sync.RWMutex
-	lockLastSubmissionTime   sync.RWMutex
-	lockRemoveSubmission     sync.RWMutex
-	lockSubmissionStats      sync.RWMutex
-	lockUpdateSubmissionTime sync.RWMutex
-}
-
-// All calls AllFunc.
-func (mock *SubmissionStorageMock) All() ([]radio.PendingSong, error) {
-	if mock.AllFunc == nil {
-		panic("SubmissionStorageMock.AllFunc: method is nil but SubmissionStorage.All was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockAll.Lock()
-	mock.calls.All = append(mock.calls.All, callInfo)
-	mock.lockAll.Unlock()
-	return mock.AllFunc()
-}
-
-// AllCalls gets all the calls that were made to All.
-// Check the length with:
-//
-//	len(mockedSubmissionStorage.AllCalls())
-func (mock *SubmissionStorageMock) AllCalls() []struct {
-} {
-	var calls []struct {
-	}
-	mock.lockAll.RLock()
-	calls = mock.calls.All
-	mock.lockAll.RUnlock()
-	return calls
-}
-
-// GetSubmission calls GetSubmissionFunc.
-func (mock *SubmissionStorageMock) GetSubmission(submissionID radio.SubmissionID) (*radio.PendingSong, error) {
-	if mock.GetSubmissionFunc == nil {
-		panic("SubmissionStorageMock.GetSubmissionFunc: method is nil but SubmissionStorage.GetSubmission was just called")
-	}
-	callInfo := struct {
-		SubmissionID radio.SubmissionID
-	}{
-		SubmissionID: submissionID,
-	}
-	mock.lockGetSubmission.Lock()
-	mock.calls.GetSubmission = append(mock.calls.GetSubmission, callInfo)
-	mock.lockGetSubmission.Unlock()
-	return mock.GetSubmissionFunc(submissionID)
-}
-
-// GetSubmissionCalls gets all the calls that were made to GetSubmission.
-// Check the length with:
-//
-//	len(mockedSubmissionStorage.GetSubmissionCalls())
-func (mock *SubmissionStorageMock) GetSubmissionCalls() []struct {
-	SubmissionID radio.SubmissionID
-} {
-	var calls []struct {
-		SubmissionID radio.SubmissionID
-	}
-	mock.lockGetSubmission.RLock()
-	calls = mock.calls.GetSubmission
-	mock.lockGetSubmission.RUnlock()
-	return calls
+	lockAuth   sync.RWMutex
+	lockCanDo  sync.RWMutex
+	lockCreate sync.RWMutex
+	lockDeauth sync.RWMutex
+	lockDo     sync.RWMutex
+	lockRedeem sync.RWMutex
 }
 
-// InsertPostPending calls InsertPostPendingFunc.
-func (mock *SubmissionStorageMock) InsertPostPending(pendingSong radio.PendingSong) error {
-	if mock.InsertPostPendingFunc == nil {
-		panic("SubmissionStorageMock.InsertPostPendingFunc: method is nil but SubmissionStorage.InsertPostPending was just called")
+// Auth calls AuthFunc.
+func (mock *GuestServiceMock) Auth(ctx context.Context, nick string) (*radio.User, error) {
+	if mock.AuthFunc == nil {
+		panic("GuestServiceMock.AuthFunc: method is nil but GuestService.Auth was just called")
 	}
 	callInfo := struct {
-		PendingSong radio.PendingSong
+		Ctx  context.Context
+		Nick string
 	}{
-		PendingSong: pendingSong,
+		Ctx:  ctx,
+		Nick: nick,
 	}
-	mock.lockInsertPostPending.Lock()
-	mock.calls.InsertPostPending = append(mock.calls.InsertPostPending, callInfo)
-	mock.lockInsertPostPending.Unlock()
-	return mock.InsertPostPendingFunc(pendingSong)
+	mock.lockAuth.Lock()
+	mock.calls.Auth = append(mock.calls.Auth, callInfo)
+	mock.lockAuth.Unlock()
+	return mock.AuthFunc(ctx, nick)
 }
 
-// InsertPostPendingCalls gets all the calls that were made to InsertPostPending.
+// AuthCalls gets all the calls that were made to Auth.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.InsertPostPendingCalls())
-func (mock *SubmissionStorageMock) InsertPostPendingCalls() []struct {
-	PendingSong radio.PendingSong
+//	len(mockedGuestService.AuthCalls())
+func (mock *GuestServiceMock) AuthCalls() []struct {
+	Ctx  context.Context
+	Nick string
 } {
 	var calls []struct {
-		PendingSong radio.PendingSong
+		Ctx  context.Context
+		Nick string
 	}
-	mock.lockInsertPostPending.RLock()
-	calls = mock.calls.InsertPostPending
-	mock.lockInsertPostPending.RUnlock()
+	mock.lockAuth.RLock()
+	calls = mock.calls.Auth
+	mock.lockAuth.RUnlock()
 	return calls
 }
-
-// InsertSubmission calls InsertSubmissionFunc.
-func (mock *SubmissionStorageMock) InsertSubmission(pendingSong radio.PendingSong) error {
-	if mock.InsertSubmissionFunc == nil {
-		panic("SubmissionStorageMock.InsertSubmissionFunc: method is nil but SubmissionStorage.InsertSubmission was just called")
+
+// CanDo calls CanDoFunc.
+func (mock *GuestServiceMock) CanDo(ctx context.Context, nick string, can radio.GuestAction) (bool, error) {
+	if mock.CanDoFunc == nil {
+		panic("GuestServiceMock.CanDoFunc: method is nil but GuestService.CanDo was just called")
 	}
 	callInfo := struct {
-		PendingSong radio.PendingSong
+		Ctx  context.Context
+		Nick string
+		Can  radio.GuestAction
 	}{
-		PendingSong: pendingSong,
+		Ctx:  ctx,
+		Nick: nick,
+		Can:  can,
 	}
-	mock.lockInsertSubmission.Lock()
-	mock.calls.InsertSubmission = append(mock.calls.InsertSubmission, callInfo)
-	mock.lockInsertSubmission.Unlock()
-	return mock.InsertSubmissionFunc(pendingSong)
+	mock.lockCanDo.Lock()
+	mock.calls.CanDo = append(mock.calls.CanDo, callInfo)
+	mock.lockCanDo.Unlock()
+	return mock.CanDoFunc(ctx, nick, can)
 }
 
-// InsertSubmissionCalls gets all the calls that were made to InsertSubmission.
+// CanDoCalls gets all the calls that were made to CanDo.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.InsertSubmissionCalls())
-func (mock *SubmissionStorageMock) InsertSubmissionCalls() []struct {
-	PendingSong radio.PendingSong
+//	len(mockedGuestService.CanDoCalls())
+func (mock *GuestServiceMock) CanDoCalls() []struct {
+	Ctx  context.Context
+	Nick string
+	Can  radio.GuestAction
 } {
 	var calls []struct {
-		PendingSong radio.PendingSong
+		Ctx  context.Context
+		Nick string
+		Can  radio.GuestAction
 	}
-	mock.lockInsertSubmission.RLock()
-	calls = mock.calls.InsertSubmission
-	mock.lockInsertSubmission.RUnlock()
+	mock.lockCanDo.RLock()
+	calls = mock.calls.CanDo
+	mock.lockCanDo.RUnlock()
 	return calls
 }
 
-// LastSubmissionTime calls LastSubmissionTimeFunc.
-func (mock *SubmissionStorageMock) LastSubmissionTime(identifier string) (time.Time, error) {
-	if mock.LastSubmissionTimeFunc == nil {
-		panic("SubmissionStorageMock.LastSubmissionTimeFunc: method is nil but SubmissionStorage.LastSubmissionTime was just called")
+// Create calls CreateFunc.
+func (mock *GuestServiceMock) Create(ctx context.Context, nick string) (*radio.User, string, error) {
+	if mock.CreateFunc == nil {
+		panic("GuestServiceMock.CreateFunc: method is nil but GuestService.Create was just called")
 	}
 	callInfo := struct {
-		Identifier string
+		Ctx  context.Context
+		Nick string
 	}{
-		Identifier: identifier,
+		Ctx:  ctx,
+		Nick: nick,
 	}
-	mock.lockLastSubmissionTime.Lock()
-	mock.calls.LastSubmissionTime = append(mock.calls.LastSubmissionTime, callInfo)
-	mock.lockLastSubmissionTime.Unlock()
-	return mock.LastSubmissionTimeFunc(identifier)
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, nick)
 }
 
-// LastSubmissionTimeCalls gets all the calls that were made to LastSubmissionTime.
+// CreateCalls gets all the calls that were made to Create.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.LastSubmissionTimeCalls())
-func (mock *SubmissionStorageMock) LastSubmissionTimeCalls() []struct {
-	Identifier string
+//	len(mockedGuestService.CreateCalls())
+func (mock *GuestServiceMock) CreateCalls() []struct {
+	Ctx  context.Context
+	Nick string
 } {
 	var calls []struct {
-		Identifier string
+		Ctx  context.Context
+		Nick string
 	}
-	mock.lockLastSubmissionTime.RLock()
-	calls = mock.calls.LastSubmissionTime
-	mock.lockLastSubmissionTime.RUnlock()
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
 	return calls
 }
 
-// RemoveSubmission calls RemoveSubmissionFunc.
-func (mock *SubmissionStorageMock) RemoveSubmission(submissionID radio.SubmissionID) error {
-	if mock.RemoveSubmissionFunc == nil {
-		panic("SubmissionStorageMock.RemoveSubmissionFunc: method is nil but SubmissionStorage.RemoveSubmission was just called")
+// Deauth calls DeauthFunc.
+func (mock *GuestServiceMock) Deauth(ctx context.Context, nick string) error {
+	if mock.DeauthFunc == nil {
+		panic("GuestServiceMock.DeauthFunc: method is nil but GuestService.Deauth was just called")
 	}
 	callInfo := struct {
-		SubmissionID radio.SubmissionID
+		Ctx  context.Context
+		Nick string
 	}{
-		SubmissionID: submissionID,
+		Ctx:  ctx,
+		Nick: nick,
 	}
-	mock.lockRemoveSubmission.Lock()
-	mock.calls.RemoveSubmission = append(mock.calls.RemoveSubmission, callInfo)
-	mock.lockRemoveSubmission.Unlock()
-	return mock.RemoveSubmissionFunc(submissionID)
+	mock.lockDeauth.Lock()
+	mock.calls.Deauth = append(mock.calls.Deauth, callInfo)
+	mock.lockDeauth.Unlock()
+	return mock.DeauthFunc(ctx, nick)
 }
 
-// RemoveSubmissionCalls gets all the calls that were made to RemoveSubmission.
+// DeauthCalls gets all the calls that were made to Deauth.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.RemoveSubmissionCalls())
-func (mock *SubmissionStorageMock) RemoveSubmissionCalls() []struct {
-	SubmissionID radio.SubmissionID
+//	len(mockedGuestService.DeauthCalls())
+func (mock *GuestServiceMock) DeauthCalls() []struct {
+	Ctx  context.Context
+	Nick string
 } {
 	var calls []struct {
-		SubmissionID radio.SubmissionID
+		Ctx  context.Context
+		Nick string
 	}
-	mock.lockRemoveSubmission.RLock()
-	calls = mock.calls.RemoveSubmission
-	mock.lockRemoveSubmission.RUnlock()
+	mock.lockDeauth.RLock()
+	calls = mock.calls.Deauth
+	mock.lockDeauth.RUnlock()
 	return calls
 }
 
-// SubmissionStats calls SubmissionStatsFunc.
-func (mock *SubmissionStorageMock) SubmissionStats(identifier string) (radio.SubmissionStats, error) {
-	if mock.SubmissionStatsFunc == nil {
-		panic("SubmissionStorageMock.SubmissionStatsFunc: method is nil but SubmissionStorage.SubmissionStats was just called")
+// Do calls DoFunc.
+func (mock *GuestServiceMock) Do(ctx context.Context, nick string, can radio.GuestAction) (bool, error) {
+	if mock.DoFunc == nil {
+		panic("GuestServiceMock.DoFunc: method is nil but GuestService.Do was just called")
 	}
 	callInfo := struct {
-		Identifier string
+		Ctx  context.Context
+		Nick string
+		Can  radio.GuestAction
 	}{
-		Identifier: identifier,
+		Ctx:  ctx,
+		Nick: nick,
+		Can:  can,
 	}
-	mock.lockSubmissionStats.Lock()
-	mock.calls.SubmissionStats = append(mock.calls.SubmissionStats, callInfo)
-	mock.lockSubmissionStats.Unlock()
-	return mock.SubmissionStatsFunc(identifier)
+	mock.lockDo.Lock()
+	mock.calls.Do = append(mock.calls.Do, callInfo)
+	mock.lockDo.Unlock()
+	return mock.DoFunc(ctx, nick, can)
 }
 
-// SubmissionStatsCalls gets all the calls that were made to SubmissionStats.
+// DoCalls gets all the calls that were made to Do.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.SubmissionStatsCalls())
-func (mock *SubmissionStorageMock) SubmissionStatsCalls() []struct {
-	Identifier string
+//	len(mockedGuestService.DoCalls())
+func (mock *GuestServiceMock) DoCalls() []struct {
+	Ctx  context.Context
+	Nick string
+	Can  radio.GuestAction
 } {
 	var calls []struct {
-		Identifier string
+		Ctx  context.Context
+		Nick string
+		Can  radio.GuestAction
 	}
-	mock.lockSubmissionStats.RLock()
-	calls = mock.calls.SubmissionStats
-	mock.lockSubmissionStats.RUnlock()
+	mock.lockDo.RLock()
+	calls = mock.calls.Do
+	mock.lockDo.RUnlock()
 	return calls
 }
 
-// UpdateSubmissionTime calls UpdateSubmissionTimeFunc.
-func (mock *SubmissionStorageMock) UpdateSubmissionTime(identifier string) error {
-	if mock.UpdateSubmissionTimeFunc == nil {
-		panic("SubmissionStorageMock.UpdateSubmissionTimeFunc: method is nil but SubmissionStorage.UpdateSubmissionTime was just called")
+// Redeem calls RedeemFunc.
+func (mock *GuestServiceMock) Redeem(ctx context.Context, code string, nick string) (*radio.User, error) {
+	if mock.RedeemFunc == nil {
+		panic("GuestServiceMock.RedeemFunc: method is nil but GuestService.Redeem was just called")
 	}
 	callInfo := struct {
-		Identifier string
+		Ctx  context.Context
+		Code string
+		Nick string
 	}{
-		Identifier: identifier,
+		Ctx:  ctx,
+		Code: code,
+		Nick: nick,
 	}
-	mock.lockUpdateSubmissionTime.Lock()
-	mock.calls.UpdateSubmissionTime = append(mock.calls.UpdateSubmissionTime, callInfo)
-	mock.lockUpdateSubmissionTime.Unlock()
-	return mock.UpdateSubmissionTimeFunc(identifier)
+	mock.lockRedeem.Lock()
+	mock.calls.Redeem = append(mock.calls.Redeem, callInfo)
+	mock.lockRedeem.Unlock()
+	return mock.RedeemFunc(ctx, code, nick)
 }
 
-// UpdateSubmissionTimeCalls gets all the calls that were made to UpdateSubmissionTime.
+// RedeemCalls gets all the calls that were made to Redeem.
 // Check the length with:
 //
-//	len(mockedSubmissionStorage.UpdateSubmissionTimeCalls())
-func (mock *SubmissionStorageMock) UpdateSubmissionTimeCalls() []struct {
-	Identifier string
+//	len(mockedGuestService.RedeemCalls())
+func (mock *GuestServiceMock) RedeemCalls() []struct {
+	Ctx  context.Context
+	Code string
+	Nick string
 } {
 	var calls []struct {
-		Identifier string
+		Ctx  context.Context
+		Code string
+		Nick string
 	}
-	mock.lockUpdateSubmissionTime.RLock()
-	calls = mock.calls.UpdateSubmissionTime
-	mock.lockUpdateSubmissionTime.RUnlock()
+	mock.lockRedeem.RLock()
+	calls = mock.calls.Redeem
+	mock.lockRedeem.RUnlock()
 	return calls
 }
 
-// Ensure, that RelayStorageMock does implement radio.RelayStorage.
+// Ensure, that RequestPoolStorageServiceMock does implement radio.RequestPoolStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.RelayStorage = &RelayStorageMock{}
+var _ radio.RequestPoolStorageService = &RequestPoolStorageServiceMock{}
 
-// RelayStorageMock is a mock implementation of radio.RelayStorage.
+// RequestPoolStorageServiceMock is a mock implementation of radio.RequestPoolStorageService.
 //
-//	func TestSomethingThatUsesRelayStorage(t *testing.T) {
+//	func TestSomethingThatUsesRequestPoolStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.RelayStorage
-//		mockedRelayStorage := &RelayStorageMock{
-//			AllFunc: func() ([]radio.Relay, error) {
-//				panic("mock out the All method")
+//		// make and configure a mocked radio.RequestPoolStorageService
+//		mockedRequestPoolStorageService := &RequestPoolStorageServiceMock{
+//			RequestPoolFunc: func(contextMoqParam context.Context) radio.RequestPoolStorage {
+//				panic("mock out the RequestPool method")
 //			},
-//			UpdateFunc: func(r radio.Relay) error {
-//				panic("mock out the Update method")
+//			RequestPoolTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+//				panic("mock out the RequestPoolTx method")
 //			},
 //		}
 //
-//		// use mockedRelayStorage in code that requires radio.RelayStorage
+//		// use mockedRequestPoolStorageService in code that requires radio.RequestPoolStorageService
 //		// and then make assertions.
 //
 //	}
-type RelayStorageMock struct {
-	// AllFunc mocks the All method.
-	AllFunc func() ([]radio.Relay, error)
+type RequestPoolStorageServiceMock struct {
+	// RequestPoolFunc mocks the RequestPool method.
+	RequestPoolFunc func(contextMoqParam context.Context) radio.RequestPoolStorage
 
-	// UpdateFunc mocks the Update method.
-	UpdateFunc func(r radio.Relay) error
+	// RequestPoolTxFunc mocks the RequestPoolTx method.
+	RequestPoolTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// All holds details about calls to the All method.
-		All []struct {
+		// RequestPool holds details about calls to the RequestPool method.
+		RequestPool []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
 		}
-		// Update holds details about calls to the Update method.
-		Update []struct {
-			// R is the r argument value.
-			R radio.Relay
+		// RequestPoolTx holds details about calls to the RequestPoolTx method.
+		RequestPoolTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
 		}
 	}
-	lockAll    sync.RWMutex
-	lockUpdate sync.RWMutex
+	lockRequestPool   sync.RWMutex
+	lockRequestPoolTx sync.RWMutex
 }
 
-// All calls AllFunc.
-func (mock *RelayStorageMock) All() ([]radio.Relay, error) {
-	if mock.AllFunc == nil {
-		panic("RelayStorageMock.AllFunc: method is nil but RelayStorage.All was just called")
+// RequestPool calls RequestPoolFunc.
+func (mock *RequestPoolStorageServiceMock) RequestPool(contextMoqParam context.Context) radio.RequestPoolStorage {
+	if mock.RequestPoolFunc == nil {
+		panic("RequestPoolStorageServiceMock.RequestPoolFunc: method is nil but RequestPoolStorageService.RequestPool was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockAll.Lock()
-	mock.calls.All = append(mock.calls.All, callInfo)
-	mock.lockAll.Unlock()
-	return mock.AllFunc()
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockRequestPool.Lock()
+	mock.calls.RequestPool = append(mock.calls.RequestPool, callInfo)
+	mock.lockRequestPool.Unlock()
+	return mock.RequestPoolFunc(contextMoqParam)
 }
 
-// AllCalls gets all the calls that were made to All.
+// RequestPoolCalls gets all the calls that were made to RequestPool.
 // Check the length with:
 //
-//	len(mockedRelayStorage.AllCalls())
-func (mock *RelayStorageMock) AllCalls() []struct {
+//	len(mockedRequestPoolStorageService.RequestPoolCalls())
+func (mock *RequestPoolStorageServiceMock) RequestPoolCalls() []struct {
+	ContextMoqParam context.Context
 } {
 	var calls []struct {
+		ContextMoqParam context.Context
 	}
-	mock.lockAll.RLock()
-	calls = mock.calls.All
-	mock.lockAll.RUnlock()
+	mock.lockRequestPool.RLock()
+	calls = mock.calls.RequestPool
+	mock.lockRequestPool.RUnlock()
 	return calls
 }
 
-// Update calls UpdateFunc.
-func (mock *RelayStorageMock) Update(r radio.Relay) error {
-	if mock.UpdateFunc == nil {
-		panic("RelayStorageMock.UpdateFunc: method is nil but RelayStorage.Update was just called")
+// RequestPoolTx calls RequestPoolTxFunc.
+func (mock *RequestPoolStorageServiceMock) RequestPoolTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+	if mock.RequestPoolTxFunc == nil {
+		panic("RequestPoolStorageServiceMock.RequestPoolTxFunc: method is nil but RequestPoolStorageService.RequestPoolTx was just called")
 	}
 	callInfo := struct {
-		R radio.Relay
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}{
-		R: r,
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
 	}
-	mock.lockUpdate.Lock()
-	mock.calls.Update = append(mock.calls.Update, callInfo)
-	mock.lockUpdate.Unlock()
-	return mock.UpdateFunc(r)
+	mock.lockRequestPoolTx.Lock()
+	mock.calls.RequestPoolTx = append(mock.calls.RequestPoolTx, callInfo)
+	mock.lockRequestPoolTx.Unlock()
+	return mock.RequestPoolTxFunc(contextMoqParam, storageTx)
 }
 
-// UpdateCalls gets all the calls that were made to Update.
+// RequestPoolTxCalls gets all the calls that were made to RequestPoolTx.
 // Check the length with:
 //
-//	len(mockedRelayStorage.UpdateCalls())
-func (mock *RelayStorageMock) UpdateCalls() []struct {
-	R radio.Relay
+//	len(mockedRequestPoolStorageService.RequestPoolTxCalls())
+func (mock *RequestPoolStorageServiceMock) RequestPoolTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
 } {
 	var calls []struct {
-		R radio.Relay
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
 	}
-	mock.lockUpdate.RLock()
-	calls = mock.calls.Update
-	mock.lockUpdate.RUnlock()
+	mock.lockRequestPoolTx.RLock()
+	calls = mock.calls.RequestPoolTx
+	mock.lockRequestPoolTx.RUnlock()
 	return calls
 }
 
-// Ensure, that RelayStorageServiceMock does implement radio.RelayStorageService.
+// Ensure, that RequestPoolStorageMock does implement radio.RequestPoolStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.RelayStorageService = &RelayStorageServiceMock{}
+var _ radio.RequestPoolStorage = &RequestPoolStorageMock{}
 
-// RelayStorageServiceMock is a mock implementation of radio.RelayStorageService.
+// RequestPoolStorageMock is a mock implementation of radio.RequestPoolStorage.
 //
-//	func TestSomethingThatUsesRelayStorageService(t *testing.T) {
+//	func TestSomethingThatUsesRequestPoolStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.RelayStorageService
-//		mockedRelayStorageService := &RelayStorageServiceMock{
-//			RelayFunc: func(contextMoqParam context.Context) radio.RelayStorage {
-//				panic("mock out the Relay method")
+//		// make and configure a mocked radio.RequestPoolStorage
+//		mockedRequestPoolStorage := &RequestPoolStorageMock{
+//			AddFunc: func(pendingRequest radio.PendingRequest) error {
+//				panic("mock out the Add method")
 //			},
-//			RelayTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
-//				panic("mock out the RelayTx method")
+//			AllFunc: func() ([]radio.PendingRequest, error) {
+//				panic("mock out the All method")
+//			},
+//			RemoveFunc: func(trackID radio.TrackID) error {
+//				panic("mock out the Remove method")
+//			},
+//			VoteFunc: func(id radio.TrackID, identifier string) error {
+//				panic("mock out the Vote method")
 //			},
 //		}
 //
-//		// use mockedRelayStorageService in code that requires radio.RelayStorageService
+//		// use mockedRequestPoolStorage in code that requires radio.RequestPoolStorage
 //		// and then make assertions.
 //
 //	}
-type RelayStorageServiceMock struct {
-	// RelayFunc mocks the Relay method.
-	RelayFunc func(contextMoqParam context.Context) radio.RelayStorage
+type RequestPoolStorageMock struct {
+	// AddFunc mocks the Add method.
+	AddFunc func(pendingRequest radio.PendingRequest) error
 
-	// RelayTxFunc mocks the RelayTx method.
-	RelayTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error)
+	// AllFunc mocks the All method.
+	AllFunc func() ([]radio.PendingRequest, error)
+
+	// RemoveFunc mocks the Remove method.
+	RemoveFunc func(trackID radio.TrackID) error
+
+	// VoteFunc mocks the Vote method.
+	VoteFunc func(id radio.TrackID, identifier string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// PendingRequest is the pendingRequest argument value.
+			PendingRequest radio.PendingRequest
+		}
+		// All holds details about calls to the All method.
+		All []struct {
+		}
+		// Remove holds details about calls to the Remove method.
+		Remove []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+		}
+		// Vote holds details about calls to the Vote method.
+		Vote []struct {
+			// Id is the id argument value.
+			Id radio.TrackID
+			// Identifier is the identifier argument value.
+			Identifier string
+		}
+	}
+	lockAdd    sync.RWMutex
+	lockAll    sync.RWMutex
+	lockRemove sync.RWMutex
+	lockVote   sync.RWMutex
+}
+
+// Add calls AddFunc.
+func (mock *RequestPoolStorageMock) Add(pendingRequest radio.PendingRequest) error {
+	if mock.AddFunc == nil {
+		panic("RequestPoolStorageMock.AddFunc: method is nil but RequestPoolStorage.Add was just called")
+	}
+	callInfo := struct {
+		PendingRequest radio.PendingRequest
+	}{
+		PendingRequest: pendingRequest,
+	}
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(pendingRequest)
+}
+
+// AddCalls gets all the calls that were made to Add.
+// Check the length with:
+//
+//	len(mockedRequestPoolStorage.AddCalls())
+func (mock *RequestPoolStorageMock) AddCalls() []struct {
+	PendingRequest radio.PendingRequest
+} {
+	var calls []struct {
+		PendingRequest radio.PendingRequest
+	}
+	mock.lockAdd.RLock()
+	calls = mock.calls.Add
+	mock.lockAdd.RUnlock()
+	return calls
+}
+
+// All calls AllFunc.
+func (mock *RequestPoolStorageMock) All() ([]radio.PendingRequest, error) {
+	if mock.AllFunc == nil {
+		panic("RequestPoolStorageMock.AllFunc: method is nil but RequestPoolStorage.All was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc()
+}
 
-	// calls tracks calls to the methods.
-	calls struct {
-		// Relay holds details about calls to the Relay method.
-		Relay []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-		}
-		// RelayTx holds details about calls to the RelayTx method.
-		RelayTx []struct {
-			// ContextMoqParam is the contextMoqParam argument value.
-			ContextMoqParam context.Context
-			// StorageTx is the storageTx argument value.
-			StorageTx radio.StorageTx
-		}
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedRequestPoolStorage.AllCalls())
+func (mock *RequestPoolStorageMock) AllCalls() []struct {
+} {
+	var calls []struct {
 	}
-	lockRelay   sync.RWMutex
-	lockRelayTx sync.RWMutex
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
 }
 
-// Relay calls RelayFunc.
-func (mock *RelayStorageServiceMock) Relay(contextMoqParam context.Context) radio.RelayStorage {
-	if mock.RelayFunc == nil {
-		panic("RelayStorageServiceMock.RelayFunc: method is nil but RelayStorageService.Relay was just called")
+// Remove calls RemoveFunc.
+func (mock *RequestPoolStorageMock) Remove(trackID radio.TrackID) error {
+	if mock.RemoveFunc == nil {
+		panic("RequestPoolStorageMock.RemoveFunc: method is nil but RequestPoolStorage.Remove was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
+		TrackID radio.TrackID
 	}{
-		ContextMoqParam: contextMoqParam,
+		TrackID: trackID,
 	}
-	mock.lockRelay.Lock()
-	mock.calls.Relay = append(mock.calls.Relay, callInfo)
-	mock.lockRelay.Unlock()
-	return mock.RelayFunc(contextMoqParam)
+	mock.lockRemove.Lock()
+	mock.calls.Remove = append(mock.calls.Remove, callInfo)
+	mock.lockRemove.Unlock()
+	return mock.RemoveFunc(trackID)
 }
 
-// RelayCalls gets all the calls that were made to Relay.
+// RemoveCalls gets all the calls that were made to Remove.
 // Check the length with:
 //
-//	len(mockedRelayStorageService.RelayCalls())
-func (mock *RelayStorageServiceMock) RelayCalls() []struct {
-	ContextMoqParam context.Context
+//	len(mockedRequestPoolStorage.RemoveCalls())
+func (mock *RequestPoolStorageMock) RemoveCalls() []struct {
+	TrackID radio.TrackID
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
+		TrackID radio.TrackID
 	}
-	mock.lockRelay.RLock()
-	calls = mock.calls.Relay
-	mock.lockRelay.RUnlock()
+	mock.lockRemove.RLock()
+	calls = mock.calls.Remove
+	mock.lockRemove.RUnlock()
 	return calls
 }
 
-// RelayTx calls RelayTxFunc.
-func (mock *RelayStorageServiceMock) RelayTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RelayStorage, radio.StorageTx, error) {
-	if mock.RelayTxFunc == nil {
-		panic("RelayStorageServiceMock.RelayTxFunc: method is nil but RelayStorageService.RelayTx was just called")
+// Vote calls VoteFunc.
+func (mock *RequestPoolStorageMock) Vote(id radio.TrackID, identifier string) error {
+	if mock.VoteFunc == nil {
+		panic("RequestPoolStorageMock.VoteFunc: method is nil but RequestPoolStorage.Vote was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Id         radio.TrackID
+		Identifier string
 	}{
-		ContextMoqParam: contextMoqParam,
-		StorageTx:       storageTx,
+		Id:         id,
+		Identifier: identifier,
 	}
-	mock.lockRelayTx.Lock()
-	mock.calls.RelayTx = append(mock.calls.RelayTx, callInfo)
-	mock.lockRelayTx.Unlock()
-	return mock.RelayTxFunc(contextMoqParam, storageTx)
+	mock.lockVote.Lock()
+	mock.calls.Vote = append(mock.calls.Vote, callInfo)
+	mock.lockVote.Unlock()
+	return mock.VoteFunc(id, identifier)
 }
 
-// RelayTxCalls gets all the calls that were made to RelayTx.
+// VoteCalls gets all the calls that were made to Vote.
 // Check the length with:
 //
-//	len(mockedRelayStorageService.RelayTxCalls())
-func (mock *RelayStorageServiceMock) RelayTxCalls() []struct {
-	ContextMoqParam context.Context
-	StorageTx       radio.StorageTx
+//	len(mockedRequestPoolStorage.VoteCalls())
+func (mock *RequestPoolStorageMock) VoteCalls() []struct {
+	Id         radio.TrackID
+	Identifier string
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
-		StorageTx       radio.StorageTx
+		Id         radio.TrackID
+		Identifier string
 	}
-	mock.lockRelayTx.RLock()
-	calls = mock.calls.RelayTx
-	mock.lockRelayTx.RUnlock()
+	mock.lockVote.RLock()
+	calls = mock.calls.Vote
+	mock.lockVote.RUnlock()
 	return calls
 }
 
-// Ensure, that ScheduleStorageServiceMock does implement radio.ScheduleStorageService.
+// Ensure, that LyricsStorageServiceMock does implement radio.LyricsStorageService.
 // If this is not the case, regenerate this file with moq.
-var _ radio.ScheduleStorageService = &ScheduleStorageServiceMock{}
+var _ radio.LyricsStorageService = &LyricsStorageServiceMock{}
 
-// ScheduleStorageServiceMock is a mock implementation of radio.ScheduleStorageService.
+// LyricsStorageServiceMock is a mock implementation of radio.LyricsStorageService.
 //
-//	func TestSomethingThatUsesScheduleStorageService(t *testing.T) {
+//	func TestSomethingThatUsesLyricsStorageService(t *testing.T) {
 //
-//		// make and configure a mocked radio.ScheduleStorageService
-//		mockedScheduleStorageService := &ScheduleStorageServiceMock{
-//			ScheduleFunc: func(contextMoqParam context.Context) radio.ScheduleStorage {
-//				panic("mock out the Schedule method")
+//		// make and configure a mocked radio.LyricsStorageService
+//		mockedLyricsStorageService := &LyricsStorageServiceMock{
+//			LyricsFunc: func(contextMoqParam context.Context) radio.LyricsStorage {
+//				panic("mock out the Lyrics method")
 //			},
-//			ScheduleTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
-//				panic("mock out the ScheduleTx method")
+//			LyricsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error) {
+//				panic("mock out the LyricsTx method")
 //			},
 //		}
 //
-//		// use mockedScheduleStorageService in code that requires radio.ScheduleStorageService
+//		// use mockedLyricsStorageService in code that requires radio.LyricsStorageService
 //		// and then make assertions.
 //
 //	}
-type ScheduleStorageServiceMock struct {
-	// ScheduleFunc mocks the Schedule method.
-	ScheduleFunc func(contextMoqParam context.Context) radio.ScheduleStorage
+type LyricsStorageServiceMock struct {
+	// LyricsFunc mocks the Lyrics method.
+	LyricsFunc func(contextMoqParam context.Context) radio.LyricsStorage
 
-	// ScheduleTxFunc mocks the ScheduleTx method.
-	ScheduleTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error)
+	// LyricsTxFunc mocks the LyricsTx method.
+	LyricsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Schedule holds details about calls to the Schedule method.
-		Schedule []struct {
+		// Lyrics holds details about calls to the Lyrics method.
+		Lyrics []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
-		// ScheduleTx holds details about calls to the ScheduleTx method.
-		ScheduleTx []struct {
+		// LyricsTx holds details about calls to the LyricsTx method.
+		LyricsTx []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
 	}
-	lockSchedule   sync.RWMutex
-	lockScheduleTx sync.RWMutex
+	lockLyrics   sync.RWMutex
+	lockLyricsTx sync.RWMutex
 }
 
-// Schedule calls ScheduleFunc.
-func (mock *ScheduleStorageServiceMock) Schedule(contextMoqParam context.Context) radio.ScheduleStorage {
-	if mock.ScheduleFunc == nil {
-		panic("ScheduleStorageServiceMock.ScheduleFunc: method is nil but ScheduleStorageService.Schedule was just called")
+// Lyrics calls LyricsFunc.
+func (mock *LyricsStorageServiceMock) Lyrics(contextMoqParam context.Context) radio.LyricsStorage {
+	if mock.LyricsFunc == nil {
+		panic("LyricsStorageServiceMock.LyricsFunc: method is nil but LyricsStorageService.Lyrics was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 	}{
 		ContextMoqParam: contextMoqParam,
 	}
-	mock.lockSchedule.Lock()
-	mock.calls.Schedule = append(mock.calls.Schedule, callInfo)
-	mock.lockSchedule.Unlock()
-	return mock.ScheduleFunc(contextMoqParam)
+	mock.lockLyrics.Lock()
+	mock.calls.Lyrics = append(mock.calls.Lyrics, callInfo)
+	mock.lockLyrics.Unlock()
+	return mock.LyricsFunc(contextMoqParam)
 }
 
-// ScheduleCalls gets all the calls that were made to Schedule.
+// LyricsCalls gets all the calls that were made to Lyrics.
 // Check the length with:
 //
-//	len(mockedScheduleStorageService.ScheduleCalls())
-func (mock *ScheduleStorageServiceMock) ScheduleCalls() []struct {
+//	len(mockedLyricsStorageService.LyricsCalls())
+func (mock *LyricsStorageServiceMock) LyricsCalls() []struct {
 	ContextMoqParam context.Context
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 	}
-	mock.lockSchedule.RLock()
-	calls = mock.calls.Schedule
-	mock.lockSchedule.RUnlock()
+	mock.lockLyrics.RLock()
+	calls = mock.calls.Lyrics
+	mock.lockLyrics.RUnlock()
 	return calls
 }
 
-// ScheduleTx calls ScheduleTxFunc.
-func (mock *ScheduleStorageServiceMock) ScheduleTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ScheduleStorage, radio.StorageTx, error) {
-	if mock.ScheduleTxFunc == nil {
-		panic("ScheduleStorageServiceMock.ScheduleTxFunc: method is nil but ScheduleStorageService.ScheduleTx was just called")
+// LyricsTx calls LyricsTxFunc.
+func (mock *LyricsStorageServiceMock) LyricsTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error) {
+	if mock.LyricsTxFunc == nil {
+		panic("LyricsStorageServiceMock.LyricsTxFunc: method is nil but LyricsStorageService.LyricsTx was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -7239,17 +12212,17 @@ func (mock *ScheduleStorageServiceMock) ScheduleTx(contextMoqParam context.Conte
 		ContextMoqParam: contextMoqParam,
 		StorageTx:       storageTx,
 	}
-	mock.lockScheduleTx.Lock()
-	mock.calls.ScheduleTx = append(mock.calls.ScheduleTx, callInfo)
-	mock.lockScheduleTx.Unlock()
-	return mock.ScheduleTxFunc(contextMoqParam, storageTx)
+	mock.lockLyricsTx.Lock()
+	mock.calls.LyricsTx = append(mock.calls.LyricsTx, callInfo)
+	mock.lockLyricsTx.Unlock()
+	return mock.LyricsTxFunc(contextMoqParam, storageTx)
 }
 
-// ScheduleTxCalls gets all the calls that were made to ScheduleTx.
+// LyricsTxCalls gets all the calls that were made to LyricsTx.
 // Check the length with:
 //
-//	len(mockedScheduleStorageService.ScheduleTxCalls())
-func (mock *ScheduleStorageServiceMock) ScheduleTxCalls() []struct {
+//	len(mockedLyricsStorageService.LyricsTxCalls())
+func (mock *LyricsStorageServiceMock) LyricsTxCalls() []struct {
 	ContextMoqParam context.Context
 	StorageTx       radio.StorageTx
 } {
@@ -7257,164 +12230,115 @@ func (mock *ScheduleStorageServiceMock) ScheduleTxCalls() []struct {
 		ContextMoqParam context.Context
 		StorageTx       radio.StorageTx
 	}
-	mock.lockScheduleTx.RLock()
-	calls = mock.calls.ScheduleTx
-	mock.lockScheduleTx.RUnlock()
+	mock.lockLyricsTx.RLock()
+	calls = mock.calls.LyricsTx
+	mock.lockLyricsTx.RUnlock()
 	return calls
 }
 
-// Ensure, that ScheduleStorageMock does implement radio.ScheduleStorage.
+// Ensure, that LyricsStorageMock does implement radio.LyricsStorage.
 // If this is not the case, regenerate this file with moq.
-var _ radio.ScheduleStorage = &ScheduleStorageMock{}
+var _ radio.LyricsStorage = &LyricsStorageMock{}
 
-// ScheduleStorageMock is a mock implementation of radio.ScheduleStorage.
+// LyricsStorageMock is a mock implementation of radio.LyricsStorage.
 //
-//	func TestSomethingThatUsesScheduleStorage(t *testing.T) {
+//	func TestSomethingThatUsesLyricsStorage(t *testing.T) {
 //
-//		// make and configure a mocked radio.ScheduleStorage
-//		mockedScheduleStorage := &ScheduleStorageMock{
-//			HistoryFunc: func(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error) {
-//				panic("mock out the History method")
-//			},
-//			LatestFunc: func() ([]*radio.ScheduleEntry, error) {
-//				panic("mock out the Latest method")
+//		// make and configure a mocked radio.LyricsStorage
+//		mockedLyricsStorage := &LyricsStorageMock{
+//			GetFunc: func(trackID radio.TrackID) (*radio.TrackLyrics, error) {
+//				panic("mock out the Get method")
 //			},
-//			UpdateFunc: func(scheduleEntry radio.ScheduleEntry) error {
+//			UpdateFunc: func(trackLyrics radio.TrackLyrics) error {
 //				panic("mock out the Update method")
 //			},
 //		}
 //
-//		// use mockedScheduleStorage in code that requires radio.ScheduleStorage
+//		// use mockedLyricsStorage in code that requires radio.LyricsStorage
 //		// and then make assertions.
 //
 //	}
-type ScheduleStorageMock struct {
-	// HistoryFunc mocks the History method.
-	HistoryFunc func(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error)
-
-	// LatestFunc mocks the Latest method.
-	LatestFunc func() ([]*radio.ScheduleEntry, error)
+type LyricsStorageMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(trackID radio.TrackID) (*radio.TrackLyrics, error)
 
 	// UpdateFunc mocks the Update method.
-	UpdateFunc func(scheduleEntry radio.ScheduleEntry) error
+	UpdateFunc func(trackLyrics radio.TrackLyrics) error
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// History holds details about calls to the History method.
-		History []struct {
-			// Day is the day argument value.
-			Day radio.ScheduleDay
-			// Limit is the limit argument value.
-			Limit int64
-			// Offset is the offset argument value.
-			Offset int64
-		}
-		// Latest holds details about calls to the Latest method.
-		Latest []struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
 		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
-			// ScheduleEntry is the scheduleEntry argument value.
-			ScheduleEntry radio.ScheduleEntry
+			// TrackLyrics is the trackLyrics argument value.
+			TrackLyrics radio.TrackLyrics
 		}
 	}
-	lockHistory sync.RWMutex
-	lockLatest  sync.RWMutex
-	lockUpdate  sync.RWMutex
+	lockGet    sync.RWMutex
+	lockUpdate sync.RWMutex
 }
 
-// History calls HistoryFunc.
-func (mock *ScheduleStorageMock) History(day radio.ScheduleDay, limit int64, offset int64) ([]radio.ScheduleEntry, error) {
-	if mock.HistoryFunc == nil {
-		panic("ScheduleStorageMock.HistoryFunc: method is nil but ScheduleStorage.History was just called")
+// Get calls GetFunc.
+func (mock *LyricsStorageMock) Get(trackID radio.TrackID) (*radio.TrackLyrics, error) {
+	if mock.GetFunc == nil {
+		panic("LyricsStorageMock.GetFunc: method is nil but LyricsStorage.Get was just called")
 	}
 	callInfo := struct {
-		Day    radio.ScheduleDay
-		Limit  int64
-		Offset int64
+		TrackID radio.TrackID
 	}{
-		Day:    day,
-		Limit:  limit,
-		Offset: offset,
-	}
-	mock.lockHistory.Lock()
-	mock.calls.History = append(mock.calls.History, callInfo)
-	mock.lockHistory.Unlock()
-	return mock.HistoryFunc(day, limit, offset)
-}
-
-// HistoryCalls gets all the calls that were made to History.
-// Check the length with:
-//
-//	len(mockedScheduleStorage.HistoryCalls())
-func (mock *ScheduleStorageMock) HistoryCalls() []struct {
-	Day    radio.ScheduleDay
-	Limit  int64
-	Offset int64
-} {
-	var calls []struct {
-		Day    radio.ScheduleDay
-		Limit  int64
-		Offset int64
-	}
-	mock.lockHistory.RLock()
-	calls = mock.calls.History
-	mock.lockHistory.RUnlock()
-	return calls
-}
-
-// Latest calls LatestFunc.
-func (mock *ScheduleStorageMock) Latest() ([]*radio.ScheduleEntry, error) {
-	if mock.LatestFunc == nil {
-		panic("ScheduleStorageMock.LatestFunc: method is nil but ScheduleStorage.Latest was just called")
+		TrackID: trackID,
 	}
-	callInfo := struct {
-	}{}
-	mock.lockLatest.Lock()
-	mock.calls.Latest = append(mock.calls.Latest, callInfo)
-	mock.lockLatest.Unlock()
-	return mock.LatestFunc()
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(trackID)
 }
 
-// LatestCalls gets all the calls that were made to Latest.
+// GetCalls gets all the calls that were made to Get.
 // Check the length with:
 //
-//	len(mockedScheduleStorage.LatestCalls())
-func (mock *ScheduleStorageMock) LatestCalls() []struct {
+//	len(mockedLyricsStorage.GetCalls())
+func (mock *LyricsStorageMock) GetCalls() []struct {
+	TrackID radio.TrackID
 } {
 	var calls []struct {
+		TrackID radio.TrackID
 	}
-	mock.lockLatest.RLock()
-	calls = mock.calls.Latest
-	mock.lockLatest.RUnlock()
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
 	return calls
 }
 
 // Update calls UpdateFunc.
-func (mock *ScheduleStorageMock) Update(scheduleEntry radio.ScheduleEntry) error {
+func (mock *LyricsStorageMock) Update(trackLyrics radio.TrackLyrics) error {
 	if mock.UpdateFunc == nil {
-		panic("ScheduleStorageMock.UpdateFunc: method is nil but ScheduleStorage.Update was just called")
+		panic("LyricsStorageMock.UpdateFunc: method is nil but LyricsStorage.Update was just called")
 	}
 	callInfo := struct {
-		ScheduleEntry radio.ScheduleEntry
+		TrackLyrics radio.TrackLyrics
 	}{
-		ScheduleEntry: scheduleEntry,
+		TrackLyrics: trackLyrics,
 	}
 	mock.lockUpdate.Lock()
 	mock.calls.Update = append(mock.calls.Update, callInfo)
 	mock.lockUpdate.Unlock()
-	return mock.UpdateFunc(scheduleEntry)
+	return mock.UpdateFunc(trackLyrics)
 }
 
 // UpdateCalls gets all the calls that were made to Update.
 // Check the length with:
 //
-//	len(mockedScheduleStorage.UpdateCalls())
-func (mock *ScheduleStorageMock) UpdateCalls() []struct {
-	ScheduleEntry radio.ScheduleEntry
+//	len(mockedLyricsStorage.UpdateCalls())
+func (mock *LyricsStorageMock) UpdateCalls() []struct {
+	TrackLyrics radio.TrackLyrics
 } {
 	var calls []struct {
-		ScheduleEntry radio.ScheduleEntry
+		TrackLyrics radio.TrackLyrics
 	}
 	mock.lockUpdate.RLock()
 	calls = mock.calls.Update
@@ -30,6 +30,18 @@ func (srv *Server) ListSources(ctx context.Context) ([]radio.ProxySource, error)
 	return srv.proxy.ListSources(ctx)
 }
 
+func (srv *Server) PlanHandover(ctx context.Context, h radio.Handover) error {
+	return srv.handover.Plan(ctx, h)
+}
+
+func (srv *Server) CancelHandover(ctx context.Context) error {
+	return srv.handover.Cancel(ctx)
+}
+
+func (srv *Server) HandoverStream(ctx context.Context) (eventstream.Stream[radio.Handover], error) {
+	return srv.handover.Stream(ctx), nil
+}
+
 func (srv *Server) StatusStream(ctx context.Context, id radio.UserID) (eventstream.Stream[[]radio.ProxySource], error) {
 	return srv.events.status.newUserStream(ctx, id), nil
 }
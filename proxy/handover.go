@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
+)
+
+// HANDOVER_MAX_DELAY is how far in the future a handover can be planned
+const HANDOVER_MAX_DELAY = radio.HandoverMaxDelay
+
+// handoverGrace is how far in the past a handover can be planned, these are
+// executed immediately
+const handoverGrace = time.Minute
+
+// HandoverFn switches the live source of the mount given to the user given
+type HandoverFn func(ctx context.Context, mountName string, id radio.UserID) error
+
+// HandoverPlanner keeps track of the planned handover and executes it at the
+// time it was planned for
+type HandoverPlanner struct {
+	ctx              context.Context
+	primaryMountName func() string
+	handover         HandoverFn
+
+	// mu protects current and timer
+	mu      sync.Mutex
+	current radio.Handover
+	timer   *time.Timer
+
+	stream *eventstream.EventStream[radio.Handover]
+}
+
+func NewHandoverPlanner(ctx context.Context, cfg config.Config, fn HandoverFn) *HandoverPlanner {
+	return &HandoverPlanner{
+		ctx: ctx,
+		primaryMountName: config.Value(cfg, func(cfg config.Config) string {
+			return cfg.Conf().Proxy.PrimaryMountName
+		}),
+		handover: fn,
+		stream:   eventstream.NewEventStream(radio.Handover{}),
+	}
+}
+
+// Plan plans the handover given, replacing any handover that was planned
+// before
+func (hp *HandoverPlanner) Plan(ctx context.Context, h radio.Handover) error {
+	const op errors.Op = "proxy/HandoverPlanner.Plan"
+
+	if !h.To.IsValid() {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing next dj"))
+	}
+	if h.From.ID == h.To.ID {
+		return errors.E(op, errors.InvalidArgument, errors.Info("can't handover to yourself"))
+	}
+	if delay := time.Until(h.At); delay < -handoverGrace || delay > HANDOVER_MAX_DELAY {
+		return errors.E(op, errors.InvalidArgument, errors.Info("handover time is out of range"))
+	}
+	if h.MountName == "" {
+		h.MountName = hp.primaryMountName()
+	}
+	h.State = radio.HandoverPlanned
+	h.Error = ""
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	if hp.timer != nil {
+		hp.timer.Stop()
+	}
+	hp.current = h
+	hp.timer = time.AfterFunc(max(time.Until(h.At), 0), func() {
+		hp.execute(h)
+	})
+
+	zerolog.Ctx(ctx).Info().Ctx(ctx).
+		Str("from", h.From.Username).
+		Str("to", h.To.Username).
+		Time("at", h.At).
+		Msg("handover planned")
+	hp.stream.Send(h)
+	return nil
+}
+
+// Cancel cancels the planned handover if there is one
+func (hp *HandoverPlanner) Cancel(ctx context.Context) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	if !hp.current.IsPending() {
+		return nil
+	}
+
+	hp.timer.Stop()
+	hp.timer = nil
+	hp.current.State = radio.HandoverCanceled
+
+	zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("handover canceled")
+	hp.stream.Send(hp.current)
+	return nil
+}
+
+// Stream returns a stream of the state of the latest handover
+func (hp *HandoverPlanner) Stream(ctx context.Context) eventstream.Stream[radio.Handover] {
+	return hp.stream.SubStream(ctx)
+}
+
+// execute does the handover given if it's still the planned one
+func (hp *HandoverPlanner) execute(h radio.Handover) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	// the handover got replaced or canceled while we were waiting on the lock
+	if !hp.current.IsPending() || hp.current.At != h.At || hp.current.To.ID != h.To.ID {
+		return
+	}
+
+	logger := zerolog.Ctx(hp.ctx).With().
+		Str("from", h.From.Username).
+		Str("to", h.To.Username).
+		Logger()
+
+	err := hp.handover(hp.ctx, h.MountName, h.To.ID)
+	if err != nil {
+		logger.Error().Ctx(hp.ctx).Err(err).Msg("handover failed")
+		hp.current.State = radio.HandoverFailed
+		hp.current.Error = "the next dj is not connected"
+		if !errors.Is(errors.SourceUnknown, err) {
+			hp.current.Error = "internal error"
+		}
+	} else {
+		logger.Info().Ctx(hp.ctx).Msg("handover completed")
+		hp.current.State = radio.HandoverCompleted
+	}
+
+	hp.timer = nil
+	hp.stream.Send(hp.current)
+}
@@ -0,0 +1,73 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandoverPlanner(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	called := make(chan radio.UserID, 1)
+	var fail bool
+	hp := NewHandoverPlanner(ctx, config.TestConfig(), func(ctx context.Context, mountName string, id radio.UserID) error {
+		if fail {
+			return errors.E(errors.SourceUnknown)
+		}
+		called <- id
+		return nil
+	})
+
+	stream := hp.Stream(ctx)
+	defer stream.Close()
+	next := func() radio.Handover {
+		h, err := stream.Next()
+		require.NoError(t, err)
+		return h
+	}
+	assert.Equal(t, radio.HandoverNone, next().State)
+
+	from := radio.User{ID: 1, Username: "from"}
+	to := radio.User{ID: 2, Username: "to"}
+
+	// invalid handovers
+	err := hp.Plan(ctx, radio.Handover{From: from, To: from, At: time.Now().Add(time.Minute)})
+	assert.True(t, errors.Is(errors.InvalidArgument, err))
+	err = hp.Plan(ctx, radio.Handover{From: from, To: to, At: time.Now().Add(HANDOVER_MAX_DELAY * 2)})
+	assert.True(t, errors.Is(errors.InvalidArgument, err))
+
+	// a canceled handover shouldn't happen
+	require.NoError(t, hp.Plan(ctx, radio.Handover{From: from, To: to, At: time.Now().Add(time.Millisecond * 50)}))
+	h := next()
+	assert.Equal(t, radio.HandoverPlanned, h.State)
+	assert.Equal(t, "/main.mp3", h.MountName)
+	require.NoError(t, hp.Cancel(ctx))
+	assert.Equal(t, radio.HandoverCanceled, next().State)
+	select {
+	case <-called:
+		t.Fatal("canceled handover happened")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// a planned handover should happen at the time given
+	require.NoError(t, hp.Plan(ctx, radio.Handover{From: from, To: to, At: time.Now().Add(time.Millisecond * 50)}))
+	assert.Equal(t, radio.HandoverPlanned, next().State)
+	assert.Equal(t, to.ID, <-called)
+	assert.Equal(t, radio.HandoverCompleted, next().State)
+
+	// and fail if the next dj isn't there
+	fail = true
+	require.NoError(t, hp.Plan(ctx, radio.Handover{From: from, To: to, At: time.Now()}))
+	assert.Equal(t, radio.HandoverPlanned, next().State)
+	h = next()
+	assert.Equal(t, radio.HandoverFailed, h.State)
+	assert.NotEmpty(t, h.Error)
+}
@@ -33,7 +33,7 @@ func Execute(ctx context.Context, cfg config.Config) error {
 		return errors.E(op, err)
 	}
 
-	grpcSrv, err := NewGRPC(ctx, srv)
+	grpcSrv, err := NewGRPC(ctx, cfg, srv)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -75,8 +75,12 @@ type GRPC struct {
 	ln  net.Listener
 }
 
-func NewGRPC(ctx context.Context, srv *Server) (*GRPC, error) {
-	gs := rpc.NewGrpcServer(ctx)
+func NewGRPC(ctx context.Context, cfg config.Config, srv *Server) (*GRPC, error) {
+	opts, err := cfg.RPCServerOptions(config.RPCProxy)
+	if err != nil {
+		return nil, err
+	}
+	gs := rpc.NewGrpcServer(ctx, opts...)
 	rpc.RegisterProxyServer(gs, rpc.NewProxy(srv))
 
 	return &GRPC{
@@ -9,6 +9,7 @@ import (
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/rs/zerolog"
 )
 
@@ -169,6 +170,25 @@ func (pm *ProxyManager) RemoveSourceClient(ctx context.Context, id radio.SourceI
 	return nil
 }
 
+// Handover makes a source of the user given the live source of the mount given
+func (pm *ProxyManager) Handover(ctx context.Context, mountName string, id radio.UserID) error {
+	const op errors.Op = "proxy/ProxyManager.Handover"
+
+	pm.mountsMu.Lock()
+	mount := pm.mounts[mountName]
+	pm.mountsMu.Unlock()
+
+	if mount == nil {
+		return errors.E(op, errors.SourceUnknown, errors.Info(mountName))
+	}
+
+	err := mount.HandoverTo(ctx, id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 func (pm *ProxyManager) ListSources(ctx context.Context) ([]radio.ProxySource, error) {
 	pm.mountsMu.Lock()
 	defer pm.mountsMu.Unlock()
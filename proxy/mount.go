@@ -11,6 +11,7 @@ import (
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/streamer/icecast"
 	"github.com/R-a-dio/valkyrie/util"
 	"github.com/cenkalti/backoff"
@@ -300,6 +301,50 @@ func (m *Mount) RemoveSource(ctx context.Context, id radio.SourceID) {
 	m.events.eventSourceDisconnect(ctx, removed.Source)
 }
 
+// HandoverTo makes the source of the user given that has the most priority
+// the live source. The source that was live before gets the next priority so
+// that it takes over again if the new source disconnects
+func (m *Mount) HandoverTo(ctx context.Context, id radio.UserID) error {
+	const op errors.Op = "proxy/Mount.HandoverTo"
+
+	m.SourcesMu.Lock()
+	defer m.SourcesMu.Unlock()
+
+	var next *MountSourceClient
+	for _, msc := range m.Sources {
+		if msc.Source.User.ID != id {
+			continue
+		}
+		if next == nil || msc.Priority < next.Priority {
+			next = msc
+		}
+	}
+	if next == nil {
+		return errors.E(op, errors.SourceUnknown, errors.Info(m.Name))
+	}
+	if next.GetLive() {
+		// already live, nothing to do
+		return nil
+	}
+
+	// move the next source to the front and keep the order of the rest
+	for _, msc := range m.Sources {
+		msc.Priority++
+	}
+	next.Priority = 0
+	adjustPriority(m.Sources)
+
+	for _, msc := range m.Sources {
+		if msc.GetLive() {
+			msc.GoOffline(ctx)
+		}
+	}
+	next.GoLive(ctx, m)
+	// send event that we went live
+	m.events.eventNewLiveSource(ctx, m.Name, next.Source)
+	return nil
+}
+
 // liveSourceSwap moves the live-ness flag to the highest priority source
 //
 // liveSourceSwap should only be called with m.SourcesMu held in a write lock
@@ -8,8 +8,10 @@ import (
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLeastPriority(t *testing.T) {
@@ -150,6 +152,49 @@ func TestMountRemoveSource(t *testing.T) {
 	assert.Equal(t, 0, getSourcesLength(mount), "should have no sources")
 }
 
+func TestMountHandoverTo(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+
+	eh := NewEventHandler(ctx, cfg)
+
+	mountName := "/test.mp3"
+	contentType := "audio/mpeg"
+
+	mount := NewMount(ctx, cfg, nil, eh, mountName, contentType, nil)
+
+	newSource := func(id radio.UserID) *SourceClient {
+		_, conn := net.Pipe()
+		user := newTestUser("test", "test")
+		user.ID = id
+		req := httptest.NewRequest("PUT", mountName, conn)
+		return NewSourceClient(radio.SourceID{UserID: id, ID: xid.New()}, "test", contentType, mountName, conn, *user, IdentFromRequest(req), &Metadata{})
+	}
+
+	current := newSource(1)
+	next := newSource(2)
+	mount.AddSource(ctx, current)
+	mount.AddSource(ctx, next)
+	assert.True(t, getSource(mount, 0).GetLive(), "first source should be live")
+	assert.False(t, getSource(mount, 1).GetLive(), "second source should not be live")
+
+	err := mount.HandoverTo(ctx, 3)
+	assert.True(t, errors.Is(errors.SourceUnknown, err), "user without a source should error")
+
+	require.NoError(t, mount.HandoverTo(ctx, 2))
+	assert.Equal(t, next.ID, getSource(mount, 0).Source.ID, "next source should be first")
+	assert.EqualValues(t, 0, getSource(mount, 0).Priority)
+	assert.True(t, getSource(mount, 0).GetLive(), "next source should be live")
+	assert.Equal(t, current.ID, getSource(mount, 1).Source.ID, "old source should be second")
+	assert.EqualValues(t, 1, getSource(mount, 1).Priority)
+	assert.False(t, getSource(mount, 1).GetLive(), "old source should not be live")
+
+	// the old source should take over again if the next one leaves
+	mount.RemoveSource(ctx, next.ID)
+	assert.True(t, getSource(mount, 0).GetLive(), "old source should be live again")
+	mount.RemoveSource(ctx, current.ID)
+}
+
 func getSourcesLength(mount *Mount) int {
 	mount.SourcesMu.RLock()
 	defer mount.SourcesMu.RUnlock()
@@ -31,22 +31,24 @@ type Server struct {
 	manager    radio.ManagerService
 	http       *http.Server
 	events     *EventHandler
+	handover   *HandoverPlanner
 }
 
-func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerService, uss radio.UserStorageService) (*Server, error) {
+func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerService, storage radio.StorageService) (*Server, error) {
 	const op errors.Op = "proxy.NewServer"
 
 	eh := NewEventHandler(ctx, cfg)
-	pm, err := NewProxyManager(ctx, cfg, uss, eh)
+	pm, err := NewProxyManager(ctx, cfg, storage, eh)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 	var srv = &Server{
-		cfg:     cfg,
-		proxy:   pm,
-		manager: manager,
-		storage: uss,
-		events:  eh,
+		cfg:      cfg,
+		proxy:    pm,
+		manager:  manager,
+		storage:  storage,
+		events:   eh,
+		handover: NewHandoverPlanner(ctx, cfg, pm.Handover),
 	}
 
 	// older icecast source clients still use the SOURCE method instead of PUT
@@ -63,7 +65,7 @@ func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerServ
 	)
 	r.Use(chiware.Recoverer)
 	// handle basic authentication
-	r.Use(middleware.BasicAuth(uss))
+	r.Use(middleware.BasicAuth(cfg, storage))
 	// and generate an identifier for the user
 	r.Use(IdentifierMiddleware)
 	// metadata route used to update mp3 metadata out-of-bound
@@ -195,6 +195,16 @@ func (msc *MountSourceClient) GoLive(ctx context.Context, out io.Writer) {
 		Msg("switching to live")
 }
 
+// GoOffline stops the data of the source from being written to the mount
+func (msc *MountSourceClient) GoOffline(ctx context.Context) {
+	msc.live.Store(false)
+	msc.out.Store(nil)
+	msc.logger.Info().
+		Str("req_id", msc.Source.ID.String()).
+		Any("identifier", msc.Source.Identifier).
+		Msg("switching to offline")
+}
+
 func (msc *MountSourceClient) GetLive() bool {
 	return msc.live.Load()
 }
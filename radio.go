@@ -5,11 +5,16 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +29,8 @@ const (
 	LimitAlbumLength  = 200
 	LimitTitleLength  = 200
 	LimitReasonLength = 120
+	LimitLyricsLength = 65535
+	LimitNotesLength  = 65535
 )
 
 // CalculateRequestDelay returns the delay between two requests of a song
@@ -216,6 +223,8 @@ func AllUserPermissions() []UserPermission {
 		PermProxyKick,
 		PermTelemetryView,
 		PermGuest,
+		PermAuditView,
+		PermGuestInvite,
 	}
 }
 
@@ -240,6 +249,8 @@ const (
 	PermProxyKick      = "proxy_kick"      // User can kick streamers"
 	PermTelemetryView  = "telemetry_view"  // User can view telemetry backend
 	PermGuest          = "guest"           // User is a guest
+	PermAuditView      = "audit_view"      // User can view the audit log
+	PermGuestInvite    = "guest_invite"    // User can invite guests
 )
 
 // User is an user account in the database
@@ -354,19 +365,90 @@ type SongInfo struct {
 
 type SearchService interface {
 	Search(ctx context.Context, query string, opt SearchOptions) (SearchResult, error)
+	// Complete returns at most limit artists, titles and albums that start
+	// with the prefix given, most common first
+	Complete(ctx context.Context, prefix string, limit int) ([]SearchCompletion, error)
 	Update(context.Context, ...Song) error
 	Delete(context.Context, ...TrackID) error
 }
 
+// SearchCompletionKind is the field a SearchCompletion was found in
+type SearchCompletionKind string
+
+const (
+	SearchCompletionArtist SearchCompletionKind = "artist"
+	SearchCompletionTitle  SearchCompletionKind = "title"
+	SearchCompletionAlbum  SearchCompletionKind = "album"
+)
+
+// SearchCompletion is a single autocomplete suggestion
+type SearchCompletion struct {
+	Kind  SearchCompletionKind
+	Value string
+	// Count is the amount of tracks that have this value
+	Count int
+}
+
 type SearchOptions struct {
 	Limit     int64
 	Offset    int64
 	ExactOnly bool
+	// Filter restricts the results to songs matching it
+	Filter SearchFilter
+	// Facets is the amount of terms returned per facet, zero means no facets
+	Facets int
+	// Fuzzy enables typo-tolerant matching and suggestions if the query
+	// has no results otherwise
+	Fuzzy bool
+}
+
+// SearchFilter restricts search results, the zero value of each field means
+// no restriction on that field
+type SearchFilter struct {
+	// Requestable only includes songs that can be requested right now
+	Requestable bool
+	// MinLength and MaxLength restrict the song length
+	MinLength time.Duration
+	MaxLength time.Duration
+	// Tag only includes songs with this tag
+	Tag string
+	// Album only includes songs with this album
+	Album string
+	// Acceptor only includes songs accepted by this user
+	Acceptor string
+	// LastPlayedBefore and LastPlayedAfter restrict when the song was last played
+	LastPlayedBefore time.Time
+	LastPlayedAfter  time.Time
+}
+
+// IsZero returns true if the filter doesn't restrict anything
+func (sf SearchFilter) IsZero() bool {
+	return sf == SearchFilter{}
 }
 
 type SearchResult struct {
 	Songs     []Song
 	TotalHits int
+	// Facets holds the facet counts, only filled if SearchOptions.Facets
+	// was non-zero
+	Facets SearchFacets
+	// Suggestions are alternative queries that might be what the user meant,
+	// only filled if SearchOptions.Fuzzy was set and the query had no results
+	Suggestions []string
+	// Fuzzy is true if Songs are fuzzy matches instead of normal ones
+	Fuzzy bool
+}
+
+// SearchFacets are the counts of songs per term for all songs that matched
+// a search, not just the ones returned
+type SearchFacets struct {
+	Tags    []SearchFacetTerm
+	Artists []SearchFacetTerm
+}
+
+type SearchFacetTerm struct {
+	Term  string
+	Count int
 }
 
 type SongUpdate struct {
@@ -431,6 +513,67 @@ type ProxyService interface {
 	StatusStream(context.Context, UserID) (eventstream.Stream[[]ProxySource], error)
 	KickSource(context.Context, SourceID) error
 	ListSources(context.Context) ([]ProxySource, error)
+
+	// PlanHandover plans a handover from one DJ to the next, this replaces
+	// any handover that is already planned
+	PlanHandover(context.Context, Handover) error
+	// CancelHandover cancels the planned handover, if there is one
+	CancelHandover(context.Context) error
+	// HandoverStream returns a stream of the state of the latest handover
+	HandoverStream(context.Context) (eventstream.Stream[Handover], error)
+}
+
+// HandoverState is the state a Handover is in
+type HandoverState int
+
+const (
+	HandoverNone HandoverState = iota
+	HandoverPlanned
+	HandoverCompleted
+	HandoverFailed
+	HandoverCanceled
+)
+
+func (hs HandoverState) String() string {
+	switch hs {
+	case HandoverNone:
+		return "none"
+	case HandoverPlanned:
+		return "planned"
+	case HandoverCompleted:
+		return "completed"
+	case HandoverFailed:
+		return "failed"
+	case HandoverCanceled:
+		return "canceled"
+	}
+	return "unknown"
+}
+
+// HandoverMaxDelay is how far in the future a handover can be planned
+const HandoverMaxDelay = time.Hour * 2
+
+// Handover is a planned switch of the live source on a mount from one DJ to
+// the next at an agreed upon time
+type Handover struct {
+	State HandoverState
+	// From is the DJ that was live when the handover was planned
+	From User
+	// To is the DJ that should be live after the handover
+	To User
+	// MountName is the mount the handover happens on, empty for the primary
+	// mount of the proxy
+	MountName string
+	// At is the time the handover should happen
+	At time.Time
+	// Error is the reason the handover failed, only set if State is
+	// HandoverFailed
+	Error string
+}
+
+// IsPending returns true if the handover still has to happen
+func (h Handover) IsPending() bool {
+	return h.State == HandoverPlanned
 }
 
 type ProxySource struct {
@@ -560,6 +703,11 @@ type QueueEntry struct {
 	UserIdentifier string
 	// ExpectedStartTime is the expected time this song will be played on stream
 	ExpectedStartTime time.Time
+	// IsPinned indicates the entry is anchored to its position in the queue,
+	// the amount of entries in front of it only goes down when those are
+	// played. Its ExpectedStartTime still changes with the length of the
+	// entries in front of it
+	IsPinned bool
 }
 
 func (qe QueueEntry) Copy() QueueEntry {
@@ -589,10 +737,22 @@ type QueueService interface {
 	// ResetReserved resets the reserved status of all entries returned by ReserveNext
 	// but not yet removed by Remove
 	ResetReserved(context.Context) error
-	// Remove removes the first occurrence of the given entry from the queue
+	// Remove removes the first occurrence of the given entry from the queue,
+	// pinned entries behind it keep their position and the entries behind those
+	// fill the space. Returns errors.QueueEntryPinned if there is nothing to
+	// fill it with
 	Remove(context.Context, QueueID) (bool, error)
 	// Entries returns all entries in the queue
 	Entries(context.Context) (Queue, error)
+	// Move moves the entry to the position given, reserved entries can't be
+	// moved and pinned entries can't be shifted by the move
+	Move(ctx context.Context, id QueueID, position int) error
+	// Insert inserts the song at the position given and returns the new entry,
+	// pinned entries can't be shifted by the insert
+	Insert(ctx context.Context, song Song, position int) (*QueueEntry, error)
+	// Pin sets the pinned status of the entry, see QueueEntry.IsPinned for
+	// what that does and doesn't guarantee
+	Pin(ctx context.Context, id QueueID, pinned bool) error
 }
 
 type AnnounceService interface {
@@ -600,6 +760,7 @@ type AnnounceService interface {
 	AnnounceRequest(context.Context, Song) error
 	AnnounceUser(context.Context, *User) error
 	AnnounceMurder(ctx context.Context, by *User, force bool) error
+	AnnounceAudit(context.Context, AuditEntry) error
 }
 
 // SongID is a songs identifier
@@ -932,6 +1093,16 @@ type StorageService interface {
 	SubmissionStorageService
 	NewsStorageService
 	ScheduleStorageService
+	APITokenStorageService
+	AuditStorageService
+	ListenerAccountStorageService
+	FingerprintStorageService
+	RecommendationStorageService
+	LeaseStorageService
+	JournalStorageService
+	GuestStorageService
+	RequestPoolStorageService
+	LyricsStorageService
 	// Close closes the storage service and cleans up any resources
 	Close() error
 }
@@ -960,6 +1131,451 @@ type Session struct {
 	Data []byte
 }
 
+// APITokenStorageService is a service able to supply an APITokenStorage
+type APITokenStorageService interface {
+	APIToken(context.Context) APITokenStorage
+	APITokenTx(context.Context, StorageTx) (APITokenStorage, StorageTx, error)
+}
+
+// APITokenStorage stores personal access tokens, only the hash of a token
+// is ever stored
+type APITokenStorage interface {
+	// Create creates the token given and returns the new ID
+	Create(APIToken) (APITokenID, error)
+	// Get returns the token matching the hash given
+	Get(APITokenHash) (*APIToken, error)
+	// ListByUser returns all tokens owned by the user given
+	ListByUser(UserID) ([]APIToken, error)
+	// Delete deletes the token given, but only if it is owned by the user given
+	Delete(UserID, APITokenID) error
+	// UpdateLastUsed sets the LastUsedAt time of the token to the current time
+	UpdateLastUsed(APITokenID) error
+}
+
+// APITokenPrefix is the prefix of all generated api tokens, it exists so that
+// tokens can be told apart from normal passwords
+const APITokenPrefix = "radio_"
+
+// APITokenID is an identifier corresponding to an api token
+type APITokenID uint32
+
+func ParseAPITokenID(s string) (APITokenID, error) {
+	id, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return APITokenID(id), nil
+}
+
+func (id APITokenID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// APITokenHash is a sha256 hash of an api token
+type APITokenHash [sha256.Size]byte
+
+// NewAPITokenHash returns the hash of the token given
+func NewAPITokenHash(token string) APITokenHash {
+	return APITokenHash(sha256.Sum256([]byte(token)))
+}
+
+// Value implements sql/driver.Valuer
+func (h APITokenHash) Value() (driver.Value, error) {
+	return h.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (h *APITokenHash) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	var err error
+	switch v := src.(type) {
+	case []byte:
+		_, err = hex.Decode((*h)[:], v)
+	case string:
+		_, err = hex.Decode((*h)[:], []byte(v))
+	default:
+		err = fmt.Errorf("unsupported type in APITokenHash.Scan: %t", src)
+	}
+	return err
+}
+
+// String returns a hexadecimal representation of the hash
+func (h APITokenHash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// IsAPIToken returns true if s looks like an api token
+func IsAPIToken(s string) bool {
+	return strings.HasPrefix(s, APITokenPrefix)
+}
+
+// GenerateAPIToken generates a new random api token, it returns the token
+// that should be given to the user and the hash that should be stored
+func GenerateAPIToken() (string, APITokenHash, error) {
+	token, err := GenerateRandomPassword(40)
+	if err != nil {
+		return "", APITokenHash{}, err
+	}
+	token = APITokenPrefix + token
+	return token, NewAPITokenHash(token), nil
+}
+
+// APIToken is a personal access token of a user, it can be used instead of
+// the password of the user in places that support it
+type APIToken struct {
+	ID     APITokenID
+	UserID UserID
+	// Name is a user supplied description of the token
+	Name string
+	Hash APITokenHash
+	// Scopes is the permissions this token is limited to
+	Scopes UserPermissions
+
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	// ExpiresAt is the time the token stops working, nil if it never expires
+	ExpiresAt *time.Time
+}
+
+// IsExpired returns true if the token is expired at the time given
+func (t APIToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// Permissions returns the permissions the token grants when used by the
+// user given, this is the intersection of the scopes and the permissions
+// of the user. PermActive is carried over from the user as is
+func (t APIToken) Permissions(user User) UserPermissions {
+	up := make(UserPermissions)
+	if user.UserPermissions.HasExplicit(PermActive) {
+		up[PermActive] = struct{}{}
+	}
+	for perm := range t.Scopes {
+		if user.UserPermissions.Has(perm) {
+			up[perm] = struct{}{}
+		}
+	}
+	return up
+}
+
+// AuditStorageService is a service able to supply an AuditStorage
+type AuditStorageService interface {
+	Audit(context.Context) AuditStorage
+	AuditTx(context.Context, StorageTx) (AuditStorage, StorageTx, error)
+}
+
+// AuditStorage stores a log of administrative actions
+type AuditStorage interface {
+	// Add adds an entry to the audit log and returns the new ID
+	Add(AuditEntry) (AuditEntryID, error)
+	// Search returns entries matching the filter given starting at offset
+	// and returning up to limit amount of entries, newest first
+	Search(filter AuditFilter, limit, offset int64) (AuditList, error)
+}
+
+// AuditEntryID is an identifier for an audit log entry
+type AuditEntryID uint64
+
+func (id AuditEntryID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// AuditAction is the kind of action an audit log entry is about
+type AuditAction string
+
+// List of audited actions
+const (
+	AuditTrackEdit         AuditAction = "track.edit"
+	AuditTrackDelete       AuditAction = "track.delete"
+	AuditTrackLyrics       AuditAction = "track.lyrics"
+	AuditPendingAccept     AuditAction = "pending.accept"
+	AuditPendingDecline    AuditAction = "pending.decline"
+	AuditPendingReplace    AuditAction = "pending.replace"
+	AuditSourceKick        AuditAction = "proxy.kick"
+	AuditListenerKick      AuditAction = "listener.kick"
+	AuditQueueRemove       AuditAction = "queue.remove"
+	AuditQueueMove         AuditAction = "queue.move"
+	AuditQueueInsert       AuditAction = "queue.insert"
+	AuditQueuePin          AuditAction = "queue.pin"
+	AuditUserCreate        AuditAction = "user.create"
+	AuditUserEdit          AuditAction = "user.edit"
+	AuditDJCreate          AuditAction = "dj.create"
+	AuditTwoFactorRemove   AuditAction = "user.twofactor_remove"
+	AuditNewsCreate        AuditAction = "news.create"
+	AuditNewsEdit          AuditAction = "news.edit"
+	AuditNewsDelete        AuditAction = "news.delete"
+	AuditNewsCommentDelete AuditAction = "news.comment_delete"
+	AuditScheduleEdit      AuditAction = "schedule.edit"
+	AuditStreamerStop      AuditAction = "streamer.stop"
+	AuditGuestInvite       AuditAction = "guest.invite"
+	AuditGuestInviteDelete AuditAction = "guest.invite_delete"
+	AuditRequestVeto       AuditAction = "request.veto"
+)
+
+// AllAuditActions returns all audited actions
+func AllAuditActions() []AuditAction {
+	return []AuditAction{
+		AuditTrackEdit,
+		AuditTrackDelete,
+		AuditTrackLyrics,
+		AuditPendingAccept,
+		AuditPendingDecline,
+		AuditPendingReplace,
+		AuditSourceKick,
+		AuditListenerKick,
+		AuditQueueRemove,
+		AuditQueueMove,
+		AuditQueueInsert,
+		AuditQueuePin,
+		AuditUserCreate,
+		AuditUserEdit,
+		AuditDJCreate,
+		AuditTwoFactorRemove,
+		AuditNewsCreate,
+		AuditNewsEdit,
+		AuditNewsDelete,
+		AuditNewsCommentDelete,
+		AuditScheduleEdit,
+		AuditStreamerStop,
+		AuditGuestInvite,
+		AuditGuestInviteDelete,
+		AuditRequestVeto,
+	}
+}
+
+// AuditEntry is a single administrative action in the audit log
+type AuditEntry struct {
+	ID AuditEntryID
+	// ActorID is the user that did the action
+	ActorID UserID
+	// Actor is the username of ActorID at the time of the action, this is
+	// kept separately so that the entry stays readable if the user is removed
+	Actor  string
+	Action AuditAction
+	// Target is a human readable description of what the action was done to,
+	// at most AuditTargetLength characters long
+	Target string
+	// Before is a JSON document of the target before the action, empty if
+	// there was nothing before
+	Before string
+	// After is a JSON document of the target after the action, empty if
+	// there is nothing after
+	After string
+	// IP is the address of the client that did the action
+	IP string
+
+	CreatedAt time.Time
+}
+
+// AuditTargetLength is the maximum length of AuditEntry.Target in characters
+const AuditTargetLength = 255
+
+// NewAuditEntry returns an AuditEntry with before and after encoded as JSON,
+// a nil before or after is left empty. The target is cut off if it is longer
+// than AuditTargetLength
+func NewAuditEntry(actor User, action AuditAction, target string, before, after any) (AuditEntry, error) {
+	if runes := []rune(target); len(runes) > AuditTargetLength {
+		target = string(runes[:AuditTargetLength])
+	}
+
+	entry := AuditEntry{
+		ActorID: actor.ID,
+		Actor:   actor.Username,
+		Action:  action,
+		Target:  target,
+	}
+
+	if before != nil {
+		b, err := json.Marshal(before)
+		if err != nil {
+			return entry, err
+		}
+		entry.Before = string(b)
+	}
+	if after != nil {
+		b, err := json.Marshal(after)
+		if err != nil {
+			return entry, err
+		}
+		entry.After = string(b)
+	}
+	return entry, nil
+}
+
+// AuditChange is a single changed field between the Before and After of an
+// AuditEntry, values are JSON encoded and empty if the field didn't exist
+type AuditChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// Diff returns the fields that differ between Before and After, nested
+// fields are joined with a dot and the result is sorted by field name
+func (e AuditEntry) Diff() []AuditChange {
+	before, after := flattenAuditJSON(e.Before), flattenAuditJSON(e.After)
+
+	var changes []AuditChange
+	for field, b := range before {
+		if a, ok := after[field]; !ok || a != b {
+			changes = append(changes, AuditChange{Field: field, Before: b, After: a})
+		}
+	}
+	for field, a := range after {
+		if _, ok := before[field]; !ok {
+			changes = append(changes, AuditChange{Field: field, After: a})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b AuditChange) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+	return changes
+}
+
+// flattenAuditJSON turns a JSON document into a map of dotted field names
+// to JSON encoded leaf values
+func flattenAuditJSON(doc string) map[string]string {
+	res := make(map[string]string)
+	if doc == "" {
+		return res
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		// not something we can take apart, treat it as a single value
+		res[""] = doc
+		return res
+	}
+
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		if m, ok := v.(map[string]any); ok && len(m) > 0 {
+			for k, sub := range m {
+				if prefix != "" {
+					k = prefix + "." + k
+				}
+				walk(k, sub)
+			}
+			return
+		}
+		b, _ := json.Marshal(v)
+		res[prefix] = string(b)
+	}
+	walk("", v)
+	return res
+}
+
+// AuditFilter is used to filter the results of AuditStorage.Search, zero
+// value fields are ignored
+type AuditFilter struct {
+	// Actor is the username of the actor
+	Actor string
+	// Action is the action
+	Action AuditAction
+	// Target is matched as a substring of the target
+	Target string
+}
+
+// AuditList contains multiple audit entries and a total count of entries
+type AuditList struct {
+	Entries []AuditEntry
+	Total   int
+}
+
+// ListenerAccountStorageService is a service able to supply a ListenerAccountStorage
+type ListenerAccountStorageService interface {
+	ListenerAccount(context.Context) ListenerAccountStorage
+	ListenerAccountTx(context.Context, StorageTx) (ListenerAccountStorage, StorageTx, error)
+}
+
+// ListenerAccountStorage stores listener accounts and the requests made by them
+type ListenerAccountStorage interface {
+	// Create creates the account given and returns the new ID
+	Create(ListenerAccount) (ListenerAccountID, error)
+	// Get returns the account with the username given
+	Get(username string) (*ListenerAccount, error)
+	// GetByNick returns the account that claimed the IRC nick given
+	GetByNick(nick string) (*ListenerAccount, error)
+	// SetClaimCode sets the code that can be used to claim an IRC nick for
+	// the account given, the code is valid until expires
+	SetClaimCode(id ListenerAccountID, code string, expires time.Time) error
+	// ClaimNick binds the nick given to the account that has the claim code
+	// given, the code is cleared afterwards
+	ClaimNick(code, nick string) (*ListenerAccount, error)
+	// AddRequest records a request of the track given by the account
+	AddRequest(ListenerAccountID, TrackID) error
+	// Requests returns the requests made by the account starting at offset
+	// and returning up to limit amount of requests, newest first
+	Requests(id ListenerAccountID, limit, offset int64) (ListenerRequestList, error)
+	// TopSongs returns up to limit amount of songs requested most by the account
+	TopSongs(id ListenerAccountID, limit int64) ([]ListenerSongCount, error)
+}
+
+// ListenerAccountID is an identifier corresponding to a listener account
+type ListenerAccountID uint64
+
+func (id ListenerAccountID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ListenerAccount is an optional account for listeners, these are separate
+// from the User accounts used by staff
+type ListenerAccount struct {
+	ID       ListenerAccountID
+	Username string
+	Password string `json:"-"`
+	// Nick is the IRC nick claimed by this account, empty if none is claimed
+	Nick string
+	// ClaimCode is the code that can be used on IRC to claim a nick
+	ClaimCode string `json:"-"`
+	// ClaimExpires is the time ClaimCode stops being valid
+	ClaimExpires *time.Time `json:"-"`
+
+	CreatedAt time.Time
+}
+
+func (a ListenerAccount) ComparePassword(passwd string) error {
+	return bcrypt.CompareHashAndPassword([]byte(a.Password), []byte(passwd))
+}
+
+func (a *ListenerAccount) IsValid() bool {
+	return a != nil && a.Username != ""
+}
+
+// ListenerClaimCodeLength is the length of the codes generated by NewListenerClaimCode
+const ListenerClaimCodeLength = 8
+
+// ListenerClaimCodeTimeout is how long a claim code is valid for
+const ListenerClaimCodeTimeout = time.Hour
+
+// NewListenerClaimCode returns a new random code to be used with
+// ListenerAccountStorage.ClaimNick
+func NewListenerClaimCode() (string, error) {
+	return GenerateRandomPassword(ListenerClaimCodeLength)
+}
+
+// ListenerRequest is a single request made by a listener account
+type ListenerRequest struct {
+	Song
+	RequestedAt time.Time
+}
+
+// ListenerRequestList contains multiple requests and a total count of requests
+type ListenerRequestList struct {
+	Requests []ListenerRequest
+	Total    int
+}
+
+// ListenerSongCount is a song and the amount of times it was requested
+type ListenerSongCount struct {
+	Song
+	Count int
+}
+
 // QueueStorageService is a service able to supply a QueueStorage
 type QueueStorageService interface {
 	Queue(context.Context) QueueStorage
@@ -972,6 +1588,22 @@ type QueueStorage interface {
 	Store(name string, queue []QueueEntry) error
 	// Load returns the queue associated with the name given
 	Load(name string) ([]QueueEntry, error)
+	// StorePosition stores the playback position of the queue with the name
+	// given, a nil position clears it
+	StorePosition(name string, pos *QueuePosition) error
+	// LoadPosition returns the playback position of the queue with the name
+	// given, or nil if there is none
+	LoadPosition(name string) (*QueuePosition, error)
+}
+
+// QueuePosition is how far the streamer got in playing a queue entry
+type QueuePosition struct {
+	// Entry is the queue entry that was playing
+	Entry QueueEntry
+	// Offset is the amount of bytes of encoded audio that was sent
+	Offset int64
+	// UpdatedAt is when the position was last stored
+	UpdatedAt time.Time
 }
 
 // SongStorageService is a service able to supply a SongStorage
@@ -1107,6 +1739,75 @@ type RequestStorage interface {
 	UpdateLastRequest(identifier string) error
 }
 
+// RequestPoolStorageService is a service able to supply a RequestPoolStorage
+type RequestPoolStorageService interface {
+	RequestPool(context.Context) RequestPoolStorage
+	RequestPoolTx(context.Context, StorageTx) (RequestPoolStorage, StorageTx, error)
+}
+
+// RequestPoolStorage stores the pool of pending requests used when requests
+// are moderated, these are promoted to the queue by votes
+type RequestPoolStorage interface {
+	// Add adds the request given to the pool, the requester counts as the
+	// first vote. Returns errors.Duplicate if the track is already pending
+	Add(PendingRequest) error
+	// Vote adds a vote from identifier to the pending request of the track
+	// given. Returns errors.Duplicate if identifier already voted for it
+	Vote(id TrackID, identifier string) error
+	// All returns all pending requests, ordered by most votes first and
+	// oldest first after that
+	All() ([]PendingRequest, error)
+	// Remove removes the pending request of the track given
+	Remove(TrackID) error
+}
+
+// PendingRequest is a request in the request pool waiting for enough votes
+// to be promoted to the queue
+type PendingRequest struct {
+	// Song is the song requested
+	Song
+	// UserIdentifier identifies the user that requested the song
+	UserIdentifier string
+	// CreatedAt is when the request was made
+	CreatedAt time.Time
+	// Votes is the amount of votes the request has, including the requester
+	Votes int
+}
+
+// LyricsStorageService is a service able to supply a LyricsStorage
+type LyricsStorageService interface {
+	Lyrics(context.Context) LyricsStorage
+	LyricsTx(context.Context, StorageTx) (LyricsStorage, StorageTx, error)
+}
+
+// LyricsStorage stores the synced lyrics and notes of tracks
+type LyricsStorage interface {
+	// Get returns the lyrics of the track given. Returns errors.LyricsUnknown
+	// if the track has none
+	Get(TrackID) (*TrackLyrics, error)
+	// Update sets the lyrics of a track, if both Lyrics and Notes are empty
+	// the lyrics are removed instead
+	Update(TrackLyrics) error
+}
+
+// TrackLyrics is the optional lyrics and notes of a track
+type TrackLyrics struct {
+	TrackID TrackID
+	// Lyrics are synced lyrics in the LRC format
+	Lyrics string
+	// Notes are freeform notes about the track
+	Notes string
+	// UpdatedBy is the username of who last changed the lyrics
+	UpdatedBy string
+	// UpdatedAt is when the lyrics were last changed
+	UpdatedAt time.Time
+}
+
+// IsEmpty returns true if there are no lyrics and no notes
+func (tl TrackLyrics) IsEmpty() bool {
+	return strings.TrimSpace(tl.Lyrics) == "" && strings.TrimSpace(tl.Notes) == ""
+}
+
 // UserStorageService is a service able to supply a UserStorage
 type UserStorageService interface {
 	User(context.Context) UserStorage
@@ -1137,6 +1838,74 @@ type UserStorage interface {
 	Permissions() ([]UserPermission, error)
 	// RecordListeners records a history of listener count
 	RecordListeners(Listeners, User) error
+	// TwoFactor returns the two-factor authentication state of the user given
+	TwoFactor(UserID) (*UserTwoFactor, error)
+	// UpdateTwoFactor creates or updates the two-factor authentication state
+	UpdateTwoFactor(UserTwoFactor) error
+	// DeleteTwoFactor removes two-factor authentication from the user given
+	DeleteTwoFactor(UserID) error
+	// UseTwoFactorStep marks the TOTP time step given as used, it returns false
+	// if the step is at or before one that was used before
+	UseTwoFactorStep(UserID, uint64) (bool, error)
+	// ReplaceRecoveryCodes replaces the recovery codes of the user with new if
+	// they are still old, it returns false if they were changed by someone else
+	ReplaceRecoveryCodes(id UserID, old, new []string) (bool, error)
+}
+
+// UserTwoFactor is the two-factor authentication state of a user
+type UserTwoFactor struct {
+	UserID UserID
+	// Secret is the base32 encoded TOTP secret
+	Secret string `json:"-"`
+	// RecoveryCodes are the hashes of the unused recovery codes
+	RecoveryCodes []string `json:"-"`
+	// EnabledAt is when the enrollment was confirmed, nil if the user
+	// hasn't confirmed it yet
+	EnabledAt *time.Time
+	// LastStep is the TOTP time step of the last code that was accepted, codes
+	// at or before it are refused
+	LastStep  uint64 `json:"-"`
+	CreatedAt time.Time
+}
+
+// IsEnabled returns true if two-factor authentication should be asked for
+func (tf *UserTwoFactor) IsEnabled() bool {
+	return tf != nil && tf.EnabledAt != nil
+}
+
+// UseRecoveryCode removes the recovery code given from the list of codes,
+// returns false if the code was not a valid recovery code
+func (tf *UserTwoFactor) UseRecoveryCode(code string) bool {
+	hash := hashRecoveryCode(code)
+	for i, rc := range tf.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(rc), []byte(hash)) == 1 {
+			// don't remove it in place, callers might still hold the old slice
+			tf.RecoveryCodes = slices.Delete(slices.Clone(tf.RecoveryCodes), i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRecoveryCodes generates n new recovery codes, it returns the codes
+// to give to the user and the hashes that should be stored
+func GenerateRecoveryCodes(n int) (codes []string, hashes []string, err error) {
+	for range n {
+		code, err := GenerateRandomPassword(12)
+		if err != nil {
+			return nil, nil, err
+		}
+		code = strings.ToLower(code[:6] + "-" + code[6:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
 }
 
 // StatusStorageService is a service able to supply a StatusStorage
@@ -1269,6 +2038,206 @@ type NewsComment struct {
 	UpdatedAt *time.Time
 }
 
+// FingerprintStorageService is a service able to supply a FingerprintStorage
+type FingerprintStorageService interface {
+	Fingerprint(context.Context) FingerprintStorage
+	FingerprintTx(context.Context, StorageTx) (FingerprintStorage, StorageTx, error)
+}
+
+// FingerprintStorage stores acoustic fingerprints of tracks
+type FingerprintStorage interface {
+	// Store stores the fingerprint given, replacing any existing fingerprint
+	// of the same track
+	Store(TrackFingerprint) error
+	// Candidates returns the fingerprints of tracks that have a length
+	// within the margin given of length
+	Candidates(length, margin time.Duration) ([]TrackFingerprint, error)
+	// Missing returns the IDs of all tracks that have no fingerprint
+	Missing() ([]TrackID, error)
+}
+
+// Fingerprint is an acoustic fingerprint of the audio of a song, each entry
+// describes a short overlapping frame of the audio
+type Fingerprint []uint32
+
+// Value implements sql/driver.Valuer
+func (f Fingerprint) Value() (driver.Value, error) {
+	b := make([]byte, len(f)*4)
+	for i, v := range f {
+		binary.LittleEndian.PutUint32(b[i*4:], v)
+	}
+	return b, nil
+}
+
+// Scan implements sql.Scanner
+func (f *Fingerprint) Scan(src any) error {
+	if src == nil {
+		*f = nil
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("unsupported type in Fingerprint.Scan: %T", src)
+	}
+	if len(b)%4 != 0 {
+		return fmt.Errorf("invalid length in Fingerprint.Scan: %d", len(b))
+	}
+
+	res := make(Fingerprint, len(b)/4)
+	for i := range res {
+		res[i] = binary.LittleEndian.Uint32(b[i*4:])
+	}
+	*f = res
+	return nil
+}
+
+// TrackFingerprint is the fingerprint of a track
+type TrackFingerprint struct {
+	TrackID TrackID
+	// Length is the length of the track, and not of the fingerprinted audio
+	// which might be shorter
+	Length      time.Duration
+	Fingerprint Fingerprint
+}
+
+// RecommendationStorageService is a service able to supply a RecommendationStorage
+type RecommendationStorageService interface {
+	Recommendation(context.Context) RecommendationStorage
+	RecommendationTx(context.Context, StorageTx) (RecommendationStorage, StorageTx, error)
+}
+
+// RecommendationStorage stores the similarity between tracks and the data
+// used to compute it
+type RecommendationStorage interface {
+	// Favorites returns all favorites that are of a track
+	Favorites() ([]TrackFavorite, error)
+	// Requests returns all requests made by listener accounts since the time
+	// given, ordered by account and then time
+	Requests(since time.Time) ([]AccountRequest, error)
+	// Replace replaces all stored similarities with the ones given
+	Replace([]TrackSimilarity) error
+	// Similar returns at most limit songs similar to the track given, the most
+	// similar one first
+	Similar(id TrackID, limit int) ([]SimilarSong, error)
+}
+
+// TrackFavorite is a single favorite of a track by a user
+type TrackFavorite struct {
+	// NickID is the internal identifier of the nick that favorited the track
+	NickID  uint64
+	TrackID TrackID
+}
+
+// AccountRequest is a single request made by a listener account
+type AccountRequest struct {
+	AccountID ListenerAccountID
+	TrackID   TrackID
+	Time      time.Time
+}
+
+// TrackSimilarity is how similar SimilarID is to TrackID, a higher score is
+// more similar
+type TrackSimilarity struct {
+	TrackID   TrackID
+	SimilarID TrackID
+	Score     float64
+}
+
+// SimilarSong is a song and how similar it is to the song it was looked up for
+type SimilarSong struct {
+	Song
+	Score float64
+}
+
+// LeaseStorageService is a service able to supply a LeaseStorage
+type LeaseStorageService interface {
+	Lease(context.Context) LeaseStorage
+}
+
+// LeaseStorage stores named leases that can be held by a single holder at a
+// time, this is used for leader election between replicas of a service
+type LeaseStorage interface {
+	// Acquire tries to acquire or renew the lease with the name given for
+	// holder, it expires after ttl unless renewed again. It returns the holder
+	// of the lease after the attempt, which is holder if it succeeded
+	Acquire(name, holder string, ttl time.Duration) (string, error)
+	// Release releases the lease with the name given if it's held by holder
+	Release(name, holder string) error
+}
+
+// JournalStorageService is a service able to supply a JournalStorage
+type JournalStorageService interface {
+	Journal(context.Context) JournalStorage
+}
+
+// JournalStorage is an append-only journal of the updates the manager
+// receives, it's used to reconstruct the status at any point in time
+type JournalStorage interface {
+	// Append adds the entry given to the journal, the ID is ignored
+	Append(JournalEntry) error
+	// Range returns all entries with a time between from and to, oldest first
+	Range(from, to time.Time) ([]JournalEntry, error)
+	// Last returns the latest entry of each kind from before or at the time
+	// given, oldest first
+	Last(before time.Time) ([]JournalEntry, error)
+	// Prune removes entries from before the time given, except for the latest
+	// entry of each kind before it. It returns the amount of entries removed
+	Prune(before time.Time) (int64, error)
+}
+
+// JournalKind is the kind of update a JournalEntry is
+type JournalKind string
+
+const (
+	// JournalUser is an update of the stream user, the data is a *User
+	JournalUser JournalKind = "user"
+	// JournalSong is an update of the song playing, the data is a *SongUpdate
+	JournalSong JournalKind = "song"
+	// JournalThread is an update of the thread, the data is a Thread
+	JournalThread JournalKind = "thread"
+	// JournalListeners is an update of the listener count, the data is Listeners
+	JournalListeners JournalKind = "listeners"
+)
+
+// JournalEntry is a single update recorded in the journal
+type JournalEntry struct {
+	ID   uint64
+	Kind JournalKind
+	Time time.Time
+	// Source is the service that sent the update
+	Source string
+	// Data is the JSON encoded value of the update, see JournalKind for
+	// what type it is
+	Data []byte
+}
+
+// GuestStorageService is a service able to supply a GuestStorage
+type GuestStorageService interface {
+	Guest(context.Context) GuestStorage
+}
+
+// GuestStorage stores guest invites and the authorizations of guests
+type GuestStorage interface {
+	// CreateInvite creates the invite given and returns the new ID
+	CreateInvite(GuestInvite) (GuestInviteID, error)
+	// Invites returns all invites that haven't ended yet, ordered by start
+	Invites() ([]GuestInvite, error)
+	// RedeemInvite marks the invite with the code given as redeemed by nick
+	// and returns it, it returns GuestInviteUnknown if no invite exists that
+	// can be redeemed by nick
+	RedeemInvite(code string, nick string) (*GuestInvite, error)
+	// DeleteInvite deletes the invite with the ID given
+	DeleteInvite(GuestInviteID) error
+
+	// SaveAuth creates or updates the authorization of a guest
+	SaveAuth(GuestAuth) error
+	// DeleteAuth removes the authorization of the nick given
+	DeleteAuth(nick string) error
+	// Auths returns all authorizations
+	Auths() ([]GuestAuth, error)
+}
+
 // SubmissionStorageService is a service able to supply a SubmissionStorage
 type SubmissionStorageService interface {
 	Submissions(context.Context) SubmissionStorage
@@ -1302,6 +2271,12 @@ type SubmissionStorage interface {
 	GetSubmission(SubmissionID) (*PendingSong, error)
 	// RemoveSubmission removes a pending song by ID
 	RemoveSubmission(SubmissionID) error
+	// UncheckedSubmissions returns all submissions that haven't had their
+	// duplicate and quality checks done yet
+	UncheckedSubmissions() ([]PendingSong, error)
+	// UpdateSubmissionCheck stores the duplicate and quality check results of
+	// the pending song given and marks it as checked
+	UpdateSubmissionCheck(PendingSong) error
 
 	// InsertPostPending inserts post-pending data
 	InsertPostPending(PendingSong) error
@@ -1380,6 +2355,9 @@ type PendingSong struct {
 	ReviewedAt time.Time
 	// Duplicate indicates if this might be a duplicate
 	Duplicate bool
+	// DuplicateID is the TrackID of the track this is likely a duplicate of
+	// according to the acoustic fingerprint, nil if none was found
+	DuplicateID *TrackID
 	// ReplacementID is the TrackID that this upload will replace
 	ReplacementID *TrackID
 	// Bitrate of the file
@@ -1390,6 +2368,12 @@ type PendingSong struct {
 	Format string
 	// EncodingMode is the encoding mode used for the file
 	EncodingMode string
+	// Analysis is the result of the quality analysis done on submission, nil
+	// if no analysis was done
+	Analysis *SubmissionAnalysis
+	// CheckedAt is when the duplicate and quality checks were done, these
+	// run in the background after submission so nil if they haven't yet
+	CheckedAt *time.Time
 
 	// Decline fields
 	Reason string
@@ -1403,6 +2387,48 @@ func (p PendingSong) Metadata() string {
 	return Metadata(p.Artist, p.Title)
 }
 
+// SubmissionAnalysis is the result of analyzing the audio of a submission for
+// common quality problems
+type SubmissionAnalysis struct {
+	// SpectralCutoff is the frequency in hz above which there is (almost) no
+	// audio, lossy encoders cut off high frequencies so a low cutoff on a high
+	// bitrate or lossless file indicates a transcode
+	SpectralCutoff int
+	// ClippingRatio is the fraction of samples that are clipped
+	ClippingRatio float64
+	// Loudness is the integrated loudness in LUFS
+	Loudness float64
+	// TruePeak is the true peak in dBTP
+	TruePeak float64
+	// LeadingSilence is the duration of silence at the start
+	LeadingSilence time.Duration
+	// TrailingSilence is the duration of silence at the end
+	TrailingSilence time.Duration
+	// Warnings is a human readable summary of the problems found
+	Warnings []string
+}
+
+// HasWarnings returns true if any problems were found
+func (a *SubmissionAnalysis) HasWarnings() bool {
+	return a != nil && len(a.Warnings) > 0
+}
+
+// Value implements sql/driver.Valuer
+func (a SubmissionAnalysis) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+// Scan implements sql.Scanner
+func (a *SubmissionAnalysis) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	}
+	return fmt.Errorf("unsupported type in SubmissionAnalysis.Scan: %T", src)
+}
+
 type PostPendingID int32
 
 func (id PostPendingID) String() string {
@@ -1556,6 +2582,93 @@ type GuestService interface {
 	Do(ctx context.Context, nick string, can GuestAction) (ok bool, err error)
 	// CanDo checks if a guest is allowed to do the GuestAction given
 	CanDo(ctx context.Context, nick string, can GuestAction) (ok bool, err error)
+	// Redeem redeems the invite with the code given for the nick given and
+	// authorizes them for the window of the invite, the guest user is created
+	// if it doesn't exist yet
+	Redeem(ctx context.Context, code string, nick string) (user *User, err error)
+}
+
+// GuestInviteID is an identifier corresponding to a guest invite
+type GuestInviteID uint64
+
+func ParseGuestInviteID(s string) (GuestInviteID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return GuestInviteID(id), nil
+}
+
+func (id GuestInviteID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// GuestInvite is a one-time invitation for a guest to stream during a
+// specific window of time
+type GuestInvite struct {
+	ID GuestInviteID
+	// Code is the secret used to redeem the invite
+	Code string
+	// Nick is the nick the invite is for, empty if it can be redeemed by anyone
+	Nick string
+	// CreatedBy is the user that created the invite
+	CreatedBy UserID
+	CreatedAt time.Time
+
+	// Start and End are the window of time the guest is allowed to stream in
+	Start time.Time
+	End   time.Time
+	// Mount is the mountpoint the guest should stream to, empty for the default
+	Mount string
+	// Priority is the priority the guest should use when streaming
+	Priority int
+	// KillLimit is the amount of times the guest can kill the current streamer
+	KillLimit int
+	// ThreadLimit is the amount of times the guest can change the thread
+	ThreadLimit int
+
+	// RedeemedBy is the nick that redeemed the invite, empty if it hasn't
+	// been redeemed yet
+	RedeemedBy string
+	RedeemedAt *time.Time
+}
+
+// IsRedeemed returns true if the invite has been redeemed
+func (gi GuestInvite) IsRedeemed() bool {
+	return gi.RedeemedAt != nil
+}
+
+// GuestAuth is the authorization of a guest, either from an invite or from
+// being authorized from irc
+type GuestAuth struct {
+	Nick   string
+	UserID UserID
+	// InviteID is the invite this authorization is from, zero if there is none
+	InviteID GuestInviteID
+
+	// Start and End are the window of time the guest is authorized for
+	Start time.Time
+	End   time.Time
+	// Mount is the mountpoint the guest should stream to, empty for the default
+	Mount string
+	// Priority is the priority the guest should use when streaming
+	Priority int
+	// KillLimit is the amount of times the guest can kill the current streamer
+	KillLimit int
+	// ThreadLimit is the amount of times the guest can change the thread
+	ThreadLimit int
+
+	// HasStreamed indicates if this guest has been "live" on the stream
+	HasStreamed bool
+	// ThreadSets is the amount of times this guest has changed the thread
+	ThreadSets int
+	// KillAttempts is the amount of times this guest has tried to kill
+	KillAttempts int
+}
+
+// IsActive returns true if now is inside the authorized window
+func (ga GuestAuth) IsActive(now time.Time) bool {
+	return !now.Before(ga.Start) && now.Before(ga.End)
 }
 
 type GuestAction int
@@ -3,6 +3,7 @@ package radio
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 	"unicode"
@@ -116,6 +117,118 @@ func TestParseSongHash(t *testing.T) {
 	p.TestingRun(t)
 }
 
+func TestParseAPITokenID(t *testing.T) {
+	testParseAndString(t, ParseAPITokenID)
+}
+
+func TestGenerateAPIToken(t *testing.T) {
+	token, hash, err := GenerateAPIToken()
+	require.NoError(t, err)
+	assert.True(t, IsAPIToken(token))
+	assert.Equal(t, NewAPITokenHash(token), hash)
+
+	var scanned APITokenHash
+	require.NoError(t, scanned.Scan(hash.String()))
+	assert.Equal(t, hash, scanned)
+
+	other, _, err := GenerateAPIToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestAPITokenPermissions(t *testing.T) {
+	token := APIToken{
+		Scopes: NewUserPermissions(PermDJ, PermQueueEdit, PermAdmin),
+	}
+
+	t.Run("intersection", func(t *testing.T) {
+		user := User{UserPermissions: NewUserPermissions(PermActive, PermDJ, PermNews)}
+		perms := token.Permissions(user)
+		assert.Equal(t, NewUserPermissions(PermActive, PermDJ), perms)
+	})
+	t.Run("dev only gets scopes", func(t *testing.T) {
+		user := User{UserPermissions: NewUserPermissions(PermActive, PermDev)}
+		perms := token.Permissions(user)
+		assert.True(t, perms.Has(PermQueueEdit))
+		assert.False(t, perms.Has(PermNews))
+		assert.False(t, perms.HasExplicit(PermDev))
+	})
+	t.Run("inactive user", func(t *testing.T) {
+		user := User{UserPermissions: NewUserPermissions(PermDJ)}
+		perms := token.Permissions(user)
+		assert.False(t, perms.Has(PermDJ))
+	})
+}
+
+func TestAPITokenIsExpired(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	assert.False(t, APIToken{}.IsExpired(now))
+	assert.False(t, APIToken{ExpiresAt: &future}.IsExpired(now))
+	assert.True(t, APIToken{ExpiresAt: &past}.IsExpired(now))
+	assert.True(t, APIToken{ExpiresAt: &now}.IsExpired(now))
+}
+
+func TestUserTwoFactorRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes(3)
+	require.NoError(t, err)
+	require.Len(t, codes, 3)
+	require.Len(t, hashes, 3)
+
+	tf := &UserTwoFactor{RecoveryCodes: hashes}
+	assert.False(t, tf.UseRecoveryCode("not a code"))
+	// codes should be case-insensitive
+	assert.True(t, tf.UseRecoveryCode(strings.ToUpper(codes[1])))
+	assert.Len(t, tf.RecoveryCodes, 2)
+	// and single use
+	assert.False(t, tf.UseRecoveryCode(codes[1]))
+	assert.True(t, tf.UseRecoveryCode(codes[0]))
+	assert.True(t, tf.UseRecoveryCode(codes[2]))
+	assert.Empty(t, tf.RecoveryCodes)
+}
+
+func TestAuditEntryDiff(t *testing.T) {
+	before := User{
+		ID:              5,
+		Username:        "audit",
+		Email:           "old@example.org",
+		UserPermissions: NewUserPermissions(PermActive),
+	}
+	after := before
+	after.Email = "new@example.org"
+	after.DJ.Name = "dj audit"
+	after.UserPermissions = NewUserPermissions(PermActive, PermDJ)
+
+	entry, err := NewAuditEntry(User{ID: 1, Username: "admin"}, AuditUserEdit, "audit", before, after)
+	require.NoError(t, err)
+	assert.Equal(t, UserID(1), entry.ActorID)
+	assert.Equal(t, "admin", entry.Actor)
+
+	diff := entry.Diff()
+	assert.Equal(t, []AuditChange{
+		{Field: "DJ.Name", Before: `""`, After: `"dj audit"`},
+		{Field: "Email", Before: `"old@example.org"`, After: `"new@example.org"`},
+		{Field: "UserPermissions.dj", After: `{}`},
+	}, diff)
+
+	// the password should never end up in the audit log
+	before.Password = "hunter2"
+	entry, err = NewAuditEntry(User{}, AuditUserEdit, "audit", before, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, entry.Before, "hunter2")
+	assert.Empty(t, entry.After)
+	for _, change := range entry.Diff() {
+		assert.Empty(t, change.After)
+	}
+
+	// long targets should be cut off to fit
+	target := strings.Repeat("ア", AuditTargetLength+10)
+	entry, err = NewAuditEntry(User{}, AuditTrackEdit, target, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, target[:len("ア")*AuditTargetLength], entry.Target)
+}
+
 type stringAndComparable interface {
 	fmt.Stringer
 	comparable
@@ -0,0 +1,217 @@
+// Package recommend computes item-to-item similarity between tracks from the
+// favorites of users and the order listeners request tracks in
+package recommend
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+// Options are the knobs of Compute
+type Options struct {
+	// FavoriteWeight is the weight of the co-favorite similarity
+	FavoriteWeight float64
+	// RequestWeight is the weight of the request sequence similarity
+	RequestWeight float64
+	// MaxFavorites is the maximum amount of favorites a user can have before
+	// they're ignored, users that favorite everything say little about
+	// individual tracks and cost a lot to process
+	MaxFavorites int
+	// SequenceWindow is the maximum time between two requests of the same
+	// account for them to count as being related
+	SequenceWindow time.Duration
+	// SequenceSpan is the maximum amount of requests after a request that
+	// count as being related to it
+	SequenceSpan int
+	// MinSupport is the minimum amount of users that need to have linked two
+	// tracks before they're considered similar
+	MinSupport int
+	// PerTrack is the maximum amount of similar tracks kept for each track
+	PerTrack int
+}
+
+// DefaultOptions are the options used by the recommend job
+var DefaultOptions = Options{
+	FavoriteWeight: 1,
+	RequestWeight:  0.5,
+	MaxFavorites:   1000,
+	SequenceWindow: time.Hour * 3,
+	SequenceSpan:   3,
+	MinSupport:     2,
+	PerTrack:       20,
+}
+
+// pair is an unordered pair of tracks, a is always the smallest
+type pair struct {
+	a, b radio.TrackID
+}
+
+func newPair(a, b radio.TrackID) pair {
+	if a > b {
+		a, b = b, a
+	}
+	return pair{a, b}
+}
+
+// cooccurrence counts how often two tracks are linked together
+type cooccurrence struct {
+	// weights is the summed weight of each pair
+	weights map[pair]float64
+	// support is the amount of distinct users that linked each pair
+	support map[pair]int
+	// totals is the summed weight of each track
+	totals map[radio.TrackID]float64
+}
+
+func newCooccurrence() *cooccurrence {
+	return &cooccurrence{
+		weights: make(map[pair]float64),
+		support: make(map[pair]int),
+		totals:  make(map[radio.TrackID]float64),
+	}
+}
+
+// similarity returns the cosine similarity of the pair given
+func (c *cooccurrence) similarity(p pair) float64 {
+	norm := math.Sqrt(c.totals[p.a] * c.totals[p.b])
+	if norm == 0 {
+		return 0
+	}
+	return c.weights[p] / norm
+}
+
+// favorites builds the co-favorite counts, every user that has favorited both
+// tracks adds one to the pair
+func favorites(favs []radio.TrackFavorite, opt Options) *cooccurrence {
+	c := newCooccurrence()
+
+	users := make(map[uint64][]radio.TrackID)
+	for _, f := range favs {
+		users[f.NickID] = append(users[f.NickID], f.TrackID)
+	}
+
+	for _, tracks := range users {
+		slices.Sort(tracks)
+		tracks = slices.Compact(tracks)
+		if opt.MaxFavorites > 0 && len(tracks) > opt.MaxFavorites {
+			continue
+		}
+
+		for i, a := range tracks {
+			c.totals[a]++
+			for _, b := range tracks[i+1:] {
+				p := newPair(a, b)
+				c.weights[p]++
+				c.support[p]++
+			}
+		}
+	}
+	return c
+}
+
+// sequences builds the request sequence counts, requests that follow each other
+// closely are linked with a weight that drops the further apart they are, reqs
+// should be ordered by account and then time
+func sequences(reqs []radio.AccountRequest, opt Options) *cooccurrence {
+	c := newCooccurrence()
+
+	for start := 0; start < len(reqs); {
+		// find the requests of this account
+		end := start + 1
+		for end < len(reqs) && reqs[end].AccountID == reqs[start].AccountID {
+			end++
+		}
+
+		// each account only gives support to a pair once
+		seen := make(map[pair]bool)
+		account := reqs[start:end]
+		for i, a := range account {
+			c.totals[a.TrackID]++
+			for j := i + 1; j < len(account) && j-i <= opt.SequenceSpan; j++ {
+				b := account[j]
+				if b.Time.Sub(a.Time) > opt.SequenceWindow {
+					break
+				}
+				if a.TrackID == b.TrackID {
+					continue
+				}
+
+				p := newPair(a.TrackID, b.TrackID)
+				c.weights[p] += 1 / float64(j-i)
+				if !seen[p] {
+					seen[p] = true
+					c.support[p]++
+				}
+			}
+		}
+		start = end
+	}
+	return c
+}
+
+// Compute computes the similarity between tracks, each track gets at most
+// opt.PerTrack similar tracks and both directions of a pair are returned
+func Compute(favs []radio.TrackFavorite, reqs []radio.AccountRequest, opt Options) []radio.TrackSimilarity {
+	fc := favorites(favs, opt)
+	sc := sequences(reqs, opt)
+
+	scores := make(map[pair]float64)
+	for _, c := range []struct {
+		*cooccurrence
+		weight float64
+	}{
+		{fc, opt.FavoriteWeight},
+		{sc, opt.RequestWeight},
+	} {
+		if c.weight == 0 {
+			continue
+		}
+		for p := range c.weights {
+			scores[p] += c.weight * c.similarity(p)
+		}
+	}
+
+	perTrack := make(map[radio.TrackID][]radio.TrackSimilarity)
+	for p, score := range scores {
+		if fc.support[p]+sc.support[p] < opt.MinSupport || score <= 0 {
+			continue
+		}
+		perTrack[p.a] = append(perTrack[p.a], radio.TrackSimilarity{
+			TrackID:   p.a,
+			SimilarID: p.b,
+			Score:     score,
+		})
+		perTrack[p.b] = append(perTrack[p.b], radio.TrackSimilarity{
+			TrackID:   p.b,
+			SimilarID: p.a,
+			Score:     score,
+		})
+	}
+
+	var res []radio.TrackSimilarity
+	for _, sims := range perTrack {
+		slices.SortFunc(sims, func(a, b radio.TrackSimilarity) int {
+			return cmp.Or(
+				cmp.Compare(b.Score, a.Score),
+				cmp.Compare(a.SimilarID, b.SimilarID),
+			)
+		})
+		if opt.PerTrack > 0 && len(sims) > opt.PerTrack {
+			sims = sims[:opt.PerTrack]
+		}
+		res = append(res, sims...)
+	}
+
+	slices.SortFunc(res, func(a, b radio.TrackSimilarity) int {
+		return cmp.Or(
+			cmp.Compare(a.TrackID, b.TrackID),
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.SimilarID, b.SimilarID),
+		)
+	})
+	return res
+}
@@ -0,0 +1,92 @@
+package recommend
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func similarTo(sims []radio.TrackSimilarity, id radio.TrackID) []radio.TrackID {
+	var res []radio.TrackID
+	for _, s := range sims {
+		if s.TrackID == id {
+			res = append(res, s.SimilarID)
+		}
+	}
+	return res
+}
+
+func TestComputeFavorites(t *testing.T) {
+	favs := []radio.TrackFavorite{
+		// 1 and 2 are liked together by three users
+		{NickID: 1, TrackID: 1}, {NickID: 1, TrackID: 2},
+		{NickID: 2, TrackID: 1}, {NickID: 2, TrackID: 2}, {NickID: 2, TrackID: 3},
+		{NickID: 3, TrackID: 1}, {NickID: 3, TrackID: 2}, {NickID: 3, TrackID: 3},
+		// 4 is only liked together with 1 once
+		{NickID: 4, TrackID: 1}, {NickID: 4, TrackID: 4},
+		// and this user likes everything so should be ignored
+		{NickID: 5, TrackID: 1}, {NickID: 5, TrackID: 2}, {NickID: 5, TrackID: 3},
+		{NickID: 5, TrackID: 4}, {NickID: 5, TrackID: 5},
+	}
+
+	opt := DefaultOptions
+	opt.MaxFavorites = 4
+	sims := Compute(favs, nil, opt)
+
+	assert.Equal(t, []radio.TrackID{2, 3}, similarTo(sims, 1))
+	assert.Equal(t, []radio.TrackID{1, 3}, similarTo(sims, 2))
+	assert.Empty(t, similarTo(sims, 4), "not enough support")
+	assert.Empty(t, similarTo(sims, 5), "user with too many favorites")
+
+	// similarity should be symmetric
+	for _, s := range sims {
+		var found bool
+		for _, o := range sims {
+			if o.TrackID == s.SimilarID && o.SimilarID == s.TrackID {
+				assert.InDelta(t, s.Score, o.Score, 1e-9)
+				found = true
+			}
+		}
+		assert.True(t, found, "missing reverse of %v", s)
+	}
+
+	opt.PerTrack = 1
+	sims = Compute(favs, nil, opt)
+	assert.Equal(t, []radio.TrackID{2}, similarTo(sims, 1))
+}
+
+func TestComputeRequests(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(account radio.ListenerAccountID, id radio.TrackID, minutes int) radio.AccountRequest {
+		return radio.AccountRequest{
+			AccountID: account,
+			TrackID:   id,
+			Time:      start.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+
+	reqs := []radio.AccountRequest{
+		at(1, 10, 0), at(1, 11, 30), at(1, 12, 60),
+		// too far apart from the one before it
+		at(1, 13, 60*24),
+		at(2, 10, 0), at(2, 11, 45),
+		at(2, 13, 60*24),
+		at(3, 12, 0), at(3, 13, 10),
+	}
+
+	opt := DefaultOptions
+	opt.FavoriteWeight = 0
+	sims := Compute(nil, reqs, opt)
+
+	require.NotEmpty(t, sims)
+	assert.Equal(t, []radio.TrackID{11}, similarTo(sims, 10))
+	assert.Empty(t, similarTo(sims, 13), "only linked by one account")
+
+	opt.MinSupport = 1
+	sims = Compute(nil, reqs, opt)
+	assert.Equal(t, []radio.TrackID{11, 12}, similarTo(sims, 10), "closer requests should score higher")
+	assert.Equal(t, []radio.TrackID{12}, similarTo(sims, 13))
+}
@@ -0,0 +1,342 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/R-a-dio/valkyrie/errors"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+)
+
+// SecurityMode is how a RPC API authenticates its clients
+type SecurityMode string
+
+const (
+	// SecurityInsecure does no authentication and sends everything in plain text
+	SecurityInsecure SecurityMode = "insecure"
+	// SecurityToken authenticates clients with a shared-secret token, the
+	// connection is always encrypted so that the token can't be read by
+	// anyone else. Servers need a certificate and clients the CA for it
+	SecurityToken SecurityMode = "token"
+	// SecurityMTLS authenticates both sides with certificates, the identity
+	// of a client is the common name of its certificate
+	SecurityMTLS SecurityMode = "mtls"
+)
+
+const (
+	identityMetadataKey      = "x-rpc-identity"
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
+)
+
+// AnyIdentity can be used in a method rule to allow every authenticated
+// identity to call the method
+const AnyIdentity = "*"
+
+// DefaultAllow is the method authorization used for methods that aren't
+// configured otherwise, methods not in here can't be called by anyone. Manager
+// replicas forward updates to their leader, so they are allowed to do the
+// updates their clients can
+var DefaultAllow = map[string][]string{
+	"/radio.Manager/CurrentStatus":        {AnyIdentity},
+	"/radio.Manager/UpdateFromStorage":    {"website", "irc", "manager"},
+	"/radio.Manager/CurrentSong":          {AnyIdentity},
+	"/radio.Manager/UpdateSong":           {"proxy", "manager"},
+	"/radio.Manager/CurrentThread":        {AnyIdentity},
+	"/radio.Manager/UpdateThread":         {"website", "irc", "manager"},
+	"/radio.Manager/CurrentUser":          {AnyIdentity},
+	"/radio.Manager/UpdateUser":           {"proxy", "manager"},
+	"/radio.Manager/CurrentListenerCount": {AnyIdentity},
+	"/radio.Manager/UpdateListenerCount":  {"listener-tracker", "manager"},
+
+	"/radio.Guest/Create": {"website", "irc"},
+	"/radio.Guest/Auth":   {"irc"},
+	"/radio.Guest/Deauth": {"website", "irc"},
+	"/radio.Guest/CanDo":  {AnyIdentity},
+	"/radio.Guest/Do":     {"website", "irc"},
+	"/radio.Guest/Redeem": {"website"},
+
+	"/radio.Proxy/SourceStream":   {AnyIdentity},
+	"/radio.Proxy/MetadataStream": {AnyIdentity},
+	"/radio.Proxy/StatusStream":   {AnyIdentity},
+	"/radio.Proxy/KickSource":     {"website"},
+	"/radio.Proxy/ListSources":    {AnyIdentity},
+	"/radio.Proxy/PlanHandover":   {"website"},
+	"/radio.Proxy/CancelHandover": {"website"},
+	"/radio.Proxy/HandoverStream": {AnyIdentity},
+
+	"/radio.Announcer/AnnounceSong":    {"manager"},
+	"/radio.Announcer/AnnounceRequest": {"streamer"},
+	"/radio.Announcer/AnnounceUser":    {"manager"},
+	"/radio.Announcer/AnnounceMurder":  {"streamer"},
+	"/radio.Announcer/AnnounceAudit":   {"website"},
+
+	"/radio.Streamer/Start":       {"website", "irc"},
+	"/radio.Streamer/Stop":        {"website", "irc"},
+	"/radio.Streamer/RequestSong": {"website", "irc"},
+	"/radio.Streamer/SetConfig":   {"website"},
+	"/radio.Streamer/Queue":       {AnyIdentity},
+
+	"/radio.Queue/AddRequest":    {"streamer"},
+	"/radio.Queue/ReserveNext":   {"streamer"},
+	"/radio.Queue/ResetReserved": {"streamer"},
+	"/radio.Queue/Remove":        {"website"},
+	"/radio.Queue/Entries":       {AnyIdentity},
+	"/radio.Queue/Move":          {"website"},
+	"/radio.Queue/Insert":        {"website"},
+	"/radio.Queue/Pin":           {"website"},
+
+	"/radio.ListenerTracker/ListClients":  {AnyIdentity},
+	"/radio.ListenerTracker/RemoveClient": {"website"},
+}
+
+// Security is the authentication configuration of one side of a RPC connection
+type Security struct {
+	Mode SecurityMode
+	// Identity is our own identity, it's sent to the server in token mode
+	Identity string
+	// Token is our own token in token mode
+	Token string
+	// Tokens maps each identity to the token it should send, this is only
+	// used by servers in token mode
+	Tokens map[string]string
+	// CA is used to verify the certificate of the other side
+	CA *x509.CertPool
+	// Certificate is our own certificate, required for mtls and for a server
+	// in token mode
+	Certificate *tls.Certificate
+	// Allow maps full method names to the identities allowed to call them,
+	// DefaultAllow is used for methods that aren't in here and methods in
+	// neither are refused
+	Allow map[string][]string
+}
+
+// DialOptions returns the options to connect to a server at addr
+func (s Security) DialOptions(addr string) ([]grpc.DialOption, error) {
+	const op errors.Op = "rpc/Security.DialOptions"
+
+	switch s.Mode {
+	case SecurityInsecure, "":
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	case SecurityToken:
+		if s.CA == nil {
+			return nil, errors.E(op, errors.InvalidArgument, errors.Info("token mode requires a CA"))
+		}
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(s.clientTLS(addr))),
+			grpc.WithPerRPCCredentials(tokenCredentials{
+				identity: s.Identity,
+				token:    s.Token,
+			}),
+		}, nil
+	case SecurityMTLS:
+		if s.CA == nil || s.Certificate == nil {
+			return nil, errors.E(op, errors.InvalidArgument, errors.Info("mtls requires a CA and certificate"))
+		}
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(s.clientTLS(addr))),
+		}, nil
+	}
+	return nil, errors.E(op, errors.InvalidArgument, errors.Info("unknown mode: "+string(s.Mode)))
+}
+
+func (s Security) clientTLS(addr string) *tls.Config {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		RootCAs:    s.CA,
+		ServerName: host,
+	}
+	if s.Certificate != nil {
+		conf.Certificates = []tls.Certificate{*s.Certificate}
+	}
+	return conf
+}
+
+// ServerOptions returns the options for a server
+func (s Security) ServerOptions() ([]grpc.ServerOption, error) {
+	const op errors.Op = "rpc/Security.ServerOptions"
+
+	var authenticate func(ctx context.Context) (string, error)
+
+	var opts []grpc.ServerOption
+	switch s.Mode {
+	case SecurityInsecure, "":
+		return nil, nil
+	case SecurityToken:
+		if s.Certificate == nil {
+			return nil, errors.E(op, errors.InvalidArgument, errors.Info("token mode requires a certificate"))
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			MinVersion:   tls.VersionTLS13,
+			Certificates: []tls.Certificate{*s.Certificate},
+		})))
+		authenticate = s.authenticateToken
+	case SecurityMTLS:
+		if s.CA == nil || s.Certificate == nil {
+			return nil, errors.E(op, errors.InvalidArgument, errors.Info("mtls requires a CA and certificate"))
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			MinVersion:   tls.VersionTLS13,
+			Certificates: []tls.Certificate{*s.Certificate},
+			ClientCAs:    s.CA,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		})))
+		authenticate = authenticateCertificate
+	default:
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("unknown mode: "+string(s.Mode)))
+	}
+
+	check := func(ctx context.Context, method string) (context.Context, error) {
+		identity, err := authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !s.allowed(method, identity) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", identity, method)
+		}
+		return context.WithValue(ctx, identityKey{}, identity), nil
+	}
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			ctx, err := check(ctx, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := check(ss.Context(), info.FullMethod)
+			if err != nil {
+				return err
+			}
+			return handler(srv, identityStream{ss, ctx})
+		}),
+	)
+	return opts, nil
+}
+
+// allowed returns true if identity is allowed to call method
+func (s Security) allowed(method, identity string) bool {
+	allow, ok := s.Allow[method]
+	if !ok {
+		allow, ok = DefaultAllow[method]
+	}
+	if !ok {
+		// nothing configured, so nobody can call it
+		return false
+	}
+	return slices.Contains(allow, AnyIdentity) || slices.Contains(allow, identity)
+}
+
+// authenticateToken returns the identity of the client if it sent the token
+// that belongs to it
+func (s Security) authenticateToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	identities := md.Get(identityMetadataKey)
+	auth := md.Get(authorizationMetadataKey)
+	if len(identities) != 1 || len(auth) != 1 || !strings.HasPrefix(auth[0], bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, "missing identity or token")
+	}
+
+	identity := identities[0]
+	expected, ok := s.Tokens[identity]
+	if !ok || expected == "" {
+		return "", status.Error(codes.Unauthenticated, "invalid identity or token")
+	}
+
+	token := strings.TrimPrefix(auth[0], bearerPrefix)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return "", status.Error(codes.Unauthenticated, "invalid identity or token")
+	}
+	return identity, nil
+}
+
+// authenticateCertificate returns the common name of the verified client
+// certificate as identity
+func authenticateCertificate(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing peer")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing client certificate")
+	}
+
+	identity := info.State.VerifiedChains[0][0].Subject.CommonName
+	if identity == "" {
+		return "", status.Error(codes.Unauthenticated, "client certificate has no common name")
+	}
+	return identity, nil
+}
+
+// tokenCredentials sends our identity and token with each call
+type tokenCredentials struct {
+	identity string
+	token    string
+}
+
+func (tc tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		identityMetadataKey:      tc.identity,
+		authorizationMetadataKey: bearerPrefix + tc.token,
+	}, nil
+}
+
+// RequireTransportSecurity is always true, the token would be readable by
+// anyone on the network otherwise
+func (tc tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the identity of the client that is calling, this
+// is only available on servers that use authentication
+func IdentityFromContext(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(identityKey{}).(string)
+	return identity, ok
+}
+
+// SourceFromContext returns a description of the client that is calling, this
+// is the identity if the server uses authentication and the address of the
+// client otherwise. An empty string is returned if it's not a RPC call
+func SourceFromContext(ctx context.Context) string {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// identityStream is a grpc.ServerStream with the identity added to its context
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (is identityStream) Context() context.Context {
+	return is.ctx
+}
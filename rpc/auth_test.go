@@ -0,0 +1,282 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// testCA is a certificate authority that can issue certificates for tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert, key, pool}
+}
+
+// issue returns a certificate for name that is valid for localhost
+func (ca *testCA) issue(t *testing.T, name string) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	return &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+// testTracker is a radio.ListenerTrackerService that remembers who called it
+type testTracker struct {
+	identities chan string
+}
+
+func (tt testTracker) ListClients(ctx context.Context) ([]radio.Listener, error) {
+	identity, _ := IdentityFromContext(ctx)
+	tt.identities <- identity
+	return nil, nil
+}
+
+func (tt testTracker) RemoveClient(ctx context.Context, id radio.ListenerClientID) error {
+	identity, _ := IdentityFromContext(ctx)
+	tt.identities <- identity
+	return nil
+}
+
+// startAuthServer starts a tracker server with the security given and returns
+// the address it's listening on
+func startAuthServer(t *testing.T, sec Security) (string, testTracker) {
+	opts, err := sec.ServerOptions()
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	tracker := testTracker{identities: make(chan string, 10)}
+	gs := NewGrpcServer(context.Background(), opts...)
+	RegisterListenerTrackerServer(gs, NewListenerTracker(tracker))
+	go gs.Serve(ln)
+	t.Cleanup(gs.Stop)
+
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	return "localhost:" + port, tracker
+}
+
+func dialTracker(t *testing.T, addr string, sec Security) radio.ListenerTrackerService {
+	opts, err := sec.DialOptions(addr)
+	require.NoError(t, err)
+
+	conn := PrepareConn(addr, opts...)
+	t.Cleanup(func() { conn.Close() })
+	return NewListenerTrackerService(conn)
+}
+
+func TestSecurityMTLS(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	ca := newTestCA(t)
+	addr, tracker := startAuthServer(t, Security{
+		Mode:        SecurityMTLS,
+		CA:          ca.pool,
+		Certificate: ca.issue(t, "tracker"),
+	})
+
+	client := func(name string) radio.ListenerTrackerService {
+		return dialTracker(t, addr, Security{
+			Mode:        SecurityMTLS,
+			CA:          ca.pool,
+			Certificate: ca.issue(t, name),
+		})
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		website := client("website")
+		require.NoError(t, website.RemoveClient(ctx, 5))
+		assert.Equal(t, "website", <-tracker.identities)
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		irc := client("irc")
+		err := irc.RemoveClient(ctx, 5)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// but methods open to anyone are fine
+		_, err = irc.ListClients(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "irc", <-tracker.identities)
+	})
+
+	t.Run("unknown ca", func(t *testing.T) {
+		other := newTestCA(t)
+		c := dialTracker(t, addr, Security{
+			Mode:        SecurityMTLS,
+			CA:          ca.pool,
+			Certificate: other.issue(t, "website"),
+		})
+		_, err := c.ListClients(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		c := dialTracker(t, addr, Security{Mode: SecurityInsecure})
+		_, err := c.ListClients(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestSecurityToken(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	server := Security{
+		Mode: SecurityToken,
+		Tokens: map[string]string{
+			"website": "website-secret",
+			"irc":     "irc-secret",
+		},
+		Allow: map[string][]string{
+			"/radio.ListenerTracker/RemoveClient": {"irc"},
+		},
+	}
+
+	check := func(t *testing.T, server Security, client Security) {
+		addr, tracker := startAuthServer(t, server)
+		dial := func(identity, token string) radio.ListenerTrackerService {
+			sec := client
+			sec.Identity = identity
+			sec.Token = token
+			return dialTracker(t, addr, sec)
+		}
+
+		_, err := dial("website", "website-secret").ListClients(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "website", <-tracker.identities)
+
+		// the configured rule should replace the default one
+		err = dial("website", "website-secret").RemoveClient(ctx, 1)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.NoError(t, dial("irc", "irc-secret").RemoveClient(ctx, 1))
+		assert.Equal(t, "irc", <-tracker.identities)
+
+		_, err = dial("website", "irc-secret").ListClients(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = dial("unknown", "").ListClients(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	t.Run("tls", func(t *testing.T) {
+		ca := newTestCA(t)
+		server := server
+		server.Certificate = ca.issue(t, "tracker")
+		check(t, server, Security{Mode: SecurityToken, CA: ca.pool})
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		// tokens should never be sent without encryption
+		_, err := server.ServerOptions()
+		assert.Error(t, err)
+		_, err = Security{Mode: SecurityToken, Identity: "website", Token: "website-secret"}.DialOptions("localhost:1234")
+		assert.Error(t, err)
+	})
+}
+
+func TestSecurityAllowed(t *testing.T) {
+	s := Security{
+		Allow: map[string][]string{
+			"/radio.Queue/Entries": {"irc"},
+		},
+	}
+
+	assert.True(t, s.allowed("/radio.Queue/Move", "website"))
+	assert.False(t, s.allowed("/radio.Queue/Move", "irc"))
+	assert.True(t, s.allowed("/radio.Queue/Entries", "irc"))
+	// the configured rule replaces the any rule
+	assert.False(t, s.allowed("/radio.Queue/Entries", "website"))
+	assert.True(t, s.allowed("/radio.Manager/CurrentSong", "website"))
+	// methods without a rule are refused
+	assert.False(t, s.allowed("/radio.Queue/Unknown", "website"))
+}
+
+// TestDefaultAllowComplete checks that every method in radio.proto has a rule,
+// otherwise it can't be called at all
+func TestDefaultAllowComplete(t *testing.T) {
+	proto, err := os.ReadFile("radio.proto")
+	require.NoError(t, err)
+
+	serviceRe := regexp.MustCompile(`^service\s+(\w+)`)
+	rpcRe := regexp.MustCompile(`^rpc\s+(\w+)\s*\(`)
+
+	var service string
+	var count int
+	for _, line := range strings.Split(string(proto), "\n") {
+		line = strings.TrimSpace(line)
+		if m := serviceRe.FindStringSubmatch(line); m != nil {
+			service = m[1]
+			continue
+		}
+		if m := rpcRe.FindStringSubmatch(line); m != nil {
+			method := "/radio." + service + "/" + m[1]
+			assert.Contains(t, DefaultAllow, method)
+			count++
+		}
+	}
+	require.NotZero(t, count)
+	// and no rules for methods that don't exist
+	assert.Len(t, DefaultAllow, count)
+}
@@ -2,20 +2,27 @@ package rpc
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	grpc "google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var GrpcDial = grpc.NewClient
 
-func PrepareConn(addr string) *grpc.ClientConn {
+// PrepareConn prepares a connection to addr, the connection is insecure unless
+// opts contains other transport credentials
+func PrepareConn(addr string, opts ...grpc.DialOption) *grpc.ClientConn {
 	if len(addr) == 0 {
 		panic("invalid address passed to PrepareConn: empty string")
 	}
@@ -24,9 +31,11 @@ func PrepareConn(addr string) *grpc.ClientConn {
 		addr = "localhost" + addr
 	}
 
-	conn, err := GrpcDial(addr,
+	opts = append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	}, opts...)
+
+	conn, err := GrpcDial(addr, opts...)
 	if err != nil {
 		panic("failed to setup grpc client: " + err.Error())
 	}
@@ -125,6 +134,11 @@ func (a AnnouncerClientRPC) AnnounceMurder(ctx context.Context, by *radio.User,
 	return err
 }
 
+func (a AnnouncerClientRPC) AnnounceAudit(ctx context.Context, entry radio.AuditEntry) error {
+	_, err := a.rpc.AnnounceAudit(ctx, toProtoAuditEntry(entry))
+	return err
+}
+
 func NewProxyService(c *grpc.ClientConn) radio.ProxyService {
 	return ProxyClientRPC{
 		rpc: NewProxyClient(c),
@@ -179,6 +193,23 @@ func (p ProxyClientRPC) ListSources(ctx context.Context) ([]radio.ProxySource, e
 	return ss, nil
 }
 
+func (p ProxyClientRPC) PlanHandover(ctx context.Context, h radio.Handover) error {
+	_, err := p.rpc.PlanHandover(ctx, toProtoHandover(h))
+	return convertClientError(err)
+}
+
+func (p ProxyClientRPC) CancelHandover(ctx context.Context) error {
+	_, err := p.rpc.CancelHandover(ctx, new(emptypb.Empty))
+	return convertClientError(err)
+}
+
+func (p ProxyClientRPC) HandoverStream(ctx context.Context) (eventstream.Stream[radio.Handover], error) {
+	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Handover], error) {
+		return p.rpc.HandoverStream(ctx, e, opts...)
+	}
+	return streamFromProtobuf(ctx, c, new(emptypb.Empty), fromProtoHandover)
+}
+
 func NewGuestService(c *grpc.ClientConn) radio.GuestService {
 	return GuestClientRPC{
 		rpc: NewGuestClient(c),
@@ -220,82 +251,128 @@ func (g GuestClientRPC) Do(ctx context.Context, nick string, action radio.GuestA
 	return b.GetValue(), err
 }
 
-// NewManagerService returns a new client implementing radio.ManagerService
-func NewManagerService(c *grpc.ClientConn) radio.ManagerService {
+func (g GuestClientRPC) Redeem(ctx context.Context, code string, nick string) (*radio.User, error) {
+	u, err := g.rpc.Redeem(ctx, toProtoGuestRedeem(code, nick))
+	if u != nil {
+		return fromProtoUser(u.User), convertClientError(err)
+	}
+	return nil, convertClientError(err)
+}
+
+// NewManagerService returns a new client implementing radio.ManagerService,
+// calls fail over to the replicas given when the current one is unavailable
+func NewManagerService(c *grpc.ClientConn, replicas ...*grpc.ClientConn) radio.ManagerService {
+	clients := []ManagerClient{NewManagerClient(c)}
+	for _, r := range replicas {
+		clients = append(clients, NewManagerClient(r))
+	}
 	return ManagerClientRPC{
-		rpc: NewManagerClient(c),
+		rpc:     clients,
+		current: new(atomic.Int64),
 	}
 }
 
 // ManagerClient is a grpc client that implements radio.ManagerService
 type ManagerClientRPC struct {
-	rpc ManagerClient
+	rpc []ManagerClient
+	// current is the index in rpc of the last client that was available
+	current *atomic.Int64
 }
 
 var _ radio.ManagerService = ManagerClientRPC{}
 
+// failover calls fn with each client until one of them is available, starting
+// with the one that was available last time
+func failover[T any](m ManagerClientRPC, fn func(ManagerClient) (T, error)) (T, error) {
+	start := int(m.current.Load())
+
+	var res T
+	var err error
+	for i := range m.rpc {
+		n := (start + i) % len(m.rpc)
+		res, err = fn(m.rpc[n])
+		if status.Code(err) == grpccodes.Unavailable {
+			continue
+		}
+		if n != start {
+			m.current.Store(int64(n))
+		}
+		break
+	}
+	return res, err
+}
+
 // Status implements radio.ManagerService
 func (m ManagerClientRPC) CurrentStatus(ctx context.Context) (eventstream.Stream[radio.Status], error) {
-	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
-		return m.rpc.CurrentStatus(ctx, e, opts...)
-	}
-	return streamFromProtobuf(ctx, c, new(emptypb.Empty), fromProtoStatus)
+	return resumableStream(ctx, func(ctx context.Context, req *StreamRequest) (eventstream.Stream[radio.Status], error) {
+		return failover(m, func(mc ManagerClient) (eventstream.Stream[radio.Status], error) {
+			return streamFromProtobuf(ctx, mc.CurrentStatus, req, fromProtoStatus)
+		})
+	})
 }
 
 func (m ManagerClientRPC) CurrentUser(ctx context.Context) (eventstream.Stream[*radio.User], error) {
-	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
-		return m.rpc.CurrentUser(ctx, e, opts...)
-	}
-	return streamFromProtobuf(ctx, c, new(emptypb.Empty), fromProtoUser)
+	return failover(m, func(mc ManagerClient) (eventstream.Stream[*radio.User], error) {
+		return streamFromProtobuf(ctx, mc.CurrentUser, new(emptypb.Empty), fromProtoUser)
+	})
 }
 
 // UpdateUser implements radio.ManagerService
 func (m ManagerClientRPC) UpdateUser(ctx context.Context, u *radio.User) error {
-	_, err := m.rpc.UpdateUser(ctx, toProtoUser(u))
+	_, err := failover(m, func(mc ManagerClient) (*emptypb.Empty, error) {
+		return mc.UpdateUser(ctx, toProtoUser(u))
+	})
 	return err
 }
 
 func (m ManagerClientRPC) CurrentSong(ctx context.Context) (eventstream.Stream[*radio.SongUpdate], error) {
-	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongUpdate], error) {
-		return m.rpc.CurrentSong(ctx, e, opts...)
-	}
-	return streamFromProtobuf(ctx, c, new(emptypb.Empty), fromProtoSongUpdate)
+	return resumableStream(ctx, func(ctx context.Context, req *StreamRequest) (eventstream.Stream[*radio.SongUpdate], error) {
+		return failover(m, func(mc ManagerClient) (eventstream.Stream[*radio.SongUpdate], error) {
+			return streamFromProtobuf(ctx, mc.CurrentSong, req, fromProtoSongUpdate)
+		})
+	})
 }
 
 // UpdateSong implements radio.ManagerService
 func (m ManagerClientRPC) UpdateSong(ctx context.Context, u *radio.SongUpdate) error {
-	_, err := m.rpc.UpdateSong(ctx, toProtoSongUpdate(u))
+	_, err := failover(m, func(mc ManagerClient) (*emptypb.Empty, error) {
+		return mc.UpdateSong(ctx, toProtoSongUpdate(u))
+	})
 	return err
 }
 
 // UpdateThread implements radio.ManagerService
 func (m ManagerClientRPC) UpdateThread(ctx context.Context, thread radio.Thread) error {
-	_, err := m.rpc.UpdateThread(ctx, wrapperspb.String(thread))
+	_, err := failover(m, func(mc ManagerClient) (*emptypb.Empty, error) {
+		return mc.UpdateThread(ctx, wrapperspb.String(thread))
+	})
 	return err
 }
 
 func (m ManagerClientRPC) CurrentThread(ctx context.Context) (eventstream.Stream[radio.Thread], error) {
-	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[wrapperspb.StringValue], error) {
-		return m.rpc.CurrentThread(ctx, e, opts...)
-	}
-	return streamFromProtobuf(ctx, c, new(emptypb.Empty), func(v *wrapperspb.StringValue) radio.Thread { return v.Value })
+	return failover(m, func(mc ManagerClient) (eventstream.Stream[radio.Thread], error) {
+		return streamFromProtobuf(ctx, mc.CurrentThread, new(emptypb.Empty), func(v *wrapperspb.StringValue) radio.Thread { return v.Value })
+	})
 }
 
 // UpdateListeners implements radio.ManagerService
 func (m ManagerClientRPC) UpdateListeners(ctx context.Context, count radio.Listeners) error {
-	_, err := m.rpc.UpdateListenerCount(ctx, wrapperspb.Int64(count))
+	_, err := failover(m, func(mc ManagerClient) (*emptypb.Empty, error) {
+		return mc.UpdateListenerCount(ctx, wrapperspb.Int64(count))
+	})
 	return err
 }
 
 func (m ManagerClientRPC) CurrentListeners(ctx context.Context) (eventstream.Stream[radio.Listeners], error) {
-	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[wrapperspb.Int64Value], error) {
-		return m.rpc.CurrentListenerCount(ctx, e, opts...)
-	}
-	return streamFromProtobuf(ctx, c, new(emptypb.Empty), func(v *wrapperspb.Int64Value) radio.Listeners { return v.Value })
+	return failover(m, func(mc ManagerClient) (eventstream.Stream[radio.Listeners], error) {
+		return streamFromProtobuf(ctx, mc.CurrentListenerCount, new(emptypb.Empty), func(v *wrapperspb.Int64Value) radio.Listeners { return v.Value })
+	})
 }
 
 func (m ManagerClientRPC) UpdateFromStorage(ctx context.Context) error {
-	_, err := m.rpc.UpdateFromStorage(ctx, new(emptypb.Empty))
+	_, err := failover(m, func(mc ManagerClient) (*emptypb.Empty, error) {
+		return mc.UpdateFromStorage(ctx, new(emptypb.Empty))
+	})
 	return err
 }
 
@@ -433,6 +510,38 @@ func (q QueueClientRPC) Entries(ctx context.Context) (radio.Queue, error) {
 	return queue, nil
 }
 
+// Move implements radio.QueueService
+func (q QueueClientRPC) Move(ctx context.Context, id radio.QueueID, position int) error {
+	_, err := q.rpc.Move(ctx, &QueueMove{
+		QueueId:  toProtoQueueID(id),
+		Position: int64(position),
+	})
+	return err
+}
+
+// Insert implements radio.QueueService
+func (q QueueClientRPC) Insert(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error) {
+	resp, err := q.rpc.Insert(ctx, &QueueInsert{
+		Song:     toProtoSong(song),
+		Position: int64(position),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := fromProtoQueueEntry(resp)
+	return &entry, nil
+}
+
+// Pin implements radio.QueueService
+func (q QueueClientRPC) Pin(ctx context.Context, id radio.QueueID, pinned bool) error {
+	_, err := q.rpc.Pin(ctx, &QueuePin{
+		QueueId: toProtoQueueID(id),
+		Pinned:  pinned,
+	})
+	return err
+}
+
 type pbCreator[P, A any] func(context.Context, A, ...grpc.CallOption) (grpc.ServerStreamingClient[P], error)
 
 type grpcStream[P, T any] struct {
@@ -440,6 +549,8 @@ type grpcStream[P, T any] struct {
 	conv          func(*P) T
 	cancel        context.CancelFunc
 	setSpanStatus func()
+	// eventID is the event_id of the last message if it has one
+	eventID uint64
 }
 
 func (gs *grpcStream[P, T]) Next() (T, error) {
@@ -447,9 +558,16 @@ func (gs *grpcStream[P, T]) Next() (T, error) {
 	if err != nil {
 		return *new(T), err
 	}
+	if e, ok := any(p).(interface{ GetEventId() uint64 }); ok {
+		gs.eventID = e.GetEventId()
+	}
 	return gs.conv(p), nil
 }
 
+func (gs *grpcStream[P, T]) EventID() uint64 {
+	return gs.eventID
+}
+
 func (gs *grpcStream[P, T]) Close() error {
 	// telemetry support, because our grpc instrumentation marks a context canceled
 	// as an Error and we don't want that, so we set an explicit OK here if we get closed
@@ -492,3 +610,79 @@ func streamFromProtobuf[A, P, T any](ctx context.Context, streamFn pbCreator[P,
 	gs.conv = conv
 	return &gs, nil
 }
+
+// resumeAttempts is the amount of times a resumable stream tries to reconnect
+// before giving up and returning the error to the caller
+const resumeAttempts = 3
+
+// resumableStream returns a stream that reconnects by calling streamFn when
+// the current stream breaks, it passes the event ID of the last value seen
+// such that nothing is missed during short disconnects. The first stream
+// resumes after the ID stored in ctx by eventstream.WithLastEventID
+func resumableStream[T any](ctx context.Context, streamFn func(context.Context, *StreamRequest) (eventstream.Stream[T], error)) (eventstream.Stream[T], error) {
+	rs := &resumeStream[T]{
+		ctx:      ctx,
+		streamFn: streamFn,
+		eventID:  eventstream.LastEventID(ctx),
+	}
+
+	var err error
+	rs.stream, err = streamFn(ctx, &StreamRequest{LastEventId: rs.eventID})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+type resumeStream[T any] struct {
+	ctx      context.Context
+	streamFn func(context.Context, *StreamRequest) (eventstream.Stream[T], error)
+	stream   eventstream.Stream[T]
+	eventID  uint64
+}
+
+func (rs *resumeStream[T]) Next() (T, error) {
+	for {
+		v, err := rs.stream.Next()
+		if err == nil {
+			if id := eventstream.EventID(rs.stream); id != 0 {
+				rs.eventID = id
+			}
+			return v, nil
+		}
+		if rs.ctx.Err() != nil || status.Code(err) == grpccodes.Canceled || !rs.reconnect() {
+			return v, err
+		}
+	}
+}
+
+// reconnect replaces the current stream with a new one that resumes after the
+// last event seen, it returns false if no new stream could be made
+func (rs *resumeStream[T]) reconnect() bool {
+	rs.stream.Close()
+
+	for i := range resumeAttempts {
+		select {
+		case <-rs.ctx.Done():
+			return false
+		case <-time.After(time.Second * time.Duration(i)):
+		}
+
+		stream, err := rs.streamFn(rs.ctx, &StreamRequest{LastEventId: rs.eventID})
+		if err != nil {
+			zerolog.Ctx(rs.ctx).Warn().Ctx(rs.ctx).Err(err).Uint64("event_id", rs.eventID).Msg("failed to resume stream")
+			continue
+		}
+		rs.stream = stream
+		return true
+	}
+	return false
+}
+
+func (rs *resumeStream[T]) EventID() uint64 {
+	return rs.eventID
+}
+
+func (rs *resumeStream[T]) Close() error {
+	return rs.stream.Close()
+}
@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// threadManager is a radio.ManagerService that only implements UpdateThread
+type threadManager struct {
+	radio.ManagerService
+	threads chan radio.Thread
+}
+
+func (tm threadManager) UpdateThread(ctx context.Context, thread radio.Thread) error {
+	tm.threads <- thread
+	return nil
+}
+
+func TestManagerFailover(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// an address that nothing is listening on
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	deadAddr := ln.Addr().String()
+	ln.Close()
+
+	ln, err = net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	threads := make(chan radio.Thread, 1)
+	gs := NewGrpcServer(ctx)
+	RegisterManagerServer(gs, NewManager(threadManager{threads: threads}))
+	go gs.Serve(ln)
+	defer gs.Stop()
+
+	dead := PrepareConn(deadAddr)
+	defer dead.Close()
+	alive := PrepareConn(ln.Addr().String())
+	defer alive.Close()
+
+	m := NewManagerService(dead, alive).(ManagerClientRPC)
+
+	require.NoError(t, m.UpdateThread(ctx, "failover"))
+	assert.Equal(t, radio.Thread("failover"), <-threads)
+	// the replica that worked should be used first from now on
+	assert.EqualValues(t, 1, m.current.Load())
+
+	require.NoError(t, m.UpdateThread(ctx, "again"))
+	assert.Equal(t, radio.Thread("again"), <-threads)
+}
+
+// songManager is a radio.ManagerService that only implements CurrentSong
+type songManager struct {
+	radio.ManagerService
+	songs *eventstream.EventStream[*radio.SongUpdate]
+}
+
+func (sm songManager) CurrentSong(ctx context.Context) (eventstream.Stream[*radio.SongUpdate], error) {
+	return sm.songs.SubStream(ctx), nil
+}
+
+func TestManagerStreamResume(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	song := func(metadata string) *radio.SongUpdate {
+		return &radio.SongUpdate{Song: radio.Song{Metadata: metadata}}
+	}
+
+	songs := eventstream.NewEventStreamHistory(song("initial"), 8)
+	defer songs.Shutdown()
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+
+	serve := func(ln net.Listener) func() {
+		gs := NewGrpcServer(ctx)
+		RegisterManagerServer(gs, NewManager(songManager{songs: songs}))
+		go gs.Serve(ln)
+		return gs.Stop
+	}
+	stop := serve(ln)
+
+	conn := PrepareConn(addr)
+	defer conn.Close()
+	m := NewManagerService(conn)
+
+	stream, err := m.CurrentSong(ctx)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	su, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "initial", su.Metadata)
+
+	// take the server away and send songs while the client is disconnected
+	stop()
+	songs.Send(song("first"))
+	songs.Send(song("second"))
+
+	ln, err = net.Listen("tcp", addr)
+	require.NoError(t, err)
+	stop = serve(ln)
+	defer stop()
+
+	// the stream should reconnect by itself and not miss anything
+	su, err = stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "first", su.Metadata)
+	su, err = stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "second", su.Metadata)
+}
@@ -196,6 +196,7 @@ func toProtoQueueEntry(entry radio.QueueEntry) *QueueEntry {
 		IsUserRequest:     entry.IsUserRequest,
 		UserIdentifier:    entry.UserIdentifier,
 		ExpectedStartTime: tp(entry.ExpectedStartTime),
+		IsPinned:          entry.IsPinned,
 	}
 }
 
@@ -210,6 +211,7 @@ func fromProtoQueueEntry(entry *QueueEntry) radio.QueueEntry {
 		IsUserRequest:     entry.IsUserRequest,
 		UserIdentifier:    entry.UserIdentifier,
 		ExpectedStartTime: t(entry.ExpectedStartTime),
+		IsPinned:          entry.IsPinned,
 	}
 }
 
@@ -302,6 +304,34 @@ func fromProtoUser(u *User) *radio.User {
 	}
 }
 
+func toProtoAuditEntry(e radio.AuditEntry) *AuditAnnouncement {
+	return &AuditAnnouncement{
+		Id:        uint64(e.ID),
+		ActorId:   int32(e.ActorID),
+		Actor:     e.Actor,
+		Action:    string(e.Action),
+		Target:    e.Target,
+		Before:    e.Before,
+		After:     e.After,
+		Ip:        e.IP,
+		CreatedAt: tp(e.CreatedAt),
+	}
+}
+
+func fromProtoAuditEntry(e *AuditAnnouncement) radio.AuditEntry {
+	return radio.AuditEntry{
+		ID:        radio.AuditEntryID(e.Id),
+		ActorID:   radio.UserID(e.ActorId),
+		Actor:     e.Actor,
+		Action:    radio.AuditAction(e.Action),
+		Target:    e.Target,
+		Before:    e.Before,
+		After:     e.After,
+		IP:        e.Ip,
+		CreatedAt: t(e.CreatedAt),
+	}
+}
+
 func toProtoUserPermissions(up radio.UserPermissions) []string {
 	if up == nil {
 		return nil
@@ -618,6 +648,28 @@ func fromProtoProxySource(s *ProxySource) radio.ProxySource {
 	}
 }
 
+func toProtoHandover(h radio.Handover) *Handover {
+	return &Handover{
+		State:     HandoverState(h.State),
+		From:      toProtoUser(&h.From),
+		To:        toProtoUser(&h.To),
+		MountName: h.MountName,
+		At:        tp(h.At),
+		Error:     h.Error,
+	}
+}
+
+func fromProtoHandover(h *Handover) radio.Handover {
+	return radio.Handover{
+		State:     radio.HandoverState(h.GetState()),
+		From:      *fromProtoUser(h.From),
+		To:        *fromProtoUser(h.To),
+		MountName: h.MountName,
+		At:        t(h.At),
+		Error:     h.Error,
+	}
+}
+
 func toProtoProxyStatusEvent(s []radio.ProxySource) *ProxyStatusEvent {
 	var pse ProxyStatusEvent
 	pse.Connections = make([]*ProxySource, len(s))
@@ -655,6 +707,20 @@ func toProtoGuestCanDo(nick string, action radio.GuestAction) *GuestCanDo {
 	}
 }
 
+func toProtoGuestRedeem(code, nick string) *GuestRedeem {
+	return &GuestRedeem{
+		User: toProtoGuestUser(nick),
+		Code: code,
+	}
+}
+
+func fromProtoGuestRedeem(gr *GuestRedeem) (code, nick string) {
+	if gr == nil {
+		return "", ""
+	}
+	return gr.GetCode(), gr.User.GetName()
+}
+
 func fromProtoGuestCanDo(gcd *GuestCanDo) (nick string, action radio.GuestAction) {
 	if gcd == nil {
 		return "", radio.GuestNone
@@ -73,6 +73,61 @@ func (GuestAction) EnumDescriptor() ([]byte, []int) {
 	return file_radio_proto_rawDescGZIP(), []int{0}
 }
 
+type HandoverState int32
+
+const (
+	HandoverState_HandoverNone      HandoverState = 0
+	HandoverState_HandoverPlanned   HandoverState = 1
+	HandoverState_HandoverCompleted HandoverState = 2
+	HandoverState_HandoverFailed    HandoverState = 3
+	HandoverState_HandoverCanceled  HandoverState = 4
+)
+
+// Enum value maps for HandoverState.
+var (
+	HandoverState_name = map[int32]string{
+		0: "HandoverNone",
+		1: "HandoverPlanned",
+		2: "HandoverCompleted",
+		3: "HandoverFailed",
+		4: "HandoverCanceled",
+	}
+	HandoverState_value = map[string]int32{
+		"HandoverNone":      0,
+		"HandoverPlanned":   1,
+		"HandoverCompleted": 2,
+		"HandoverFailed":    3,
+		"HandoverCanceled":  4,
+	}
+)
+
+func (x HandoverState) Enum() *HandoverState {
+	p := new(HandoverState)
+	*p = x
+	return p
+}
+
+func (x HandoverState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandoverState) Descriptor() protoreflect.EnumDescriptor {
+	return file_radio_proto_enumTypes[1].Descriptor()
+}
+
+func (HandoverState) Type() protoreflect.EnumType {
+	return &file_radio_proto_enumTypes[1]
+}
+
+func (x HandoverState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandoverState.Descriptor instead.
+func (HandoverState) EnumDescriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{1}
+}
+
 type ProxySourceEventType int32
 
 const (
@@ -106,11 +161,11 @@ func (x ProxySourceEventType) String() string {
 }
 
 func (ProxySourceEventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_radio_proto_enumTypes[1].Descriptor()
+	return file_radio_proto_enumTypes[2].Descriptor()
 }
 
 func (ProxySourceEventType) Type() protoreflect.EnumType {
-	return &file_radio_proto_enumTypes[1]
+	return &file_radio_proto_enumTypes[2]
 }
 
 func (x ProxySourceEventType) Number() protoreflect.EnumNumber {
@@ -119,7 +174,7 @@ func (x ProxySourceEventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProxySourceEventType.Descriptor instead.
 func (ProxySourceEventType) EnumDescriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{1}
+	return file_radio_proto_rawDescGZIP(), []int{2}
 }
 
 type Song struct {
@@ -342,6 +397,54 @@ func (x *Song) GetSyncTime() *timestamppb.Timestamp {
 	return nil
 }
 
+// StreamRequest is the argument to streams that can be resumed, it is wire
+// compatible with google.protobuf.Empty
+type StreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the event_id of the last value seen, the stream will first send any
+	// values that came after it, or only the latest if it's unknown
+	LastEventId   uint64 `protobuf:"varint,1,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	mi := &file_radio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamRequest) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
 type GuestCreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -352,7 +455,7 @@ type GuestCreateResponse struct {
 
 func (x *GuestCreateResponse) Reset() {
 	*x = GuestCreateResponse{}
-	mi := &file_radio_proto_msgTypes[1]
+	mi := &file_radio_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +467,7 @@ func (x *GuestCreateResponse) String() string {
 func (*GuestCreateResponse) ProtoMessage() {}
 
 func (x *GuestCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[1]
+	mi := &file_radio_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +480,7 @@ func (x *GuestCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuestCreateResponse.ProtoReflect.Descriptor instead.
 func (*GuestCreateResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{1}
+	return file_radio_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GuestCreateResponse) GetUser() *User {
@@ -403,7 +506,7 @@ type GuestAuthResponse struct {
 
 func (x *GuestAuthResponse) Reset() {
 	*x = GuestAuthResponse{}
-	mi := &file_radio_proto_msgTypes[2]
+	mi := &file_radio_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +518,7 @@ func (x *GuestAuthResponse) String() string {
 func (*GuestAuthResponse) ProtoMessage() {}
 
 func (x *GuestAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[2]
+	mi := &file_radio_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +531,7 @@ func (x *GuestAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuestAuthResponse.ProtoReflect.Descriptor instead.
 func (*GuestAuthResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{2}
+	return file_radio_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GuestAuthResponse) GetUser() *User {
@@ -447,7 +550,7 @@ type GuestUser struct {
 
 func (x *GuestUser) Reset() {
 	*x = GuestUser{}
-	mi := &file_radio_proto_msgTypes[3]
+	mi := &file_radio_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +562,7 @@ func (x *GuestUser) String() string {
 func (*GuestUser) ProtoMessage() {}
 
 func (x *GuestUser) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[3]
+	mi := &file_radio_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +575,7 @@ func (x *GuestUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuestUser.ProtoReflect.Descriptor instead.
 func (*GuestUser) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{3}
+	return file_radio_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GuestUser) GetName() string {
@@ -492,7 +595,7 @@ type GuestCanDo struct {
 
 func (x *GuestCanDo) Reset() {
 	*x = GuestCanDo{}
-	mi := &file_radio_proto_msgTypes[4]
+	mi := &file_radio_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +607,7 @@ func (x *GuestCanDo) String() string {
 func (*GuestCanDo) ProtoMessage() {}
 
 func (x *GuestCanDo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[4]
+	mi := &file_radio_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +620,7 @@ func (x *GuestCanDo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuestCanDo.ProtoReflect.Descriptor instead.
 func (*GuestCanDo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{4}
+	return file_radio_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GuestCanDo) GetUser() *GuestUser {
@@ -534,6 +637,142 @@ func (x *GuestCanDo) GetAction() GuestAction {
 	return GuestAction_None
 }
 
+type GuestRedeem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *GuestUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestRedeem) Reset() {
+	*x = GuestRedeem{}
+	mi := &file_radio_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestRedeem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestRedeem) ProtoMessage() {}
+
+func (x *GuestRedeem) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestRedeem.ProtoReflect.Descriptor instead.
+func (*GuestRedeem) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GuestRedeem) GetUser() *GuestUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GuestRedeem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Handover struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         HandoverState          `protobuf:"varint,1,opt,name=state,proto3,enum=radio.HandoverState" json:"state,omitempty"`
+	From          *User                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *User                  `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	MountName     string                 `protobuf:"bytes,4,opt,name=mount_name,json=mountName,proto3" json:"mount_name,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Handover) Reset() {
+	*x = Handover{}
+	mi := &file_radio_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handover) ProtoMessage() {}
+
+func (x *Handover) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handover.ProtoReflect.Descriptor instead.
+func (*Handover) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Handover) GetState() HandoverState {
+	if x != nil {
+		return x.State
+	}
+	return HandoverState_HandoverNone
+}
+
+func (x *Handover) GetFrom() *User {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Handover) GetTo() *User {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Handover) GetMountName() string {
+	if x != nil {
+		return x.MountName
+	}
+	return ""
+}
+
+func (x *Handover) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Handover) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type ProxyListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sources       []*ProxySource         `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
@@ -543,7 +782,7 @@ type ProxyListResponse struct {
 
 func (x *ProxyListResponse) Reset() {
 	*x = ProxyListResponse{}
-	mi := &file_radio_proto_msgTypes[5]
+	mi := &file_radio_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +794,7 @@ func (x *ProxyListResponse) String() string {
 func (*ProxyListResponse) ProtoMessage() {}
 
 func (x *ProxyListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[5]
+	mi := &file_radio_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +807,7 @@ func (x *ProxyListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyListResponse.ProtoReflect.Descriptor instead.
 func (*ProxyListResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{5}
+	return file_radio_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ProxyListResponse) GetSources() []*ProxySource {
@@ -587,7 +826,7 @@ type ProxyStatusRequest struct {
 
 func (x *ProxyStatusRequest) Reset() {
 	*x = ProxyStatusRequest{}
-	mi := &file_radio_proto_msgTypes[6]
+	mi := &file_radio_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +838,7 @@ func (x *ProxyStatusRequest) String() string {
 func (*ProxyStatusRequest) ProtoMessage() {}
 
 func (x *ProxyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[6]
+	mi := &file_radio_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +851,7 @@ func (x *ProxyStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyStatusRequest.ProtoReflect.Descriptor instead.
 func (*ProxyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{6}
+	return file_radio_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProxyStatusRequest) GetUserId() int32 {
@@ -631,7 +870,7 @@ type ProxyStatusEvent struct {
 
 func (x *ProxyStatusEvent) Reset() {
 	*x = ProxyStatusEvent{}
-	mi := &file_radio_proto_msgTypes[7]
+	mi := &file_radio_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +882,7 @@ func (x *ProxyStatusEvent) String() string {
 func (*ProxyStatusEvent) ProtoMessage() {}
 
 func (x *ProxyStatusEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[7]
+	mi := &file_radio_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +895,7 @@ func (x *ProxyStatusEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyStatusEvent.ProtoReflect.Descriptor instead.
 func (*ProxyStatusEvent) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{7}
+	return file_radio_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProxyStatusEvent) GetConnections() []*ProxySource {
@@ -683,7 +922,7 @@ type ProxySource struct {
 
 func (x *ProxySource) Reset() {
 	*x = ProxySource{}
-	mi := &file_radio_proto_msgTypes[8]
+	mi := &file_radio_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -695,7 +934,7 @@ func (x *ProxySource) String() string {
 func (*ProxySource) ProtoMessage() {}
 
 func (x *ProxySource) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[8]
+	mi := &file_radio_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +947,7 @@ func (x *ProxySource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxySource.ProtoReflect.Descriptor instead.
 func (*ProxySource) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{8}
+	return file_radio_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ProxySource) GetUser() *User {
@@ -786,7 +1025,7 @@ type ProxySourceEvent struct {
 
 func (x *ProxySourceEvent) Reset() {
 	*x = ProxySourceEvent{}
-	mi := &file_radio_proto_msgTypes[9]
+	mi := &file_radio_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +1037,7 @@ func (x *ProxySourceEvent) String() string {
 func (*ProxySourceEvent) ProtoMessage() {}
 
 func (x *ProxySourceEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[9]
+	mi := &file_radio_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +1050,7 @@ func (x *ProxySourceEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxySourceEvent.ProtoReflect.Descriptor instead.
 func (*ProxySourceEvent) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{9}
+	return file_radio_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProxySourceEvent) GetUser() *User {
@@ -851,7 +1090,7 @@ type SourceID struct {
 
 func (x *SourceID) Reset() {
 	*x = SourceID{}
-	mi := &file_radio_proto_msgTypes[10]
+	mi := &file_radio_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +1102,7 @@ func (x *SourceID) String() string {
 func (*SourceID) ProtoMessage() {}
 
 func (x *SourceID) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[10]
+	mi := &file_radio_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1115,7 @@ func (x *SourceID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceID.ProtoReflect.Descriptor instead.
 func (*SourceID) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{10}
+	return file_radio_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SourceID) GetID() string {
@@ -897,7 +1136,7 @@ type ProxyMetadataEvent struct {
 
 func (x *ProxyMetadataEvent) Reset() {
 	*x = ProxyMetadataEvent{}
-	mi := &file_radio_proto_msgTypes[11]
+	mi := &file_radio_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1148,7 @@ func (x *ProxyMetadataEvent) String() string {
 func (*ProxyMetadataEvent) ProtoMessage() {}
 
 func (x *ProxyMetadataEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[11]
+	mi := &file_radio_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1161,7 @@ func (x *ProxyMetadataEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyMetadataEvent.ProtoReflect.Descriptor instead.
 func (*ProxyMetadataEvent) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{11}
+	return file_radio_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProxyMetadataEvent) GetUser() *User {
@@ -963,14 +1202,16 @@ type StatusResponse struct {
 	// the display name given to us by the streaming user
 	StreamerName string `protobuf:"bytes,7,opt,name=streamer_name,json=streamerName,proto3" json:"streamer_name,omitempty"`
 	// the current user that is streaming
-	StreamUser    *User `protobuf:"bytes,8,opt,name=stream_user,json=streamUser,proto3" json:"stream_user,omitempty"`
+	StreamUser *User `protobuf:"bytes,8,opt,name=stream_user,json=streamUser,proto3" json:"stream_user,omitempty"`
+	// the id of this event, can be used to resume the stream
+	EventId       uint64 `protobuf:"varint,9,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_radio_proto_msgTypes[12]
+	mi := &file_radio_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -982,7 +1223,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[12]
+	mi := &file_radio_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1236,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{12}
+	return file_radio_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StatusResponse) GetUser() *User {
@@ -1054,17 +1295,26 @@ func (x *StatusResponse) GetStreamUser() *User {
 	return nil
 }
 
+func (x *StatusResponse) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
 type SongUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Song          *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
-	Info          *SongInfo              `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Song  *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	Info  *SongInfo              `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// the id of this event, can be used to resume the stream
+	EventId       uint64 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SongUpdate) Reset() {
 	*x = SongUpdate{}
-	mi := &file_radio_proto_msgTypes[13]
+	mi := &file_radio_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1076,7 +1326,7 @@ func (x *SongUpdate) String() string {
 func (*SongUpdate) ProtoMessage() {}
 
 func (x *SongUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[13]
+	mi := &file_radio_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1339,7 @@ func (x *SongUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongUpdate.ProtoReflect.Descriptor instead.
 func (*SongUpdate) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{13}
+	return file_radio_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SongUpdate) GetSong() *Song {
@@ -1106,6 +1356,13 @@ func (x *SongUpdate) GetInfo() *SongInfo {
 	return nil
 }
 
+func (x *SongUpdate) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
 type SongInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the time this song started playing
@@ -1118,7 +1375,7 @@ type SongInfo struct {
 
 func (x *SongInfo) Reset() {
 	*x = SongInfo{}
-	mi := &file_radio_proto_msgTypes[14]
+	mi := &file_radio_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1387,7 @@ func (x *SongInfo) String() string {
 func (*SongInfo) ProtoMessage() {}
 
 func (x *SongInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[14]
+	mi := &file_radio_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1400,7 @@ func (x *SongInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongInfo.ProtoReflect.Descriptor instead.
 func (*SongInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{14}
+	return file_radio_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SongInfo) GetStartTime() *timestamppb.Timestamp {
@@ -1172,7 +1429,7 @@ type StreamerConfig struct {
 
 func (x *StreamerConfig) Reset() {
 	*x = StreamerConfig{}
-	mi := &file_radio_proto_msgTypes[15]
+	mi := &file_radio_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1184,7 +1441,7 @@ func (x *StreamerConfig) String() string {
 func (*StreamerConfig) ProtoMessage() {}
 
 func (x *StreamerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[15]
+	mi := &file_radio_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,7 +1454,7 @@ func (x *StreamerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamerConfig.ProtoReflect.Descriptor instead.
 func (*StreamerConfig) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{15}
+	return file_radio_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StreamerConfig) GetRequestsEnabled() bool {
@@ -1224,7 +1481,7 @@ type UserUpdate struct {
 
 func (x *UserUpdate) Reset() {
 	*x = UserUpdate{}
-	mi := &file_radio_proto_msgTypes[16]
+	mi := &file_radio_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1493,7 @@ func (x *UserUpdate) String() string {
 func (*UserUpdate) ProtoMessage() {}
 
 func (x *UserUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[16]
+	mi := &file_radio_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1506,7 @@ func (x *UserUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserUpdate.ProtoReflect.Descriptor instead.
 func (*UserUpdate) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{16}
+	return file_radio_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UserUpdate) GetUser() *User {
@@ -1286,7 +1543,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_radio_proto_msgTypes[17]
+	mi := &file_radio_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1555,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[17]
+	mi := &file_radio_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1568,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{17}
+	return file_radio_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *User) GetId() int32 {
@@ -1411,7 +1668,7 @@ type DJ struct {
 
 func (x *DJ) Reset() {
 	*x = DJ{}
-	mi := &file_radio_proto_msgTypes[18]
+	mi := &file_radio_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1423,7 +1680,7 @@ func (x *DJ) String() string {
 func (*DJ) ProtoMessage() {}
 
 func (x *DJ) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[18]
+	mi := &file_radio_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1693,7 @@ func (x *DJ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DJ.ProtoReflect.Descriptor instead.
 func (*DJ) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{18}
+	return file_radio_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DJ) GetId() uint64 {
@@ -1526,7 +1783,7 @@ type ListenerInfo struct {
 
 func (x *ListenerInfo) Reset() {
 	*x = ListenerInfo{}
-	mi := &file_radio_proto_msgTypes[19]
+	mi := &file_radio_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +1795,7 @@ func (x *ListenerInfo) String() string {
 func (*ListenerInfo) ProtoMessage() {}
 
 func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[19]
+	mi := &file_radio_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1808,7 @@ func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerInfo.ProtoReflect.Descriptor instead.
 func (*ListenerInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{19}
+	return file_radio_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListenerInfo) GetListeners() int64 {
@@ -1571,7 +1828,7 @@ type MurderAnnouncement struct {
 
 func (x *MurderAnnouncement) Reset() {
 	*x = MurderAnnouncement{}
-	mi := &file_radio_proto_msgTypes[20]
+	mi := &file_radio_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1583,7 +1840,7 @@ func (x *MurderAnnouncement) String() string {
 func (*MurderAnnouncement) ProtoMessage() {}
 
 func (x *MurderAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[20]
+	mi := &file_radio_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +1853,7 @@ func (x *MurderAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MurderAnnouncement.ProtoReflect.Descriptor instead.
 func (*MurderAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{20}
+	return file_radio_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MurderAnnouncement) GetBy() *User {
@@ -1624,7 +1881,7 @@ type SongAnnouncement struct {
 
 func (x *SongAnnouncement) Reset() {
 	*x = SongAnnouncement{}
-	mi := &file_radio_proto_msgTypes[21]
+	mi := &file_radio_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1636,7 +1893,7 @@ func (x *SongAnnouncement) String() string {
 func (*SongAnnouncement) ProtoMessage() {}
 
 func (x *SongAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[21]
+	mi := &file_radio_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,7 +1906,7 @@ func (x *SongAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongAnnouncement.ProtoReflect.Descriptor instead.
 func (*SongAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{21}
+	return file_radio_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SongAnnouncement) GetSong() *Song {
@@ -1682,7 +1939,7 @@ type SongRequestAnnouncement struct {
 
 func (x *SongRequestAnnouncement) Reset() {
 	*x = SongRequestAnnouncement{}
-	mi := &file_radio_proto_msgTypes[22]
+	mi := &file_radio_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1694,7 +1951,7 @@ func (x *SongRequestAnnouncement) String() string {
 func (*SongRequestAnnouncement) ProtoMessage() {}
 
 func (x *SongRequestAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[22]
+	mi := &file_radio_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +1964,7 @@ func (x *SongRequestAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongRequestAnnouncement.ProtoReflect.Descriptor instead.
 func (*SongRequestAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{22}
+	return file_radio_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SongRequestAnnouncement) GetSong() *Song {
@@ -1726,7 +1983,7 @@ type UserAnnouncement struct {
 
 func (x *UserAnnouncement) Reset() {
 	*x = UserAnnouncement{}
-	mi := &file_radio_proto_msgTypes[23]
+	mi := &file_radio_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +1995,7 @@ func (x *UserAnnouncement) String() string {
 func (*UserAnnouncement) ProtoMessage() {}
 
 func (x *UserAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[23]
+	mi := &file_radio_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +2008,7 @@ func (x *UserAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAnnouncement.ProtoReflect.Descriptor instead.
 func (*UserAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{23}
+	return file_radio_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UserAnnouncement) GetUser() *User {
@@ -1761,6 +2018,117 @@ func (x *UserAnnouncement) GetUser() *User {
 	return nil
 }
 
+type AuditAnnouncement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the user that did the action
+	ActorId int32  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Actor   string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action  string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// description of what the action was done to
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// JSON documents of the target before and after the action
+	Before        string                 `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Ip            string                 `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditAnnouncement) Reset() {
+	*x = AuditAnnouncement{}
+	mi := &file_radio_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAnnouncement) ProtoMessage() {}
+
+func (x *AuditAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAnnouncement.ProtoReflect.Descriptor instead.
+func (*AuditAnnouncement) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuditAnnouncement) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditAnnouncement) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditAnnouncement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditAnnouncement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type StreamerStopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Who           *User                  `protobuf:"bytes,1,opt,name=who,proto3" json:"who,omitempty"`
@@ -1771,7 +2139,7 @@ type StreamerStopRequest struct {
 
 func (x *StreamerStopRequest) Reset() {
 	*x = StreamerStopRequest{}
-	mi := &file_radio_proto_msgTypes[24]
+	mi := &file_radio_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1783,7 +2151,7 @@ func (x *StreamerStopRequest) String() string {
 func (*StreamerStopRequest) ProtoMessage() {}
 
 func (x *StreamerStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[24]
+	mi := &file_radio_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1796,7 +2164,7 @@ func (x *StreamerStopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamerStopRequest.ProtoReflect.Descriptor instead.
 func (*StreamerStopRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{24}
+	return file_radio_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *StreamerStopRequest) GetWho() *User {
@@ -1822,7 +2190,7 @@ type StreamerResponse struct {
 
 func (x *StreamerResponse) Reset() {
 	*x = StreamerResponse{}
-	mi := &file_radio_proto_msgTypes[25]
+	mi := &file_radio_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1834,7 +2202,7 @@ func (x *StreamerResponse) String() string {
 func (*StreamerResponse) ProtoMessage() {}
 
 func (x *StreamerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[25]
+	mi := &file_radio_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1847,7 +2215,7 @@ func (x *StreamerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamerResponse.ProtoReflect.Descriptor instead.
 func (*StreamerResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{25}
+	return file_radio_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *StreamerResponse) GetError() []*Error {
@@ -1866,7 +2234,7 @@ type QueueID struct {
 
 func (x *QueueID) Reset() {
 	*x = QueueID{}
-	mi := &file_radio_proto_msgTypes[26]
+	mi := &file_radio_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1878,7 +2246,7 @@ func (x *QueueID) String() string {
 func (*QueueID) ProtoMessage() {}
 
 func (x *QueueID) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[26]
+	mi := &file_radio_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1891,7 +2259,7 @@ func (x *QueueID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueID.ProtoReflect.Descriptor instead.
 func (*QueueID) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{26}
+	return file_radio_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueueID) GetID() string {
@@ -1912,14 +2280,16 @@ type QueueEntry struct {
 	// expected_start_time is the expected time this song will start playing
 	ExpectedStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_start_time,json=expectedStartTime,proto3" json:"expected_start_time,omitempty"`
 	// unique id of the entry
-	QueueId       *QueueID `protobuf:"bytes,5,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueId *QueueID `protobuf:"bytes,5,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	// is_pinned indicates the entry keeps its position in the queue
+	IsPinned      bool `protobuf:"varint,6,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueueEntry) Reset() {
 	*x = QueueEntry{}
-	mi := &file_radio_proto_msgTypes[27]
+	mi := &file_radio_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1931,7 +2301,7 @@ func (x *QueueEntry) String() string {
 func (*QueueEntry) ProtoMessage() {}
 
 func (x *QueueEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[27]
+	mi := &file_radio_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +2314,7 @@ func (x *QueueEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
 func (*QueueEntry) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{27}
+	return file_radio_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueueEntry) GetSong() *Song {
@@ -1982,6 +2352,171 @@ func (x *QueueEntry) GetQueueId() *QueueID {
 	return nil
 }
 
+func (x *QueueEntry) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+type QueueMove struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	QueueId *QueueID               `protobuf:"bytes,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	// position is the index in the queue the entry should move to
+	Position      int64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueMove) Reset() {
+	*x = QueueMove{}
+	mi := &file_radio_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueMove) ProtoMessage() {}
+
+func (x *QueueMove) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueMove.ProtoReflect.Descriptor instead.
+func (*QueueMove) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueueMove) GetQueueId() *QueueID {
+	if x != nil {
+		return x.QueueId
+	}
+	return nil
+}
+
+func (x *QueueMove) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type QueueInsert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Song  *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// position is the index in the queue the song should be inserted at
+	Position      int64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueInsert) Reset() {
+	*x = QueueInsert{}
+	mi := &file_radio_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueInsert) ProtoMessage() {}
+
+func (x *QueueInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueInsert.ProtoReflect.Descriptor instead.
+func (*QueueInsert) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueueInsert) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *QueueInsert) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type QueuePin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       *QueueID               `protobuf:"bytes,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuePin) Reset() {
+	*x = QueuePin{}
+	mi := &file_radio_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuePin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuePin) ProtoMessage() {}
+
+func (x *QueuePin) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuePin.ProtoReflect.Descriptor instead.
+func (*QueuePin) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueuePin) GetQueueId() *QueueID {
+	if x != nil {
+		return x.QueueId
+	}
+	return nil
+}
+
+func (x *QueuePin) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
 type QueueInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// the name of the queue implementation
@@ -1994,7 +2529,7 @@ type QueueInfo struct {
 
 func (x *QueueInfo) Reset() {
 	*x = QueueInfo{}
-	mi := &file_radio_proto_msgTypes[28]
+	mi := &file_radio_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2006,7 +2541,7 @@ func (x *QueueInfo) String() string {
 func (*QueueInfo) ProtoMessage() {}
 
 func (x *QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[28]
+	mi := &file_radio_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,7 +2554,7 @@ func (x *QueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueInfo.ProtoReflect.Descriptor instead.
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{28}
+	return file_radio_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueueInfo) GetName() string {
@@ -2046,7 +2581,7 @@ type SongRequest struct {
 
 func (x *SongRequest) Reset() {
 	*x = SongRequest{}
-	mi := &file_radio_proto_msgTypes[29]
+	mi := &file_radio_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2058,7 +2593,7 @@ func (x *SongRequest) String() string {
 func (*SongRequest) ProtoMessage() {}
 
 func (x *SongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[29]
+	mi := &file_radio_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,7 +2606,7 @@ func (x *SongRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongRequest.ProtoReflect.Descriptor instead.
 func (*SongRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{29}
+	return file_radio_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SongRequest) GetUserIdentifier() string {
@@ -2097,7 +2632,7 @@ type RequestResponse struct {
 
 func (x *RequestResponse) Reset() {
 	*x = RequestResponse{}
-	mi := &file_radio_proto_msgTypes[30]
+	mi := &file_radio_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2109,7 +2644,7 @@ func (x *RequestResponse) String() string {
 func (*RequestResponse) ProtoMessage() {}
 
 func (x *RequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[30]
+	mi := &file_radio_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +2657,7 @@ func (x *RequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestResponse.ProtoReflect.Descriptor instead.
 func (*RequestResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{30}
+	return file_radio_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RequestResponse) GetError() []*Error {
@@ -2147,7 +2682,7 @@ type Error struct {
 
 func (x *Error) Reset() {
 	*x = Error{}
-	mi := &file_radio_proto_msgTypes[31]
+	mi := &file_radio_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +2694,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[31]
+	mi := &file_radio_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2707,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{31}
+	return file_radio_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Error) GetKind() uint32 {
@@ -2233,7 +2768,7 @@ type ErrorMessage struct {
 
 func (x *ErrorMessage) Reset() {
 	*x = ErrorMessage{}
-	mi := &file_radio_proto_msgTypes[32]
+	mi := &file_radio_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2245,7 +2780,7 @@ func (x *ErrorMessage) String() string {
 func (*ErrorMessage) ProtoMessage() {}
 
 func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[32]
+	mi := &file_radio_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,7 +2793,7 @@ func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
 func (*ErrorMessage) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{32}
+	return file_radio_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ErrorMessage) GetError() []*Error {
@@ -2277,7 +2812,7 @@ type TrackerRemoveClientRequest struct {
 
 func (x *TrackerRemoveClientRequest) Reset() {
 	*x = TrackerRemoveClientRequest{}
-	mi := &file_radio_proto_msgTypes[33]
+	mi := &file_radio_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2289,7 +2824,7 @@ func (x *TrackerRemoveClientRequest) String() string {
 func (*TrackerRemoveClientRequest) ProtoMessage() {}
 
 func (x *TrackerRemoveClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[33]
+	mi := &file_radio_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +2837,7 @@ func (x *TrackerRemoveClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackerRemoveClientRequest.ProtoReflect.Descriptor instead.
 func (*TrackerRemoveClientRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{33}
+	return file_radio_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *TrackerRemoveClientRequest) GetId() uint64 {
@@ -2321,7 +2856,7 @@ type Listeners struct {
 
 func (x *Listeners) Reset() {
 	*x = Listeners{}
-	mi := &file_radio_proto_msgTypes[34]
+	mi := &file_radio_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2333,7 +2868,7 @@ func (x *Listeners) String() string {
 func (*Listeners) ProtoMessage() {}
 
 func (x *Listeners) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[34]
+	mi := &file_radio_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,7 +2881,7 @@ func (x *Listeners) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Listeners.ProtoReflect.Descriptor instead.
 func (*Listeners) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{34}
+	return file_radio_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Listeners) GetEntries() []*Listener {
@@ -2368,7 +2903,7 @@ type Listener struct {
 
 func (x *Listener) Reset() {
 	*x = Listener{}
-	mi := &file_radio_proto_msgTypes[35]
+	mi := &file_radio_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2380,7 +2915,7 @@ func (x *Listener) String() string {
 func (*Listener) ProtoMessage() {}
 
 func (x *Listener) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[35]
+	mi := &file_radio_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,7 +2928,7 @@ func (x *Listener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Listener.ProtoReflect.Descriptor instead.
 func (*Listener) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{35}
+	return file_radio_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *Listener) GetId() uint64 {
@@ -2485,357 +3020,441 @@ var file_radio_proto_rawDesc = []byte{
 	0x37, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
-	0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x13, 0x47, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3a, 0x0a, 0x11,
-	0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x1f, 0x0a, 0x09, 0x47, 0x75, 0x65, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x0a, 0x47, 0x75, 0x65,
-	0x73, 0x74, 0x43, 0x61, 0x6e, 0x44, 0x6f, 0x12, 0x24, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75,
-	0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x12,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x39, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c,
-	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x69, 0x76,
-	0x65, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a,
+	0x13, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x08, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x70, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x1f, 0x0a,
+	0x09, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e,
+	0x0a, 0x0a, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x44, 0x6f, 0x12, 0x24, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x47,
+	0x0a, 0x0b, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12, 0x24, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x08, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x1b, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x41, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04,
 	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64,
 	0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a,
 	0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdc, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04,
-	0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64,
-	0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x23, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61,
-	0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x0a, 0x53, 0x6f, 0x6e, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
-	0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e,
-	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x82, 0x01, 0x0a, 0x08,
-	0x53, 0x6f, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
-	0x22, 0x5a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x55, 0x73, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x0a,
-	0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x92, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x1a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x70, 0x0a, 0x12,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf7,
+	0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x0a, 0x53, 0x6f, 0x6e, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x53, 0x6f, 0x6e, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x19, 0x0a, 0x02, 0x64, 0x6a, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x72, 0x61,
-	0x64, 0x69, 0x6f, 0x2e, 0x44, 0x4a, 0x52, 0x02, 0x64, 0x6a, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x02, 0x44, 0x4a, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x12, 0x4d, 0x75, 0x72, 0x64, 0x65, 0x72,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x02,
-	0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x02, 0x62, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
-	0x92, 0x01, 0x0a, 0x10, 0x53, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0d, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x0a, 0x17, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x5a, 0x0a, 0x0e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x55, 0x73, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x92, 0x03, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x64,
+	0x6a, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
+	0x44, 0x4a, 0x52, 0x02, 0x64, 0x6a, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xf0, 0x01, 0x0a, 0x02, 0x44, 0x4a, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x68, 0x65, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x22, 0x47, 0x0a, 0x12, 0x4d, 0x75, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x02, 0x62, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x02, 0x62, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x10,
+	0x53, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e,
+	0x67, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x3a, 0x0a, 0x17, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
+	0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x33, 0x0a, 0x10,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0xfd, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x4a, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x36, 0x0a,
+	0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x19, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x92, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
 	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67,
-	0x22, 0x33, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65,
-	0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03,
-	0x77, 0x68, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63,
-	0x65, 0x22, 0x36, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x19, 0x0a, 0x07, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x49, 0x44, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04,
-	0x73, 0x6f, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x29, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x4a, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x52,
+	0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x44, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x69,
+	0x6e, 0x12, 0x29, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x49, 0x44, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x09,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x6f,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
-	0x6f, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x1a, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2a, 0x2d, 0x0a, 0x0b, 0x47, 0x75, 0x65,
-	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x69, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x4c, 0x69, 0x76, 0x65, 0x10, 0x02, 0x32, 0x98, 0x05, 0x0a, 0x07, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x72,
-	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
-	0x72, 0x6f, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x65, 0x49, 0x44, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
+	0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x0f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x32, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x36, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x29,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x2a, 0x2d, 0x0a, 0x0b, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x69,
+	0x63, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x10, 0x02,
+	0x2a, 0x77, 0x0a, 0x0d, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x6e,
+	0x65, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x2a, 0x3d, 0x0a, 0x14, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x4c, 0x69, 0x76, 0x65, 0x10, 0x02, 0x32, 0x94, 0x05, 0x0a, 0x07, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x6f, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x47, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34,
-	0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0d,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x32, 0x94, 0x02, 0x0a, 0x05, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47,
-	0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1a, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x72,
-	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x18,
-	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x44, 0x65, 0x61, 0x75,
-	0x74, 0x68, 0x12, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x05,
-	0x43, 0x61, 0x6e, 0x44, 0x6f, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x44, 0x6f, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x02, 0x44, 0x6f, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64,
-	0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x44, 0x6f, 0x1a, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xcf, 0x02, 0x0a, 0x05, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x72, 0x61,
-	0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30,
+	0x01, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32,
+	0xcc, 0x02, 0x0a, 0x05, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1a, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69,
+	0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x18, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x44, 0x65, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x43, 0x61, 0x6e,
+	0x44, 0x6f, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x6e, 0x44, 0x6f, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x33, 0x0a, 0x02, 0x44, 0x6f, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x44, 0x6f, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x12, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x1a, 0x18, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x87,
+	0x04, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x44, 0x0a,
-	0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x18, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9d, 0x02, 0x0a, 0x09,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x6e,
-	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e,
+	0x1a, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x44, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x4d, 0x75, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
-	0x2e, 0x4d, 0x75, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72,
+	0x12, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x30, 0x01, 0x32, 0xe0, 0x02, 0x0a, 0x09, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4d,
+	0x75, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4d, 0x75,
+	0x72, 0x64, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x61, 0x64, 0x69,
+	0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
 	0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xab, 0x02, 0x0a, 0x08,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
@@ -2856,7 +3475,7 @@ var file_radio_proto_rawDesc = []byte{
 	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xa6, 0x02, 0x0a, 0x05, 0x51, 0x75,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xb9, 0x03, 0x0a, 0x05, 0x51, 0x75,
 	0x65, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
@@ -2875,19 +3494,28 @@ var file_radio_proto_rawDesc = []byte{
 	0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
 	0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x32, 0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12,
-	0x49, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x21, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x2d, 0x61, 0x2d, 0x64, 0x69, 0x6f,
-	0x2f, 0x76, 0x61, 0x6c, 0x6b, 0x79, 0x72, 0x69, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x12,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x03, 0x50, 0x69, 0x6e, 0x12, 0x0f, 0x2e, 0x72,
+	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x69, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x21, 0x5a,
+	0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x2d, 0x61, 0x2d,
+	0x64, 0x69, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x6b, 0x79, 0x72, 0x69, 0x65, 0x2f, 0x72, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2902,184 +3530,217 @@ func file_radio_proto_rawDescGZIP() []byte {
 	return file_radio_proto_rawDescData
 }
 
-var file_radio_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_radio_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_radio_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_radio_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_radio_proto_goTypes = []any{
 	(GuestAction)(0),                   // 0: radio.GuestAction
-	(ProxySourceEventType)(0),          // 1: radio.ProxySourceEventType
-	(*Song)(nil),                       // 2: radio.Song
-	(*GuestCreateResponse)(nil),        // 3: radio.GuestCreateResponse
-	(*GuestAuthResponse)(nil),          // 4: radio.GuestAuthResponse
-	(*GuestUser)(nil),                  // 5: radio.GuestUser
-	(*GuestCanDo)(nil),                 // 6: radio.GuestCanDo
-	(*ProxyListResponse)(nil),          // 7: radio.ProxyListResponse
-	(*ProxyStatusRequest)(nil),         // 8: radio.ProxyStatusRequest
-	(*ProxyStatusEvent)(nil),           // 9: radio.ProxyStatusEvent
-	(*ProxySource)(nil),                // 10: radio.ProxySource
-	(*ProxySourceEvent)(nil),           // 11: radio.ProxySourceEvent
-	(*SourceID)(nil),                   // 12: radio.SourceID
-	(*ProxyMetadataEvent)(nil),         // 13: radio.ProxyMetadataEvent
-	(*StatusResponse)(nil),             // 14: radio.StatusResponse
-	(*SongUpdate)(nil),                 // 15: radio.SongUpdate
-	(*SongInfo)(nil),                   // 16: radio.SongInfo
-	(*StreamerConfig)(nil),             // 17: radio.StreamerConfig
-	(*UserUpdate)(nil),                 // 18: radio.UserUpdate
-	(*User)(nil),                       // 19: radio.User
-	(*DJ)(nil),                         // 20: radio.DJ
-	(*ListenerInfo)(nil),               // 21: radio.ListenerInfo
-	(*MurderAnnouncement)(nil),         // 22: radio.MurderAnnouncement
-	(*SongAnnouncement)(nil),           // 23: radio.SongAnnouncement
-	(*SongRequestAnnouncement)(nil),    // 24: radio.SongRequestAnnouncement
-	(*UserAnnouncement)(nil),           // 25: radio.UserAnnouncement
-	(*StreamerStopRequest)(nil),        // 26: radio.StreamerStopRequest
-	(*StreamerResponse)(nil),           // 27: radio.StreamerResponse
-	(*QueueID)(nil),                    // 28: radio.QueueID
-	(*QueueEntry)(nil),                 // 29: radio.QueueEntry
-	(*QueueInfo)(nil),                  // 30: radio.QueueInfo
-	(*SongRequest)(nil),                // 31: radio.SongRequest
-	(*RequestResponse)(nil),            // 32: radio.RequestResponse
-	(*Error)(nil),                      // 33: radio.Error
-	(*ErrorMessage)(nil),               // 34: radio.ErrorMessage
-	(*TrackerRemoveClientRequest)(nil), // 35: radio.TrackerRemoveClientRequest
-	(*Listeners)(nil),                  // 36: radio.Listeners
-	(*Listener)(nil),                   // 37: radio.Listener
-	(*durationpb.Duration)(nil),        // 38: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),      // 39: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 40: google.protobuf.Empty
-	(*wrapperspb.StringValue)(nil),     // 41: google.protobuf.StringValue
-	(*wrapperspb.Int64Value)(nil),      // 42: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),       // 43: google.protobuf.BoolValue
+	(HandoverState)(0),                 // 1: radio.HandoverState
+	(ProxySourceEventType)(0),          // 2: radio.ProxySourceEventType
+	(*Song)(nil),                       // 3: radio.Song
+	(*StreamRequest)(nil),              // 4: radio.StreamRequest
+	(*GuestCreateResponse)(nil),        // 5: radio.GuestCreateResponse
+	(*GuestAuthResponse)(nil),          // 6: radio.GuestAuthResponse
+	(*GuestUser)(nil),                  // 7: radio.GuestUser
+	(*GuestCanDo)(nil),                 // 8: radio.GuestCanDo
+	(*GuestRedeem)(nil),                // 9: radio.GuestRedeem
+	(*Handover)(nil),                   // 10: radio.Handover
+	(*ProxyListResponse)(nil),          // 11: radio.ProxyListResponse
+	(*ProxyStatusRequest)(nil),         // 12: radio.ProxyStatusRequest
+	(*ProxyStatusEvent)(nil),           // 13: radio.ProxyStatusEvent
+	(*ProxySource)(nil),                // 14: radio.ProxySource
+	(*ProxySourceEvent)(nil),           // 15: radio.ProxySourceEvent
+	(*SourceID)(nil),                   // 16: radio.SourceID
+	(*ProxyMetadataEvent)(nil),         // 17: radio.ProxyMetadataEvent
+	(*StatusResponse)(nil),             // 18: radio.StatusResponse
+	(*SongUpdate)(nil),                 // 19: radio.SongUpdate
+	(*SongInfo)(nil),                   // 20: radio.SongInfo
+	(*StreamerConfig)(nil),             // 21: radio.StreamerConfig
+	(*UserUpdate)(nil),                 // 22: radio.UserUpdate
+	(*User)(nil),                       // 23: radio.User
+	(*DJ)(nil),                         // 24: radio.DJ
+	(*ListenerInfo)(nil),               // 25: radio.ListenerInfo
+	(*MurderAnnouncement)(nil),         // 26: radio.MurderAnnouncement
+	(*SongAnnouncement)(nil),           // 27: radio.SongAnnouncement
+	(*SongRequestAnnouncement)(nil),    // 28: radio.SongRequestAnnouncement
+	(*UserAnnouncement)(nil),           // 29: radio.UserAnnouncement
+	(*AuditAnnouncement)(nil),          // 30: radio.AuditAnnouncement
+	(*StreamerStopRequest)(nil),        // 31: radio.StreamerStopRequest
+	(*StreamerResponse)(nil),           // 32: radio.StreamerResponse
+	(*QueueID)(nil),                    // 33: radio.QueueID
+	(*QueueEntry)(nil),                 // 34: radio.QueueEntry
+	(*QueueMove)(nil),                  // 35: radio.QueueMove
+	(*QueueInsert)(nil),                // 36: radio.QueueInsert
+	(*QueuePin)(nil),                   // 37: radio.QueuePin
+	(*QueueInfo)(nil),                  // 38: radio.QueueInfo
+	(*SongRequest)(nil),                // 39: radio.SongRequest
+	(*RequestResponse)(nil),            // 40: radio.RequestResponse
+	(*Error)(nil),                      // 41: radio.Error
+	(*ErrorMessage)(nil),               // 42: radio.ErrorMessage
+	(*TrackerRemoveClientRequest)(nil), // 43: radio.TrackerRemoveClientRequest
+	(*Listeners)(nil),                  // 44: radio.Listeners
+	(*Listener)(nil),                   // 45: radio.Listener
+	(*durationpb.Duration)(nil),        // 46: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 47: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 48: google.protobuf.Empty
+	(*wrapperspb.StringValue)(nil),     // 49: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),      // 50: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),       // 51: google.protobuf.BoolValue
 }
 var file_radio_proto_depIdxs = []int32{
-	38, // 0: radio.Song.length:type_name -> google.protobuf.Duration
-	39, // 1: radio.Song.last_played:type_name -> google.protobuf.Timestamp
-	19, // 2: radio.Song.last_played_by:type_name -> radio.User
-	39, // 3: radio.Song.last_requested:type_name -> google.protobuf.Timestamp
-	38, // 4: radio.Song.request_delay:type_name -> google.protobuf.Duration
-	39, // 5: radio.Song.sync_time:type_name -> google.protobuf.Timestamp
-	19, // 6: radio.GuestCreateResponse.user:type_name -> radio.User
-	19, // 7: radio.GuestAuthResponse.user:type_name -> radio.User
-	5,  // 8: radio.GuestCanDo.user:type_name -> radio.GuestUser
-	0,  // 9: radio.GuestCanDo.action:type_name -> radio.GuestAction
-	10, // 10: radio.ProxyListResponse.sources:type_name -> radio.ProxySource
-	10, // 11: radio.ProxyStatusEvent.connections:type_name -> radio.ProxySource
-	19, // 12: radio.ProxySource.user:type_name -> radio.User
-	12, // 13: radio.ProxySource.ID:type_name -> radio.SourceID
-	39, // 14: radio.ProxySource.start_time:type_name -> google.protobuf.Timestamp
-	19, // 15: radio.ProxySourceEvent.user:type_name -> radio.User
-	1,  // 16: radio.ProxySourceEvent.event:type_name -> radio.ProxySourceEventType
-	12, // 17: radio.ProxySourceEvent.ID:type_name -> radio.SourceID
-	19, // 18: radio.ProxyMetadataEvent.user:type_name -> radio.User
-	19, // 19: radio.StatusResponse.user:type_name -> radio.User
-	2,  // 20: radio.StatusResponse.song:type_name -> radio.Song
-	16, // 21: radio.StatusResponse.info:type_name -> radio.SongInfo
-	21, // 22: radio.StatusResponse.listener_info:type_name -> radio.ListenerInfo
-	17, // 23: radio.StatusResponse.streamer_config:type_name -> radio.StreamerConfig
-	19, // 24: radio.StatusResponse.stream_user:type_name -> radio.User
-	2,  // 25: radio.SongUpdate.song:type_name -> radio.Song
-	16, // 26: radio.SongUpdate.info:type_name -> radio.SongInfo
-	39, // 27: radio.SongInfo.start_time:type_name -> google.protobuf.Timestamp
-	39, // 28: radio.SongInfo.end_time:type_name -> google.protobuf.Timestamp
-	19, // 29: radio.UserUpdate.user:type_name -> radio.User
-	39, // 30: radio.User.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 31: radio.User.deleted_at:type_name -> google.protobuf.Timestamp
-	39, // 32: radio.User.created_at:type_name -> google.protobuf.Timestamp
-	20, // 33: radio.User.dj:type_name -> radio.DJ
-	19, // 34: radio.MurderAnnouncement.by:type_name -> radio.User
-	2,  // 35: radio.SongAnnouncement.song:type_name -> radio.Song
-	16, // 36: radio.SongAnnouncement.info:type_name -> radio.SongInfo
-	21, // 37: radio.SongAnnouncement.listener_info:type_name -> radio.ListenerInfo
-	2,  // 38: radio.SongRequestAnnouncement.song:type_name -> radio.Song
-	19, // 39: radio.UserAnnouncement.user:type_name -> radio.User
-	19, // 40: radio.StreamerStopRequest.who:type_name -> radio.User
-	33, // 41: radio.StreamerResponse.error:type_name -> radio.Error
-	2,  // 42: radio.QueueEntry.song:type_name -> radio.Song
-	39, // 43: radio.QueueEntry.expected_start_time:type_name -> google.protobuf.Timestamp
-	28, // 44: radio.QueueEntry.queue_id:type_name -> radio.QueueID
-	29, // 45: radio.QueueInfo.entries:type_name -> radio.QueueEntry
-	2,  // 46: radio.SongRequest.song:type_name -> radio.Song
-	33, // 47: radio.RequestResponse.error:type_name -> radio.Error
-	38, // 48: radio.Error.delay:type_name -> google.protobuf.Duration
-	33, // 49: radio.ErrorMessage.error:type_name -> radio.Error
-	37, // 50: radio.Listeners.entries:type_name -> radio.Listener
-	39, // 51: radio.Listener.start:type_name -> google.protobuf.Timestamp
-	40, // 52: radio.Manager.CurrentStatus:input_type -> google.protobuf.Empty
-	40, // 53: radio.Manager.UpdateFromStorage:input_type -> google.protobuf.Empty
-	40, // 54: radio.Manager.CurrentSong:input_type -> google.protobuf.Empty
-	15, // 55: radio.Manager.UpdateSong:input_type -> radio.SongUpdate
-	40, // 56: radio.Manager.CurrentThread:input_type -> google.protobuf.Empty
-	41, // 57: radio.Manager.UpdateThread:input_type -> google.protobuf.StringValue
-	40, // 58: radio.Manager.CurrentUser:input_type -> google.protobuf.Empty
-	19, // 59: radio.Manager.UpdateUser:input_type -> radio.User
-	40, // 60: radio.Manager.CurrentListenerCount:input_type -> google.protobuf.Empty
-	42, // 61: radio.Manager.UpdateListenerCount:input_type -> google.protobuf.Int64Value
-	5,  // 62: radio.Guest.Create:input_type -> radio.GuestUser
-	5,  // 63: radio.Guest.Auth:input_type -> radio.GuestUser
-	5,  // 64: radio.Guest.Deauth:input_type -> radio.GuestUser
-	6,  // 65: radio.Guest.CanDo:input_type -> radio.GuestCanDo
-	6,  // 66: radio.Guest.Do:input_type -> radio.GuestCanDo
-	40, // 67: radio.Proxy.SourceStream:input_type -> google.protobuf.Empty
-	40, // 68: radio.Proxy.MetadataStream:input_type -> google.protobuf.Empty
-	8,  // 69: radio.Proxy.StatusStream:input_type -> radio.ProxyStatusRequest
-	12, // 70: radio.Proxy.KickSource:input_type -> radio.SourceID
-	40, // 71: radio.Proxy.ListSources:input_type -> google.protobuf.Empty
-	23, // 72: radio.Announcer.AnnounceSong:input_type -> radio.SongAnnouncement
-	24, // 73: radio.Announcer.AnnounceRequest:input_type -> radio.SongRequestAnnouncement
-	25, // 74: radio.Announcer.AnnounceUser:input_type -> radio.UserAnnouncement
-	22, // 75: radio.Announcer.AnnounceMurder:input_type -> radio.MurderAnnouncement
-	40, // 76: radio.Streamer.Start:input_type -> google.protobuf.Empty
-	26, // 77: radio.Streamer.Stop:input_type -> radio.StreamerStopRequest
-	31, // 78: radio.Streamer.RequestSong:input_type -> radio.SongRequest
-	17, // 79: radio.Streamer.SetConfig:input_type -> radio.StreamerConfig
-	40, // 80: radio.Streamer.Queue:input_type -> google.protobuf.Empty
-	29, // 81: radio.Queue.AddRequest:input_type -> radio.QueueEntry
-	40, // 82: radio.Queue.ReserveNext:input_type -> google.protobuf.Empty
-	40, // 83: radio.Queue.ResetReserved:input_type -> google.protobuf.Empty
-	28, // 84: radio.Queue.Remove:input_type -> radio.QueueID
-	40, // 85: radio.Queue.Entries:input_type -> google.protobuf.Empty
-	40, // 86: radio.ListenerTracker.ListClients:input_type -> google.protobuf.Empty
-	35, // 87: radio.ListenerTracker.RemoveClient:input_type -> radio.TrackerRemoveClientRequest
-	14, // 88: radio.Manager.CurrentStatus:output_type -> radio.StatusResponse
-	40, // 89: radio.Manager.UpdateFromStorage:output_type -> google.protobuf.Empty
-	15, // 90: radio.Manager.CurrentSong:output_type -> radio.SongUpdate
-	40, // 91: radio.Manager.UpdateSong:output_type -> google.protobuf.Empty
-	41, // 92: radio.Manager.CurrentThread:output_type -> google.protobuf.StringValue
-	40, // 93: radio.Manager.UpdateThread:output_type -> google.protobuf.Empty
-	19, // 94: radio.Manager.CurrentUser:output_type -> radio.User
-	40, // 95: radio.Manager.UpdateUser:output_type -> google.protobuf.Empty
-	42, // 96: radio.Manager.CurrentListenerCount:output_type -> google.protobuf.Int64Value
-	40, // 97: radio.Manager.UpdateListenerCount:output_type -> google.protobuf.Empty
-	3,  // 98: radio.Guest.Create:output_type -> radio.GuestCreateResponse
-	4,  // 99: radio.Guest.Auth:output_type -> radio.GuestAuthResponse
-	40, // 100: radio.Guest.Deauth:output_type -> google.protobuf.Empty
-	43, // 101: radio.Guest.CanDo:output_type -> google.protobuf.BoolValue
-	43, // 102: radio.Guest.Do:output_type -> google.protobuf.BoolValue
-	11, // 103: radio.Proxy.SourceStream:output_type -> radio.ProxySourceEvent
-	13, // 104: radio.Proxy.MetadataStream:output_type -> radio.ProxyMetadataEvent
-	9,  // 105: radio.Proxy.StatusStream:output_type -> radio.ProxyStatusEvent
-	40, // 106: radio.Proxy.KickSource:output_type -> google.protobuf.Empty
-	7,  // 107: radio.Proxy.ListSources:output_type -> radio.ProxyListResponse
-	40, // 108: radio.Announcer.AnnounceSong:output_type -> google.protobuf.Empty
-	40, // 109: radio.Announcer.AnnounceRequest:output_type -> google.protobuf.Empty
-	40, // 110: radio.Announcer.AnnounceUser:output_type -> google.protobuf.Empty
-	40, // 111: radio.Announcer.AnnounceMurder:output_type -> google.protobuf.Empty
-	27, // 112: radio.Streamer.Start:output_type -> radio.StreamerResponse
-	27, // 113: radio.Streamer.Stop:output_type -> radio.StreamerResponse
-	32, // 114: radio.Streamer.RequestSong:output_type -> radio.RequestResponse
-	40, // 115: radio.Streamer.SetConfig:output_type -> google.protobuf.Empty
-	30, // 116: radio.Streamer.Queue:output_type -> radio.QueueInfo
-	40, // 117: radio.Queue.AddRequest:output_type -> google.protobuf.Empty
-	29, // 118: radio.Queue.ReserveNext:output_type -> radio.QueueEntry
-	40, // 119: radio.Queue.ResetReserved:output_type -> google.protobuf.Empty
-	43, // 120: radio.Queue.Remove:output_type -> google.protobuf.BoolValue
-	30, // 121: radio.Queue.Entries:output_type -> radio.QueueInfo
-	36, // 122: radio.ListenerTracker.ListClients:output_type -> radio.Listeners
-	40, // 123: radio.ListenerTracker.RemoveClient:output_type -> google.protobuf.Empty
-	88, // [88:124] is the sub-list for method output_type
-	52, // [52:88] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+	46,  // 0: radio.Song.length:type_name -> google.protobuf.Duration
+	47,  // 1: radio.Song.last_played:type_name -> google.protobuf.Timestamp
+	23,  // 2: radio.Song.last_played_by:type_name -> radio.User
+	47,  // 3: radio.Song.last_requested:type_name -> google.protobuf.Timestamp
+	46,  // 4: radio.Song.request_delay:type_name -> google.protobuf.Duration
+	47,  // 5: radio.Song.sync_time:type_name -> google.protobuf.Timestamp
+	23,  // 6: radio.GuestCreateResponse.user:type_name -> radio.User
+	23,  // 7: radio.GuestAuthResponse.user:type_name -> radio.User
+	7,   // 8: radio.GuestCanDo.user:type_name -> radio.GuestUser
+	0,   // 9: radio.GuestCanDo.action:type_name -> radio.GuestAction
+	7,   // 10: radio.GuestRedeem.user:type_name -> radio.GuestUser
+	1,   // 11: radio.Handover.state:type_name -> radio.HandoverState
+	23,  // 12: radio.Handover.from:type_name -> radio.User
+	23,  // 13: radio.Handover.to:type_name -> radio.User
+	47,  // 14: radio.Handover.at:type_name -> google.protobuf.Timestamp
+	14,  // 15: radio.ProxyListResponse.sources:type_name -> radio.ProxySource
+	14,  // 16: radio.ProxyStatusEvent.connections:type_name -> radio.ProxySource
+	23,  // 17: radio.ProxySource.user:type_name -> radio.User
+	16,  // 18: radio.ProxySource.ID:type_name -> radio.SourceID
+	47,  // 19: radio.ProxySource.start_time:type_name -> google.protobuf.Timestamp
+	23,  // 20: radio.ProxySourceEvent.user:type_name -> radio.User
+	2,   // 21: radio.ProxySourceEvent.event:type_name -> radio.ProxySourceEventType
+	16,  // 22: radio.ProxySourceEvent.ID:type_name -> radio.SourceID
+	23,  // 23: radio.ProxyMetadataEvent.user:type_name -> radio.User
+	23,  // 24: radio.StatusResponse.user:type_name -> radio.User
+	3,   // 25: radio.StatusResponse.song:type_name -> radio.Song
+	20,  // 26: radio.StatusResponse.info:type_name -> radio.SongInfo
+	25,  // 27: radio.StatusResponse.listener_info:type_name -> radio.ListenerInfo
+	21,  // 28: radio.StatusResponse.streamer_config:type_name -> radio.StreamerConfig
+	23,  // 29: radio.StatusResponse.stream_user:type_name -> radio.User
+	3,   // 30: radio.SongUpdate.song:type_name -> radio.Song
+	20,  // 31: radio.SongUpdate.info:type_name -> radio.SongInfo
+	47,  // 32: radio.SongInfo.start_time:type_name -> google.protobuf.Timestamp
+	47,  // 33: radio.SongInfo.end_time:type_name -> google.protobuf.Timestamp
+	23,  // 34: radio.UserUpdate.user:type_name -> radio.User
+	47,  // 35: radio.User.updated_at:type_name -> google.protobuf.Timestamp
+	47,  // 36: radio.User.deleted_at:type_name -> google.protobuf.Timestamp
+	47,  // 37: radio.User.created_at:type_name -> google.protobuf.Timestamp
+	24,  // 38: radio.User.dj:type_name -> radio.DJ
+	23,  // 39: radio.MurderAnnouncement.by:type_name -> radio.User
+	3,   // 40: radio.SongAnnouncement.song:type_name -> radio.Song
+	20,  // 41: radio.SongAnnouncement.info:type_name -> radio.SongInfo
+	25,  // 42: radio.SongAnnouncement.listener_info:type_name -> radio.ListenerInfo
+	3,   // 43: radio.SongRequestAnnouncement.song:type_name -> radio.Song
+	23,  // 44: radio.UserAnnouncement.user:type_name -> radio.User
+	47,  // 45: radio.AuditAnnouncement.created_at:type_name -> google.protobuf.Timestamp
+	23,  // 46: radio.StreamerStopRequest.who:type_name -> radio.User
+	41,  // 47: radio.StreamerResponse.error:type_name -> radio.Error
+	3,   // 48: radio.QueueEntry.song:type_name -> radio.Song
+	47,  // 49: radio.QueueEntry.expected_start_time:type_name -> google.protobuf.Timestamp
+	33,  // 50: radio.QueueEntry.queue_id:type_name -> radio.QueueID
+	33,  // 51: radio.QueueMove.queue_id:type_name -> radio.QueueID
+	3,   // 52: radio.QueueInsert.song:type_name -> radio.Song
+	33,  // 53: radio.QueuePin.queue_id:type_name -> radio.QueueID
+	34,  // 54: radio.QueueInfo.entries:type_name -> radio.QueueEntry
+	3,   // 55: radio.SongRequest.song:type_name -> radio.Song
+	41,  // 56: radio.RequestResponse.error:type_name -> radio.Error
+	46,  // 57: radio.Error.delay:type_name -> google.protobuf.Duration
+	41,  // 58: radio.ErrorMessage.error:type_name -> radio.Error
+	45,  // 59: radio.Listeners.entries:type_name -> radio.Listener
+	47,  // 60: radio.Listener.start:type_name -> google.protobuf.Timestamp
+	4,   // 61: radio.Manager.CurrentStatus:input_type -> radio.StreamRequest
+	48,  // 62: radio.Manager.UpdateFromStorage:input_type -> google.protobuf.Empty
+	4,   // 63: radio.Manager.CurrentSong:input_type -> radio.StreamRequest
+	19,  // 64: radio.Manager.UpdateSong:input_type -> radio.SongUpdate
+	48,  // 65: radio.Manager.CurrentThread:input_type -> google.protobuf.Empty
+	49,  // 66: radio.Manager.UpdateThread:input_type -> google.protobuf.StringValue
+	48,  // 67: radio.Manager.CurrentUser:input_type -> google.protobuf.Empty
+	23,  // 68: radio.Manager.UpdateUser:input_type -> radio.User
+	48,  // 69: radio.Manager.CurrentListenerCount:input_type -> google.protobuf.Empty
+	50,  // 70: radio.Manager.UpdateListenerCount:input_type -> google.protobuf.Int64Value
+	7,   // 71: radio.Guest.Create:input_type -> radio.GuestUser
+	7,   // 72: radio.Guest.Auth:input_type -> radio.GuestUser
+	7,   // 73: radio.Guest.Deauth:input_type -> radio.GuestUser
+	8,   // 74: radio.Guest.CanDo:input_type -> radio.GuestCanDo
+	8,   // 75: radio.Guest.Do:input_type -> radio.GuestCanDo
+	9,   // 76: radio.Guest.Redeem:input_type -> radio.GuestRedeem
+	48,  // 77: radio.Proxy.SourceStream:input_type -> google.protobuf.Empty
+	48,  // 78: radio.Proxy.MetadataStream:input_type -> google.protobuf.Empty
+	12,  // 79: radio.Proxy.StatusStream:input_type -> radio.ProxyStatusRequest
+	16,  // 80: radio.Proxy.KickSource:input_type -> radio.SourceID
+	48,  // 81: radio.Proxy.ListSources:input_type -> google.protobuf.Empty
+	10,  // 82: radio.Proxy.PlanHandover:input_type -> radio.Handover
+	48,  // 83: radio.Proxy.CancelHandover:input_type -> google.protobuf.Empty
+	48,  // 84: radio.Proxy.HandoverStream:input_type -> google.protobuf.Empty
+	27,  // 85: radio.Announcer.AnnounceSong:input_type -> radio.SongAnnouncement
+	28,  // 86: radio.Announcer.AnnounceRequest:input_type -> radio.SongRequestAnnouncement
+	29,  // 87: radio.Announcer.AnnounceUser:input_type -> radio.UserAnnouncement
+	26,  // 88: radio.Announcer.AnnounceMurder:input_type -> radio.MurderAnnouncement
+	30,  // 89: radio.Announcer.AnnounceAudit:input_type -> radio.AuditAnnouncement
+	48,  // 90: radio.Streamer.Start:input_type -> google.protobuf.Empty
+	31,  // 91: radio.Streamer.Stop:input_type -> radio.StreamerStopRequest
+	39,  // 92: radio.Streamer.RequestSong:input_type -> radio.SongRequest
+	21,  // 93: radio.Streamer.SetConfig:input_type -> radio.StreamerConfig
+	48,  // 94: radio.Streamer.Queue:input_type -> google.protobuf.Empty
+	34,  // 95: radio.Queue.AddRequest:input_type -> radio.QueueEntry
+	48,  // 96: radio.Queue.ReserveNext:input_type -> google.protobuf.Empty
+	48,  // 97: radio.Queue.ResetReserved:input_type -> google.protobuf.Empty
+	33,  // 98: radio.Queue.Remove:input_type -> radio.QueueID
+	48,  // 99: radio.Queue.Entries:input_type -> google.protobuf.Empty
+	35,  // 100: radio.Queue.Move:input_type -> radio.QueueMove
+	36,  // 101: radio.Queue.Insert:input_type -> radio.QueueInsert
+	37,  // 102: radio.Queue.Pin:input_type -> radio.QueuePin
+	48,  // 103: radio.ListenerTracker.ListClients:input_type -> google.protobuf.Empty
+	43,  // 104: radio.ListenerTracker.RemoveClient:input_type -> radio.TrackerRemoveClientRequest
+	18,  // 105: radio.Manager.CurrentStatus:output_type -> radio.StatusResponse
+	48,  // 106: radio.Manager.UpdateFromStorage:output_type -> google.protobuf.Empty
+	19,  // 107: radio.Manager.CurrentSong:output_type -> radio.SongUpdate
+	48,  // 108: radio.Manager.UpdateSong:output_type -> google.protobuf.Empty
+	49,  // 109: radio.Manager.CurrentThread:output_type -> google.protobuf.StringValue
+	48,  // 110: radio.Manager.UpdateThread:output_type -> google.protobuf.Empty
+	23,  // 111: radio.Manager.CurrentUser:output_type -> radio.User
+	48,  // 112: radio.Manager.UpdateUser:output_type -> google.protobuf.Empty
+	50,  // 113: radio.Manager.CurrentListenerCount:output_type -> google.protobuf.Int64Value
+	48,  // 114: radio.Manager.UpdateListenerCount:output_type -> google.protobuf.Empty
+	5,   // 115: radio.Guest.Create:output_type -> radio.GuestCreateResponse
+	6,   // 116: radio.Guest.Auth:output_type -> radio.GuestAuthResponse
+	48,  // 117: radio.Guest.Deauth:output_type -> google.protobuf.Empty
+	51,  // 118: radio.Guest.CanDo:output_type -> google.protobuf.BoolValue
+	51,  // 119: radio.Guest.Do:output_type -> google.protobuf.BoolValue
+	6,   // 120: radio.Guest.Redeem:output_type -> radio.GuestAuthResponse
+	15,  // 121: radio.Proxy.SourceStream:output_type -> radio.ProxySourceEvent
+	17,  // 122: radio.Proxy.MetadataStream:output_type -> radio.ProxyMetadataEvent
+	13,  // 123: radio.Proxy.StatusStream:output_type -> radio.ProxyStatusEvent
+	48,  // 124: radio.Proxy.KickSource:output_type -> google.protobuf.Empty
+	11,  // 125: radio.Proxy.ListSources:output_type -> radio.ProxyListResponse
+	48,  // 126: radio.Proxy.PlanHandover:output_type -> google.protobuf.Empty
+	48,  // 127: radio.Proxy.CancelHandover:output_type -> google.protobuf.Empty
+	10,  // 128: radio.Proxy.HandoverStream:output_type -> radio.Handover
+	48,  // 129: radio.Announcer.AnnounceSong:output_type -> google.protobuf.Empty
+	48,  // 130: radio.Announcer.AnnounceRequest:output_type -> google.protobuf.Empty
+	48,  // 131: radio.Announcer.AnnounceUser:output_type -> google.protobuf.Empty
+	48,  // 132: radio.Announcer.AnnounceMurder:output_type -> google.protobuf.Empty
+	48,  // 133: radio.Announcer.AnnounceAudit:output_type -> google.protobuf.Empty
+	32,  // 134: radio.Streamer.Start:output_type -> radio.StreamerResponse
+	32,  // 135: radio.Streamer.Stop:output_type -> radio.StreamerResponse
+	40,  // 136: radio.Streamer.RequestSong:output_type -> radio.RequestResponse
+	48,  // 137: radio.Streamer.SetConfig:output_type -> google.protobuf.Empty
+	38,  // 138: radio.Streamer.Queue:output_type -> radio.QueueInfo
+	48,  // 139: radio.Queue.AddRequest:output_type -> google.protobuf.Empty
+	34,  // 140: radio.Queue.ReserveNext:output_type -> radio.QueueEntry
+	48,  // 141: radio.Queue.ResetReserved:output_type -> google.protobuf.Empty
+	51,  // 142: radio.Queue.Remove:output_type -> google.protobuf.BoolValue
+	38,  // 143: radio.Queue.Entries:output_type -> radio.QueueInfo
+	48,  // 144: radio.Queue.Move:output_type -> google.protobuf.Empty
+	34,  // 145: radio.Queue.Insert:output_type -> radio.QueueEntry
+	48,  // 146: radio.Queue.Pin:output_type -> google.protobuf.Empty
+	44,  // 147: radio.ListenerTracker.ListClients:output_type -> radio.Listeners
+	48,  // 148: radio.ListenerTracker.RemoveClient:output_type -> google.protobuf.Empty
+	105, // [105:149] is the sub-list for method output_type
+	61,  // [61:105] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_radio_proto_init() }
@@ -3092,8 +3753,8 @@ func file_radio_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_radio_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   36,
+			NumEnums:      3,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   7,
 		},
@@ -37,9 +37,9 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ManagerClient interface {
-	CurrentStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
+	CurrentStatus(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error)
 	UpdateFromStorage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	CurrentSong(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongUpdate], error)
+	CurrentSong(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongUpdate], error)
 	UpdateSong(ctx context.Context, in *SongUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CurrentThread(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[wrapperspb.StringValue], error)
 	UpdateThread(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -57,13 +57,13 @@ func NewManagerClient(cc grpc.ClientConnInterface) ManagerClient {
 	return &managerClient{cc}
 }
 
-func (c *managerClient) CurrentStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
+func (c *managerClient) CurrentStatus(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatusResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[0], Manager_CurrentStatus_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[emptypb.Empty, StatusResponse]{ClientStream: stream}
+	x := &grpc.GenericClientStream[StreamRequest, StatusResponse]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -86,13 +86,13 @@ func (c *managerClient) UpdateFromStorage(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
-func (c *managerClient) CurrentSong(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongUpdate], error) {
+func (c *managerClient) CurrentSong(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[1], Manager_CurrentSong_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[emptypb.Empty, SongUpdate]{ClientStream: stream}
+	x := &grpc.GenericClientStream[StreamRequest, SongUpdate]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -206,9 +206,9 @@ func (c *managerClient) UpdateListenerCount(ctx context.Context, in *wrapperspb.
 // All implementations must embed UnimplementedManagerServer
 // for forward compatibility.
 type ManagerServer interface {
-	CurrentStatus(*emptypb.Empty, grpc.ServerStreamingServer[StatusResponse]) error
+	CurrentStatus(*StreamRequest, grpc.ServerStreamingServer[StatusResponse]) error
 	UpdateFromStorage(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	CurrentSong(*emptypb.Empty, grpc.ServerStreamingServer[SongUpdate]) error
+	CurrentSong(*StreamRequest, grpc.ServerStreamingServer[SongUpdate]) error
 	UpdateSong(context.Context, *SongUpdate) (*emptypb.Empty, error)
 	CurrentThread(*emptypb.Empty, grpc.ServerStreamingServer[wrapperspb.StringValue]) error
 	UpdateThread(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
@@ -226,13 +226,13 @@ type ManagerServer interface {
 // pointer dereference when methods are called.
 type UnimplementedManagerServer struct{}
 
-func (UnimplementedManagerServer) CurrentStatus(*emptypb.Empty, grpc.ServerStreamingServer[StatusResponse]) error {
+func (UnimplementedManagerServer) CurrentStatus(*StreamRequest, grpc.ServerStreamingServer[StatusResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CurrentStatus not implemented")
 }
 func (UnimplementedManagerServer) UpdateFromStorage(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFromStorage not implemented")
 }
-func (UnimplementedManagerServer) CurrentSong(*emptypb.Empty, grpc.ServerStreamingServer[SongUpdate]) error {
+func (UnimplementedManagerServer) CurrentSong(*StreamRequest, grpc.ServerStreamingServer[SongUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method CurrentSong not implemented")
 }
 func (UnimplementedManagerServer) UpdateSong(context.Context, *SongUpdate) (*emptypb.Empty, error) {
@@ -278,11 +278,11 @@ func RegisterManagerServer(s grpc.ServiceRegistrar, srv ManagerServer) {
 }
 
 func _Manager_CurrentStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
+	m := new(StreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ManagerServer).CurrentStatus(m, &grpc.GenericServerStream[emptypb.Empty, StatusResponse]{ServerStream: stream})
+	return srv.(ManagerServer).CurrentStatus(m, &grpc.GenericServerStream[StreamRequest, StatusResponse]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
@@ -307,11 +307,11 @@ func _Manager_UpdateFromStorage_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _Manager_CurrentSong_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
+	m := new(StreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ManagerServer).CurrentSong(m, &grpc.GenericServerStream[emptypb.Empty, SongUpdate]{ServerStream: stream})
+	return srv.(ManagerServer).CurrentSong(m, &grpc.GenericServerStream[StreamRequest, SongUpdate]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
@@ -486,6 +486,7 @@ const (
 	Guest_Deauth_FullMethodName = "/radio.Guest/Deauth"
 	Guest_CanDo_FullMethodName  = "/radio.Guest/CanDo"
 	Guest_Do_FullMethodName     = "/radio.Guest/Do"
+	Guest_Redeem_FullMethodName = "/radio.Guest/Redeem"
 )
 
 // GuestClient is the client API for Guest service.
@@ -497,6 +498,7 @@ type GuestClient interface {
 	Deauth(ctx context.Context, in *GuestUser, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CanDo(ctx context.Context, in *GuestCanDo, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	Do(ctx context.Context, in *GuestCanDo, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	Redeem(ctx context.Context, in *GuestRedeem, opts ...grpc.CallOption) (*GuestAuthResponse, error)
 }
 
 type guestClient struct {
@@ -557,6 +559,16 @@ func (c *guestClient) Do(ctx context.Context, in *GuestCanDo, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *guestClient) Redeem(ctx context.Context, in *GuestRedeem, opts ...grpc.CallOption) (*GuestAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestAuthResponse)
+	err := c.cc.Invoke(ctx, Guest_Redeem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuestServer is the server API for Guest service.
 // All implementations must embed UnimplementedGuestServer
 // for forward compatibility.
@@ -566,6 +578,7 @@ type GuestServer interface {
 	Deauth(context.Context, *GuestUser) (*emptypb.Empty, error)
 	CanDo(context.Context, *GuestCanDo) (*wrapperspb.BoolValue, error)
 	Do(context.Context, *GuestCanDo) (*wrapperspb.BoolValue, error)
+	Redeem(context.Context, *GuestRedeem) (*GuestAuthResponse, error)
 	mustEmbedUnimplementedGuestServer()
 }
 
@@ -591,6 +604,9 @@ func (UnimplementedGuestServer) CanDo(context.Context, *GuestCanDo) (*wrapperspb
 func (UnimplementedGuestServer) Do(context.Context, *GuestCanDo) (*wrapperspb.BoolValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Do not implemented")
 }
+func (UnimplementedGuestServer) Redeem(context.Context, *GuestRedeem) (*GuestAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
 func (UnimplementedGuestServer) mustEmbedUnimplementedGuestServer() {}
 func (UnimplementedGuestServer) testEmbeddedByValue()               {}
 
@@ -702,6 +718,24 @@ func _Guest_Do_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Guest_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Guest_Redeem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServer).Redeem(ctx, req.(*GuestRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Guest_ServiceDesc is the grpc.ServiceDesc for Guest service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -729,6 +763,10 @@ var Guest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Do",
 			Handler:    _Guest_Do_Handler,
 		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Guest_Redeem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
@@ -740,6 +778,9 @@ const (
 	Proxy_StatusStream_FullMethodName   = "/radio.Proxy/StatusStream"
 	Proxy_KickSource_FullMethodName     = "/radio.Proxy/KickSource"
 	Proxy_ListSources_FullMethodName    = "/radio.Proxy/ListSources"
+	Proxy_PlanHandover_FullMethodName   = "/radio.Proxy/PlanHandover"
+	Proxy_CancelHandover_FullMethodName = "/radio.Proxy/CancelHandover"
+	Proxy_HandoverStream_FullMethodName = "/radio.Proxy/HandoverStream"
 )
 
 // ProxyClient is the client API for Proxy service.
@@ -751,6 +792,9 @@ type ProxyClient interface {
 	StatusStream(ctx context.Context, in *ProxyStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProxyStatusEvent], error)
 	KickSource(ctx context.Context, in *SourceID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProxyListResponse, error)
+	PlanHandover(ctx context.Context, in *Handover, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelHandover(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HandoverStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Handover], error)
 }
 
 type proxyClient struct {
@@ -838,6 +882,45 @@ func (c *proxyClient) ListSources(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *proxyClient) PlanHandover(ctx context.Context, in *Handover, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Proxy_PlanHandover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) CancelHandover(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Proxy_CancelHandover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) HandoverStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Handover], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Proxy_ServiceDesc.Streams[3], Proxy_HandoverStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Handover]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Proxy_HandoverStreamClient = grpc.ServerStreamingClient[Handover]
+
 // ProxyServer is the server API for Proxy service.
 // All implementations must embed UnimplementedProxyServer
 // for forward compatibility.
@@ -847,6 +930,9 @@ type ProxyServer interface {
 	StatusStream(*ProxyStatusRequest, grpc.ServerStreamingServer[ProxyStatusEvent]) error
 	KickSource(context.Context, *SourceID) (*emptypb.Empty, error)
 	ListSources(context.Context, *emptypb.Empty) (*ProxyListResponse, error)
+	PlanHandover(context.Context, *Handover) (*emptypb.Empty, error)
+	CancelHandover(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	HandoverStream(*emptypb.Empty, grpc.ServerStreamingServer[Handover]) error
 	mustEmbedUnimplementedProxyServer()
 }
 
@@ -872,6 +958,15 @@ func (UnimplementedProxyServer) KickSource(context.Context, *SourceID) (*emptypb
 func (UnimplementedProxyServer) ListSources(context.Context, *emptypb.Empty) (*ProxyListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSources not implemented")
 }
+func (UnimplementedProxyServer) PlanHandover(context.Context, *Handover) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanHandover not implemented")
+}
+func (UnimplementedProxyServer) CancelHandover(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHandover not implemented")
+}
+func (UnimplementedProxyServer) HandoverStream(*emptypb.Empty, grpc.ServerStreamingServer[Handover]) error {
+	return status.Errorf(codes.Unimplemented, "method HandoverStream not implemented")
+}
 func (UnimplementedProxyServer) mustEmbedUnimplementedProxyServer() {}
 func (UnimplementedProxyServer) testEmbeddedByValue()               {}
 
@@ -962,6 +1057,53 @@ func _Proxy_ListSources_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_PlanHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Handover)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).PlanHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_PlanHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).PlanHandover(ctx, req.(*Handover))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_CancelHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).CancelHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_CancelHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).CancelHandover(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_HandoverStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyServer).HandoverStream(m, &grpc.GenericServerStream[emptypb.Empty, Handover]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Proxy_HandoverStreamServer = grpc.ServerStreamingServer[Handover]
+
 // Proxy_ServiceDesc is the grpc.ServiceDesc for Proxy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -977,6 +1119,14 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSources",
 			Handler:    _Proxy_ListSources_Handler,
 		},
+		{
+			MethodName: "PlanHandover",
+			Handler:    _Proxy_PlanHandover_Handler,
+		},
+		{
+			MethodName: "CancelHandover",
+			Handler:    _Proxy_CancelHandover_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -994,6 +1144,11 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Proxy_StatusStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HandoverStream",
+			Handler:       _Proxy_HandoverStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "radio.proto",
 }
@@ -1003,6 +1158,7 @@ const (
 	Announcer_AnnounceRequest_FullMethodName = "/radio.Announcer/AnnounceRequest"
 	Announcer_AnnounceUser_FullMethodName    = "/radio.Announcer/AnnounceUser"
 	Announcer_AnnounceMurder_FullMethodName  = "/radio.Announcer/AnnounceMurder"
+	Announcer_AnnounceAudit_FullMethodName   = "/radio.Announcer/AnnounceAudit"
 )
 
 // AnnouncerClient is the client API for Announcer service.
@@ -1013,6 +1169,7 @@ type AnnouncerClient interface {
 	AnnounceRequest(ctx context.Context, in *SongRequestAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AnnounceUser(ctx context.Context, in *UserAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AnnounceMurder(ctx context.Context, in *MurderAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AnnounceAudit(ctx context.Context, in *AuditAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type announcerClient struct {
@@ -1063,6 +1220,16 @@ func (c *announcerClient) AnnounceMurder(ctx context.Context, in *MurderAnnounce
 	return out, nil
 }
 
+func (c *announcerClient) AnnounceAudit(ctx context.Context, in *AuditAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Announcer_AnnounceAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnnouncerServer is the server API for Announcer service.
 // All implementations must embed UnimplementedAnnouncerServer
 // for forward compatibility.
@@ -1071,6 +1238,7 @@ type AnnouncerServer interface {
 	AnnounceRequest(context.Context, *SongRequestAnnouncement) (*emptypb.Empty, error)
 	AnnounceUser(context.Context, *UserAnnouncement) (*emptypb.Empty, error)
 	AnnounceMurder(context.Context, *MurderAnnouncement) (*emptypb.Empty, error)
+	AnnounceAudit(context.Context, *AuditAnnouncement) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAnnouncerServer()
 }
 
@@ -1093,6 +1261,9 @@ func (UnimplementedAnnouncerServer) AnnounceUser(context.Context, *UserAnnouncem
 func (UnimplementedAnnouncerServer) AnnounceMurder(context.Context, *MurderAnnouncement) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnounceMurder not implemented")
 }
+func (UnimplementedAnnouncerServer) AnnounceAudit(context.Context, *AuditAnnouncement) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceAudit not implemented")
+}
 func (UnimplementedAnnouncerServer) mustEmbedUnimplementedAnnouncerServer() {}
 func (UnimplementedAnnouncerServer) testEmbeddedByValue()                   {}
 
@@ -1186,6 +1357,24 @@ func _Announcer_AnnounceMurder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Announcer_AnnounceAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditAnnouncement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncerServer).AnnounceAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Announcer_AnnounceAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncerServer).AnnounceAudit(ctx, req.(*AuditAnnouncement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Announcer_ServiceDesc is the grpc.ServiceDesc for Announcer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1209,6 +1398,10 @@ var Announcer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnounceMurder",
 			Handler:    _Announcer_AnnounceMurder_Handler,
 		},
+		{
+			MethodName: "AnnounceAudit",
+			Handler:    _Announcer_AnnounceAudit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
@@ -1486,6 +1679,9 @@ const (
 	Queue_ResetReserved_FullMethodName = "/radio.Queue/ResetReserved"
 	Queue_Remove_FullMethodName        = "/radio.Queue/Remove"
 	Queue_Entries_FullMethodName       = "/radio.Queue/Entries"
+	Queue_Move_FullMethodName          = "/radio.Queue/Move"
+	Queue_Insert_FullMethodName        = "/radio.Queue/Insert"
+	Queue_Pin_FullMethodName           = "/radio.Queue/Pin"
 )
 
 // QueueClient is the client API for Queue service.
@@ -1499,6 +1695,9 @@ type QueueClient interface {
 	ResetReserved(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Remove(ctx context.Context, in *QueueID, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	Entries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueueInfo, error)
+	Move(ctx context.Context, in *QueueMove, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Insert(ctx context.Context, in *QueueInsert, opts ...grpc.CallOption) (*QueueEntry, error)
+	Pin(ctx context.Context, in *QueuePin, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type queueClient struct {
@@ -1559,6 +1758,36 @@ func (c *queueClient) Entries(ctx context.Context, in *emptypb.Empty, opts ...gr
 	return out, nil
 }
 
+func (c *queueClient) Move(ctx context.Context, in *QueueMove, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Queue_Move_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Insert(ctx context.Context, in *QueueInsert, opts ...grpc.CallOption) (*QueueEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueEntry)
+	err := c.cc.Invoke(ctx, Queue_Insert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Pin(ctx context.Context, in *QueuePin, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Queue_Pin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServer is the server API for Queue service.
 // All implementations must embed UnimplementedQueueServer
 // for forward compatibility.
@@ -1570,6 +1799,9 @@ type QueueServer interface {
 	ResetReserved(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Remove(context.Context, *QueueID) (*wrapperspb.BoolValue, error)
 	Entries(context.Context, *emptypb.Empty) (*QueueInfo, error)
+	Move(context.Context, *QueueMove) (*emptypb.Empty, error)
+	Insert(context.Context, *QueueInsert) (*QueueEntry, error)
+	Pin(context.Context, *QueuePin) (*emptypb.Empty, error)
 	mustEmbedUnimplementedQueueServer()
 }
 
@@ -1595,6 +1827,15 @@ func (UnimplementedQueueServer) Remove(context.Context, *QueueID) (*wrapperspb.B
 func (UnimplementedQueueServer) Entries(context.Context, *emptypb.Empty) (*QueueInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Entries not implemented")
 }
+func (UnimplementedQueueServer) Move(context.Context, *QueueMove) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (UnimplementedQueueServer) Insert(context.Context, *QueueInsert) (*QueueEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (UnimplementedQueueServer) Pin(context.Context, *QueuePin) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pin not implemented")
+}
 func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
 func (UnimplementedQueueServer) testEmbeddedByValue()               {}
 
@@ -1706,6 +1947,60 @@ func _Queue_Entries_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_Move_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Move(ctx, req.(*QueueMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_Insert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Insert(ctx, req.(*QueueInsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Pin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuePin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Pin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_Pin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Pin(ctx, req.(*QueuePin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Queue_ServiceDesc is the grpc.ServiceDesc for Queue service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1733,6 +2028,18 @@ var Queue_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Entries",
 			Handler:    _Queue_Entries_Handler,
 		},
+		{
+			MethodName: "Move",
+			Handler:    _Queue_Move_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _Queue_Insert_Handler,
+		},
+		{
+			MethodName: "Pin",
+			Handler:    _Queue_Pin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
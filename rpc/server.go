@@ -91,6 +91,11 @@ func (as AnnouncerShim) AnnounceMurder(ctx context.Context, ma *MurderAnnounceme
 	return new(emptypb.Empty), err
 }
 
+func (as AnnouncerShim) AnnounceAudit(ctx context.Context, aa *AuditAnnouncement) (*emptypb.Empty, error) {
+	err := as.announcer.AnnounceAudit(ctx, fromProtoAuditEntry(aa))
+	return new(emptypb.Empty), err
+}
+
 func NewProxy(p radio.ProxyService) ProxyServer {
 	return ProxyShim{
 		proxy: p,
@@ -121,6 +126,20 @@ func (ps ProxyShim) KickSource(ctx context.Context, id *SourceID) (*emptypb.Empt
 	return new(emptypb.Empty), err
 }
 
+func (ps ProxyShim) PlanHandover(ctx context.Context, h *Handover) (*emptypb.Empty, error) {
+	err := ps.proxy.PlanHandover(ctx, fromProtoHandover(h))
+	return new(emptypb.Empty), convertServerError(err)
+}
+
+func (ps ProxyShim) CancelHandover(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	err := ps.proxy.CancelHandover(ctx)
+	return new(emptypb.Empty), convertServerError(err)
+}
+
+func (ps ProxyShim) HandoverStream(_ *emptypb.Empty, s Proxy_HandoverStreamServer) error {
+	return streamToProtobuf(s, ps.proxy.HandoverStream, toProtoHandover)
+}
+
 func (ps ProxyShim) ListSources(ctx context.Context, _ *emptypb.Empty) (*ProxyListResponse, error) {
 	sl, err := ps.proxy.ListSources(ctx)
 	if err != nil {
@@ -180,6 +199,14 @@ func (g GuestShim) Do(ctx context.Context, gcd *GuestCanDo) (*wrapperspb.BoolVal
 	return wrapperspb.Bool(ok), err
 }
 
+func (g GuestShim) Redeem(ctx context.Context, gr *GuestRedeem) (*GuestAuthResponse, error) {
+	code, nick := fromProtoGuestRedeem(gr)
+	u, err := g.guest.Redeem(ctx, code, nick)
+	return &GuestAuthResponse{
+		User: toProtoUser(u),
+	}, convertServerError(err)
+}
+
 // NewManager returns a new shim around the service given
 func NewManager(m radio.ManagerService) ManagerServer {
 	return ManagerShim{
@@ -194,8 +221,8 @@ type ManagerShim struct {
 }
 
 // Status implements Manager
-func (sm ManagerShim) CurrentStatus(_ *emptypb.Empty, s Manager_CurrentStatusServer) error {
-	return streamToProtobuf(s, sm.manager.CurrentStatus, toProtoStatus)
+func (sm ManagerShim) CurrentStatus(req *StreamRequest, s Manager_CurrentStatusServer) error {
+	return streamToProtobuf(s, resumeAfter(req, sm.manager.CurrentStatus), toProtoStatus)
 }
 
 type pbSender[P any] interface {
@@ -203,6 +230,25 @@ type pbSender[P any] interface {
 	grpc.ServerStream
 }
 
+// eventIDSetter is implemented by protobuf types that have an event_id
+type eventIDSetter interface {
+	setEventID(uint64)
+}
+
+func (x *StatusResponse) setEventID(id uint64) { x.EventId = id }
+func (x *SongUpdate) setEventID(id uint64)     { x.EventId = id }
+
+// resumeAfter wraps streamFn such that the stream resumes after the
+// last_event_id in the request given
+func resumeAfter[T any](req *StreamRequest, streamFn func(context.Context) (eventstream.Stream[T], error)) func(context.Context) (eventstream.Stream[T], error) {
+	return func(ctx context.Context) (eventstream.Stream[T], error) {
+		if id := req.GetLastEventId(); id != 0 {
+			ctx = eventstream.WithLastEventID(ctx, id)
+		}
+		return streamFn(ctx)
+	}
+}
+
 // streamToProtobuf turns an eventstream.Stream into an grpc.ServerStream
 //
 // the types are:
@@ -215,6 +261,8 @@ type pbSender[P any] interface {
 //	s: the grpc ServerStream
 //	streamFn: the function to make the eventstream.Stream
 //	conv: a function that converts T into P
+//
+// if P has an event_id it is filled in with the ID of the value from the stream
 func streamToProtobuf[T any, P any](s pbSender[P], streamFn func(context.Context) (eventstream.Stream[T], error), conv func(T) P) error {
 	ctx, cancel := context.WithCancel(s.Context())
 	defer cancel()
@@ -236,7 +284,11 @@ func streamToProtobuf[T any, P any](s pbSender[P], streamFn func(context.Context
 			}
 			return err
 		}
-		err = s.Send(conv(recv))
+		p := conv(recv)
+		if e, ok := any(p).(eventIDSetter); ok {
+			e.setEventID(eventstream.EventID(stream))
+		}
+		err = s.Send(p)
 		if err != nil {
 			if errors.IsE(err, io.EOF, context.Canceled) {
 				return nil
@@ -246,8 +298,8 @@ func streamToProtobuf[T any, P any](s pbSender[P], streamFn func(context.Context
 	}
 }
 
-func (sm ManagerShim) CurrentSong(_ *emptypb.Empty, s Manager_CurrentSongServer) error {
-	return streamToProtobuf(s, sm.manager.CurrentSong, toProtoSongUpdate)
+func (sm ManagerShim) CurrentSong(req *StreamRequest, s Manager_CurrentSongServer) error {
+	return streamToProtobuf(s, resumeAfter(req, sm.manager.CurrentSong), toProtoSongUpdate)
 }
 
 func (m ManagerShim) UpdateSong(ctx context.Context, su *SongUpdate) (*emptypb.Empty, error) {
@@ -410,6 +462,33 @@ func (q QueueShim) ResetReserved(ctx context.Context, _ *emptypb.Empty) (*emptyp
 	return nil, q.queue.ResetReserved(ctx)
 }
 
+// Move implements Queue
+func (q QueueShim) Move(ctx context.Context, m *QueueMove) (*emptypb.Empty, error) {
+	err := q.queue.Move(ctx, fromProtoQueueID(m.QueueId), int(m.Position))
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// Insert implements Queue
+func (q QueueShim) Insert(ctx context.Context, i *QueueInsert) (*QueueEntry, error) {
+	e, err := q.queue.Insert(ctx, fromProtoSong(i.Song), int(i.Position))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoQueueEntry(*e), nil
+}
+
+// Pin implements Queue
+func (q QueueShim) Pin(ctx context.Context, p *QueuePin) (*emptypb.Empty, error) {
+	err := q.queue.Pin(ctx, fromProtoQueueID(p.QueueId), p.Pinned)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
 func NewListenerTracker(lt radio.ListenerTrackerService) ListenerTrackerServer {
 	return ListenerTrackerShim{tracker: lt}
 }
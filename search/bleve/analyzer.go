@@ -23,9 +23,11 @@ import (
 )
 
 const (
-	radioAnalyzerName = "radio"
-	exactAnalyzerName = "radio.exact"
-	sortAnalyzerName  = "radio.sort"
+	radioAnalyzerName   = "radio"
+	exactAnalyzerName   = "radio.exact"
+	sortAnalyzerName    = "radio.sort"
+	tagAnalyzerName     = "radio.tag"
+	suggestAnalyzerName = "radio.suggest"
 
 	NgramFilterMin = 2
 	NgramFilterMax = 3
@@ -51,6 +53,27 @@ func SortAnalyzerConstructor(config map[string]any, cache *registry.Cache) (anal
 		},
 	}, nil
 }
+
+// TagAnalyzerConstructor creates an analyzer that splits tags on whitespace
+// and nothing else, such that each tag becomes a single term
+func TagAnalyzerConstructor(config map[string]any, cache *registry.Cache) (analysis.Analyzer, error) {
+	toLowerFilter, err := cache.TokenFilterNamed(lowercase.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizeFilter := unicodenorm.MustNewUnicodeNormalizeFilter(unicodenorm.NFC)
+
+	return &analysis.DefaultAnalyzer{
+		Tokenizer: character.NewCharacterTokenizer(IsNotSpace),
+		TokenFilters: []analysis.TokenFilter{
+			toLowerFilter,
+			normalizeFilter,
+			unique.NewUniqueTermFilter(),
+		},
+	}, nil
+}
+
 func RadioAnalyzerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Analyzer, error) {
 	toLowerFilter, err := cache.TokenFilterNamed(lowercase.Name)
 	if err != nil {
@@ -99,10 +122,36 @@ func ExactAnalyzerConstructor(config map[string]interface{}, cache *registry.Cac
 	return exact, nil
 }
 
+// SuggestAnalyzerConstructor creates an analyzer like the exact analyzer but
+// that also includes romaji variants of japanese terms, it's used for fuzzy
+// matching and suggestions
+func SuggestAnalyzerConstructor(config map[string]any, cache *registry.Cache) (analysis.Analyzer, error) {
+	toLowerFilter, err := cache.TokenFilterNamed(lowercase.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizeFilter := unicodenorm.MustNewUnicodeNormalizeFilter(unicodenorm.NFC)
+
+	tokenizer := character.NewCharacterTokenizer(IsNotSpace)
+
+	return &analysis.DefaultAnalyzer{
+		Tokenizer: NewKagomeTokenizer(tokenizer),
+		TokenFilters: []analysis.TokenFilter{
+			toLowerFilter,
+			normalizeFilter,
+			FilterFn(RomajiFilter),
+			length.NewLengthFilter(2, 0), // filter away single char terms
+		},
+	}, nil
+}
+
 func init() {
 	registry.RegisterAnalyzer(radioAnalyzerName, RadioAnalyzerConstructor)
 	registry.RegisterAnalyzer(exactAnalyzerName, ExactAnalyzerConstructor)
 	registry.RegisterAnalyzer(sortAnalyzerName, SortAnalyzerConstructor)
+	registry.RegisterAnalyzer(tagAnalyzerName, TagAnalyzerConstructor)
+	registry.RegisterAnalyzer(suggestAnalyzerName, SuggestAnalyzerConstructor)
 }
 
 type FilterFn func(input analysis.TokenStream) analysis.TokenStream
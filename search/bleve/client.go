@@ -3,13 +3,12 @@ package bleve
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
-	"github.com/blevesearch/bleve/v2"
 	"github.com/vmihailenco/msgpack/v4"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -25,18 +24,22 @@ func NewClient(uri *url.URL) *Client {
 	updateURL := uri.String()
 	uri.Path = deletePath
 	deleteURL := uri.String()
+	uri.Path = completePath
+	completeURL := uri.String()
 	return &Client{
-		searchURL: searchURL,
-		deleteURL: deleteURL,
-		updateURL: updateURL,
-		hc:        client,
+		searchURL:   searchURL,
+		completeURL: completeURL,
+		deleteURL:   deleteURL,
+		updateURL:   updateURL,
+		hc:          client,
 	}
 }
 
 type Client struct {
-	searchURL string
-	deleteURL string
-	updateURL string
+	searchURL   string
+	completeURL string
+	deleteURL   string
+	updateURL   string
 
 	hc *http.Client
 }
@@ -53,12 +56,19 @@ var _ radio.SearchService = &Client{}
 
 func (c *Client) Search(ctx context.Context, query string, opt radio.SearchOptions) (radio.SearchResult, error) {
 	const op errors.Op = "search/bleve.Client.Search"
-	uri := c.searchURL + fmt.Sprintf("?q=%s&limit=%d&offset=%d&exact=%t",
-		url.QueryEscape(query),
-		opt.Limit,
-		opt.Offset,
-		opt.ExactOnly,
-	)
+	values := url.Values{}
+	values.Set("q", query)
+	values.Set("limit", strconv.FormatInt(opt.Limit, 10))
+	values.Set("offset", strconv.FormatInt(opt.Offset, 10))
+	values.Set("exact", strconv.FormatBool(opt.ExactOnly))
+	if opt.Fuzzy {
+		values.Set("fuzzy", "true")
+	}
+	if opt.Facets > 0 {
+		values.Set("facets", strconv.Itoa(opt.Facets))
+	}
+	filterValues(values, opt.Filter)
+	uri := c.searchURL + "?" + values.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -75,7 +85,7 @@ func (c *Client) Search(ctx context.Context, query string, opt radio.SearchOptio
 		return radio.SearchResult{}, errors.E(op, decodeError(resp.Body))
 	}
 
-	result := new(bleve.SearchResult)
+	result := new(SearchResult)
 	err = decodeResult(resp.Body, result)
 	if err != nil {
 		return radio.SearchResult{}, errors.E(op, err)
@@ -88,6 +98,39 @@ func (c *Client) Search(ctx context.Context, query string, opt radio.SearchOptio
 	return res, nil
 }
 
+func (c *Client) Complete(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+	const op errors.Op = "search/bleve.Client.Complete"
+
+	values := url.Values{}
+	values.Set("q", prefix)
+	values.Set("limit", strconv.Itoa(limit))
+	uri := c.completeURL + "?" + values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.E(op, http.StatusText(resp.StatusCode))
+	}
+
+	var res []radio.SearchCompletion
+	dec := _cache.dec.Get()
+	dec.Reset(resp.Body)
+	defer _cache.dec.Put(dec)
+	if err = dec.Decode(&res); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
 func (c *Client) Delete(ctx context.Context, tids ...radio.TrackID) error {
 	const op errors.Op = "search/bleve.Client.Delete"
 
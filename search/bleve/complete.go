@@ -0,0 +1,338 @@
+package bleve
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"unsafe"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+const (
+	DefaultCompleteLimit = 10
+	MaxCompleteLimit     = 50
+
+	// completeMaxWords is the maximum amount of words in a value we complete
+	// from, words after this can't be completed from
+	completeMaxWords = 8
+	// completeMaxScan is the maximum amount of prefix matches we look at
+	// before ranking them, this keeps very short prefixes fast
+	completeMaxScan = 2000
+	// completeLoadBatch is the amount of documents loaded at once when filling
+	// the completer from the index
+	completeLoadBatch = 1000
+)
+
+// completionKey identifies a single value
+type completionKey struct {
+	kind radio.SearchCompletionKind
+	norm string
+}
+
+// completion is a value and the amount of tracks that have it
+type completion struct {
+	value string
+	count int
+}
+
+// prefixEntry is an entry in the sorted prefix list, each value has one of these
+// for every word it contains such that you can complete from the middle
+type prefixEntry struct {
+	text string
+	key  completionKey
+	// start is true if text is the start of the value
+	start bool
+}
+
+func comparePrefixEntry(a, b prefixEntry) int {
+	return cmp.Or(
+		strings.Compare(a.text, b.text),
+		strings.Compare(string(a.key.kind), string(b.key.kind)),
+		strings.Compare(a.key.norm, b.key.norm),
+	)
+}
+
+// completer is an in-memory prefix index of artists, titles and albums that
+// sits next to the bleve index, it's kept up-to-date by indexWrap
+type completer struct {
+	mu sync.RWMutex
+	// tracks are the keys each track added, used to remove them again
+	tracks map[radio.TrackID][]completionKey
+	// values are all the values we know of
+	values map[completionKey]*completion
+	// prefixes is sorted by text
+	prefixes []prefixEntry
+}
+
+func newCompleter() *completer {
+	return &completer{
+		tracks: make(map[radio.TrackID][]completionKey),
+		values: make(map[completionKey]*completion),
+	}
+}
+
+// normalizeCompletion lowercases s and collapses any whitespace
+func normalizeCompletion(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// wordStarts returns the value given starting at each of its words
+func wordStarts(norm string) []string {
+	res := []string{norm}
+	for i := 0; i < len(norm) && len(res) < completeMaxWords; i++ {
+		if norm[i] == ' ' {
+			res = append(res, norm[i+1:])
+		}
+	}
+	return res
+}
+
+// Update adds the songs given, replacing any values they had before
+func (c *completer) Update(songs ...radio.Song) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var b prefixBatch
+	for _, song := range songs {
+		if !song.HasTrack() {
+			continue
+		}
+		c.remove(&b, song.TrackID)
+		c.add(&b, song)
+	}
+	c.apply(&b)
+}
+
+// Delete removes the tracks given
+func (c *completer) Delete(tids ...radio.TrackID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var b prefixBatch
+	for _, tid := range tids {
+		c.remove(&b, tid)
+	}
+	c.apply(&b)
+}
+
+// prefixBatch collects the changes to the prefix list such that they can be
+// applied all at once, inserting them one by one is quadratic
+type prefixBatch struct {
+	// added are the entries of values that were added
+	added []prefixEntry
+	// removed are the values that were removed
+	removed map[completionKey]struct{}
+}
+
+func (c *completer) add(b *prefixBatch, song radio.Song) {
+	var keys []completionKey
+	for _, v := range []struct {
+		kind  radio.SearchCompletionKind
+		value string
+	}{
+		{radio.SearchCompletionArtist, song.Artist},
+		{radio.SearchCompletionTitle, song.Title},
+		{radio.SearchCompletionAlbum, song.Album},
+	} {
+		value := strings.TrimSpace(v.value)
+		key := completionKey{v.kind, normalizeCompletion(value)}
+		if key.norm == "" {
+			continue
+		}
+		keys = append(keys, key)
+
+		if existing, ok := c.values[key]; ok {
+			existing.count++
+			continue
+		}
+
+		c.values[key] = &completion{value: value, count: 1}
+		for i, text := range wordStarts(key.norm) {
+			b.added = append(b.added, prefixEntry{text: text, key: key, start: i == 0})
+		}
+	}
+	c.tracks[song.TrackID] = keys
+}
+
+func (c *completer) remove(b *prefixBatch, tid radio.TrackID) {
+	for _, key := range c.tracks[tid] {
+		existing, ok := c.values[key]
+		if !ok {
+			continue
+		}
+		if existing.count--; existing.count > 0 {
+			continue
+		}
+
+		delete(c.values, key)
+		if b.removed == nil {
+			b.removed = make(map[completionKey]struct{})
+		}
+		b.removed[key] = struct{}{}
+	}
+	delete(c.tracks, tid)
+}
+
+// apply applies the batch to the prefix list
+func (c *completer) apply(b *prefixBatch) {
+	// a value can be removed and added again in the same batch, so only
+	// drop the entries of values that are actually gone
+	gone := func(e prefixEntry) bool {
+		if _, ok := b.removed[e.key]; !ok {
+			return false
+		}
+		_, ok := c.values[e.key]
+		return !ok
+	}
+
+	if len(b.removed) > 0 {
+		c.prefixes = slices.DeleteFunc(c.prefixes, gone)
+	}
+	if len(b.added) == 0 {
+		return
+	}
+
+	added := slices.DeleteFunc(b.added, gone)
+	slices.SortFunc(added, comparePrefixEntry)
+	added = slices.CompactFunc(added, func(a, b prefixEntry) bool {
+		return comparePrefixEntry(a, b) == 0
+	})
+
+	// merge the two sorted lists, dropping entries that exist in both
+	merged := make([]prefixEntry, 0, len(c.prefixes)+len(added))
+	i, j := 0, 0
+	for i < len(c.prefixes) && j < len(added) {
+		switch n := comparePrefixEntry(c.prefixes[i], added[j]); {
+		case n < 0:
+			merged = append(merged, c.prefixes[i])
+			i++
+		case n > 0:
+			merged = append(merged, added[j])
+			j++
+		default:
+			merged = append(merged, c.prefixes[i])
+			i++
+			j++
+		}
+	}
+	merged = append(merged, c.prefixes[i:]...)
+	merged = append(merged, added[j:]...)
+	c.prefixes = merged
+}
+
+// Complete returns at most limit completions for the prefix given, values that
+// start with the prefix go before ones that only have a word starting with it
+func (c *completer) Complete(prefix string, limit int) []radio.SearchCompletion {
+	prefix = normalizeCompletion(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	type match struct {
+		key   completionKey
+		start bool
+		*completion
+	}
+
+	seen := make(map[completionKey]int)
+	var matches []match
+
+	i, _ := slices.BinarySearchFunc(c.prefixes, prefixEntry{text: prefix}, comparePrefixEntry)
+	for ; i < len(c.prefixes) && len(matches) < completeMaxScan; i++ {
+		entry := c.prefixes[i]
+		if !strings.HasPrefix(entry.text, prefix) {
+			break
+		}
+		if j, ok := seen[entry.key]; ok {
+			// we've seen this value already, but maybe not from its start
+			matches[j].start = matches[j].start || entry.start
+			continue
+		}
+		seen[entry.key] = len(matches)
+		matches = append(matches, match{entry.key, entry.start, c.values[entry.key]})
+	}
+
+	slices.SortFunc(matches, func(a, b match) int {
+		if a.start != b.start {
+			if a.start {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(
+			cmp.Compare(b.count, a.count),
+			cmp.Compare(len(a.value), len(b.value)),
+			strings.Compare(a.value, b.value),
+		)
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	res := make([]radio.SearchCompletion, len(matches))
+	for i, m := range matches {
+		res[i] = radio.SearchCompletion{
+			Kind:  m.key.kind,
+			Value: m.value,
+			Count: m.count,
+		}
+	}
+	return res
+}
+
+// loadCompletions fills the completer with all the songs in the index
+func (b *indexWrap) loadCompletions(ctx context.Context) error {
+	const op errors.Op = "search/bleve.loadCompletions"
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	err := b.complete.load(ctx, b.index)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// load adds all the songs in idx to the completer
+func (c *completer) load(ctx context.Context, idx bleve.Index) error {
+	for from := 0; ; from += completeLoadBatch {
+		req := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), completeLoadBatch, from, false)
+		req.Fields = dataField
+		req.SortBy([]string{"_id"})
+
+		result, err := idx.SearchInContext(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		songs := make([]radio.Song, 0, len(result.Hits))
+		for _, hit := range result.Hits {
+			tmp, ok := hit.Fields["data"].(string)
+			if !ok {
+				continue
+			}
+			var song radio.Song
+			data := unsafe.Slice(unsafe.StringData(tmp), len(tmp))
+			if err := msgpack.Unmarshal(data, &song); err != nil {
+				return err
+			}
+			songs = append(songs, song)
+		}
+		c.Update(songs...)
+
+		if len(result.Hits) < completeLoadBatch {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package bleve
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func completionValues(cs []radio.SearchCompletion) []string {
+	var res []string
+	for _, c := range cs {
+		res = append(res, string(c.Kind)+":"+c.Value)
+	}
+	return res
+}
+
+func TestCompleter(t *testing.T) {
+	c := newCompleter()
+
+	song := func(id radio.TrackID, artist, title, album string) radio.Song {
+		return radio.Song{DatabaseTrack: &radio.DatabaseTrack{
+			TrackID: id,
+			Artist:  artist,
+			Title:   title,
+			Album:   album,
+		}}
+	}
+
+	c.Update(
+		song(1, "School Food Punishment", "How To Go", "amp-reflection"),
+		song(2, "School Food Punishment", "futuristic imagination", "amp-reflection"),
+		song(3, "Schoolgirl Byebye", "Punish me", ""),
+		song(4, "Taishi", "Personalizer", ""),
+	)
+
+	assert.Equal(t, []string{
+		"artist:School Food Punishment",
+		"artist:Schoolgirl Byebye",
+	}, completionValues(c.Complete("  SCHOOL ", 10)))
+
+	// values starting with the prefix go before word matches
+	assert.Equal(t, []string{
+		"title:Punish me",
+		"artist:School Food Punishment",
+	}, completionValues(c.Complete("punish", 10)))
+
+	assert.Equal(t, 2, c.Complete("amp", 10)[0].Count)
+	assert.Len(t, c.Complete("s", 1), 1)
+	assert.Empty(t, c.Complete("", 10))
+
+	// renaming should remove the old value, but keep the one still in use
+	c.Update(song(2, "School Food Punishment", "futuristic imagination", "Prog-Roid"))
+	assert.Equal(t, 1, c.Complete("amp", 10)[0].Count)
+	assert.Equal(t, []string{"album:Prog-Roid"}, completionValues(c.Complete("prog", 10)))
+
+	c.Delete(1, 2)
+	assert.Equal(t, []string{"artist:Schoolgirl Byebye"}, completionValues(c.Complete("school", 10)))
+	assert.Empty(t, c.Complete("amp", 10))
+	assert.Empty(t, c.Complete("prog", 10))
+}
+
+func TestLoadCompletions(t *testing.T) {
+	ctx := context.Background()
+	idx := newTestIndex(t)
+
+	var songs []radio.Song
+	for i, song := range testData {
+		songs = append(songs, radio.Song{
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID: radio.TrackID(i + 1),
+				Title:   song.title,
+				Artist:  song.artist,
+			},
+		})
+	}
+	require.NoError(t, idx.Index(ctx, songs))
+
+	// pretend we just started up with an existing index
+	idx.complete = newCompleter()
+	require.NoError(t, idx.loadCompletions(ctx))
+
+	assert.Equal(t, []string{"title:Personalizer"}, completionValues(idx.complete.Complete("perso", 10)))
+	assert.Equal(t, []string{"artist:Taishi feat. みとせのりこ"}, completionValues(idx.complete.Complete("みとせ", 10)))
+}
+
+func TestCompleterBatch(t *testing.T) {
+	c := newCompleter()
+
+	song := func(id radio.TrackID, artist, title string) radio.Song {
+		return radio.Song{DatabaseTrack: &radio.DatabaseTrack{
+			TrackID: id,
+			Artist:  artist,
+			Title:   title,
+		}}
+	}
+
+	// the same track updated multiple times in one batch should only keep
+	// the last values, and values removed and added again should stay
+	c.Update(
+		song(1, "Taishi", "Personalizer"),
+		song(1, "Taishi", "Renamed"),
+		song(2, "Taishi", "Other"),
+		song(2, "Taishi", "Other"),
+	)
+
+	assert.Empty(t, c.Complete("perso", 10))
+	assert.Equal(t, []string{"title:Renamed"}, completionValues(c.Complete("ren", 10)))
+	assert.Equal(t, 2, c.Complete("taishi", 10)[0].Count)
+	assert.True(t, slices.IsSortedFunc(c.prefixes, comparePrefixEntry))
+	assert.Len(t, c.prefixes, 3)
+}
+
+func BenchmarkCompleterLoad(b *testing.B) {
+	songs := make([]radio.Song, 40000)
+	for i := range songs {
+		songs[i] = radio.Song{DatabaseTrack: &radio.DatabaseTrack{
+			TrackID: radio.TrackID(i + 1),
+			Artist:  fmt.Sprintf("artist %d of the band", i%5000),
+			Title:   fmt.Sprintf("title number %d with some words", i),
+			Album:   fmt.Sprintf("album %d", i%8000),
+		}}
+	}
+
+	for b.Loop() {
+		c := newCompleter()
+		for chunk := range slices.Chunk(songs, completeLoadBatch) {
+			c.Update(chunk...)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package bleve
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	tagsFacetName   = "tags"
+	artistFacetName = "artist"
+)
+
+// filterValues adds the filter to the url values given
+func filterValues(v url.Values, f radio.SearchFilter) {
+	if f.Requestable {
+		v.Set("requestable", "true")
+	}
+	if f.MinLength > 0 {
+		v.Set("min_length", strconv.Itoa(int(f.MinLength.Seconds())))
+	}
+	if f.MaxLength > 0 {
+		v.Set("max_length", strconv.Itoa(int(f.MaxLength.Seconds())))
+	}
+	if f.Tag != "" {
+		v.Set("tag", f.Tag)
+	}
+	if f.Album != "" {
+		v.Set("album", f.Album)
+	}
+	if f.Acceptor != "" {
+		v.Set("acceptor", f.Acceptor)
+	}
+	if !f.LastPlayedBefore.IsZero() {
+		v.Set("lp_before", strconv.FormatInt(f.LastPlayedBefore.Unix(), 10))
+	}
+	if !f.LastPlayedAfter.IsZero() {
+		v.Set("lp_after", strconv.FormatInt(f.LastPlayedAfter.Unix(), 10))
+	}
+}
+
+// filterFromRequest is the reverse of filterValues
+func filterFromRequest(r *http.Request) radio.SearchFilter {
+	asTime := func(s string) time.Time {
+		unix, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || unix <= 0 {
+			return time.Time{}
+		}
+		return time.Unix(unix, 0)
+	}
+
+	return radio.SearchFilter{
+		Requestable:      r.FormValue("requestable") == "true",
+		MinLength:        time.Duration(AsIntOrDefault(r.FormValue("min_length"), 0)) * time.Second,
+		MaxLength:        time.Duration(AsIntOrDefault(r.FormValue("max_length"), 0)) * time.Second,
+		Tag:              r.FormValue("tag"),
+		Album:            r.FormValue("album"),
+		Acceptor:         r.FormValue("acceptor"),
+		LastPlayedBefore: asTime(r.FormValue("lp_before")),
+		LastPlayedAfter:  asTime(r.FormValue("lp_after")),
+	}
+}
+
+// filterQueries returns the queries that implement the filter, these are
+// given a boost of zero so they don't influence the score
+func (rq *RadioQuery) filterQueries(m mapping.IndexMapping, now time.Time) []query.Query {
+	f := rq.Filter
+	var queries []query.Query
+	add := func(q query.Query) {
+		if bq, ok := q.(query.BoostableQuery); ok {
+			bq.SetBoost(0)
+		}
+		queries = append(queries, q)
+	}
+
+	if f.Requestable {
+		q := query.NewDateRangeInclusiveQuery(time.Time{}, now, nil, &inclusive)
+		q.SetField("ra")
+		add(q)
+	}
+	if f.MinLength > 0 || f.MaxLength > 0 {
+		var min, max *float64
+		if f.MinLength > 0 {
+			min = ptr(f.MinLength.Seconds())
+		}
+		if f.MaxLength > 0 {
+			max = ptr(f.MaxLength.Seconds())
+		}
+		q := query.NewNumericRangeInclusiveQuery(min, max, &inclusive, &inclusive)
+		q.SetField("length")
+		add(q)
+	}
+	if f.Tag != "" {
+		add(rq.generateFieldQuery(m, "facet.tags", f.Tag, 0))
+	}
+	if f.Album != "" {
+		add(rq.generateFieldQuery(m, "facet.album", f.Album, 0))
+	}
+	if f.Acceptor != "" {
+		add(rq.generateFieldQuery(m, "acceptor", f.Acceptor, 0))
+	}
+	if !f.LastPlayedAfter.IsZero() {
+		q := query.NewDateRangeQuery(f.LastPlayedAfter, time.Time{})
+		q.SetField("lp")
+		add(q)
+	}
+	if !f.LastPlayedBefore.IsZero() {
+		// songs that were never played don't have an lp field, so we exclude
+		// everything played after instead of including everything before
+		played := query.NewDateRangeQuery(f.LastPlayedBefore, time.Time{})
+		played.SetField("lp")
+		q := query.NewBooleanQuery(nil, nil, nil)
+		q.AddMustNot(played)
+		add(q)
+	}
+
+	return queries
+}
+
+var inclusive = true
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// addFacets adds the facet requests to req if size is non-zero
+func addFacets(req *bleve.SearchRequest, size int) {
+	if size <= 0 {
+		return
+	}
+	req.AddFacet(tagsFacetName, bleve.NewFacetRequest("facet.tags", size))
+	req.AddFacet(artistFacetName, bleve.NewFacetRequest("facet.artist", size))
+}
+
+// bleveToFacets converts the bleve facet results into our own type
+func bleveToFacets(results search.FacetResults) radio.SearchFacets {
+	terms := func(name string) []radio.SearchFacetTerm {
+		fr, ok := results[name]
+		if !ok || fr.Terms == nil {
+			return nil
+		}
+		var res []radio.SearchFacetTerm
+		for _, t := range fr.Terms.Terms() {
+			res = append(res, radio.SearchFacetTerm{
+				Term:  t.Term,
+				Count: t.Count,
+			})
+		}
+		return res
+	}
+
+	return radio.SearchFacets{
+		Tags:    terms(tagsFacetName),
+		Artists: terms(artistFacetName),
+	}
+}
@@ -3,8 +3,10 @@ package bleve
 import (
 	"context"
 	"net/http"
-	"path/filepath"
+	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
@@ -44,6 +46,14 @@ type indexSort struct {
 	ID     int    `bleve:"id"`
 }
 
+// indexFacet holds fields we filter and facet on, these are kept
+// mostly as-is such that they can be shown to users
+type indexFacet struct {
+	Tags   string `bleve:"tags"`
+	Artist string `bleve:"artist"`
+	Album  string `bleve:"album"`
+}
+
 // indexSong is the structure of the bleve document
 type indexSong struct {
 	// fields to index with radio analyzer
@@ -54,6 +64,14 @@ type indexSong struct {
 	// time fields
 	LastRequested time.Time `bleve:"lr"`
 	LastPlayed    time.Time `bleve:"lp"`
+	// RequestableAt is when the song can be requested again
+	RequestableAt time.Time `bleve:"ra"`
+	// Length is the song length in seconds
+	Length float64 `bleve:"length"`
+	// facet and filter fields
+	Facet indexFacet `bleve:"facet"`
+	// artist and title for fuzzy matching
+	Suggest string `bleve:"suggest"`
 	// keyword fields
 	ID       string `bleve:"id"`
 	Acceptor string `bleve:"acceptor"`
@@ -85,6 +103,8 @@ func toIndexSong(s radio.Song) *indexSong {
 		Exact:         text,
 		LastRequested: s.LastRequested,
 		LastPlayed:    s.LastPlayed,
+		RequestableAt: requestableAt(s),
+		Length:        s.Length.Seconds(),
 		ID:            s.TrackID.String(),
 		Sort: indexSort{
 			Title:  s.Title,
@@ -98,50 +118,156 @@ func toIndexSong(s radio.Song) *indexSong {
 		Priority:     s.Priority,
 		RequestCount: s.RequestCount,
 		Data:         string(data),
+		Facet: indexFacet{
+			Tags:   s.Tags,
+			Artist: s.Artist,
+			Album:  s.Album,
+		},
+		Suggest: s.Artist + " " + s.Title,
+	}
+}
+
+// requestableAt returns the time at which the song can be requested again, songs
+// that were never played or requested return the unix epoch since the zero time
+// can't be indexed
+func requestableAt(s radio.Song) time.Time {
+	furthest := s.LastPlayed
+	if s.LastRequested.After(furthest) {
+		furthest = s.LastRequested
 	}
+	if furthest.IsZero() {
+		return time.Unix(0, 0)
+	}
+	return furthest.Add(s.RequestDelay())
 }
 
 type indexWrap struct {
+	// mu protects index and complete, it is write locked when RebuildIndex
+	// swaps in the new index
+	mu        sync.RWMutex
 	indexPath string
 	index     bleve.Index
+	complete  *completer
+
+	// replayMu protects rebuilding and replay
+	replayMu sync.Mutex
+	// rebuilding is true while RebuildIndex is running
+	rebuilding bool
+	// replay are the changes made while rebuilding, these are applied to
+	// the new index before it is swapped in
+	replay []indexChange
+}
+
+// indexChange is a single call to Index or Delete
+type indexChange struct {
+	songs []radio.Song
+	tids  []radio.TrackID
+}
+
+func newIndexWrap(indexPath string, idx bleve.Index) *indexWrap {
+	return &indexWrap{
+		indexPath: indexPath,
+		index:     idx,
+		complete:  newCompleter(),
+	}
 }
 
 func (b *indexWrap) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	return b.index.Close()
 }
 
-func (b *indexWrap) SearchFromRequest(r *http.Request) (*bleve.SearchResult, error) {
+// Stats returns the stats of the index
+func (b *indexWrap) Stats() *bleve.IndexStat {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.index.Stats()
+}
+
+// Complete returns at most limit completions for the prefix given
+func (b *indexWrap) Complete(prefix string, limit int) []radio.SearchCompletion {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.complete.Complete(prefix, limit)
+}
+
+// MappingOutdated returns true if the index was created with a different mapping
+// than the one constructIndexMapping returns, the index should be rebuilt with
+// RebuildIndex if that is the case
+func (b *indexWrap) MappingOutdated() (bool, error) {
+	const op errors.Op = "search/bleve.MappingOutdated"
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	version, err := b.index.GetInternal(mappingVersionKey)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return string(version) != indexMappingVersion, nil
+}
+
+// recordChange records the change given if a rebuild is running
+func (b *indexWrap) recordChange(change indexChange) {
+	b.replayMu.Lock()
+	defer b.replayMu.Unlock()
+	if b.rebuilding {
+		b.replay = append(b.replay, change)
+	}
+}
+
+func (b *indexWrap) SearchFromRequest(r *http.Request) (*SearchResult, error) {
 	const op errors.Op = "search/bleve.SearchFromRequest"
 
 	raw := r.FormValue("q")
-	limit := AsIntOrDefault(r.FormValue("limit"), DefaultLimit)
-	offset := AsIntOrDefault(r.FormValue("offset"), DefaultOffset)
-	exact := r.FormValue("exact") == "true"
+	opt := radio.SearchOptions{
+		Limit:     int64(AsIntOrDefault(r.FormValue("limit"), DefaultLimit)),
+		Offset:    int64(AsIntOrDefault(r.FormValue("offset"), DefaultOffset)),
+		ExactOnly: r.FormValue("exact") == "true",
+		Filter:    filterFromRequest(r),
+		Facets:    AsIntOrDefault(r.FormValue("facets"), 0),
+		Fuzzy:     r.FormValue("fuzzy") == "true",
+	}
 
-	res, err := b.Search(r.Context(), raw, exact, limit, offset)
+	res, err := b.Search(r.Context(), raw, opt)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 	return res, nil
 }
 
-func (b *indexWrap) Search(ctx context.Context, raw string, exactOnly bool, limit, offset int) (*bleve.SearchResult, error) {
+func (b *indexWrap) Search(ctx context.Context, raw string, opt radio.SearchOptions) (*SearchResult, error) {
 	const op errors.Op = "search/bleve.Search"
 	ctx, span := otel.Tracer("bleve").Start(ctx, string(op))
 	defer span.End()
 
-	query, err := NewQuery(ctx, raw, exactOnly)
+	query, err := NewQuery(ctx, raw, opt.ExactOnly)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	query.Filter = opt.Filter
 
-	req := NewSearchRequest(query, limit, offset)
+	req := NewSearchRequest(query, int(opt.Limit), int(opt.Offset))
+	addFacets(req, opt.Facets)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 
 	result, err := b.index.SearchInContext(ctx, req)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	return result, nil
+
+	if result.Total == 0 && opt.Fuzzy && query.Query != "" {
+		// nothing found, try again but with typos allowed
+		fuzzy, err := b.fuzzySearch(ctx, query, req)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return fuzzy, nil
+	}
+	return &SearchResult{SearchResult: *result}, nil
 }
 
 func (b *indexWrap) Index(ctx context.Context, songs []radio.Song) error {
@@ -154,29 +280,39 @@ func (b *indexWrap) Index(ctx context.Context, songs []radio.Song) error {
 		Value: attribute.IntValue(len(songs)),
 	})
 
-	batch := b.index.NewBatch()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	err := indexSongs(b.index, songs)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	b.recordChange(indexChange{songs: songs})
+	b.complete.Update(songs...)
+	return nil
+}
+
+// indexSongs adds the songs given to idx
+func indexSongs(idx bleve.Index, songs []radio.Song) error {
+	batch := idx.NewBatch()
 	for _, song := range songs {
-		doc, err := b.createDocument(song)
+		doc, err := createDocument(idx, song)
 		if err != nil {
-			return errors.E(op, err)
+			return err
 		}
 
 		err = batch.IndexAdvanced(doc)
 		if err != nil {
-			return errors.E(op, err)
+			return err
 		}
 	}
-	err := b.index.Batch(batch)
-	if err != nil {
-		return errors.E(op, err)
-	}
-	return nil
+	return idx.Batch(batch)
 }
 
-func (b *indexWrap) createDocument(song radio.Song) (*document.Document, error) {
+func createDocument(idx bleve.Index, song radio.Song) (*document.Document, error) {
 	doc := document.NewDocument(song.TrackID.String())
 	// first run us through the normal mapping, this will generate the usual bleve document
-	err := b.index.Mapping().MapDocument(doc, toIndexSong(song))
+	err := idx.Mapping().MapDocument(doc, toIndexSong(song))
 	if err != nil {
 		return nil, err
 	}
@@ -223,17 +359,27 @@ func (b *indexWrap) Delete(ctx context.Context, tids []radio.TrackID) error {
 	ctx, span := otel.Tracer("bleve").Start(ctx, string(op))
 	defer span.End()
 
-	batch := b.index.NewBatch()
-	for _, tid := range tids {
-		batch.Delete(tid.String())
-	}
-	err := b.index.Batch(batch)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	err := deleteTracks(b.index, tids)
 	if err != nil {
 		return errors.E(op, err)
 	}
+	b.recordChange(indexChange{tids: tids})
+	b.complete.Delete(tids...)
 	return nil
 }
 
+// deleteTracks removes the tracks given from idx
+func deleteTracks(idx bleve.Index, tids []radio.TrackID) error {
+	batch := idx.NewBatch()
+	for _, tid := range tids {
+		batch.Delete(tid.String())
+	}
+	return idx.Batch(batch)
+}
+
 func newTextMapping() *mapping.FieldMapping {
 	fm := bleve.NewTextFieldMapping()
 	fm.Store = false
@@ -295,6 +441,26 @@ func constructIndexMapping() (mapping.IndexMapping, error) {
 
 	sm.AddSubDocumentMapping("sort", sort)
 
+	// create the facet submapping
+	facet := bleve.NewDocumentStaticMapping()
+	facet.StructTagKey = "bleve"
+	facetTags := bleve.NewTextFieldMapping()
+	facetTags.Analyzer = tagAnalyzerName
+	facetTags.Store = false
+	facet.AddFieldMappingsAt("tags", facetTags)
+	facetArtist := bleve.NewKeywordFieldMapping()
+	facetArtist.Store = false
+	facet.AddFieldMappingsAt("artist", facetArtist)
+	facet.AddFieldMappingsAt("album", newSortMapping())
+
+	sm.AddSubDocumentMapping("facet", facet)
+
+	suggest := bleve.NewTextFieldMapping()
+	suggest.Analyzer = suggestAnalyzerName
+	suggest.Store = false
+	suggest.IncludeTermVectors = true
+	sm.AddFieldMappingsAt("suggest", suggest)
+
 	// create the rest of the normal mappings
 	sm.AddFieldMappingsAt("acceptor", newSortMapping())
 
@@ -328,6 +494,16 @@ func constructIndexMapping() (mapping.IndexMapping, error) {
 	lp.Store = false
 	sm.AddFieldMappingsAt("lp", lp)
 
+	ra := bleve.NewDateTimeFieldMapping()
+	ra.Index = true
+	ra.Store = false
+	sm.AddFieldMappingsAt("ra", ra)
+
+	length := bleve.NewNumericFieldMapping()
+	length.Index = true
+	length.Store = false
+	sm.AddFieldMappingsAt("length", length)
+
 	data := bleve.NewKeywordFieldMapping()
 	data.Index = false
 	data.Store = true
@@ -342,13 +518,27 @@ func Open(ctx context.Context, cfg config.Config) (radio.SearchService, error) {
 	return NewClient(cfg.Conf().Search.Endpoint.URL()), nil
 }
 
+// memoryIndexPath is the index path used for a memory-only index
+const memoryIndexPath = ":memory:"
+
+// indexMappingVersion is the version of the mapping made by constructIndexMapping,
+// it should be increased whenever the mapping changes such that existing indexes
+// are rebuilt with the new mapping
+const indexMappingVersion = "1"
+
+// mappingVersionKey is the internal key the mapping version is stored under
+var mappingVersionKey = []byte("radio_mapping_version")
+
+// NewIndex opens the index at indexPath or creates a new one if it doesn't exist,
+// an existing index keeps the mapping it was created with so callers should check
+// MappingOutdated
 func NewIndex(indexPath string) (*indexWrap, error) {
 	const op errors.Op = "bleve.NewIndex"
 
 	idx, err := bleve.Open(indexPath)
 	if err == nil {
 		// happy path, we have an index and opened it
-		return &indexWrap{indexPath, idx}, nil
+		return newIndexWrap(indexPath, idx), nil
 	}
 
 	// check if error was not-exist
@@ -361,10 +551,7 @@ func NewIndex(indexPath string) (*indexWrap, error) {
 		return nil, errors.E(op, err)
 	}
 
-	return &indexWrap{
-		indexPath: indexPath,
-		index:     idx,
-	}, nil
+	return newIndexWrap(indexPath, idx), nil
 }
 
 func newIndex(indexPath string) (bleve.Index, error) {
@@ -377,7 +564,7 @@ func newIndex(indexPath string) (bleve.Index, error) {
 	}
 
 	var idx bleve.Index
-	if indexPath == ":memory:" { // support memory-only index for testing purposes
+	if indexPath == memoryIndexPath { // support memory-only index for testing purposes
 		idx, err = bleve.NewMemOnly(mapping)
 	} else {
 		idx, err = bleve.New(indexPath, mapping)
@@ -385,36 +572,163 @@ func newIndex(indexPath string) (bleve.Index, error) {
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+
+	err = idx.SetInternal(mappingVersionKey, []byte(indexMappingVersion))
+	if err != nil {
+		idx.Close()
+		return nil, errors.E(op, err)
+	}
 	return idx, nil
 }
 
+// RebuildIndex creates a new index from all the tracks in storage and replaces
+// the current index with it once done. The current index keeps serving while the
+// rebuild is running, and any changes made to it are replayed on the new index
 func (b *indexWrap) RebuildIndex(ctx context.Context, ts radio.TrackStorage) error {
 	const op errors.Op = "bleve.RebuildIndex"
 
-	var indexPath string
-
-	// create a temporary dir for our new index
-	indexPath = filepath.Join(indexPath, xid.New().String())
+	b.replayMu.Lock()
+	if b.rebuilding {
+		b.replayMu.Unlock()
+		return errors.E(op, errors.Info("rebuild already running"))
+	}
+	b.rebuilding = true
+	b.replayMu.Unlock()
+
+	defer func() {
+		b.replayMu.Lock()
+		b.rebuilding = false
+		b.replay = nil
+		b.replayMu.Unlock()
+	}()
+
+	// create the new index next to the current one, it's moved to the
+	// current path once it's done
+	newPath := memoryIndexPath
+	if b.indexPath != memoryIndexPath {
+		newPath = b.indexPath + ".rebuild-" + xid.New().String()
+	}
 
-	// create a new index in that location
-	idx, err := newIndex(indexPath)
+	idx, err := newIndex(newPath)
 	if err != nil {
 		return errors.E(op, err)
 	}
 
-	// wrap it into our API, this is temporary
-	ss := &indexWrap{indexPath, idx}
+	err = b.fillIndex(ctx, idx, ts)
+	if err != nil {
+		idx.Close()
+		removeIndex(newPath)
+		return errors.E(op, err)
+	}
+	complete := newCompleter()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// apply anything that happened while we were filling the index, nothing
+	// can be added now that we hold the write lock
+	b.replayMu.Lock()
+	replay := b.replay
+	b.replay = nil
+	b.replayMu.Unlock()
+	for _, change := range replay {
+		if len(change.songs) > 0 {
+			err = indexSongs(idx, change.songs)
+		} else {
+			err = deleteTracks(idx, change.tids)
+		}
+		if err != nil {
+			idx.Close()
+			removeIndex(newPath)
+			return errors.E(op, err)
+		}
+	}
 
-	// now get all the songs
-	songs, err := ts.All()
+	err = complete.load(ctx, idx)
 	if err != nil {
+		idx.Close()
+		removeIndex(newPath)
 		return errors.E(op, err)
 	}
-	// and add them to the new index
-	err = ss.Index(ctx, songs)
+
+	err = b.swapIndex(idx, newPath)
 	if err != nil {
 		return errors.E(op, err)
 	}
+	b.complete = complete
+	return nil
+}
+
+// fillIndex adds all the tracks in storage to idx
+func (b *indexWrap) fillIndex(ctx context.Context, idx bleve.Index, ts radio.TrackStorage) error {
+	songs, err := ts.All()
+	if err != nil {
+		return err
+	}
+
+	for chunk := range slices.Chunk(songs, rebuildBatchSize) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err = indexSongs(idx, chunk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildBatchSize is the amount of songs indexed at once by RebuildIndex
+const rebuildBatchSize = 1000
+
+// swapIndex replaces the current index with idx that lives at path, the
+// current index is closed and removed. b.mu should be write locked
+func (b *indexWrap) swapIndex(idx bleve.Index, path string) error {
+	old := b.index
+
+	if b.indexPath == memoryIndexPath {
+		b.index = idx
+		return old.Close()
+	}
+
+	// bleve can't move an open index, so close both and open the new
+	// one again once it's been moved to the current path
+	err := idx.Close()
+	if err != nil {
+		removeIndex(path)
+		return err
+	}
+	err = old.Close()
+	if err != nil {
+		return err
+	}
 
+	err = removeIndex(b.indexPath)
+	if err == nil {
+		err = os.Rename(path, b.indexPath)
+	}
+	if err != nil {
+		// couldn't move it, but the new index is still usable where it is
+		idx, openErr := bleve.Open(path)
+		if openErr != nil {
+			return errors.E(err, errors.Info("failed to reopen index: "+openErr.Error()))
+		}
+		b.index = idx
+		return err
+	}
+
+	idx, err = bleve.Open(b.indexPath)
+	if err != nil {
+		return err
+	}
+	b.index = idx
 	return nil
 }
+
+// removeIndex removes the index at path from disk
+func removeIndex(path string) error {
+	if path == memoryIndexPath {
+		return nil
+	}
+	return os.RemoveAll(path)
+}
@@ -1,10 +1,18 @@
 package bleve
 
 import (
+	"bytes"
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -95,3 +103,301 @@ func TestAnalyzer(t *testing.T) {
 		t.Log("===============================")
 	}
 }
+
+func TestFilterAndFacets(t *testing.T) {
+	ctx := context.Background()
+	idx := newTestIndex(t)
+
+	now := time.Now()
+	songs := []radio.Song{
+		{
+			Length:     time.Minute * 3,
+			LastPlayed: now.Add(-time.Hour * 24 * 30),
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID:  1,
+				Artist:   "School Food Punishment",
+				Title:    "How To Go",
+				Album:    "amp-reflection",
+				Tags:     "rock anime",
+				Acceptor: "vin",
+			},
+		},
+		{
+			Length:     time.Minute * 5,
+			LastPlayed: now.Add(-time.Minute),
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID:  2,
+				Artist:   "School Food Punishment",
+				Title:    "futuristic imagination",
+				Album:    "amp-reflection",
+				Tags:     "rock",
+				Acceptor: "ed",
+			},
+		},
+		{
+			Length: time.Minute * 4,
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID: 3,
+				Artist:  "Taishi",
+				Title:   "Personalizer",
+				Tags:    "electronic anime",
+			},
+		},
+	}
+	require.NoError(t, idx.Index(ctx, songs))
+
+	search := func(t *testing.T, raw string, filter radio.SearchFilter) []radio.TrackID {
+		res, err := idx.Search(ctx, raw, radio.SearchOptions{
+			Limit:  100,
+			Filter: filter,
+		})
+		require.NoError(t, err)
+
+		var ids []radio.TrackID
+		for _, hit := range res.Hits {
+			id, err := radio.ParseTrackID(hit.ID)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	cases := []struct {
+		name   string
+		raw    string
+		filter radio.SearchFilter
+		expect []radio.TrackID
+	}{
+		{"requestable", "", radio.SearchFilter{Requestable: true}, []radio.TrackID{1, 3}},
+		{"tag", "", radio.SearchFilter{Tag: "Anime"}, []radio.TrackID{1, 3}},
+		{"tag and query", "school", radio.SearchFilter{Tag: "anime"}, []radio.TrackID{1}},
+		{"album", "", radio.SearchFilter{Album: "AMP-reflection"}, []radio.TrackID{1, 2}},
+		{"acceptor", "", radio.SearchFilter{Acceptor: "ed"}, []radio.TrackID{2}},
+		{"min length", "", radio.SearchFilter{MinLength: time.Minute * 4}, []radio.TrackID{2, 3}},
+		{"length range", "", radio.SearchFilter{MinLength: time.Minute * 2, MaxLength: time.Minute * 4}, []radio.TrackID{1, 3}},
+		{"played before", "", radio.SearchFilter{LastPlayedBefore: now.Add(-time.Hour)}, []radio.TrackID{1, 3}},
+		{"played after", "", radio.SearchFilter{LastPlayedAfter: now.Add(-time.Hour)}, []radio.TrackID{2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.ElementsMatch(t, c.expect, search(t, c.raw, c.filter))
+		})
+	}
+
+	t.Run("facets", func(t *testing.T) {
+		res, err := idx.Search(ctx, "", radio.SearchOptions{
+			Limit:  100,
+			Filter: radio.SearchFilter{Tag: "anime"},
+			Facets: 10,
+		})
+		require.NoError(t, err)
+
+		// round-trip through the client encoding to make sure facets survive
+		var buf bytes.Buffer
+		require.NoError(t, encodeResult(&buf, res))
+		decoded := new(SearchResult)
+		require.NoError(t, decodeResult(&buf, decoded))
+
+		result, err := bleveToRadio(decoded)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []radio.SearchFacetTerm{
+			{Term: "anime", Count: 2},
+			{Term: "rock", Count: 1},
+			{Term: "electronic", Count: 1},
+		}, result.Facets.Tags)
+		assert.ElementsMatch(t, []radio.SearchFacetTerm{
+			{Term: "School Food Punishment", Count: 1},
+			{Term: "Taishi", Count: 1},
+		}, result.Facets.Artists)
+	})
+}
+
+func TestFuzzySearch(t *testing.T) {
+	ctx := context.Background()
+	idx := newTestIndex(t)
+
+	var songs []radio.Song
+	for i, song := range testData {
+		songs = append(songs, radio.Song{
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID: radio.TrackID(i + 1),
+				Title:   song.title,
+				Artist:  song.artist,
+			},
+		})
+	}
+	require.NoError(t, idx.Index(ctx, songs))
+
+	search := func(t *testing.T, raw string, fuzzy bool) radio.SearchResult {
+		res, err := idx.Search(ctx, raw, radio.SearchOptions{
+			Limit:     20,
+			ExactOnly: true,
+			Fuzzy:     fuzzy,
+		})
+		require.NoError(t, err)
+
+		result, err := bleveToRadio(res)
+		if err != nil {
+			require.ErrorIs(t, err, errors.E(errors.SearchNoResults))
+		}
+		return result
+	}
+
+	t.Run("typo", func(t *testing.T) {
+		res := search(t, "scool punishmant", true)
+		require.Len(t, res.Songs, 1)
+		assert.True(t, res.Fuzzy)
+		assert.EqualValues(t, 2, res.Songs[0].TrackID)
+		assert.Equal(t, []string{"school punishment"}, res.Suggestions)
+	})
+
+	t.Run("romaji typo", func(t *testing.T) {
+		res := search(t, "motme", true)
+		require.Len(t, res.Songs, 1)
+		assert.EqualValues(t, 1, res.Songs[0].TrackID)
+		assert.Equal(t, []string{"motome"}, res.Suggestions)
+	})
+
+	t.Run("not fuzzy", func(t *testing.T) {
+		res := search(t, "scool punishmant", false)
+		assert.Empty(t, res.Songs)
+		assert.False(t, res.Fuzzy)
+		assert.Empty(t, res.Suggestions)
+	})
+
+	t.Run("exact match", func(t *testing.T) {
+		res := search(t, "school punishment", true)
+		require.Len(t, res.Songs, 1)
+		assert.False(t, res.Fuzzy, "exact matches shouldn't be fuzzy")
+		assert.Empty(t, res.Suggestions)
+	})
+}
+
+// newOldIndex creates an index at path like one made before the mapping was
+// versioned, without any of our field mappings
+func newOldIndex(t *testing.T, path string) {
+	old, err := bleve.New(path, bleve.NewIndexMapping())
+	require.NoError(t, err)
+	require.NoError(t, old.Close())
+}
+
+func TestRebuildIndex(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "index")
+	newOldIndex(t, path)
+
+	idx, err := NewIndex(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { idx.Close() })
+
+	outdated, err := idx.MappingOutdated()
+	require.NoError(t, err)
+	require.True(t, outdated)
+
+	songs := []radio.Song{
+		{DatabaseTrack: &radio.DatabaseTrack{TrackID: 1, Artist: "School Food Punishment", Title: "How To Go", Tags: "rock anime"}},
+		{DatabaseTrack: &radio.DatabaseTrack{TrackID: 2, Artist: "Taishi", Title: "Personalizer", Tags: "electronic"}},
+	}
+	late := radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 3, Artist: "Taishi", Title: "Late", Tags: "anime"}}
+
+	ts := &mocks.TrackStorageMock{
+		AllFunc: func() ([]radio.Song, error) {
+			// changes made while rebuilding should end up in the new index
+			require.NoError(t, idx.Index(ctx, []radio.Song{late}))
+			require.NoError(t, idx.Delete(ctx, []radio.TrackID{2}))
+			return songs, nil
+		},
+	}
+	require.NoError(t, idx.RebuildIndex(ctx, ts))
+
+	outdated, err = idx.MappingOutdated()
+	require.NoError(t, err)
+	assert.False(t, outdated)
+
+	res, err := idx.Search(ctx, "", radio.SearchOptions{
+		Limit:  10,
+		Filter: radio.SearchFilter{Tag: "anime"},
+	})
+	require.NoError(t, err)
+	var ids []string
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ID)
+	}
+	assert.ElementsMatch(t, []string{"1", "3"}, ids)
+	assert.Equal(t, []string{"artist:Taishi"}, completionValues(idx.Complete("tai", 10)))
+
+	// the rebuilt index should be at the configured path with nothing left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "index", entries[0].Name())
+
+	// and should still be current after reopening it
+	require.NoError(t, idx.Close())
+	idx, err = NewIndex(path)
+	require.NoError(t, err)
+	outdated, err = idx.MappingOutdated()
+	require.NoError(t, err)
+	assert.False(t, outdated)
+	count, err := idx.index.DocCount()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
+func TestFuzzySearchOldMapping(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "index")
+	newOldIndex(t, path)
+
+	var songs []radio.Song
+	for i, song := range testData {
+		songs = append(songs, radio.Song{
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID: radio.TrackID(i + 1),
+				Title:   song.title,
+				Artist:  song.artist,
+			},
+		})
+	}
+
+	idx, err := NewIndex(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { idx.Close() })
+
+	search := func(t *testing.T) radio.SearchResult {
+		res, err := idx.Search(ctx, "scool punishmant", radio.SearchOptions{
+			Limit:     20,
+			ExactOnly: true,
+			Fuzzy:     true,
+		})
+		require.NoError(t, err)
+
+		result, err := bleveToRadio(res)
+		if err != nil {
+			require.ErrorIs(t, err, errors.E(errors.SearchNoResults))
+		}
+		return result
+	}
+
+	// the old mapping has no suggest field, so fuzzy matching finds nothing
+	require.NoError(t, idx.Index(ctx, songs))
+	assert.Empty(t, search(t).Songs)
+
+	outdated, err := idx.MappingOutdated()
+	require.NoError(t, err)
+	require.True(t, outdated)
+
+	// until the index is rebuilt with the current mapping
+	require.NoError(t, idx.RebuildIndex(ctx, &mocks.TrackStorageMock{
+		AllFunc: func() ([]radio.Song, error) {
+			return songs, nil
+		},
+	}))
+
+	res := search(t)
+	require.Len(t, res.Songs, 1)
+	assert.True(t, res.Fuzzy)
+	assert.EqualValues(t, 2, res.Songs[0].TrackID)
+	assert.Equal(t, []string{"school punishment"}, res.Suggestions)
+}
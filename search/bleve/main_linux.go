@@ -8,6 +8,7 @@ import (
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/search"
+	"github.com/R-a-dio/valkyrie/storage"
 	"github.com/R-a-dio/valkyrie/util"
 	"github.com/R-a-dio/valkyrie/website"
 	"github.com/Wessie/fdstore"
@@ -23,7 +24,32 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	if err != nil {
 		return errors.E(op, err)
 	}
-	defer idx.index.Close()
+	defer idx.Close()
+
+	outdated, err := idx.MappingOutdated()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if outdated {
+		// the index was made with an older mapping, fields added since then
+		// won't be indexed so we have to rebuild it from scratch
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("index mapping is outdated, rebuilding index")
+		store, err := storage.Open(ctx, cfg)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		// this also fills the completions
+		err = idx.RebuildIndex(ctx, store.Track(ctx))
+		store.Close()
+		if err != nil {
+			return errors.E(op, err)
+		}
+	} else {
+		err = idx.loadCompletions(ctx)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
 
 	srv, err := NewServer(ctx, idx)
 	if err != nil {
@@ -79,6 +105,7 @@ func NewServer(ctx context.Context, idx *indexWrap) (*http.Server, error) {
 	r.Get(searchPath, SearchHandler(idx))
 	r.Get(searchJSONPath, SearchJSONHandler(idx))
 	r.Get(extendedPath, ExtendedSearchHandler(idx))
+	r.Get(completePath, CompleteHandler(idx))
 	r.Get(indexStatsPath, IndexStatsHandler(idx))
 	r.Post(deletePath, DeleteHandler(idx))
 	r.Delete(deletePath, DeleteHandler(idx))
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/analysis"
@@ -152,6 +154,10 @@ type RadioQuery struct {
 	FieldQueries map[string]string `json:"field_queries"`
 	Sort         search.SortOrder  `json:"sort"`
 	ExactOnly    bool              `json:"exact_only"`
+	// Filter restricts the results, it doesn't influence scoring
+	Filter radio.SearchFilter `json:"filter"`
+	// Fuzzy makes Query match with typos allowed
+	Fuzzy bool `json:"fuzzy"`
 }
 
 func NewSearchRequest(query *RadioQuery, limit, offset int) *bleve.SearchRequest {
@@ -368,7 +374,12 @@ func (rq *RadioQuery) Searcher(ctx context.Context, i index.IndexReader, m mappi
 
 	var queries []query.Query
 	if rq.Query != "" {
-		q := rq.generateQuery(m, "_all", rq.Query)
+		var q query.Query
+		if rq.Fuzzy {
+			q = rq.generateFuzzyQuery(m, rq.Query)
+		} else {
+			q = rq.generateQuery(m, "_all", rq.Query)
+		}
 		queries = append(queries, q)
 	}
 
@@ -377,12 +388,20 @@ func (rq *RadioQuery) Searcher(ctx context.Context, i index.IndexReader, m mappi
 		queries = append(queries, q)
 	}
 
+	if rq.RawQuery == "" && !rq.Filter.IsZero() {
+		// no subqueries but we do have a filter, so match everything
+		// that passes the filter
+		queries = append(queries, bleve.NewMatchAllQuery())
+	}
+
 	if len(queries) == 0 {
 		// no subqueries, so we just match nothing
 		noneQuery := query.NewMatchNoneQuery()
 		return noneQuery.Searcher(ctx, i, m, options)
 	}
 
+	queries = append(queries, rq.filterQueries(m, time.Now())...)
+
 	q := query.NewConjunctionQuery(queries)
 	q.SetBoost(1.0)
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"reflect"
 	"strconv"
 	"unsafe"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/util/pool"
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/search"
 	"github.com/rs/zerolog/hlog"
 	"github.com/vmihailenco/msgpack/v4"
 )
@@ -18,11 +20,13 @@ import (
 var (
 	DefaultLimit  = 20
 	DefaultOffset = 0
+	DefaultFacets = 10
 	dataField     = []string{"data"}
 
 	searchPath     = "/search"
 	searchJSONPath = "/search_json"
 	extendedPath   = "/search_extended"
+	completePath   = "/complete"
 	indexStatsPath = "/index_stats"
 	updatePath     = "/update"
 	deletePath     = "/delete"
@@ -37,6 +41,43 @@ var _cache = cache{
 	}),
 }
 
+// SearchResult is the result the search server returns, it's the bleve result
+// with our own additions
+type SearchResult struct {
+	bleve.SearchResult
+	// Suggestions are corrected queries, only set if a fuzzy search was done
+	Suggestions []string `json:"suggestions,omitempty"`
+	// Fuzzy is true if the hits are from a fuzzy search
+	Fuzzy bool `json:"fuzzy,omitempty"`
+}
+
+func init() {
+	// TermFacets only has unexported fields, so we have to tell msgpack how to
+	// encode it or we lose the facet terms between server and client
+	msgpack.Register((*search.TermFacets)(nil),
+		func(enc *msgpack.Encoder, v reflect.Value) error {
+			if v.IsNil() {
+				return enc.EncodeNil()
+			}
+			return enc.Encode(v.Interface().(*search.TermFacets).Terms())
+		},
+		func(dec *msgpack.Decoder, v reflect.Value) error {
+			var terms []*search.TermFacet
+			if err := dec.Decode(&terms); err != nil {
+				return err
+			}
+			if terms == nil {
+				v.Set(reflect.Zero(v.Type()))
+				return nil
+			}
+			tf := new(search.TermFacets)
+			tf.Add(terms...)
+			v.Set(reflect.ValueOf(tf))
+			return nil
+		},
+	)
+}
+
 type cache struct {
 	enc *pool.Pool[*msgpack.Encoder]
 	dec *pool.Pool[*msgpack.Decoder]
@@ -98,7 +139,7 @@ func AsIntOrDefault(s string, def int) int {
 
 func IndexStatsHandler(idx *indexWrap) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		stats := idx.index.Stats()
+		stats := idx.Stats()
 		enc := json.NewEncoder(w)
 		enc.SetIndent("", "  ")
 		enc.Encode(stats)
@@ -126,6 +167,26 @@ func SearchHandler(idx *indexWrap) http.HandlerFunc {
 	}
 }
 
+func CompleteHandler(idx *indexWrap) http.HandlerFunc {
+	const op errors.Op = "search/bleve.CompleteHandler"
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := AsIntOrDefault(r.FormValue("limit"), DefaultCompleteLimit)
+		limit = min(limit, MaxCompleteLimit)
+
+		res := idx.Complete(r.FormValue("q"), limit)
+
+		enc := _cache.enc.Get()
+		enc.Reset(w)
+		defer _cache.enc.Put(enc)
+		if err := enc.Encode(res); err != nil {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to encode")
+			return
+		}
+	}
+}
+
 func SearchJSONHandler(idx *indexWrap) http.HandlerFunc {
 	const op errors.Op = "search/bleve.SearchJSONHandler"
 
@@ -152,7 +213,7 @@ func SearchJSONHandler(idx *indexWrap) http.HandlerFunc {
 	}
 }
 
-func encodeResult(dst io.Writer, result *bleve.SearchResult) error {
+func encodeResult(dst io.Writer, result *SearchResult) error {
 	const op errors.Op = "search/bleve.encodeResult"
 
 	enc := _cache.enc.Get()
@@ -164,7 +225,7 @@ func encodeResult(dst io.Writer, result *bleve.SearchResult) error {
 	return nil
 }
 
-func decodeResult(src io.Reader, result *bleve.SearchResult) error {
+func decodeResult(src io.Reader, result *SearchResult) error {
 	const op errors.Op = "search/bleve.decodeResult"
 
 	dec := _cache.dec.Get()
@@ -212,16 +273,19 @@ func decodeError(src io.Reader) *SearchError {
 	return &se
 }
 
-func bleveToRadio(result *bleve.SearchResult) (radio.SearchResult, error) {
+func bleveToRadio(result *SearchResult) (radio.SearchResult, error) {
 	const op errors.Op = "search/bleve.bleveToRadio"
 
 	var res radio.SearchResult
+	res.Suggestions = result.Suggestions
+	res.Fuzzy = result.Fuzzy
 
 	if len(result.Hits) == 0 {
 		return res, errors.E(op, errors.SearchNoResults)
 	}
 
 	res.TotalHits = int(result.Total)
+	res.Facets = bleveToFacets(result.Facets)
 	res.Songs = make([]radio.Song, len(result.Hits))
 	for i, hit := range result.Hits {
 		tmp, ok := hit.Fields["data"].(string)
@@ -238,6 +302,45 @@ func bleveToRadio(result *bleve.SearchResult) (radio.SearchResult, error) {
 	return res, nil
 }
 
+// ExtendedSearchHandler is like SearchJSONHandler but returns the songs decoded
+// and includes facet counts, DefaultFacets are returned if none were asked for
 func ExtendedSearchHandler(idx *indexWrap) http.HandlerFunc {
-	return nil
+	const op errors.Op = "search/bleve.ExtendedSearchHandler"
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("facets") == "" {
+			r.Form.Set("facets", strconv.Itoa(DefaultFacets))
+		}
+
+		result, err := idx.SearchFromRequest(r)
+		if err != nil {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to search")
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(&SearchError{
+				Err: err.Error(),
+			})
+			return
+		}
+
+		res, err := bleveToRadio(result)
+		if err != nil && !errors.Is(errors.SearchNoResults, err) {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to convert")
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(&SearchError{
+				Err: err.Error(),
+			})
+			return
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(res)
+		if err != nil {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to encode")
+			return
+		}
+	}
 }
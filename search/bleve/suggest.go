@@ -0,0 +1,206 @@
+package bleve
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	// suggestField is the field holding the artist and title terms, including
+	// romaji variants, that fuzzy matching is done against
+	suggestField = "suggest"
+
+	// MaxSuggestions is the maximum amount of suggestions returned
+	MaxSuggestions = 3
+)
+
+// fuzziness returns the edit distance we allow for the term given, short
+// terms get less leeway since they'd match too much otherwise
+func fuzziness(term string) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// suggestTerms analyzes q with the suggest analyzer and returns the terms grouped
+// per word, the first term of each group is the original and the rest are variants
+func suggestTerms(m mapping.IndexMapping, q string) [][]string {
+	tokens := m.AnalyzerNamed(suggestAnalyzerName).Analyze([]byte(q))
+
+	var groups [][]string
+	var last *analysis.Token
+	for _, token := range tokens {
+		if last != nil && last.Start == token.Start {
+			// same starting position means this is a variant of the previous
+			groups[len(groups)-1] = append(groups[len(groups)-1], string(token.Term))
+			continue
+		}
+		groups = append(groups, []string{string(token.Term)})
+		last = token
+	}
+	return groups
+}
+
+// generateFuzzyQuery generates a query that matches the terms in q with some
+// typos allowed, all words need to match but any variant of a word is fine
+func (rq *RadioQuery) generateFuzzyQuery(m mapping.IndexMapping, q string) query.Query {
+	groups := suggestTerms(m, q)
+	if len(groups) == 0 {
+		return query.NewMatchNoneQuery()
+	}
+
+	words := make([]query.Query, 0, len(groups))
+	for _, variants := range groups {
+		queries := make([]query.Query, 0, len(variants))
+		for _, term := range variants {
+			fq := query.NewFuzzyQuery(term)
+			fq.SetField(suggestField)
+			fq.SetFuzziness(fuzziness(term))
+			queries = append(queries, fq)
+		}
+		words = append(words, query.NewDisjunctionQuery(queries))
+	}
+	return query.NewConjunctionQuery(words)
+}
+
+// fuzzySearch runs req again with fuzzy matching enabled and returns the result
+// together with suggestions built from the terms that matched, b.mu should be
+// read locked
+func (b *indexWrap) fuzzySearch(ctx context.Context, rq *RadioQuery, req *bleve.SearchRequest) (*SearchResult, error) {
+	const op errors.Op = "search/bleve.fuzzySearch"
+
+	fq := *rq
+	fq.Fuzzy = true
+
+	freq := *req
+	freq.Query = &fq
+	// we need the locations to know what terms we matched on
+	freq.IncludeLocations = true
+
+	result, err := b.index.SearchInContext(ctx, &freq)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	suggestions := suggest(suggestTerms(b.index.Mapping(), rq.Query), result.Hits)
+	for _, hit := range result.Hits {
+		// don't send the locations to the client, nobody uses them
+		hit.Locations = nil
+	}
+
+	return &SearchResult{
+		SearchResult: *result,
+		Suggestions:  suggestions,
+		Fuzzy:        result.Total > 0,
+	}, nil
+}
+
+type candidate struct {
+	term     string
+	distance int
+	count    int
+}
+
+// suggest returns corrected queries for the query terms given, corrections are
+// picked from the terms the hits matched on
+func suggest(groups [][]string, hits search.DocumentMatchCollection) []string {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	// count in how many documents each term matched
+	matched := make(map[string]int)
+	for _, hit := range hits {
+		for term := range hit.Locations[suggestField] {
+			matched[term]++
+		}
+	}
+
+	// find the candidates for each word, best one first
+	words := make([][]candidate, len(groups))
+	for i, variants := range groups {
+		for term, count := range matched {
+			distance := -1
+			for _, v := range variants {
+				d := search.LevenshteinDistance(v, term)
+				if d <= fuzziness(v) && (distance == -1 || d < distance) {
+					distance = d
+				}
+			}
+			if distance == -1 {
+				continue
+			}
+			words[i] = append(words[i], candidate{term, distance, count})
+		}
+
+		slices.SortFunc(words[i], func(a, b candidate) int {
+			if a.distance != b.distance {
+				return a.distance - b.distance
+			}
+			if a.count != b.count {
+				return b.count - a.count
+			}
+			return strings.Compare(a.term, b.term)
+		})
+
+		if len(words[i]) == 0 {
+			// nothing close, keep the original
+			words[i] = []candidate{{term: variants[0]}}
+		}
+	}
+
+	original := make([]string, len(groups))
+	for i, variants := range groups {
+		original[i] = variants[0]
+	}
+
+	var res []string
+	add := func(pick []int) {
+		terms := make([]string, len(words))
+		for i, j := range pick {
+			terms[i] = words[i][j].term
+		}
+		if slices.Equal(terms, original) {
+			return
+		}
+		s := strings.Join(terms, " ")
+		if !slices.Contains(res, s) {
+			res = append(res, s)
+		}
+	}
+
+	// the best candidate for every word first
+	pick := make([]int, len(words))
+	add(pick)
+	// and then the runner-up for each word in turn
+	for i := range words {
+		if len(res) >= MaxSuggestions {
+			break
+		}
+		if len(words[i]) < 2 {
+			continue
+		}
+		pick[i] = 1
+		add(pick)
+		pick[i] = 0
+	}
+
+	if len(res) > MaxSuggestions {
+		res = res[:MaxSuggestions]
+	}
+	return res
+}
@@ -46,6 +46,10 @@ func (bg background) Search(ctx context.Context, query string, opt radio.SearchO
 	return bg.search.Search(ctx, query, opt)
 }
 
+func (bg background) Complete(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+	return bg.search.Complete(ctx, prefix, limit)
+}
+
 // partialStorage is an interface containing all the methods we are NOT interested in.
 type partialStorage interface {
 	radio.SessionStorageService
@@ -58,6 +62,16 @@ type partialStorage interface {
 	radio.SubmissionStorageService
 	radio.NewsStorageService
 	radio.ScheduleStorageService
+	radio.APITokenStorageService
+	radio.AuditStorageService
+	radio.ListenerAccountStorageService
+	radio.FingerprintStorageService
+	radio.RecommendationStorageService
+	radio.LeaseStorageService
+	radio.JournalStorageService
+	radio.GuestStorageService
+	radio.RequestPoolStorageService
+	radio.LyricsStorageService
 	Close() error
 }
 
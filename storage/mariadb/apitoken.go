@@ -0,0 +1,229 @@
+package mariadb
+
+import (
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// APITokenStorage implements radio.APITokenStorage
+type APITokenStorage struct {
+	handle handle
+}
+
+const apiTokenColumns = `
+	api_tokens.id AS id,
+	api_tokens.user_id AS userid,
+	api_tokens.name AS name,
+	api_tokens.hash AS hash,
+	NULLIF(api_tokens.scopes, '') AS scopes,
+	api_tokens.created_at AS created_at,
+	api_tokens.last_used_at AS lastusedat,
+	api_tokens.expires_at AS expiresat
+`
+
+type APITokenCreateParams struct {
+	UserID    radio.UserID
+	Name      string
+	Hash      radio.APITokenHash
+	Scopes    string
+	ExpiresAt *time.Time
+}
+
+const apiTokenCreateQuery = `
+INSERT INTO
+	api_tokens (
+		user_id,
+		name,
+		hash,
+		scopes,
+		created_at,
+		expires_at
+	) VALUES (
+		:userid,
+		:name,
+		:hash,
+		:scopes,
+		NOW(),
+		:expiresat
+	);
+`
+
+var _ = CheckQuery[APITokenCreateParams](apiTokenCreateQuery)
+
+// Create implements radio.APITokenStorage
+func (as APITokenStorage) Create(token radio.APIToken) (radio.APITokenID, error) {
+	const op errors.Op = "mariadb/APITokenStorage.Create"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	if token.UserID == 0 {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("missing user id"))
+	}
+
+	// store the scopes as a sorted comma separated list
+	scopes := make([]string, 0, len(token.Scopes))
+	for perm := range token.Scopes {
+		scopes = append(scopes, string(perm))
+	}
+	slices.Sort(scopes)
+
+	new, err := namedExecLastInsertId(handle, apiTokenCreateQuery, APITokenCreateParams{
+		UserID:    token.UserID,
+		Name:      token.Name,
+		Hash:      token.Hash,
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: token.ExpiresAt,
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	return radio.APITokenID(new), nil
+}
+
+type APITokenGetParams struct {
+	Hash radio.APITokenHash
+}
+
+var apiTokenGetQuery = `
+SELECT
+` + apiTokenColumns + `
+FROM
+	api_tokens
+WHERE
+	api_tokens.hash=:hash;
+`
+
+var _ = CheckQuery[APITokenGetParams](apiTokenGetQuery)
+
+// Get implements radio.APITokenStorage
+func (as APITokenStorage) Get(hash radio.APITokenHash) (*radio.APIToken, error) {
+	const op errors.Op = "mariadb/APITokenStorage.Get"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	var token radio.APIToken
+
+	err := handle.Get(&token, apiTokenGetQuery, APITokenGetParams{
+		Hash: hash,
+	})
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.TokenUnknown)
+		}
+		return nil, errors.E(op, err)
+	}
+
+	return &token, nil
+}
+
+type APITokenListByUserParams struct {
+	UserID radio.UserID
+}
+
+var apiTokenListByUserQuery = `
+SELECT
+` + apiTokenColumns + `
+FROM
+	api_tokens
+WHERE
+	api_tokens.user_id=:userid
+ORDER BY
+	api_tokens.created_at DESC, api_tokens.id DESC;
+`
+
+var _ = CheckQuery[APITokenListByUserParams](apiTokenListByUserQuery)
+
+// ListByUser implements radio.APITokenStorage
+func (as APITokenStorage) ListByUser(id radio.UserID) ([]radio.APIToken, error) {
+	const op errors.Op = "mariadb/APITokenStorage.ListByUser"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	var tokens = []radio.APIToken{}
+
+	err := handle.Select(&tokens, apiTokenListByUserQuery, APITokenListByUserParams{
+		UserID: id,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return tokens, nil
+}
+
+type APITokenDeleteParams struct {
+	UserID radio.UserID
+	ID     radio.APITokenID
+}
+
+const apiTokenDeleteQuery = `
+DELETE FROM
+	api_tokens
+WHERE
+	id=:id AND user_id=:userid;
+`
+
+var _ = CheckQuery[APITokenDeleteParams](apiTokenDeleteQuery)
+
+// Delete implements radio.APITokenStorage
+func (as APITokenStorage) Delete(userID radio.UserID, id radio.APITokenID) error {
+	const op errors.Op = "mariadb/APITokenStorage.Delete"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, apiTokenDeleteQuery, APITokenDeleteParams{
+		UserID: userID,
+		ID:     id,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n == 0 {
+		return errors.E(op, errors.TokenUnknown)
+	}
+
+	return nil
+}
+
+type APITokenUpdateLastUsedParams struct {
+	ID radio.APITokenID
+}
+
+const apiTokenUpdateLastUsedQuery = `
+UPDATE
+	api_tokens
+SET
+	last_used_at=NOW()
+WHERE
+	id=:id;
+`
+
+var _ = CheckQuery[APITokenUpdateLastUsedParams](apiTokenUpdateLastUsedQuery)
+
+// UpdateLastUsed implements radio.APITokenStorage
+func (as APITokenStorage) UpdateLastUsed(id radio.APITokenID) error {
+	const op errors.Op = "mariadb/APITokenStorage.UpdateLastUsed"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	_, err := sqlx.NamedExec(handle, apiTokenUpdateLastUsedQuery, APITokenUpdateLastUsedParams{
+		ID: id,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	return nil
+}
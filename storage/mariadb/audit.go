@@ -0,0 +1,152 @@
+package mariadb
+
+import (
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// AuditStorage implements radio.AuditStorage
+type AuditStorage struct {
+	handle handle
+}
+
+const auditColumns = `
+	audit_log.id AS id,
+	audit_log.actor_id AS actorid,
+	audit_log.actor AS actor,
+	audit_log.action AS action,
+	audit_log.target AS target,
+	audit_log.data_before AS before,
+	audit_log.data_after AS after,
+	audit_log.ip AS ip,
+	audit_log.created_at AS created_at
+`
+
+type AuditAddParams struct {
+	ActorID radio.UserID
+	Actor   string
+	Action  radio.AuditAction
+	Target  string
+	Before  string
+	After   string
+	IP      string
+}
+
+const auditAddQuery = `
+INSERT INTO
+	audit_log (
+		actor_id,
+		actor,
+		action,
+		target,
+		data_before,
+		data_after,
+		ip,
+		created_at
+	) VALUES (
+		:actorid,
+		:actor,
+		:action,
+		:target,
+		:before,
+		:after,
+		:ip,
+		NOW()
+	);
+`
+
+var _ = CheckQuery[AuditAddParams](auditAddQuery)
+
+// Add implements radio.AuditStorage
+func (as AuditStorage) Add(entry radio.AuditEntry) (radio.AuditEntryID, error) {
+	const op errors.Op = "mariadb/AuditStorage.Add"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	if entry.Action == "" {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("missing action"))
+	}
+
+	new, err := namedExecLastInsertId(handle, auditAddQuery, AuditAddParams{
+		ActorID: entry.ActorID,
+		Actor:   entry.Actor,
+		Action:  entry.Action,
+		Target:  entry.Target,
+		Before:  entry.Before,
+		After:   entry.After,
+		IP:      entry.IP,
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	return radio.AuditEntryID(new), nil
+}
+
+type AuditSearchParams struct {
+	Actor  string
+	Action radio.AuditAction
+	Target string
+	Limit  int64
+	Offset int64
+}
+
+const auditSearchWhere = `
+WHERE
+	(:actor = '' OR audit_log.actor = :actor) AND
+	(:action = '' OR audit_log.action = :action) AND
+	(:target = '' OR audit_log.target LIKE CONCAT('%', :target, '%'))
+`
+
+var auditSearchQuery = `
+SELECT
+` + auditColumns + `
+FROM
+	audit_log
+` + auditSearchWhere + `
+ORDER BY
+	audit_log.created_at DESC, audit_log.id DESC
+LIMIT :limit OFFSET :offset;
+`
+
+var _ = CheckQuery[AuditSearchParams](auditSearchQuery)
+
+var auditSearchCountQuery = `
+SELECT
+	COUNT(*) AS total
+FROM
+	audit_log
+` + auditSearchWhere + `;`
+
+var _ = CheckQuery[AuditSearchParams](auditSearchCountQuery)
+
+// Search implements radio.AuditStorage
+func (as AuditStorage) Search(filter radio.AuditFilter, limit, offset int64) (radio.AuditList, error) {
+	const op errors.Op = "mariadb/AuditStorage.Search"
+	handle, deferFn := as.handle.span(op)
+	defer deferFn()
+
+	params := AuditSearchParams{
+		Actor:  filter.Actor,
+		Action: filter.Action,
+		Target: filter.Target,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	var list = radio.AuditList{
+		Entries: make([]radio.AuditEntry, 0, limit),
+	}
+
+	err := handle.Select(&list.Entries, auditSearchQuery, params)
+	if err != nil {
+		return radio.AuditList{}, errors.E(op, err)
+	}
+
+	err = handle.Get(&list.Total, auditSearchCountQuery, params)
+	if err != nil {
+		return radio.AuditList{}, errors.E(op, err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,119 @@
+package mariadb
+
+import (
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// FingerprintStorage implements radio.FingerprintStorage
+type FingerprintStorage struct {
+	handle handle
+}
+
+const fingerprintStoreQuery = `
+INSERT INTO
+	track_fingerprints (
+		track_id,
+		length,
+		fingerprint,
+		created_at
+	) VALUES (
+		:trackid,
+		from_go_duration(:length),
+		:fingerprint,
+		NOW()
+	)
+ON DUPLICATE KEY UPDATE
+	length=from_go_duration(:length),
+	fingerprint=:fingerprint,
+	created_at=NOW();
+`
+
+var _ = CheckQuery[radio.TrackFingerprint](fingerprintStoreQuery)
+
+// Store implements radio.FingerprintStorage
+func (fs FingerprintStorage) Store(fp radio.TrackFingerprint) error {
+	const op errors.Op = "mariadb/FingerprintStorage.Store"
+	handle, deferFn := fs.handle.span(op)
+	defer deferFn()
+
+	if fp.TrackID == 0 {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing track id"))
+	}
+	if len(fp.Fingerprint) == 0 {
+		return errors.E(op, errors.InvalidArgument, errors.Info("empty fingerprint"))
+	}
+
+	_, err := sqlx.NamedExec(handle, fingerprintStoreQuery, fp)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+type FingerprintCandidatesParams struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+const fingerprintCandidatesQuery = `
+SELECT
+	track_fingerprints.track_id AS trackid,
+	to_go_duration(track_fingerprints.length) AS length,
+	track_fingerprints.fingerprint AS fingerprint
+FROM
+	track_fingerprints
+WHERE
+	track_fingerprints.length BETWEEN from_go_duration(:min) AND from_go_duration(:max);
+`
+
+var _ = CheckQuery[FingerprintCandidatesParams](fingerprintCandidatesQuery)
+
+// Candidates implements radio.FingerprintStorage
+func (fs FingerprintStorage) Candidates(length, margin time.Duration) ([]radio.TrackFingerprint, error) {
+	const op errors.Op = "mariadb/FingerprintStorage.Candidates"
+	handle, deferFn := fs.handle.span(op)
+	defer deferFn()
+
+	var res []radio.TrackFingerprint
+
+	err := handle.Select(&res, fingerprintCandidatesQuery, FingerprintCandidatesParams{
+		Min: max(length-margin, 0),
+		Max: length + margin,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+const fingerprintMissingQuery = `
+SELECT
+	tracks.id
+FROM
+	tracks
+LEFT JOIN
+	track_fingerprints ON track_fingerprints.track_id = tracks.id
+WHERE
+	track_fingerprints.track_id IS NULL AND tracks.usable = 1;
+`
+
+var _ = CheckQuery[NoParams](fingerprintMissingQuery)
+
+// Missing implements radio.FingerprintStorage
+func (fs FingerprintStorage) Missing() ([]radio.TrackID, error) {
+	const op errors.Op = "mariadb/FingerprintStorage.Missing"
+	handle, deferFn := fs.handle.span(op)
+	defer deferFn()
+
+	var res []radio.TrackID
+
+	err := handle.Select(&res, fingerprintMissingQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,350 @@
+package mariadb
+
+import (
+	"database/sql"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// GuestStorage implements radio.GuestStorage
+type GuestStorage struct {
+	handle handle
+}
+
+const guestInviteColumns = `
+	guest_invites.id AS id,
+	guest_invites.code AS code,
+	guest_invites.nick AS nick,
+	guest_invites.created_by AS createdby,
+	guest_invites.created_at AS created_at,
+	guest_invites.starts_at AS start,
+	guest_invites.ends_at AS end,
+	guest_invites.mount AS mount,
+	guest_invites.priority AS priority,
+	guest_invites.kill_limit AS killlimit,
+	guest_invites.thread_limit AS threadlimit,
+	guest_invites.redeemed_by AS redeemedby,
+	guest_invites.redeemed_at AS redeemedat
+`
+
+const guestCreateInviteQuery = `
+INSERT INTO
+	guest_invites (
+		code,
+		nick,
+		created_by,
+		created_at,
+		starts_at,
+		ends_at,
+		mount,
+		priority,
+		kill_limit,
+		thread_limit
+	) VALUES (
+		:code,
+		:nick,
+		:createdby,
+		NOW(6),
+		:start,
+		:end,
+		:mount,
+		:priority,
+		:killlimit,
+		:threadlimit
+	);
+`
+
+var _ = CheckQuery[radio.GuestInvite](guestCreateInviteQuery)
+
+// CreateInvite implements radio.GuestStorage
+func (gs GuestStorage) CreateInvite(invite radio.GuestInvite) (radio.GuestInviteID, error) {
+	const op errors.Op = "mariadb/GuestStorage.CreateInvite"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	if invite.Code == "" {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("missing code"))
+	}
+	if !invite.Start.Before(invite.End) {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("start is not before end"))
+	}
+
+	new, err := namedExecLastInsertId(handle, guestCreateInviteQuery, invite)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	return radio.GuestInviteID(new), nil
+}
+
+var guestInvitesQuery = `
+SELECT
+` + guestInviteColumns + `
+FROM
+	guest_invites
+WHERE
+	guest_invites.ends_at > NOW(6)
+ORDER BY
+	guest_invites.starts_at ASC, guest_invites.id ASC;
+`
+
+var _ = CheckQuery[NoParams](guestInvitesQuery)
+
+// Invites implements radio.GuestStorage
+func (gs GuestStorage) Invites() ([]radio.GuestInvite, error) {
+	const op errors.Op = "mariadb/GuestStorage.Invites"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	var invites = []radio.GuestInvite{}
+
+	err := handle.Select(&invites, guestInvitesQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return invites, nil
+}
+
+type GuestRedeemInviteParams struct {
+	Code string
+	Nick string
+}
+
+// guestRedeemInviteQuery only redeems invites that haven't been redeemed yet,
+// haven't ended and are either for anyone or for the nick given
+const guestRedeemInviteQuery = `
+UPDATE
+	guest_invites
+SET
+	redeemed_by=:nick,
+	redeemed_at=NOW(6)
+WHERE
+	code=:code AND
+	redeemed_at IS NULL AND
+	ends_at > NOW(6) AND
+	(nick='' OR nick=:nick);
+`
+
+var _ = CheckQuery[GuestRedeemInviteParams](guestRedeemInviteQuery)
+
+var guestInviteByCodeQuery = `
+SELECT
+` + guestInviteColumns + `
+FROM
+	guest_invites
+WHERE
+	guest_invites.code=:code;
+`
+
+var _ = CheckQuery[GuestRedeemInviteParams](guestInviteByCodeQuery)
+
+// RedeemInvite implements radio.GuestStorage
+func (gs GuestStorage) RedeemInvite(code string, nick string) (*radio.GuestInvite, error) {
+	const op errors.Op = "mariadb/GuestStorage.RedeemInvite"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	handle, tx, err := requireTx(handle)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	params := GuestRedeemInviteParams{
+		Code: code,
+		Nick: nick,
+	}
+
+	res, err := sqlx.NamedExec(handle, guestRedeemInviteQuery, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if n == 0 {
+		return nil, errors.E(op, errors.GuestInviteUnknown)
+	}
+
+	var invite radio.GuestInvite
+
+	err = handle.Get(&invite, guestInviteByCodeQuery, params)
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.GuestInviteUnknown)
+		}
+		return nil, errors.E(op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &invite, nil
+}
+
+type GuestDeleteInviteParams struct {
+	ID radio.GuestInviteID
+}
+
+const guestDeleteInviteQuery = `
+DELETE FROM
+	guest_invites
+WHERE
+	id=:id;
+`
+
+var _ = CheckQuery[GuestDeleteInviteParams](guestDeleteInviteQuery)
+
+// DeleteInvite implements radio.GuestStorage
+func (gs GuestStorage) DeleteInvite(id radio.GuestInviteID) error {
+	const op errors.Op = "mariadb/GuestStorage.DeleteInvite"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, guestDeleteInviteQuery, GuestDeleteInviteParams{
+		ID: id,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n == 0 {
+		return errors.E(op, errors.GuestInviteUnknown)
+	}
+	return nil
+}
+
+const guestSaveAuthQuery = `
+INSERT INTO
+	guest_auths (
+		nick,
+		user_id,
+		invite_id,
+		starts_at,
+		ends_at,
+		mount,
+		priority,
+		kill_limit,
+		thread_limit,
+		has_streamed,
+		thread_sets,
+		kill_attempts
+	) VALUES (
+		:nick,
+		:userid,
+		:inviteid,
+		:start,
+		:end,
+		:mount,
+		:priority,
+		:killlimit,
+		:threadlimit,
+		:hasstreamed,
+		:threadsets,
+		:killattempts
+	)
+ON DUPLICATE KEY UPDATE
+	user_id=:userid,
+	invite_id=:inviteid,
+	starts_at=:start,
+	ends_at=:end,
+	mount=:mount,
+	priority=:priority,
+	kill_limit=:killlimit,
+	thread_limit=:threadlimit,
+	has_streamed=:hasstreamed,
+	thread_sets=:threadsets,
+	kill_attempts=:killattempts;
+`
+
+var _ = CheckQuery[radio.GuestAuth](guestSaveAuthQuery)
+
+// SaveAuth implements radio.GuestStorage
+func (gs GuestStorage) SaveAuth(auth radio.GuestAuth) error {
+	const op errors.Op = "mariadb/GuestStorage.SaveAuth"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	if auth.Nick == "" {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing nick"))
+	}
+
+	_, err := sqlx.NamedExec(handle, guestSaveAuthQuery, auth)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+type GuestDeleteAuthParams struct {
+	Nick string
+}
+
+const guestDeleteAuthQuery = `
+DELETE FROM
+	guest_auths
+WHERE
+	nick=:nick;
+`
+
+var _ = CheckQuery[GuestDeleteAuthParams](guestDeleteAuthQuery)
+
+// DeleteAuth implements radio.GuestStorage
+func (gs GuestStorage) DeleteAuth(nick string) error {
+	const op errors.Op = "mariadb/GuestStorage.DeleteAuth"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	_, err := sqlx.NamedExec(handle, guestDeleteAuthQuery, GuestDeleteAuthParams{
+		Nick: nick,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+const guestAuthsQuery = `
+SELECT
+	nick,
+	user_id AS userid,
+	invite_id AS inviteid,
+	starts_at AS start,
+	ends_at AS end,
+	mount,
+	priority,
+	kill_limit AS killlimit,
+	thread_limit AS threadlimit,
+	has_streamed AS hasstreamed,
+	thread_sets AS threadsets,
+	kill_attempts AS killattempts
+FROM
+	guest_auths
+ORDER BY
+	starts_at ASC;
+`
+
+var _ = CheckQuery[NoParams](guestAuthsQuery)
+
+// Auths implements radio.GuestStorage
+func (gs GuestStorage) Auths() ([]radio.GuestAuth, error) {
+	const op errors.Op = "mariadb/GuestStorage.Auths"
+	handle, deferFn := gs.handle.span(op)
+	defer deferFn()
+
+	var auths = []radio.GuestAuth{}
+
+	err := handle.Select(&auths, guestAuthsQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return auths, nil
+}
@@ -454,6 +454,150 @@ func (s *StorageService) ScheduleTx(ctx context.Context, tx radio.StorageTx) (ra
 	return storage, tx, nil
 }
 
+func (s *StorageService) APIToken(ctx context.Context) radio.APITokenStorage {
+	return APITokenStorage{
+		handle: newHandle(ctx, s.db, "apitoken"),
+	}
+}
+
+func (s *StorageService) APITokenTx(ctx context.Context, tx radio.StorageTx) (radio.APITokenStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := APITokenStorage{
+		handle: newHandle(ctx, db, "apitoken"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Audit(ctx context.Context) radio.AuditStorage {
+	return AuditStorage{
+		handle: newHandle(ctx, s.db, "audit"),
+	}
+}
+
+func (s *StorageService) AuditTx(ctx context.Context, tx radio.StorageTx) (radio.AuditStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := AuditStorage{
+		handle: newHandle(ctx, db, "audit"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) ListenerAccount(ctx context.Context) radio.ListenerAccountStorage {
+	return ListenerAccountStorage{
+		handle: newHandle(ctx, s.db, "listener"),
+	}
+}
+
+func (s *StorageService) ListenerAccountTx(ctx context.Context, tx radio.StorageTx) (radio.ListenerAccountStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := ListenerAccountStorage{
+		handle: newHandle(ctx, db, "listener"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Fingerprint(ctx context.Context) radio.FingerprintStorage {
+	return FingerprintStorage{
+		handle: newHandle(ctx, s.db, "fingerprint"),
+	}
+}
+
+func (s *StorageService) FingerprintTx(ctx context.Context, tx radio.StorageTx) (radio.FingerprintStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := FingerprintStorage{
+		handle: newHandle(ctx, db, "fingerprint"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Recommendation(ctx context.Context) radio.RecommendationStorage {
+	return RecommendationStorage{
+		handle: newHandle(ctx, s.db, "recommendation"),
+	}
+}
+
+func (s *StorageService) RecommendationTx(ctx context.Context, tx radio.StorageTx) (radio.RecommendationStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := RecommendationStorage{
+		handle: newHandle(ctx, db, "recommendation"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Lease(ctx context.Context) radio.LeaseStorage {
+	return LeaseStorage{
+		handle: newHandle(ctx, s.db, "lease"),
+	}
+}
+
+func (s *StorageService) Journal(ctx context.Context) radio.JournalStorage {
+	return JournalStorage{
+		handle: newHandle(ctx, s.db, "journal"),
+	}
+}
+
+func (s *StorageService) Guest(ctx context.Context) radio.GuestStorage {
+	return GuestStorage{
+		handle: newHandle(ctx, s.db, "guest"),
+	}
+}
+
+func (s *StorageService) RequestPool(ctx context.Context) radio.RequestPoolStorage {
+	return RequestPoolStorage{
+		handle: newHandle(ctx, s.db, "request_pool"),
+	}
+}
+
+func (s *StorageService) RequestPoolTx(ctx context.Context, tx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := RequestPoolStorage{
+		handle: newHandle(ctx, db, "request_pool"),
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Lyrics(ctx context.Context) radio.LyricsStorage {
+	return LyricsStorage{
+		handle: newHandle(ctx, s.db, "lyrics"),
+	}
+}
+
+func (s *StorageService) LyricsTx(ctx context.Context, tx radio.StorageTx) (radio.LyricsStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := LyricsStorage{
+		handle: newHandle(ctx, db, "lyrics"),
+	}
+	return storage, tx, nil
+}
+
 type extContext interface {
 	sqlx.ExecerContext
 	sqlx.QueryerContext
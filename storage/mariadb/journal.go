@@ -0,0 +1,188 @@
+package mariadb
+
+import (
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// JournalStorage implements radio.JournalStorage
+type JournalStorage struct {
+	handle handle
+}
+
+const journalAppendQuery = `
+INSERT INTO
+	manager_journal (
+		kind,
+		time,
+		source,
+		data
+	) VALUES (
+		:kind,
+		:time,
+		:source,
+		:data
+	);
+`
+
+var _ = CheckQuery[radio.JournalEntry](journalAppendQuery)
+
+// Append implements radio.JournalStorage
+func (js JournalStorage) Append(entry radio.JournalEntry) error {
+	const op errors.Op = "mariadb/JournalStorage.Append"
+	handle, deferFn := js.handle.span(op)
+	defer deferFn()
+
+	if entry.Kind == "" {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing kind"))
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	_, err := sqlx.NamedExec(handle, journalAppendQuery, entry)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+type JournalRangeParams struct {
+	From time.Time
+	To   time.Time
+}
+
+const journalRangeQuery = `
+SELECT
+	id,
+	kind,
+	time,
+	source,
+	data
+FROM
+	manager_journal
+WHERE
+	time BETWEEN :from AND :to
+ORDER BY
+	time ASC, id ASC;
+`
+
+var _ = CheckQuery[JournalRangeParams](journalRangeQuery)
+
+// Range implements radio.JournalStorage
+func (js JournalStorage) Range(from, to time.Time) ([]radio.JournalEntry, error) {
+	const op errors.Op = "mariadb/JournalStorage.Range"
+	handle, deferFn := js.handle.span(op)
+	defer deferFn()
+
+	var entries []radio.JournalEntry
+
+	err := handle.Select(&entries, journalRangeQuery, JournalRangeParams{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return entries, nil
+}
+
+type JournalLastParams struct {
+	Before time.Time
+}
+
+const journalLastQuery = `
+SELECT
+	id,
+	kind,
+	time,
+	source,
+	data
+FROM
+	manager_journal
+WHERE
+	id IN (
+		SELECT
+			MAX(id)
+		FROM
+			manager_journal
+		WHERE
+			time <= :before
+		GROUP BY
+			kind
+	)
+ORDER BY
+	time ASC, id ASC;
+`
+
+var _ = CheckQuery[JournalLastParams](journalLastQuery)
+
+// Last implements radio.JournalStorage
+func (js JournalStorage) Last(before time.Time) ([]radio.JournalEntry, error) {
+	const op errors.Op = "mariadb/JournalStorage.Last"
+	handle, deferFn := js.handle.span(op)
+	defer deferFn()
+
+	var entries []radio.JournalEntry
+
+	err := handle.Select(&entries, journalLastQuery, JournalLastParams{
+		Before: before,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return entries, nil
+}
+
+type JournalPruneParams struct {
+	Before time.Time
+}
+
+// journalPruneQuery deletes everything before the time given except for the
+// latest entry of each kind before it, Last needs those to know the state at
+// the start of what is kept. The extra derived table is needed since mariadb doesn't
+// allow selecting from the table we're deleting from directly
+const journalPruneQuery = `
+DELETE FROM
+	manager_journal
+WHERE
+	time < :before
+AND
+	id NOT IN (
+		SELECT id FROM (
+			SELECT
+				MAX(id) AS id
+			FROM
+				manager_journal
+			WHERE
+				time < :before
+			GROUP BY
+				kind
+		) AS latest
+	);
+`
+
+var _ = CheckQuery[JournalPruneParams](journalPruneQuery)
+
+// Prune implements radio.JournalStorage
+func (js JournalStorage) Prune(before time.Time) (int64, error) {
+	const op errors.Op = "mariadb/JournalStorage.Prune"
+	handle, deferFn := js.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, journalPruneQuery, JournalPruneParams{
+		Before: before,
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
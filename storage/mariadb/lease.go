@@ -0,0 +1,117 @@
+package mariadb
+
+import (
+	"time"
+
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// LeaseStorage implements radio.LeaseStorage
+type LeaseStorage struct {
+	handle handle
+}
+
+type LeaseParams struct {
+	Name   string
+	Holder string
+	// TTL is in microseconds
+	TTL int64
+}
+
+// leaseAcquireQuery takes over the lease if it's expired or already ours, the
+// database clock is used for expiry so that the clocks of the hosts involved
+// don't matter. MariaDB applies the assignments in order, so the expires_at
+// update sees the new holder
+const leaseAcquireQuery = `
+INSERT INTO
+	leases (
+		name,
+		holder,
+		expires_at
+	) VALUES (
+		:name,
+		:holder,
+		DATE_ADD(NOW(6), INTERVAL :ttl MICROSECOND)
+	)
+ON DUPLICATE KEY UPDATE
+	holder=IF(holder=:holder OR expires_at < NOW(6), :holder, holder),
+	expires_at=IF(holder=:holder, DATE_ADD(NOW(6), INTERVAL :ttl MICROSECOND), expires_at);
+`
+
+var _ = CheckQuery[LeaseParams](leaseAcquireQuery)
+
+const leaseHolderQuery = `
+SELECT
+	holder
+FROM
+	leases
+WHERE
+	name=:name;
+`
+
+var _ = CheckQuery[LeaseParams](leaseHolderQuery)
+
+// Acquire implements radio.LeaseStorage
+func (ls LeaseStorage) Acquire(name, holder string, ttl time.Duration) (string, error) {
+	const op errors.Op = "mariadb/LeaseStorage.Acquire"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if name == "" || holder == "" {
+		return "", errors.E(op, errors.InvalidArgument, errors.Info("empty name or holder"))
+	}
+
+	handle, tx, err := requireTx(handle)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	params := LeaseParams{
+		Name:   name,
+		Holder: holder,
+		TTL:    ttl.Microseconds(),
+	}
+
+	_, err = sqlx.NamedExec(handle, leaseAcquireQuery, params)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	var current string
+	err = handle.Get(&current, leaseHolderQuery, params)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", errors.E(op, err)
+	}
+	return current, nil
+}
+
+const leaseReleaseQuery = `
+DELETE FROM
+	leases
+WHERE
+	name=:name AND holder=:holder;
+`
+
+var _ = CheckQuery[LeaseParams](leaseReleaseQuery)
+
+// Release implements radio.LeaseStorage
+func (ls LeaseStorage) Release(name, holder string) error {
+	const op errors.Op = "mariadb/LeaseStorage.Release"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	_, err := sqlx.NamedExec(handle, leaseReleaseQuery, LeaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
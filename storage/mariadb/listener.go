@@ -0,0 +1,422 @@
+package mariadb
+
+import (
+	"database/sql"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// ListenerAccountStorage implements radio.ListenerAccountStorage
+type ListenerAccountStorage struct {
+	handle handle
+}
+
+const listenerAccountColumns = `
+	listener_accounts.id AS id,
+	listener_accounts.username AS username,
+	listener_accounts.password AS password,
+	COALESCE(listener_accounts.nick, '') AS nick,
+	COALESCE(listener_accounts.claim_code, '') AS claimcode,
+	listener_accounts.claim_expires AS claimexpires,
+	listener_accounts.created_at AS created_at
+`
+
+type ListenerAccountCreateParams struct {
+	Username string
+	Password string
+}
+
+const listenerAccountCreateQuery = `
+INSERT INTO
+	listener_accounts (
+		username,
+		password,
+		created_at
+	) VALUES (
+		:username,
+		:password,
+		NOW()
+	);
+`
+
+var _ = CheckQuery[ListenerAccountCreateParams](listenerAccountCreateQuery)
+
+// Create implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) Create(account radio.ListenerAccount) (radio.ListenerAccountID, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.Create"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if account.Username == "" {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("missing username"))
+	}
+	if account.Password == "" {
+		return 0, errors.E(op, errors.InvalidArgument, errors.Info("missing password"))
+	}
+
+	new, err := namedExecLastInsertId(handle, listenerAccountCreateQuery, ListenerAccountCreateParams{
+		Username: account.Username,
+		Password: account.Password,
+	})
+	if err != nil {
+		if IsDuplicateKeyErr(err) {
+			return 0, errors.E(op, err, errors.Duplicate)
+		}
+		return 0, errors.E(op, err)
+	}
+
+	return radio.ListenerAccountID(new), nil
+}
+
+var listenerAccountGetQuery = `
+SELECT
+` + listenerAccountColumns + `
+FROM
+	listener_accounts
+WHERE
+	listener_accounts.username=?;
+`
+
+// Get implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) Get(username string) (*radio.ListenerAccount, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.Get"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	var account radio.ListenerAccount
+
+	err := sqlx.Get(handle, &account, listenerAccountGetQuery, username)
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.ListenerAccountUnknown, errors.Info(username))
+		}
+		return nil, errors.E(op, err)
+	}
+
+	return &account, nil
+}
+
+var listenerAccountGetByNickQuery = `
+SELECT
+` + listenerAccountColumns + `
+FROM
+	listener_accounts
+WHERE
+	listener_accounts.nick=?;
+`
+
+// GetByNick implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) GetByNick(nick string) (*radio.ListenerAccount, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.GetByNick"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if nick == "" {
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("nick empty"))
+	}
+
+	var account radio.ListenerAccount
+
+	err := sqlx.Get(handle, &account, listenerAccountGetByNickQuery, nick)
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.ListenerAccountUnknown, errors.Info(nick))
+		}
+		return nil, errors.E(op, err)
+	}
+
+	return &account, nil
+}
+
+type ListenerAccountSetClaimCodeParams struct {
+	ID      radio.ListenerAccountID
+	Code    string
+	Expires time.Time
+}
+
+const listenerAccountSetClaimCodeQuery = `
+UPDATE
+	listener_accounts
+SET
+	claim_code=:code,
+	claim_expires=:expires
+WHERE
+	id=:id;
+`
+
+var _ = CheckQuery[ListenerAccountSetClaimCodeParams](listenerAccountSetClaimCodeQuery)
+
+// SetClaimCode implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) SetClaimCode(id radio.ListenerAccountID, code string, expires time.Time) error {
+	const op errors.Op = "mariadb/ListenerAccountStorage.SetClaimCode"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if code == "" {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing code"))
+	}
+
+	res, err := sqlx.NamedExec(handle, listenerAccountSetClaimCodeQuery, ListenerAccountSetClaimCodeParams{
+		ID:      id,
+		Code:    code,
+		Expires: expires,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n == 0 {
+		return errors.E(op, errors.ListenerAccountUnknown)
+	}
+	return nil
+}
+
+var listenerAccountGetByClaimCodeQuery = `
+SELECT
+` + listenerAccountColumns + `
+FROM
+	listener_accounts
+WHERE
+	listener_accounts.claim_code=? AND listener_accounts.claim_expires > NOW();
+`
+
+type ListenerAccountClaimNickParams struct {
+	ID   radio.ListenerAccountID
+	Nick string
+}
+
+const listenerAccountClaimNickQuery = `
+UPDATE
+	listener_accounts
+SET
+	nick=:nick,
+	claim_code=NULL,
+	claim_expires=NULL
+WHERE
+	id=:id;
+`
+
+var _ = CheckQuery[ListenerAccountClaimNickParams](listenerAccountClaimNickQuery)
+
+// ClaimNick implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) ClaimNick(code, nick string) (*radio.ListenerAccount, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.ClaimNick"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if code == "" || nick == "" {
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("missing code or nick"))
+	}
+
+	handle, tx, err := requireTx(handle)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	var account radio.ListenerAccount
+
+	err = sqlx.Get(handle, &account, listenerAccountGetByClaimCodeQuery, code)
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.ListenerAccountUnknown, errors.Info("invalid or expired claim code"))
+		}
+		return nil, errors.E(op, err)
+	}
+
+	_, err = sqlx.NamedExec(handle, listenerAccountClaimNickQuery, ListenerAccountClaimNickParams{
+		ID:   account.ID,
+		Nick: nick,
+	})
+	if err != nil {
+		if IsDuplicateKeyErr(err) {
+			return nil, errors.E(op, err, errors.Duplicate, errors.Info("nick already claimed"))
+		}
+		return nil, errors.E(op, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	account.Nick = nick
+	account.ClaimCode = ""
+	account.ClaimExpires = nil
+	return &account, nil
+}
+
+type ListenerAccountAddRequestParams struct {
+	ID      radio.ListenerAccountID
+	TrackID radio.TrackID
+}
+
+const listenerAccountAddRequestQuery = `
+INSERT INTO
+	listener_requests (
+		account_id,
+		track_id,
+		time
+	) VALUES (
+		:id,
+		:trackid,
+		NOW()
+	);
+`
+
+var _ = CheckQuery[ListenerAccountAddRequestParams](listenerAccountAddRequestQuery)
+
+// AddRequest implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) AddRequest(id radio.ListenerAccountID, trackID radio.TrackID) error {
+	const op errors.Op = "mariadb/ListenerAccountStorage.AddRequest"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if trackID == 0 {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing track id"))
+	}
+
+	_, err := sqlx.NamedExec(handle, listenerAccountAddRequestQuery, ListenerAccountAddRequestParams{
+		ID:      id,
+		TrackID: trackID,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+type ListenerAccountRequestsParams struct {
+	ID     radio.ListenerAccountID
+	Limit  int64
+	Offset int64
+}
+
+var listenerAccountRequestsQuery = expand(`
+SELECT
+	{trackColumns},
+	{maybeSongColumns},
+	{lastplayedSelect},
+	NOW() AS synctime,
+	listener_requests.time AS requestedat
+FROM
+	listener_requests
+JOIN
+	tracks ON tracks.id = listener_requests.track_id
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+WHERE
+	listener_requests.account_id=:id
+ORDER BY
+	listener_requests.time DESC, listener_requests.id DESC
+LIMIT :limit OFFSET :offset;
+`)
+
+var _ = CheckQuery[ListenerAccountRequestsParams](listenerAccountRequestsQuery)
+
+const listenerAccountRequestsCountQuery = `
+SELECT
+	COUNT(*) AS total
+FROM
+	listener_requests
+JOIN
+	tracks ON tracks.id = listener_requests.track_id
+WHERE
+	listener_requests.account_id=:id;
+`
+
+var _ = CheckQuery[ListenerAccountRequestsParams](listenerAccountRequestsCountQuery)
+
+// Requests implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) Requests(id radio.ListenerAccountID, limit, offset int64) (radio.ListenerRequestList, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.Requests"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	params := ListenerAccountRequestsParams{
+		ID:     id,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	var list = radio.ListenerRequestList{
+		Requests: make([]radio.ListenerRequest, 0, limit),
+	}
+
+	err := handle.Select(&list.Requests, listenerAccountRequestsQuery, params)
+	if err != nil {
+		return radio.ListenerRequestList{}, errors.E(op, err)
+	}
+
+	err = handle.Get(&list.Total, listenerAccountRequestsCountQuery, params)
+	if err != nil {
+		return radio.ListenerRequestList{}, errors.E(op, err)
+	}
+
+	for i := range list.Requests {
+		list.Requests[i].Hydrate()
+	}
+	return list, nil
+}
+
+type ListenerAccountTopSongsParams struct {
+	ID    radio.ListenerAccountID
+	Limit int64
+}
+
+var listenerAccountTopSongsQuery = expand(`
+SELECT
+	{trackColumns},
+	{maybeSongColumns},
+	{lastplayedSelect},
+	NOW() AS synctime,
+	counts.count AS count
+FROM
+	(SELECT
+		track_id,
+		COUNT(*) AS count
+	FROM
+		listener_requests
+	WHERE
+		account_id=:id
+	GROUP BY
+		track_id) AS counts
+JOIN
+	tracks ON tracks.id = counts.track_id
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+ORDER BY
+	counts.count DESC, tracks.id ASC
+LIMIT :limit;
+`)
+
+var _ = CheckQuery[ListenerAccountTopSongsParams](listenerAccountTopSongsQuery)
+
+// TopSongs implements radio.ListenerAccountStorage
+func (ls ListenerAccountStorage) TopSongs(id radio.ListenerAccountID, limit int64) ([]radio.ListenerSongCount, error) {
+	const op errors.Op = "mariadb/ListenerAccountStorage.TopSongs"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	var songs = make([]radio.ListenerSongCount, 0, limit)
+
+	err := handle.Select(&songs, listenerAccountTopSongsQuery, ListenerAccountTopSongsParams{
+		ID:    id,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	for i := range songs {
+		songs[i].Hydrate()
+	}
+	return songs, nil
+}
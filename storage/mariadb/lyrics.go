@@ -0,0 +1,115 @@
+package mariadb
+
+import (
+	"database/sql"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// LyricsStorage implements radio.LyricsStorage
+type LyricsStorage struct {
+	handle handle
+}
+
+type LyricsParams struct {
+	TrackID radio.TrackID
+}
+
+const lyricsGetQuery = `
+SELECT
+	track_id AS trackid,
+	lyrics,
+	notes,
+	updated_by AS updatedby,
+	updated_at
+FROM
+	track_lyrics
+WHERE
+	track_id=:trackid;
+`
+
+var _ = CheckQuery[LyricsParams](lyricsGetQuery)
+
+// Get implements radio.LyricsStorage
+func (ls LyricsStorage) Get(id radio.TrackID) (*radio.TrackLyrics, error) {
+	const op errors.Op = "mariadb/LyricsStorage.Get"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	var lyrics radio.TrackLyrics
+
+	err := handle.Get(&lyrics, lyricsGetQuery, LyricsParams{
+		TrackID: id,
+	})
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.LyricsUnknown)
+		}
+		return nil, errors.E(op, err)
+	}
+	return &lyrics, nil
+}
+
+const lyricsUpdateQuery = `
+INSERT INTO
+	track_lyrics (
+		track_id,
+		lyrics,
+		notes,
+		updated_by,
+		updated_at
+	) VALUES (
+		:trackid,
+		:lyrics,
+		:notes,
+		:updatedby,
+		NOW(6)
+	) ON DUPLICATE KEY UPDATE
+		lyrics=VALUES(lyrics),
+		notes=VALUES(notes),
+		updated_by=VALUES(updated_by),
+		updated_at=VALUES(updated_at);
+`
+
+var _ = CheckQuery[radio.TrackLyrics](lyricsUpdateQuery)
+
+const lyricsDeleteQuery = `
+DELETE FROM
+	track_lyrics
+WHERE
+	track_id=:trackid;
+`
+
+var _ = CheckQuery[LyricsParams](lyricsDeleteQuery)
+
+// Update implements radio.LyricsStorage
+func (ls LyricsStorage) Update(lyrics radio.TrackLyrics) error {
+	const op errors.Op = "mariadb/LyricsStorage.Update"
+	handle, deferFn := ls.handle.span(op)
+	defer deferFn()
+
+	if lyrics.TrackID == 0 {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing track id"))
+	}
+
+	if lyrics.IsEmpty() {
+		_, err := sqlx.NamedExec(handle, lyricsDeleteQuery, LyricsParams{
+			TrackID: lyrics.TrackID,
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return nil
+	}
+
+	_, err := sqlx.NamedExec(handle, lyricsUpdateQuery, lyrics)
+	if err != nil {
+		if IsForeignKeyErr(err) {
+			return errors.E(op, err, errors.SongUnknown)
+		}
+		return errors.E(op, err)
+	}
+	return nil
+}
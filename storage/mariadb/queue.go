@@ -1,6 +1,8 @@
 package mariadb
 
 import (
+	"database/sql"
+
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/jmoiron/sqlx"
@@ -22,7 +24,7 @@ type queueSong struct {
 
 const QueueStoreQuery = `
 INSERT INTO
-	queue (trackid, time, ip, type, meta, length, id, queue_id)
+	queue (trackid, time, ip, type, meta, length, id, queue_id, pinned)
 VALUES (
 	:trackid,
 	:expectedstarttime,
@@ -31,7 +33,8 @@ VALUES (
 	:metadata,
 	from_go_duration(:length),
 	:position,
-	:queueid
+	:queueid,
+	:ispinned
 );
 `
 
@@ -104,6 +107,7 @@ SELECT
 	queue.type AS isrequest,
 	queue.meta AS metadata,
 	to_go_duration(queue.length) AS length,
+	queue.pinned AS ispinned,
 	{lastplayedSelect},
 	{maybeSongColumns},
 	{maybeTrackColumns}
@@ -143,3 +147,153 @@ func (qs QueueStorage) Load(name string) ([]radio.QueueEntry, error) {
 
 	return songs, nil
 }
+
+type queuePosition struct {
+	radio.QueueEntry
+
+	// indicates what kind of entry this was
+	IsRequest int
+	Name      string
+	Offset    int64
+	UpdatedAt sql.NullTime
+}
+
+const queuePositionStoreQuery = `
+INSERT INTO
+	queue_position (
+		name,
+		queue_id,
+		trackid,
+		time,
+		ip,
+		type,
+		meta,
+		length,
+		byte_offset,
+		updated_at
+	) VALUES (
+		:name,
+		:queueid,
+		:trackid,
+		:expectedstarttime,
+		:useridentifier,
+		:isrequest,
+		:metadata,
+		from_go_duration(:length),
+		:offset,
+		NOW(6)
+	)
+ON DUPLICATE KEY UPDATE
+	queue_id=VALUES(queue_id),
+	trackid=VALUES(trackid),
+	time=VALUES(time),
+	ip=VALUES(ip),
+	type=VALUES(type),
+	meta=VALUES(meta),
+	length=VALUES(length),
+	byte_offset=VALUES(byte_offset),
+	updated_at=VALUES(updated_at);
+`
+
+var _ = CheckQuery[queuePosition](queuePositionStoreQuery)
+
+type QueuePositionParams struct {
+	Name string
+}
+
+const queuePositionDeleteQuery = `
+DELETE FROM queue_position WHERE name=:name;
+`
+
+var _ = CheckQuery[QueuePositionParams](queuePositionDeleteQuery)
+
+// StorePosition implements radio.QueueStorage
+func (qs QueueStorage) StorePosition(name string, pos *radio.QueuePosition) error {
+	const op errors.Op = "mariadb/QueueStorage.StorePosition"
+	handle, deferFn := qs.handle.span(op)
+	defer deferFn()
+
+	if pos == nil {
+		_, err := sqlx.NamedExec(handle, queuePositionDeleteQuery, QueuePositionParams{
+			Name: name,
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return nil
+	}
+
+	if !pos.Entry.HasTrack() {
+		return errors.E(op, errors.SongWithoutTrack, pos.Entry)
+	}
+
+	var isRequest = 0
+	if pos.Entry.IsUserRequest {
+		isRequest = 1
+	}
+
+	_, err := sqlx.NamedExec(handle, queuePositionStoreQuery, queuePosition{
+		QueueEntry: pos.Entry,
+		IsRequest:  isRequest,
+		Name:       name,
+		Offset:     pos.Offset,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+var queuePositionLoadQuery = expand(`
+SELECT
+	queue_position.queue_id AS queueid,
+	queue_position.trackid,
+	queue_position.time AS expectedstarttime,
+	queue_position.ip AS useridentifier,
+	queue_position.type AS isrequest,
+	queue_position.meta AS metadata,
+	to_go_duration(queue_position.length) AS length,
+	queue_position.byte_offset AS offset,
+	queue_position.updated_at AS updatedat,
+	{lastplayedSelect},
+	{maybeSongColumns},
+	{maybeTrackColumns}
+FROM
+	queue_position
+LEFT JOIN
+	tracks ON queue_position.trackid = tracks.id
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+WHERE
+	queue_position.name=:name;
+`)
+
+var _ = CheckQuery[QueuePositionParams](queuePositionLoadQuery)
+
+// LoadPosition implements radio.QueueStorage
+func (qs QueueStorage) LoadPosition(name string) (*radio.QueuePosition, error) {
+	const op errors.Op = "mariadb/QueueStorage.LoadPosition"
+	handle, deferFn := qs.handle.span(op)
+	defer deferFn()
+
+	var pos queuePosition
+
+	err := handle.Get(&pos, queuePositionLoadQuery, QueuePositionParams{
+		Name: name,
+	})
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.E(op, err)
+	}
+
+	pos.IsUserRequest = pos.IsRequest == 1
+	pos.Hydrate()
+
+	return &radio.QueuePosition{
+		Entry:     pos.QueueEntry,
+		Offset:    pos.Offset,
+		UpdatedAt: pos.UpdatedAt.Time,
+	}, nil
+}
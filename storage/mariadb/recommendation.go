@@ -0,0 +1,181 @@
+package mariadb
+
+import (
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// recommendationBatchSize is the amount of similarities inserted at once, this
+// keeps us under the placeholder limit of the database
+const recommendationBatchSize = 1000
+
+// RecommendationStorage implements radio.RecommendationStorage
+type RecommendationStorage struct {
+	handle handle
+}
+
+const recommendationFavoritesQuery = `
+SELECT
+	efave.inick AS nickid,
+	tracks.id AS trackid
+FROM
+	efave
+JOIN
+	esong ON esong.id = efave.isong
+JOIN
+	tracks ON tracks.hash = esong.hash;
+`
+
+var _ = CheckQuery[NoParams](recommendationFavoritesQuery)
+
+// Favorites implements radio.RecommendationStorage
+func (rs RecommendationStorage) Favorites() ([]radio.TrackFavorite, error) {
+	const op errors.Op = "mariadb/RecommendationStorage.Favorites"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	var res []radio.TrackFavorite
+
+	err := handle.Select(&res, recommendationFavoritesQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+type RecommendationRequestsParams struct {
+	Since time.Time
+}
+
+const recommendationRequestsQuery = `
+SELECT
+	listener_requests.account_id AS accountid,
+	listener_requests.track_id AS trackid,
+	listener_requests.time AS time
+FROM
+	listener_requests
+WHERE
+	listener_requests.time >= :since
+ORDER BY
+	listener_requests.account_id ASC, listener_requests.time ASC, listener_requests.id ASC;
+`
+
+var _ = CheckQuery[RecommendationRequestsParams](recommendationRequestsQuery)
+
+// Requests implements radio.RecommendationStorage
+func (rs RecommendationStorage) Requests(since time.Time) ([]radio.AccountRequest, error) {
+	const op errors.Op = "mariadb/RecommendationStorage.Requests"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	var res []radio.AccountRequest
+
+	err := handle.Select(&res, recommendationRequestsQuery, RecommendationRequestsParams{
+		Since: since,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+const recommendationDeleteQuery = `DELETE FROM track_similarity;`
+
+var _ = CheckQuery[NoParams](recommendationDeleteQuery)
+
+const recommendationInsertQuery = `
+INSERT INTO
+	track_similarity (
+		track_id,
+		similar_id,
+		score
+	) VALUES (
+		:trackid,
+		:similarid,
+		:score
+	);
+`
+
+var _ = CheckQuery[[]radio.TrackSimilarity](recommendationInsertQuery)
+
+// Replace implements radio.RecommendationStorage
+func (rs RecommendationStorage) Replace(sims []radio.TrackSimilarity) error {
+	const op errors.Op = "mariadb/RecommendationStorage.Replace"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	handle, tx, err := requireTx(handle)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = handle.Exec(recommendationDeleteQuery)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for len(sims) > 0 {
+		batch := sims[:min(len(sims), recommendationBatchSize)]
+		sims = sims[len(batch):]
+
+		_, err = sqlx.NamedExec(handle, recommendationInsertQuery, batch)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+type RecommendationSimilarParams struct {
+	ID    radio.TrackID
+	Limit int
+}
+
+var recommendationSimilarQuery = expand(`
+SELECT
+	{trackColumns},
+	{maybeSongColumns},
+	{lastplayedSelect},
+	NOW() AS synctime,
+	track_similarity.score AS score
+FROM
+	track_similarity
+JOIN
+	tracks ON tracks.id = track_similarity.similar_id
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+WHERE
+	track_similarity.track_id=:id AND tracks.usable=1
+ORDER BY
+	track_similarity.score DESC, tracks.id ASC
+LIMIT :limit;
+`)
+
+var _ = CheckQuery[RecommendationSimilarParams](recommendationSimilarQuery)
+
+// Similar implements radio.RecommendationStorage
+func (rs RecommendationStorage) Similar(id radio.TrackID, limit int) ([]radio.SimilarSong, error) {
+	const op errors.Op = "mariadb/RecommendationStorage.Similar"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	var songs = make([]radio.SimilarSong, 0, limit)
+
+	err := handle.Select(&songs, recommendationSimilarQuery, RecommendationSimilarParams{
+		ID:    id,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	for i := range songs {
+		songs[i].Hydrate()
+	}
+	return songs, nil
+}
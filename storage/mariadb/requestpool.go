@@ -0,0 +1,189 @@
+package mariadb
+
+import (
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// RequestPoolStorage implements radio.RequestPoolStorage
+type RequestPoolStorage struct {
+	handle handle
+}
+
+type RequestPoolParams struct {
+	TrackID    radio.TrackID
+	Identifier string
+}
+
+const requestPoolAddQuery = `
+INSERT INTO
+	request_pool (
+		track_id,
+		identifier,
+		created_at
+	) VALUES (
+		:trackid,
+		:identifier,
+		NOW(6)
+	);
+`
+
+var _ = CheckQuery[RequestPoolParams](requestPoolAddQuery)
+
+// requestPoolVoteQuery only inserts a vote if the track is in the pool
+const requestPoolVoteQuery = `
+INSERT INTO
+	request_votes (
+		track_id,
+		identifier
+	)
+SELECT
+	request_pool.track_id,
+	:identifier
+FROM
+	request_pool
+WHERE
+	request_pool.track_id=:trackid;
+`
+
+var _ = CheckQuery[RequestPoolParams](requestPoolVoteQuery)
+
+// Add implements radio.RequestPoolStorage
+func (rs RequestPoolStorage) Add(request radio.PendingRequest) error {
+	const op errors.Op = "mariadb/RequestPoolStorage.Add"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	if !request.HasTrack() {
+		return errors.E(op, errors.SongWithoutTrack, request.Song)
+	}
+	if request.UserIdentifier == "" {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing identifier"))
+	}
+
+	handle, tx, err := requireTx(handle)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer tx.Rollback()
+
+	params := RequestPoolParams{
+		TrackID:    request.TrackID,
+		Identifier: request.UserIdentifier,
+	}
+
+	_, err = sqlx.NamedExec(handle, requestPoolAddQuery, params)
+	if err != nil {
+		if IsDuplicateKeyErr(err) {
+			return errors.E(op, err, errors.Duplicate)
+		}
+		return errors.E(op, err)
+	}
+
+	// the requester is the first vote
+	_, err = sqlx.NamedExec(handle, requestPoolVoteQuery, params)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	return tx.Commit()
+}
+
+// Vote implements radio.RequestPoolStorage
+func (rs RequestPoolStorage) Vote(id radio.TrackID, identifier string) error {
+	const op errors.Op = "mariadb/RequestPoolStorage.Vote"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	if identifier == "" {
+		return errors.E(op, errors.InvalidArgument, errors.Info("missing identifier"))
+	}
+
+	res, err := sqlx.NamedExec(handle, requestPoolVoteQuery, RequestPoolParams{
+		TrackID:    id,
+		Identifier: identifier,
+	})
+	if err != nil {
+		if IsDuplicateKeyErr(err) {
+			return errors.E(op, err, errors.Duplicate)
+		}
+		return errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n == 0 {
+		return errors.E(op, errors.PendingRequestUnknown)
+	}
+	return nil
+}
+
+var requestPoolAllQuery = expand(`
+SELECT
+	request_pool.identifier AS useridentifier,
+	request_pool.created_at AS created_at,
+	(SELECT COUNT(*) FROM request_votes WHERE request_votes.track_id=request_pool.track_id) AS votes,
+	{lastplayedSelect},
+	{maybeSongColumns},
+	{trackColumns}
+FROM
+	request_pool
+JOIN
+	tracks ON request_pool.track_id = tracks.id
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+ORDER BY
+	votes DESC, request_pool.created_at ASC;
+`)
+
+var _ = CheckQuery[NoParams](requestPoolAllQuery)
+
+// All implements radio.RequestPoolStorage
+func (rs RequestPoolStorage) All() ([]radio.PendingRequest, error) {
+	const op errors.Op = "mariadb/RequestPoolStorage.All"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	var requests = []radio.PendingRequest{}
+
+	err := handle.Select(&requests, requestPoolAllQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return requests, nil
+}
+
+const requestPoolRemoveQuery = `
+DELETE FROM
+	request_pool
+WHERE
+	track_id=:trackid;
+`
+
+var _ = CheckQuery[RequestPoolParams](requestPoolRemoveQuery)
+
+// Remove implements radio.RequestPoolStorage
+func (rs RequestPoolStorage) Remove(id radio.TrackID) error {
+	const op errors.Op = "mariadb/RequestPoolStorage.Remove"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, requestPoolRemoveQuery, RequestPoolParams{
+		TrackID: id,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n == 0 {
+		return errors.E(op, errors.PendingRequestUnknown)
+	}
+	return nil
+}
@@ -92,6 +92,53 @@ func (ss SearchService) Search(ctx context.Context, search_query string, opt rad
 	return radio.SearchResult{Songs: songs, TotalHits: total}, nil
 }
 
+var searchCompleteQuery = `
+SELECT kind, value, count FROM (
+	(SELECT 'artist' AS kind, artist AS value, COUNT(*) AS count
+		FROM tracks WHERE artist LIKE :prefix GROUP BY artist)
+	UNION ALL
+	(SELECT 'title' AS kind, track AS value, COUNT(*) AS count
+		FROM tracks WHERE track LIKE :prefix GROUP BY track)
+	UNION ALL
+	(SELECT 'album' AS kind, album AS value, COUNT(*) AS count
+		FROM tracks WHERE album LIKE :prefix GROUP BY album)
+) AS completions
+ORDER BY count DESC, LENGTH(value) ASC
+LIMIT :limit;
+`
+
+var _ = CheckQuery[SearchCompleteParams](searchCompleteQuery)
+
+type SearchCompleteParams struct {
+	Prefix string
+	Limit  int
+}
+
+// likeEscaper escapes the wildcard characters of LIKE
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (ss SearchService) Complete(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+	const op errors.Op = "mariadb/SearchService.Complete"
+	handle := newHandle(ctx, ss.db, "search")
+	handle, deferFn := handle.span(op)
+	defer deferFn()
+
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil, nil
+	}
+
+	var res []radio.SearchCompletion
+	err := handle.Select(&res, searchCompleteQuery, SearchCompleteParams{
+		Prefix: likeEscaper.Replace(prefix) + "%",
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
 const maxQuerySize = 128
 
 func ProcessQuery(q string) string {
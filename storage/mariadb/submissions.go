@@ -256,7 +256,11 @@ INSERT INTO
 		bitrate,
 		length,
 		format,
-		mode
+		mode,
+		dupe_flag,
+		duplicate_id,
+		analysis,
+		checked_at
 	) VALUES (
 		:artist,
 		:title,
@@ -270,7 +274,11 @@ INSERT INTO
 		:bitrate,
 		from_go_duration(:length),
 		:format,
-		:encodingmode
+		:encodingmode,
+		:duplicate,
+		:duplicateid,
+		:analysis,
+		:checkedat
 	);
 `
 
@@ -304,7 +312,11 @@ SELECT
 	bitrate,
 	to_go_duration(length) AS length,
 	format,
-	mode AS encodingmode
+	mode AS encodingmode,
+	IF(dupe_flag, TRUE, FALSE) AS duplicate,
+	duplicate_id AS duplicateid,
+	analysis,
+	checked_at AS checkedat
 FROM
 	pending;
 `
@@ -330,6 +342,86 @@ func (ss SubmissionStorage) All() ([]radio.PendingSong, error) {
 	return res, nil
 }
 
+const submissionUncheckedQuery = `
+SELECT
+	id,
+	artist,
+	track AS title,
+	album,
+	path AS filepath,
+	comment,
+	origname AS filename,
+	submitter AS useridentifier,
+	submitted AS submittedat,
+	replacement AS replacementid,
+	bitrate,
+	to_go_duration(length) AS length,
+	format,
+	mode AS encodingmode,
+	IF(dupe_flag, TRUE, FALSE) AS duplicate,
+	duplicate_id AS duplicateid,
+	analysis,
+	checked_at AS checkedat
+FROM
+	pending
+WHERE
+	checked_at IS NULL
+ORDER BY
+	submitted ASC;
+`
+
+var _ = CheckQuery[NoParams](submissionUncheckedQuery)
+
+func (ss SubmissionStorage) UncheckedSubmissions() ([]radio.PendingSong, error) {
+	const op errors.Op = "mariadb/SubmissionStorage.UncheckedSubmissions"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	var res []radio.PendingSong
+
+	err := handle.Select(&res, submissionUncheckedQuery, NoParams{})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	for i := 0; i < len(res); i++ {
+		res[i].Status = radio.SubmissionAwaitingReview
+	}
+
+	return res, nil
+}
+
+const submissionUpdateCheckQuery = `
+UPDATE
+	pending
+SET
+	dupe_flag=:duplicate,
+	duplicate_id=:duplicateid,
+	analysis=:analysis,
+	checked_at=:checkedat
+WHERE
+	id=:id;
+`
+
+var _ = CheckQuery[radio.PendingSong](submissionUpdateCheckQuery)
+
+func (ss SubmissionStorage) UpdateSubmissionCheck(song radio.PendingSong) error {
+	const op errors.Op = "mariadb/SubmissionStorage.UpdateSubmissionCheck"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	if song.CheckedAt == nil {
+		now := time.Now()
+		song.CheckedAt = &now
+	}
+
+	_, err := sqlx.NamedExec(handle, submissionUpdateCheckQuery, song)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 const submissionGetQuery = `
 SELECT
 	id,
@@ -345,7 +437,11 @@ SELECT
 	bitrate,
 	to_go_duration(length) AS length,
 	format,
-	mode AS encodingmode
+	mode AS encodingmode,
+	IF(dupe_flag, TRUE, FALSE) AS duplicate,
+	duplicate_id AS duplicateid,
+	analysis,
+	checked_at AS checkedat
 FROM
 	pending
 WHERE
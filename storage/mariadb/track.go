@@ -1044,6 +1044,14 @@ func IsDuplicateKeyErr(err error) bool {
 	return mysqlError != nil && mysqlError.Number == 1062
 }
 
+func IsForeignKeyErr(err error) bool {
+	var mysqlError *mysql.MySQLError
+	if !errors.As(err, &mysqlError) {
+		return false
+	}
+	return mysqlError != nil && mysqlError.Number == 1452
+}
+
 const trackInsertQuery = `
 INSERT INTO
 	tracks (
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
@@ -482,3 +484,196 @@ func (us UserStorage) RecordListeners(listeners radio.Listeners, user radio.User
 	}
 	return nil
 }
+
+// userTwoFactorRow is the database representation of radio.UserTwoFactor
+type userTwoFactorRow struct {
+	UserID        radio.UserID
+	Secret        string
+	RecoveryCodes string
+	EnabledAt     *time.Time
+	LastStep      uint64
+	CreatedAt     time.Time
+}
+
+const getTwoFactorQuery = `
+SELECT
+	user_id AS userid,
+	secret,
+	recovery_codes AS recoverycodes,
+	enabled_at AS enabledat,
+	last_step AS laststep,
+	created_at
+FROM
+	user_twofactor
+WHERE
+	user_id=:userid;
+`
+
+var _ = CheckQuery[userTwoFactorRow](getTwoFactorQuery)
+
+// TwoFactor implements radio.UserStorage
+func (us UserStorage) TwoFactor(id radio.UserID) (*radio.UserTwoFactor, error) {
+	const op errors.Op = "mariadb/UserStorage.TwoFactor"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	var row userTwoFactorRow
+
+	err := handle.Get(&row, getTwoFactorQuery, userTwoFactorRow{UserID: id})
+	if err != nil {
+		if errors.IsE(err, sql.ErrNoRows) {
+			return nil, errors.E(op, errors.TwoFactorUnknown)
+		}
+		return nil, errors.E(op, err)
+	}
+
+	tf := radio.UserTwoFactor{
+		UserID:    row.UserID,
+		Secret:    row.Secret,
+		EnabledAt: row.EnabledAt,
+		LastStep:  row.LastStep,
+		CreatedAt: row.CreatedAt,
+	}
+	if row.RecoveryCodes != "" {
+		tf.RecoveryCodes = strings.Split(row.RecoveryCodes, ",")
+	}
+	return &tf, nil
+}
+
+const updateTwoFactorQuery = `
+INSERT INTO
+	user_twofactor (
+		user_id,
+		secret,
+		recovery_codes,
+		enabled_at,
+		created_at
+	) VALUES (
+		:userid,
+		:secret,
+		:recoverycodes,
+		:enabledat,
+		NOW()
+	) ON DUPLICATE KEY UPDATE
+		secret=VALUE(secret),
+		recovery_codes=VALUE(recovery_codes),
+		enabled_at=VALUE(enabled_at);
+`
+
+var _ = CheckQuery[userTwoFactorRow](updateTwoFactorQuery)
+
+// UpdateTwoFactor implements radio.UserStorage
+func (us UserStorage) UpdateTwoFactor(tf radio.UserTwoFactor) error {
+	const op errors.Op = "mariadb/UserStorage.UpdateTwoFactor"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	if tf.UserID == 0 || tf.Secret == "" {
+		return errors.E(op, errors.InvalidArgument)
+	}
+
+	_, err := sqlx.NamedExec(handle, updateTwoFactorQuery, userTwoFactorRow{
+		UserID:        tf.UserID,
+		Secret:        tf.Secret,
+		RecoveryCodes: strings.Join(tf.RecoveryCodes, ","),
+		EnabledAt:     tf.EnabledAt,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+const deleteTwoFactorQuery = `
+DELETE FROM
+	user_twofactor
+WHERE
+	user_id=:userid;
+`
+
+var _ = CheckQuery[userTwoFactorRow](deleteTwoFactorQuery)
+
+// DeleteTwoFactor implements radio.UserStorage
+func (us UserStorage) DeleteTwoFactor(id radio.UserID) error {
+	const op errors.Op = "mariadb/UserStorage.DeleteTwoFactor"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	_, err := sqlx.NamedExec(handle, deleteTwoFactorQuery, userTwoFactorRow{UserID: id})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+const useTwoFactorStepQuery = `
+UPDATE
+	user_twofactor
+SET
+	last_step=:laststep
+WHERE
+	user_id=:userid AND last_step < :laststep;
+`
+
+var _ = CheckQuery[userTwoFactorRow](useTwoFactorStepQuery)
+
+// UseTwoFactorStep implements radio.UserStorage
+func (us UserStorage) UseTwoFactorStep(id radio.UserID, step uint64) (bool, error) {
+	const op errors.Op = "mariadb/UserStorage.UseTwoFactorStep"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, useTwoFactorStepQuery, userTwoFactorRow{
+		UserID:   id,
+		LastStep: step,
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return n > 0, nil
+}
+
+// recoveryCodesUpdate is the argument to replaceRecoveryCodesQuery
+type recoveryCodesUpdate struct {
+	UserID           radio.UserID
+	RecoveryCodes    string
+	OldRecoveryCodes string
+}
+
+const replaceRecoveryCodesQuery = `
+UPDATE
+	user_twofactor
+SET
+	recovery_codes=:recoverycodes
+WHERE
+	user_id=:userid AND recovery_codes=:oldrecoverycodes;
+`
+
+var _ = CheckQuery[recoveryCodesUpdate](replaceRecoveryCodesQuery)
+
+// ReplaceRecoveryCodes implements radio.UserStorage
+func (us UserStorage) ReplaceRecoveryCodes(id radio.UserID, old, new []string) (bool, error) {
+	const op errors.Op = "mariadb/UserStorage.ReplaceRecoveryCodes"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	res, err := sqlx.NamedExec(handle, replaceRecoveryCodesQuery, recoveryCodesUpdate{
+		UserID:           id,
+		RecoveryCodes:    strings.Join(new, ","),
+		OldRecoveryCodes: strings.Join(old, ","),
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return n > 0, nil
+}
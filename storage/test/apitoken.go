@@ -0,0 +1,104 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestAPITokenCreateAndGet(t *testing.T) {
+	s := suite.Storage(t)
+	as := s.APIToken(suite.ctx)
+
+	user := OneOff[radio.User](genUser())
+	user.ID = 0
+
+	uid, err := s.User(suite.ctx).Create(user)
+	require.NoError(t, err)
+
+	_, hash, err := radio.GenerateAPIToken()
+	require.NoError(t, err)
+
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	in := radio.APIToken{
+		UserID:    uid,
+		Name:      "automation",
+		Hash:      hash,
+		Scopes:    radio.NewUserPermissions(radio.PermDJ, radio.PermQueueEdit),
+		ExpiresAt: &expires,
+	}
+
+	id, err := as.Create(in)
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	got, err := as.Get(hash)
+	require.NoError(t, err)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, in.UserID, got.UserID)
+	assert.Equal(t, in.Name, got.Name)
+	assert.Equal(t, in.Hash, got.Hash)
+	assert.Equal(t, in.Scopes, got.Scopes)
+	assert.Nil(t, got.LastUsedAt)
+	if assert.NotNil(t, got.ExpiresAt) {
+		assert.WithinDuration(t, expires, *got.ExpiresAt, time.Second)
+	}
+
+	err = as.UpdateLastUsed(id)
+	require.NoError(t, err)
+
+	got, err = as.Get(hash)
+	require.NoError(t, err)
+	assert.NotNil(t, got.LastUsedAt)
+
+	_, err = as.Get(radio.NewAPITokenHash("does not exist"))
+	assert.True(t, errors.Is(errors.TokenUnknown, err))
+}
+
+func (suite *Suite) TestAPITokenListAndDelete(t *testing.T) {
+	s := suite.Storage(t)
+	as := s.APIToken(suite.ctx)
+
+	user := OneOff[radio.User](genUser())
+	user.ID = 0
+
+	uid, err := s.User(suite.ctx).Create(user)
+	require.NoError(t, err)
+
+	var ids []radio.APITokenID
+	for range 3 {
+		_, hash, err := radio.GenerateAPIToken()
+		require.NoError(t, err)
+
+		id, err := as.Create(radio.APIToken{
+			UserID: uid,
+			Name:   "token",
+			Hash:   hash,
+			Scopes: radio.NewUserPermissions(radio.PermDJ),
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	tokens, err := as.ListByUser(uid)
+	require.NoError(t, err)
+	assert.Len(t, tokens, len(ids))
+
+	// deleting with the wrong user should not work
+	err = as.Delete(uid+1, ids[0])
+	assert.True(t, errors.Is(errors.TokenUnknown, err))
+
+	err = as.Delete(uid, ids[0])
+	require.NoError(t, err)
+
+	tokens, err = as.ListByUser(uid)
+	require.NoError(t, err)
+	assert.Len(t, tokens, len(ids)-1)
+	for _, token := range tokens {
+		assert.NotEqual(t, ids[0], token.ID)
+	}
+}
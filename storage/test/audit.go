@@ -0,0 +1,75 @@
+package storagetest
+
+import (
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestAuditAddAndSearch(t *testing.T) {
+	s := suite.Storage(t)
+	as := s.Audit(suite.ctx)
+
+	actor := OneOff[radio.User](genUser())
+
+	song := radio.Song{
+		Metadata: "audit - test",
+		DatabaseTrack: &radio.DatabaseTrack{
+			TrackID: 50,
+			Artist:  "audit",
+			Title:   "test",
+		},
+	}
+	edited := song.Copy()
+	edited.Title = "edited"
+
+	entry, err := radio.NewAuditEntry(actor, radio.AuditTrackEdit, "track 50 (audit - test)", song, edited)
+	require.NoError(t, err)
+	entry.IP = "127.0.0.1"
+
+	id, err := as.Add(entry)
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	entry2, err := radio.NewAuditEntry(actor, radio.AuditTrackDelete, "track 50 (audit - edited)", edited, nil)
+	require.NoError(t, err)
+	_, err = as.Add(entry2)
+	require.NoError(t, err)
+
+	// search on actor should give us both, newest first
+	list, err := as.Search(radio.AuditFilter{Actor: actor.Username}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 2)
+	assert.Equal(t, 2, list.Total)
+	assert.Equal(t, radio.AuditTrackDelete, list.Entries[0].Action)
+
+	got := list.Entries[1]
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, entry.ActorID, got.ActorID)
+	assert.Equal(t, entry.Actor, got.Actor)
+	assert.Equal(t, entry.Target, got.Target)
+	assert.JSONEq(t, entry.Before, got.Before)
+	assert.JSONEq(t, entry.After, got.After)
+	assert.Equal(t, entry.IP, got.IP)
+	assert.NotZero(t, got.CreatedAt)
+	assert.NotEmpty(t, got.Diff())
+
+	// search on action and target
+	list, err = as.Search(radio.AuditFilter{
+		Actor:  actor.Username,
+		Action: radio.AuditTrackEdit,
+		Target: "audit - test",
+	}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 1)
+	assert.Equal(t, id, list.Entries[0].ID)
+
+	// limit and offset
+	list, err = as.Search(radio.AuditFilter{Actor: actor.Username}, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 1)
+	assert.Equal(t, 2, list.Total)
+	assert.Equal(t, id, list.Entries[0].ID)
+}
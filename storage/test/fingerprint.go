@@ -0,0 +1,54 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestFingerprintStoreCandidates(t *testing.T) {
+	s := suite.Storage(t)
+	fs := s.Fingerprint(suite.ctx)
+	ts := s.Track(suite.ctx)
+
+	song := generateTrack()
+	song.Length = time.Minute * 3
+	tid, err := ts.Insert(song)
+	require.NoError(t, err)
+
+	missing, err := fs.Missing()
+	require.NoError(t, err)
+	assert.Contains(t, missing, tid)
+
+	in := radio.TrackFingerprint{
+		TrackID:     tid,
+		Length:      song.Length,
+		Fingerprint: radio.Fingerprint{1, 2, 3, 0xFFFFFFFF},
+	}
+	require.NoError(t, fs.Store(in))
+
+	// storing again should update the existing entry
+	in.Fingerprint = append(in.Fingerprint, 5)
+	require.NoError(t, fs.Store(in))
+
+	missing, err = fs.Missing()
+	require.NoError(t, err)
+	assert.NotContains(t, missing, tid)
+
+	candidates, err := fs.Candidates(song.Length+time.Second*2, time.Second*5)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, in, candidates[0])
+
+	candidates, err = fs.Candidates(song.Length+time.Minute, time.Second*5)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+
+	// an empty fingerprint isn't allowed
+	err = fs.Store(radio.TrackFingerprint{TrackID: tid})
+	require.True(t, errors.Is(errors.InvalidArgument, err))
+}
@@ -0,0 +1,128 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestGuestInviteRedeem(t *testing.T) {
+	s := suite.Storage(t)
+	gs := s.Guest(suite.ctx)
+
+	user := OneOff[radio.User](genUser())
+	user.ID = 0
+
+	uid, err := s.User(suite.ctx).Create(user)
+	require.NoError(t, err)
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	in := radio.GuestInvite{
+		Code:        "invite-code",
+		Nick:        "guest",
+		CreatedBy:   uid,
+		Start:       start,
+		End:         start.Add(time.Hour * 2),
+		Mount:       "/main.mp3",
+		Priority:    10,
+		KillLimit:   2,
+		ThreadLimit: 5,
+	}
+
+	id, err := gs.CreateInvite(in)
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	// an invite that already ended shouldn't be listed or redeemable
+	_, err = gs.CreateInvite(radio.GuestInvite{
+		Code:      "ended-code",
+		CreatedBy: uid,
+		Start:     time.Now().Add(-time.Hour * 2),
+		End:       time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	invites, err := gs.Invites()
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	assert.Equal(t, id, invites[0].ID)
+	assert.Equal(t, in.Code, invites[0].Code)
+	assert.Equal(t, in.Nick, invites[0].Nick)
+	assert.Equal(t, uid, invites[0].CreatedBy)
+	assert.Equal(t, in.Mount, invites[0].Mount)
+	assert.Equal(t, in.Priority, invites[0].Priority)
+	assert.Equal(t, in.KillLimit, invites[0].KillLimit)
+	assert.Equal(t, in.ThreadLimit, invites[0].ThreadLimit)
+	assert.WithinDuration(t, in.Start, invites[0].Start, time.Millisecond)
+	assert.WithinDuration(t, in.End, invites[0].End, time.Millisecond)
+	assert.False(t, invites[0].IsRedeemed())
+
+	_, err = gs.RedeemInvite("ended-code", "guest")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+	// the invite is for a specific nick
+	_, err = gs.RedeemInvite(in.Code, "someone-else")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+
+	redeemed, err := gs.RedeemInvite(in.Code, "guest")
+	require.NoError(t, err)
+	assert.Equal(t, id, redeemed.ID)
+	assert.Equal(t, "guest", redeemed.RedeemedBy)
+	assert.True(t, redeemed.IsRedeemed())
+
+	// and only once
+	_, err = gs.RedeemInvite(in.Code, "guest")
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+
+	require.NoError(t, gs.DeleteInvite(id))
+	err = gs.DeleteInvite(id)
+	assert.True(t, errors.Is(errors.GuestInviteUnknown, err))
+}
+
+func (suite *Suite) TestGuestAuths(t *testing.T) {
+	s := suite.Storage(t)
+	gs := s.Guest(suite.ctx)
+
+	user := OneOff[radio.User](genUser())
+	user.ID = 0
+
+	uid, err := s.User(suite.ctx).Create(user)
+	require.NoError(t, err)
+
+	start := time.Now().Truncate(time.Second)
+	in := radio.GuestAuth{
+		Nick:        "guest",
+		UserID:      uid,
+		InviteID:    5,
+		Start:       start,
+		End:         start.Add(time.Hour),
+		KillLimit:   3,
+		ThreadLimit: 3,
+	}
+	require.NoError(t, gs.SaveAuth(in))
+
+	// saving again should update the existing one
+	in.HasStreamed = true
+	in.ThreadSets = 2
+	in.KillAttempts = 1
+	require.NoError(t, gs.SaveAuth(in))
+
+	auths, err := gs.Auths()
+	require.NoError(t, err)
+	require.Len(t, auths, 1)
+	assert.Equal(t, in.Nick, auths[0].Nick)
+	assert.Equal(t, in.UserID, auths[0].UserID)
+	assert.Equal(t, in.InviteID, auths[0].InviteID)
+	assert.True(t, auths[0].HasStreamed)
+	assert.Equal(t, 2, auths[0].ThreadSets)
+	assert.Equal(t, 1, auths[0].KillAttempts)
+	assert.WithinDuration(t, in.End, auths[0].End, time.Millisecond)
+
+	require.NoError(t, gs.DeleteAuth(in.Nick))
+	auths, err = gs.Auths()
+	require.NoError(t, err)
+	assert.Empty(t, auths)
+}
@@ -0,0 +1,69 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestJournalAppendRangeLast(t *testing.T) {
+	js := suite.Storage(t).Journal(suite.ctx)
+
+	start := time.Now().Truncate(time.Second)
+	entries := []radio.JournalEntry{
+		{Kind: radio.JournalThread, Time: start, Source: "irc", Data: []byte(`"first"`)},
+		{Kind: radio.JournalListeners, Time: start.Add(time.Second), Source: "listener-tracker", Data: []byte(`10`)},
+		{Kind: radio.JournalThread, Time: start.Add(time.Second * 2), Source: "website", Data: []byte(`"second"`)},
+		{Kind: radio.JournalListeners, Time: start.Add(time.Second * 3), Source: "listener-tracker", Data: []byte(`20`)},
+	}
+	for _, entry := range entries {
+		require.NoError(t, js.Append(entry))
+	}
+
+	res, err := js.Range(start, start.Add(time.Second*2))
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	for i := range res {
+		assert.NotZero(t, res[i].ID)
+		assert.Equal(t, entries[i].Kind, res[i].Kind)
+		assert.Equal(t, entries[i].Source, res[i].Source)
+		assert.Equal(t, entries[i].Data, res[i].Data)
+		assert.WithinDuration(t, entries[i].Time, res[i].Time, time.Millisecond)
+	}
+
+	res, err = js.Last(start.Add(time.Second * 2))
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, []byte(`10`), res[0].Data)
+	assert.Equal(t, []byte(`"second"`), res[1].Data)
+}
+
+func (suite *Suite) TestJournalPrune(t *testing.T) {
+	js := suite.Storage(t).Journal(suite.ctx)
+
+	start := time.Now().Truncate(time.Second).Add(-time.Hour)
+	entries := []radio.JournalEntry{
+		{Kind: radio.JournalThread, Time: start, Source: "irc", Data: []byte(`"old"`)},
+		{Kind: radio.JournalListeners, Time: start, Source: "listener-tracker", Data: []byte(`10`)},
+		{Kind: radio.JournalListeners, Time: start.Add(time.Second), Source: "listener-tracker", Data: []byte(`20`)},
+		{Kind: radio.JournalListeners, Time: start.Add(time.Minute * 30), Source: "listener-tracker", Data: []byte(`30`)},
+	}
+	for _, entry := range entries {
+		require.NoError(t, js.Append(entry))
+	}
+
+	// only the first listeners entry can go, the thread entry is the latest
+	// of its kind and the second listeners entry isn't old enough
+	n, err := js.Prune(start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+
+	res, err := js.Last(start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, []byte(`"old"`), res[0].Data)
+	assert.Equal(t, []byte(`20`), res[1].Data)
+}
@@ -0,0 +1,44 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestLeaseAcquire(t *testing.T) {
+	ls := suite.Storage(t).Lease(suite.ctx)
+
+	holder, err := ls.Acquire("test", "first", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "first", holder)
+
+	// someone else shouldn't be able to take it while it's valid
+	holder, err = ls.Acquire("test", "second", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "first", holder)
+
+	// but renewing it should work
+	holder, err = ls.Acquire("test", "first", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "first", holder)
+
+	// and once it expires it can be taken over
+	time.Sleep(time.Millisecond * 10)
+	holder, err = ls.Acquire("test", "second", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "second", holder)
+
+	// releasing by someone that doesn't hold it does nothing
+	require.NoError(t, ls.Release("test", "first"))
+	holder, err = ls.Acquire("test", "first", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "second", holder)
+
+	require.NoError(t, ls.Release("test", "second"))
+	holder, err = ls.Acquire("test", "first", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "first", holder)
+}
@@ -0,0 +1,116 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestListenerAccountClaimNick(t *testing.T) {
+	s := suite.Storage(t)
+	ls := s.ListenerAccount(suite.ctx)
+
+	id, err := ls.Create(radio.ListenerAccount{
+		Username: "listener-claim",
+		Password: "not-a-real-hash",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	// usernames should be unique
+	_, err = ls.Create(radio.ListenerAccount{
+		Username: "listener-claim",
+		Password: "not-a-real-hash",
+	})
+	require.True(t, errors.Is(errors.Duplicate, err))
+
+	account, err := ls.Get("listener-claim")
+	require.NoError(t, err)
+	assert.Equal(t, id, account.ID)
+	assert.Empty(t, account.Nick)
+
+	_, err = ls.GetByNick("claimed-nick")
+	require.True(t, errors.Is(errors.ListenerAccountUnknown, err))
+
+	// an expired code shouldn't work
+	err = ls.SetClaimCode(id, "expiredcode", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = ls.ClaimNick("expiredcode", "claimed-nick")
+	require.True(t, errors.Is(errors.ListenerAccountUnknown, err))
+
+	err = ls.SetClaimCode(id, "validcode", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	claimed, err := ls.ClaimNick("validcode", "claimed-nick")
+	require.NoError(t, err)
+	assert.Equal(t, id, claimed.ID)
+	assert.Equal(t, "claimed-nick", claimed.Nick)
+
+	// code should be single-use
+	_, err = ls.ClaimNick("validcode", "claimed-nick")
+	require.True(t, errors.Is(errors.ListenerAccountUnknown, err))
+
+	byNick, err := ls.GetByNick("claimed-nick")
+	require.NoError(t, err)
+	assert.Equal(t, id, byNick.ID)
+	assert.Empty(t, byNick.ClaimCode)
+
+	// another account shouldn't be able to claim the same nick
+	other, err := ls.Create(radio.ListenerAccount{
+		Username: "listener-claim-other",
+		Password: "not-a-real-hash",
+	})
+	require.NoError(t, err)
+	err = ls.SetClaimCode(other, "othercode", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = ls.ClaimNick("othercode", "claimed-nick")
+	require.True(t, errors.Is(errors.Duplicate, err))
+}
+
+func (suite *Suite) TestListenerAccountRequests(t *testing.T) {
+	s := suite.Storage(t)
+	ls := s.ListenerAccount(suite.ctx)
+	ts := s.Track(suite.ctx)
+
+	id, err := ls.Create(radio.ListenerAccount{
+		Username: "listener-requests",
+		Password: "not-a-real-hash",
+	})
+	require.NoError(t, err)
+
+	var tracks []radio.TrackID
+	for range 3 {
+		tid, err := ts.Insert(generateTrack())
+		require.NoError(t, err)
+		tracks = append(tracks, tid)
+	}
+
+	// request the first track three times, the second twice and the last once
+	for i, tid := range tracks {
+		for range len(tracks) - i {
+			require.NoError(t, ls.AddRequest(id, tid))
+		}
+	}
+
+	list, err := ls.Requests(id, 4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 6, list.Total)
+	require.Len(t, list.Requests, 4)
+	assert.Equal(t, tracks[2], list.Requests[0].TrackID, "newest request should be first")
+	assert.NotZero(t, list.Requests[0].RequestedAt)
+
+	list, err = ls.Requests(id, 4, 4)
+	require.NoError(t, err)
+	assert.Len(t, list.Requests, 2)
+
+	top, err := ls.TopSongs(id, 2)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, tracks[0], top[0].TrackID)
+	assert.Equal(t, 3, top[0].Count)
+	assert.Equal(t, tracks[1], top[1].TrackID)
+	assert.Equal(t, 2, top[1].Count)
+}
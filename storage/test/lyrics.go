@@ -0,0 +1,57 @@
+package storagetest
+
+import (
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestLyrics(t *testing.T) {
+	s := suite.Storage(t)
+	ls := s.Lyrics(suite.ctx)
+	ts := s.Track(suite.ctx)
+
+	song := generateTrack()
+	tid, err := ts.Insert(song)
+	require.NoError(t, err)
+
+	// nothing stored yet
+	_, err = ls.Get(tid)
+	assert.True(t, errors.Is(errors.LyricsUnknown, err))
+
+	lyrics := radio.TrackLyrics{
+		TrackID:   tid,
+		Lyrics:    "[00:01.00]一行目\n[00:05.50]second line",
+		Notes:     "some notes",
+		UpdatedBy: "editor",
+	}
+	require.NoError(t, ls.Update(lyrics))
+
+	got, err := ls.Get(tid)
+	require.NoError(t, err)
+	assert.Equal(t, lyrics.Lyrics, got.Lyrics)
+	assert.Equal(t, lyrics.Notes, got.Notes)
+	assert.Equal(t, lyrics.UpdatedBy, got.UpdatedBy)
+	assert.False(t, got.UpdatedAt.IsZero())
+
+	// updating again should overwrite the previous
+	lyrics.Notes = ""
+	require.NoError(t, ls.Update(lyrics))
+	got, err = ls.Get(tid)
+	require.NoError(t, err)
+	assert.Equal(t, lyrics.Lyrics, got.Lyrics)
+	assert.Empty(t, got.Notes)
+
+	// and emptying both removes them
+	lyrics.Lyrics = ""
+	require.NoError(t, ls.Update(lyrics))
+	_, err = ls.Get(tid)
+	assert.True(t, errors.Is(errors.LyricsUnknown, err))
+
+	// lyrics for tracks that don't exist should fail
+	err = ls.Update(radio.TrackLyrics{TrackID: tid + 1000, Notes: "notes"})
+	assert.Error(t, err)
+}
@@ -70,6 +70,59 @@ func (suite *Suite) TestQueueStoreAndLoad(t *testing.T) {
 	})
 }
 
+func (suite *Suite) TestQueuePosition(t *testing.T) {
+	s := suite.Storage(t)
+	qs := s.Queue(suite.ctx)
+	ts := s.Track(suite.ctx)
+
+	// nothing stored yet
+	pos, err := qs.LoadPosition("test")
+	require.NoError(t, err)
+	require.Nil(t, pos)
+
+	song := generateTrack()
+	tid, err := ts.Insert(song)
+	require.NoError(t, err)
+	song.TrackID = tid
+
+	entry := radio.QueueEntry{
+		QueueID:        radio.NewQueueID(),
+		Song:           song,
+		IsUserRequest:  true,
+		UserIdentifier: "127.0.0.1",
+	}
+
+	err = qs.StorePosition("test", &radio.QueuePosition{Entry: entry, Offset: 1024})
+	require.NoError(t, err)
+
+	pos, err = qs.LoadPosition("test")
+	require.NoError(t, err)
+	require.NotNil(t, pos)
+	assert.Equal(t, entry.QueueID, pos.Entry.QueueID)
+	assert.Equal(t, tid, pos.Entry.TrackID)
+	assert.True(t, pos.Entry.IsUserRequest)
+	assert.Equal(t, entry.UserIdentifier, pos.Entry.UserIdentifier)
+	assert.EqualValues(t, 1024, pos.Offset)
+	assert.False(t, pos.UpdatedAt.IsZero())
+
+	// storing again should replace the old position
+	err = qs.StorePosition("test", &radio.QueuePosition{Entry: entry, Offset: 4096})
+	require.NoError(t, err)
+
+	pos, err = qs.LoadPosition("test")
+	require.NoError(t, err)
+	require.NotNil(t, pos)
+	assert.EqualValues(t, 4096, pos.Offset)
+
+	// and nil should clear it
+	err = qs.StorePosition("test", nil)
+	require.NoError(t, err)
+
+	pos, err = qs.LoadPosition("test")
+	require.NoError(t, err)
+	require.Nil(t, pos)
+}
+
 func newArbitrary() *arbitrary.Arbitraries {
 	a := arbitrary.DefaultArbitraries()
 	a.RegisterGen(gen.AlphaString())
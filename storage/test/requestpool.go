@@ -0,0 +1,64 @@
+package storagetest
+
+import (
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestRequestPool(t *testing.T) {
+	s := suite.Storage(t)
+	rs := s.RequestPool(suite.ctx)
+	ts := s.Track(suite.ctx)
+
+	var songs []radio.Song
+	for range 3 {
+		song := generateTrack()
+		tid, err := ts.Insert(song)
+		require.NoError(t, err)
+		song.TrackID = tid
+		songs = append(songs, song)
+	}
+
+	for i, song := range songs {
+		require.NoError(t, rs.Add(radio.PendingRequest{
+			Song:           song,
+			UserIdentifier: "requester",
+		}))
+		// give the last song the most votes and the first none extra
+		for n := range i * 2 {
+			require.NoError(t, rs.Vote(song.TrackID, "voter"+string(rune('a'+n))))
+		}
+	}
+
+	// a track can only be pending once
+	err := rs.Add(radio.PendingRequest{Song: songs[0], UserIdentifier: "someone"})
+	assert.True(t, errors.Is(errors.Duplicate, err))
+	// and everyone only gets one vote, including the requester
+	err = rs.Vote(songs[0].TrackID, "requester")
+	assert.True(t, errors.Is(errors.Duplicate, err))
+	// can't vote on something that isn't pending
+	err = rs.Vote(songs[0].TrackID+1000, "voter")
+	assert.True(t, errors.Is(errors.PendingRequestUnknown, err))
+
+	pending, err := rs.All()
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	for i, want := range []int{2, 1, 0} {
+		assert.Equal(t, songs[want].TrackID, pending[i].TrackID)
+		assert.Equal(t, 1+want*2, pending[i].Votes)
+		assert.Equal(t, "requester", pending[i].UserIdentifier)
+		assert.False(t, pending[i].CreatedAt.IsZero())
+	}
+
+	require.NoError(t, rs.Remove(songs[2].TrackID))
+	err = rs.Remove(songs[2].TrackID)
+	assert.True(t, errors.Is(errors.PendingRequestUnknown, err))
+
+	pending, err = rs.All()
+	require.NoError(t, err)
+	assert.Len(t, pending, 2)
+}
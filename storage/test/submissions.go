@@ -2,9 +2,11 @@ package storagetest
 
 import (
 	"testing"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -72,11 +74,91 @@ func (suite *Suite) TestSubmissionInsert(t *testing.T) {
 	song.UserIdentifier = capAt(song.UserIdentifier, 50)
 	song.Format = capAt(song.Format, 10)
 	song.EncodingMode = capAt(song.EncodingMode, 10)
+	// arbitrary floats can be NaN or Inf which can't be stored
+	song.Analysis = nil
 
 	err := ss.InsertSubmission(song)
 	require.NoError(t, err)
 }
 
+func (suite *Suite) TestSubmissionAnalysis(t *testing.T) {
+	s := suite.Storage(t)
+	ss := s.Submissions(suite.ctx)
+
+	analysis := &radio.SubmissionAnalysis{
+		SpectralCutoff:  16000,
+		ClippingRatio:   0.01,
+		Loudness:        -7.5,
+		TruePeak:        0.5,
+		LeadingSilence:  time.Second,
+		TrailingSilence: time.Second * 2,
+		Warnings:        []string{"likely a transcode"},
+	}
+
+	err := ss.InsertSubmission(radio.PendingSong{
+		Artist:      "analysis",
+		Title:       "with",
+		FilePath:    "analysis-with.mp3",
+		SubmittedAt: time.Now(),
+		Analysis:    analysis,
+	})
+	require.NoError(t, err)
+	err = ss.InsertSubmission(radio.PendingSong{
+		Artist:      "analysis",
+		Title:       "without",
+		FilePath:    "analysis-without.mp3",
+		SubmittedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	res, err := ss.All()
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for _, song := range res {
+		if song.Title == "with" {
+			assert.Equal(t, analysis, song.Analysis)
+		} else {
+			assert.Nil(t, song.Analysis)
+		}
+	}
+}
+
+func (suite *Suite) TestSubmissionCheck(t *testing.T) {
+	s := suite.Storage(t)
+	ss := s.Submissions(suite.ctx)
+
+	err := ss.InsertSubmission(radio.PendingSong{
+		Artist:      "check",
+		Title:       "unchecked",
+		FilePath:    "check-unchecked.mp3",
+		SubmittedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	unchecked, err := ss.UncheckedSubmissions()
+	require.NoError(t, err)
+	require.Len(t, unchecked, 1)
+	assert.Nil(t, unchecked[0].CheckedAt)
+
+	song := unchecked[0]
+	song.Duplicate = true
+	song.Analysis = &radio.SubmissionAnalysis{
+		Loudness: -9,
+		Warnings: []string{"too loud"},
+	}
+	require.NoError(t, ss.UpdateSubmissionCheck(song))
+
+	unchecked, err = ss.UncheckedSubmissions()
+	require.NoError(t, err)
+	assert.Empty(t, unchecked)
+
+	got, err := ss.GetSubmission(song.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Duplicate)
+	assert.Equal(t, song.Analysis, got.Analysis)
+	assert.NotNil(t, got.CheckedAt)
+}
+
 func (suite *Suite) TestSubmissionAll(t *testing.T) {
 	s := suite.Storage(t)
 	ss := s.Submissions(suite.ctx)
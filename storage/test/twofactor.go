@@ -0,0 +1,82 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestUserTwoFactor(t *testing.T) {
+	us := suite.Storage(t).User(suite.ctx)
+
+	user := OneOff[radio.User](genUser())
+	user.ID = 0
+
+	uid, err := us.Create(user)
+	require.NoError(t, err)
+
+	_, err = us.TwoFactor(uid)
+	assert.True(t, errors.Is(errors.TwoFactorUnknown, err))
+
+	// pending enrollment
+	in := radio.UserTwoFactor{
+		UserID: uid,
+		Secret: "JBSWY3DPEHPK3PXP",
+	}
+	require.NoError(t, us.UpdateTwoFactor(in))
+
+	got, err := us.TwoFactor(uid)
+	require.NoError(t, err)
+	assert.Equal(t, in.Secret, got.Secret)
+	assert.False(t, got.IsEnabled())
+	assert.Empty(t, got.RecoveryCodes)
+
+	// confirmed enrollment
+	_, hashes, err := radio.GenerateRecoveryCodes(5)
+	require.NoError(t, err)
+	now := time.Now()
+	in.EnabledAt = &now
+	in.RecoveryCodes = hashes
+	require.NoError(t, us.UpdateTwoFactor(in))
+
+	got, err = us.TwoFactor(uid)
+	require.NoError(t, err)
+	assert.True(t, got.IsEnabled())
+	assert.Equal(t, hashes, got.RecoveryCodes)
+
+	// steps can only be used once and only move forward
+	ok, err := us.UseTwoFactorStep(uid, 100)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = us.UseTwoFactorStep(uid, 100)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = us.UseTwoFactorStep(uid, 99)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// and updating the other fields shouldn't reset it
+	require.NoError(t, us.UpdateTwoFactor(in))
+	got, err = us.TwoFactor(uid)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, got.LastStep)
+
+	// recovery codes are only replaced if nobody else changed them first
+	ok, err = us.ReplaceRecoveryCodes(uid, hashes, hashes[1:])
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = us.ReplaceRecoveryCodes(uid, hashes, hashes[1:])
+	require.NoError(t, err)
+	assert.False(t, ok)
+	got, err = us.TwoFactor(uid)
+	require.NoError(t, err)
+	assert.Equal(t, hashes[1:], got.RecoveryCodes)
+
+	require.NoError(t, us.DeleteTwoFactor(uid))
+	_, err = us.TwoFactor(uid)
+	assert.True(t, errors.Is(errors.TwoFactorUnknown, err))
+}
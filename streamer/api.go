@@ -25,13 +25,20 @@ func NewGRPCServer(ctx context.Context, cfg config.Config, storage radio.Storage
 		cfgUserRequestDelay: config.Value(cfg, func(cfg config.Config) time.Duration {
 			return time.Duration(cfg.Conf().UserRequestDelay)
 		}),
+		cfgModeratedRequests: config.Value(cfg, func(cfg config.Config) bool {
+			return cfg.Conf().Streamer.ModeratedRequests
+		}),
 		Storage:  storage,
 		announce: announce,
 		queue:    queue,
 		streamer: streamer,
 	}
 
-	gs := rpc.NewGrpcServer(ctx)
+	opts, err := cfg.RPCServerOptions(config.RPCStreamer)
+	if err != nil {
+		return nil, err
+	}
+	gs := rpc.NewGrpcServer(ctx, opts...)
 	rpc.RegisterStreamerServer(gs, rpc.NewStreamer(s))
 	rpc.RegisterQueueServer(gs, rpc.NewQueue(queue))
 
@@ -39,8 +46,9 @@ func NewGRPCServer(ctx context.Context, cfg config.Config, storage radio.Storage
 }
 
 type streamerService struct {
-	cfgRequestsEnabled  func() bool
-	cfgUserRequestDelay func() time.Duration
+	cfgRequestsEnabled   func() bool
+	cfgUserRequestDelay  func() time.Duration
+	cfgModeratedRequests func() bool
 
 	Storage radio.StorageService
 
@@ -171,6 +179,27 @@ func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, iden
 		return errors.E(op, errors.SongCooldown, errors.Delay(d), song)
 	}
 
+	if s.cfgModeratedRequests() {
+		// moderated requests go into the request pool, the queue picks
+		// them up from there when they have enough votes and updates the
+		// database to represent the request at that point
+		pool, _, err := s.Storage.RequestPoolTx(ctx, tx)
+		if err != nil {
+			return errors.E(op, errors.TransactionBegin, err, song)
+		}
+		err = pool.Add(radio.PendingRequest{
+			Song:           song,
+			UserIdentifier: identifier,
+		})
+		if err != nil {
+			return errors.E(op, err, song)
+		}
+		if err = tx.Commit(); err != nil {
+			return errors.E(op, errors.TransactionCommit, err)
+		}
+		return nil
+	}
+
 	// update the database to represent the request
 	err = rs.UpdateLastRequest(identifier)
 	if err != nil {
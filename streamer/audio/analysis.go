@@ -0,0 +1,285 @@
+package audio
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// input format as produced by DecodeFile
+	analysisSampleRate = 44100
+	analysisChannels   = 2
+
+	analysisFrameSize = 4096
+	// only one in every analysisFrameSkip frames is used for the spectrum
+	analysisFrameSkip = 4
+	// analysisCutoffDrop is how many dB below the reference level the spectrum
+	// has to drop before we consider it the cutoff
+	analysisCutoffDrop = 70
+	// reference level range used for the spectral cutoff
+	analysisReferenceMin = 200
+	analysisReferenceMax = 4000
+	// analysisSilenceLevel is the sample level below which we consider it silence,
+	// about -60dBFS
+	analysisSilenceLevel = 32
+	// analysisClipRun is the amount of consecutive samples at full scale before
+	// we consider it clipping
+	analysisClipRun = 3
+)
+
+// thresholds used to generate the warnings in AnalysisWarnings
+const (
+	warnClippingRatio   = 0.0005
+	warnLoudnessMax     = -6
+	warnLoudnessMin     = -30
+	warnTruePeak        = 1
+	warnLeadingSilence  = 5 * time.Second
+	warnTrailingSilence = 10 * time.Second
+)
+
+// Analyze runs a quality analysis on the file given, this includes a loudness
+// measurement, clipping and silence detection and the spectral cutoff
+func Analyze(ctx context.Context, filename string) (*radio.SubmissionAnalysis, error) {
+	const op errors.Op = "streamer/audio.Analyze"
+	ctx, span := otel.Tracer("").Start(ctx, string(op))
+	defer span.End()
+
+	info, err := loudnessAnalysis(ctx, filename, replaygainSettings)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	mb, err := DecodeFile(ctx, filename)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer mb.Close()
+
+	mr, err := mb.Reader()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer mr.Close()
+
+	analysis, err := AnalyzePCM(mr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	analysis.Loudness = parseLoudness(info.InputI)
+	analysis.TruePeak = parseLoudness(info.InputTp)
+	return analysis, nil
+}
+
+// analysisLoudnessFloor is the lowest loudness we report, loudnorm reports
+// -inf for silent files which we can't encode as JSON
+const analysisLoudnessFloor = -70
+
+// parseLoudness parses a loudness value as reported by loudnorm
+func parseLoudness(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) {
+		return analysisLoudnessFloor
+	}
+	return max(v, analysisLoudnessFloor)
+}
+
+// AnalyzePCM does the PCM based part of Analyze, the data is expected to be in the
+// format DecodeFile outputs; s16le with 2 channels at 44100hz. Loudness and Warnings
+// are left empty
+func AnalyzePCM(r io.Reader) (*radio.SubmissionAnalysis, error) {
+	const op errors.Op = "streamer/audio.AnalyzePCM"
+
+	var (
+		br  = bufio.NewReader(r)
+		raw = make([]byte, analysisChannels*2)
+
+		total        int // amount of sample frames
+		firstAudible = -1
+		lastAudible  = -1
+
+		clipped int
+		clipRun [analysisChannels]int
+
+		frame    = make([]complex128, 0, analysisFrameSize)
+		spectrum = make([]float64, analysisFrameSize/2)
+	)
+
+	window := make([]float64, analysisFrameSize)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(analysisFrameSize-1))
+	}
+
+	for {
+		_, err := io.ReadFull(br, raw)
+		if err != nil {
+			if errors.IsE(err, io.EOF) || errors.IsE(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, errors.E(op, err)
+		}
+
+		var mono float64
+		var audible bool
+		for c := range analysisChannels {
+			s := int16(binary.LittleEndian.Uint16(raw[c*2:]))
+
+			if s >= math.MaxInt16 || s <= math.MinInt16+1 {
+				clipRun[c]++
+			} else {
+				if clipRun[c] >= analysisClipRun {
+					clipped += clipRun[c]
+				}
+				clipRun[c] = 0
+			}
+
+			if s > analysisSilenceLevel || s < -analysisSilenceLevel {
+				audible = true
+			}
+			mono += float64(s)
+		}
+
+		if audible {
+			if firstAudible == -1 {
+				firstAudible = total
+			}
+			lastAudible = total
+		}
+		block := total / analysisFrameSize
+		total++
+
+		if block%analysisFrameSkip != 0 {
+			continue
+		}
+
+		frame = append(frame, complex(mono/analysisChannels/math.MaxInt16*window[len(frame)], 0))
+		if len(frame) == analysisFrameSize {
+			fft(frame)
+			for i := range spectrum {
+				spectrum[i] += real(frame[i])*real(frame[i]) + imag(frame[i])*imag(frame[i])
+			}
+			frame = frame[:0]
+		}
+	}
+	for c := range clipRun {
+		if clipRun[c] >= analysisClipRun {
+			clipped += clipRun[c]
+		}
+	}
+
+	if total == 0 {
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("no audio to analyze"))
+	}
+
+	var analysis radio.SubmissionAnalysis
+	analysis.ClippingRatio = float64(clipped) / float64(total*analysisChannels)
+	analysis.SpectralCutoff = spectralCutoff(spectrum)
+	if firstAudible == -1 {
+		// everything is silent
+		analysis.LeadingSilence = samplesToDuration(total)
+	} else {
+		analysis.LeadingSilence = samplesToDuration(firstAudible)
+		analysis.TrailingSilence = samplesToDuration(total - lastAudible - 1)
+	}
+
+	return &analysis, nil
+}
+
+// spectralCutoff returns the frequency above which the spectrum given drops below
+// analysisCutoffDrop dB of the reference level
+func spectralCutoff(spectrum []float64) int {
+	binToFreq := func(bin int) int {
+		return bin * analysisSampleRate / analysisFrameSize
+	}
+	freqToBin := func(freq int) int {
+		return freq * analysisFrameSize / analysisSampleRate
+	}
+
+	var reference float64
+	refMin, refMax := freqToBin(analysisReferenceMin), freqToBin(analysisReferenceMax)
+	for _, v := range spectrum[refMin:refMax] {
+		reference += v
+	}
+	reference /= float64(refMax - refMin)
+	if reference == 0 {
+		// no audio at all
+		return 0
+	}
+
+	threshold := reference * math.Pow(10, -analysisCutoffDrop/10.0)
+	for bin := len(spectrum) - 1; bin > 0; bin-- {
+		if spectrum[bin] > threshold {
+			return binToFreq(bin)
+		}
+	}
+	return 0
+}
+
+func samplesToDuration(n int) time.Duration {
+	return time.Duration(n) * time.Second / analysisSampleRate
+}
+
+// expectedCutoff returns the spectral cutoff we expect to find at minimum for a file
+// with the format and bitrate given, bitrate is in bits per second
+func expectedCutoff(format string, bitrate uint) int {
+	for _, f := range strings.Split(strings.ToLower(format), ",") {
+		if f == "flac" || f == "wav" {
+			return 19000
+		}
+	}
+
+	switch {
+	case bitrate >= 256000:
+		return 19000
+	case bitrate >= 160000:
+		return 17000
+	default:
+		return 15000
+	}
+}
+
+// AnalysisWarnings returns a human readable summary of the problems found in the
+// analysis, format and bitrate should be what ffprobe reported for the file
+func AnalysisWarnings(format string, bitrate uint, a radio.SubmissionAnalysis) []string {
+	var warnings []string
+
+	if a.SpectralCutoff > 0 {
+		if expected := expectedCutoff(format, bitrate); a.SpectralCutoff < expected {
+			warnings = append(warnings, fmt.Sprintf(
+				"spectral cutoff at %.1fkHz is low for %s at %dkbps, likely a transcode",
+				float64(a.SpectralCutoff)/1000, format, bitrate/1000,
+			))
+		}
+	}
+	if a.ClippingRatio > warnClippingRatio {
+		warnings = append(warnings, fmt.Sprintf("%.2f%% of samples are clipped", a.ClippingRatio*100))
+	}
+	if a.Loudness > warnLoudnessMax {
+		warnings = append(warnings, fmt.Sprintf("very loud at %.1f LUFS", a.Loudness))
+	}
+	if a.Loudness < warnLoudnessMin {
+		warnings = append(warnings, fmt.Sprintf("very quiet at %.1f LUFS", a.Loudness))
+	}
+	if a.TruePeak > warnTruePeak {
+		warnings = append(warnings, fmt.Sprintf("true peak at %+.1f dBTP", a.TruePeak))
+	}
+	if a.LeadingSilence > warnLeadingSilence {
+		warnings = append(warnings, fmt.Sprintf("%s of silence at the start", a.LeadingSilence.Round(time.Second/10)))
+	}
+	if a.TrailingSilence > warnTrailingSilence {
+		warnings = append(warnings, fmt.Sprintf("%s of silence at the end", a.TrailingSilence.Round(time.Second/10)))
+	}
+
+	return warnings
+}
@@ -0,0 +1,121 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noisePCM generates mono samples of white noise, if cutoff is non-zero the
+// noise is made up of sines below that frequency instead
+func noisePCM(seconds float64, amplitude float64, cutoff float64) []float64 {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	res := make([]float64, int(seconds*analysisSampleRate))
+
+	if cutoff == 0 {
+		for i := range res {
+			res[i] = (rnd.Float64()*2 - 1) * amplitude
+		}
+		return res
+	}
+
+	var freqs, phases [64]float64
+	for i := range freqs {
+		freqs[i] = 100 + rnd.Float64()*(cutoff-100)
+		phases[i] = rnd.Float64() * 2 * math.Pi
+	}
+	for i := range res {
+		var v float64
+		for f := range freqs {
+			v += math.Sin(2*math.Pi*freqs[f]*float64(i)/analysisSampleRate + phases[f])
+		}
+		res[i] = v / float64(len(freqs)) * amplitude * 4
+	}
+	return res
+}
+
+// encodePCM encodes the mono samples given as s16le stereo PCM
+func encodePCM(samples ...[]float64) []byte {
+	var buf bytes.Buffer
+	for _, part := range samples {
+		for _, v := range part {
+			s := int16(max(min(v, 1), -1) * math.MaxInt16)
+			binary.Write(&buf, binary.LittleEndian, [2]int16{s, s})
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestAnalyzePCM(t *testing.T) {
+	t.Run("clean", func(t *testing.T) {
+		a, err := AnalyzePCM(bytes.NewReader(encodePCM(noisePCM(5, 0.3, 0))))
+		require.NoError(t, err)
+
+		assert.Greater(t, a.SpectralCutoff, 21000)
+		assert.Zero(t, a.ClippingRatio)
+		assert.Zero(t, a.LeadingSilence)
+		assert.Zero(t, a.TrailingSilence)
+	})
+
+	t.Run("lowpassed", func(t *testing.T) {
+		a, err := AnalyzePCM(bytes.NewReader(encodePCM(noisePCM(5, 0.3, 16000))))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 16000, a.SpectralCutoff, 500)
+	})
+
+	t.Run("clipped", func(t *testing.T) {
+		a, err := AnalyzePCM(bytes.NewReader(encodePCM(noisePCM(5, 3, 0))))
+		require.NoError(t, err)
+
+		assert.Greater(t, a.ClippingRatio, 0.1)
+	})
+
+	t.Run("silence", func(t *testing.T) {
+		a, err := AnalyzePCM(bytes.NewReader(encodePCM(
+			make([]float64, 6*analysisSampleRate),
+			noisePCM(5, 0.3, 0),
+			make([]float64, 12*analysisSampleRate),
+		)))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 6*time.Second, a.LeadingSilence, float64(time.Millisecond))
+		assert.InDelta(t, 12*time.Second, a.TrailingSilence, float64(time.Millisecond))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := AnalyzePCM(bytes.NewReader(nil))
+		require.Error(t, err)
+	})
+}
+
+func TestAnalysisWarnings(t *testing.T) {
+	clean := radio.SubmissionAnalysis{
+		SpectralCutoff: 20500,
+		Loudness:       -10,
+		TruePeak:       -0.5,
+	}
+	assert.Empty(t, AnalysisWarnings("mp3", 320000, clean))
+
+	// a youtube rip upscaled to 320kbps
+	transcode := clean
+	transcode.SpectralCutoff = 16000
+	assert.Len(t, AnalysisWarnings("mp3", 320000, transcode), 1)
+	assert.Len(t, AnalysisWarnings("flac", 900000, transcode), 1)
+	assert.Empty(t, AnalysisWarnings("mp3", 128000, transcode))
+
+	bad := clean
+	bad.ClippingRatio = 0.01
+	bad.Loudness = -4
+	bad.TruePeak = 2
+	bad.LeadingSilence = 10 * time.Second
+	bad.TrailingSilence = 30 * time.Second
+	assert.Len(t, AnalysisWarnings("mp3", 320000, bad), 5)
+}
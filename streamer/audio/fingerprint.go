@@ -0,0 +1,247 @@
+package audio
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// FingerprintMaxLength is the maximum length of audio used to create a fingerprint
+	FingerprintMaxLength = 120 * time.Second
+	// FingerprintThreshold is the similarity above which two fingerprints are
+	// considered to be of the same audio
+	FingerprintThreshold = 0.7
+
+	// input format as produced by DecodeFile
+	fingerprintInputRate     = 44100
+	fingerprintInputChannels = 2
+	// we downsample the input by this factor before doing anything else
+	fingerprintDecimate   = 4
+	fingerprintSampleRate = fingerprintInputRate / fingerprintDecimate
+	fingerprintFrameSize  = 2048
+	fingerprintHopSize    = fingerprintFrameSize / 8
+	// fingerprintBands is the amount of frequency bands used, each frame
+	// produces a single uint32 so this has to be 33
+	fingerprintBands   = 33
+	fingerprintMinFreq = 300
+	fingerprintMaxFreq = 2000
+	// fingerprintMaxOffset is the maximum offset in frames that is checked
+	// when comparing two fingerprints, about 5 seconds
+	fingerprintMaxOffset = 200
+	// fingerprintMinOverlap is the minimum amount of frames that need to overlap
+	// for two fingerprints to be compared
+	fingerprintMinOverlap = 160
+)
+
+// Fingerprint decodes the file given and creates an acoustic fingerprint of the
+// first FingerprintMaxLength of audio
+func Fingerprint(ctx context.Context, filename string) (radio.Fingerprint, error) {
+	const op errors.Op = "streamer/audio.Fingerprint"
+	ctx, span := otel.Tracer("").Start(ctx, string(op))
+	defer span.End()
+
+	mb, err := DecodeFile(ctx, filename)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer mb.Close()
+
+	mr, err := mb.Reader()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer mr.Close()
+
+	fp, err := FingerprintPCM(mr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return fp, nil
+}
+
+// FingerprintPCM creates an acoustic fingerprint from the PCM data given, the data is
+// expected to be in the format DecodeFile outputs; s16le with 2 channels at 44100hz.
+//
+// Every frame of audio is turned into 32 bits that describe how the energy differences
+// between neighbouring frequency bands change between frames, this is resistant to
+// volume changes and lossy encoding
+func FingerprintPCM(r io.Reader) (radio.Fingerprint, error) {
+	const op errors.Op = "streamer/audio.FingerprintPCM"
+
+	samples, err := fingerprintSamples(r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(samples) < fingerprintFrameSize+fingerprintHopSize {
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("not enough audio to fingerprint"))
+	}
+
+	edges := fingerprintBandEdges()
+	window := make([]float64, fingerprintFrameSize)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(fingerprintFrameSize-1))
+	}
+
+	buf := make([]complex128, fingerprintFrameSize)
+	prev := make([]float64, fingerprintBands)
+	cur := make([]float64, fingerprintBands)
+
+	var fp radio.Fingerprint
+	for frame, start := 0, 0; start+fingerprintFrameSize <= len(samples); frame, start = frame+1, start+fingerprintHopSize {
+		for i := range buf {
+			buf[i] = complex(samples[start+i]*window[i], 0)
+		}
+		fft(buf)
+
+		for b := range cur {
+			var energy float64
+			for bin := edges[b]; bin < edges[b+1]; bin++ {
+				energy += real(buf[bin])*real(buf[bin]) + imag(buf[bin])*imag(buf[bin])
+			}
+			cur[b] = energy
+		}
+
+		if frame > 0 {
+			var v uint32
+			for b := 0; b < fingerprintBands-1; b++ {
+				diff := (cur[b] - cur[b+1]) - (prev[b] - prev[b+1])
+				if diff > 0 {
+					v |= 1 << b
+				}
+			}
+			fp = append(fp, v)
+		}
+		prev, cur = cur, prev
+	}
+
+	return fp, nil
+}
+
+// fingerprintSamples reads PCM from r and returns it as mono samples at
+// fingerprintSampleRate, at most FingerprintMaxLength is read
+func fingerprintSamples(r io.Reader) ([]float64, error) {
+	const frameSize = fingerprintInputChannels * 2
+	maxSamples := int(FingerprintMaxLength.Seconds() * fingerprintSampleRate)
+
+	br := bufio.NewReader(r)
+	samples := make([]float64, 0, maxSamples)
+	raw := make([]byte, frameSize*fingerprintDecimate)
+
+	for len(samples) < maxSamples {
+		_, err := io.ReadFull(br, raw)
+		if err != nil {
+			if errors.IsE(err, io.EOF) || errors.IsE(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, err
+		}
+
+		// average all channels and decimated samples together, this doubles
+		// as a crude low-pass filter
+		var sum float64
+		for i := 0; i < len(raw); i += 2 {
+			sum += float64(int16(binary.LittleEndian.Uint16(raw[i:])))
+		}
+		samples = append(samples, sum/float64(len(raw)/2)/math.MaxInt16)
+	}
+
+	return samples, nil
+}
+
+// fingerprintBandEdges returns the FFT bin edges of the logarithmically spaced
+// bands used by the fingerprint, band n covers bins [edges[n], edges[n+1])
+func fingerprintBandEdges() []int {
+	edges := make([]int, fingerprintBands+1)
+	ratio := math.Log(float64(fingerprintMaxFreq) / fingerprintMinFreq)
+	for i := range edges {
+		freq := fingerprintMinFreq * math.Exp(ratio*float64(i)/fingerprintBands)
+		edges[i] = int(math.Round(freq * fingerprintFrameSize / fingerprintSampleRate))
+	}
+	// make sure every band has atleast a single bin
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			edges[i] = edges[i-1] + 1
+		}
+	}
+	return edges
+}
+
+// fft does an in-place radix-2 fast fourier transform, len(x) must be a power of two
+func fft(x []complex128) {
+	n := len(x)
+
+	// bit reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				a, b := x[start+k], x[start+k+size/2]*w
+				x[start+k], x[start+k+size/2] = a+b, a-b
+				w *= step
+			}
+		}
+	}
+}
+
+// FingerprintSimilarity compares two fingerprints and returns how similar they are,
+// 1 means identical and unrelated audio will be around 0.5. Small offsets between the
+// two are accounted for
+func FingerprintSimilarity(a, b radio.Fingerprint) float64 {
+	var best float64
+	for offset := -fingerprintMaxOffset; offset <= fingerprintMaxOffset; offset++ {
+		// align a[i] with b[i+offset]
+		ai, bi := 0, offset
+		if offset < 0 {
+			ai, bi = -offset, 0
+		}
+		overlap := min(len(a)-ai, len(b)-bi)
+		if overlap < fingerprintMinOverlap {
+			continue
+		}
+
+		var errs int
+		for i := range overlap {
+			errs += bits.OnesCount32(a[ai+i] ^ b[bi+i])
+		}
+
+		similarity := 1 - float64(errs)/float64(overlap*32)
+		if similarity > best {
+			best = similarity
+		}
+	}
+	return best
+}
+
+// FingerprintMatch returns the candidate most similar to fp, ok is false if none
+// of the candidates are similar enough to pass FingerprintThreshold
+func FingerprintMatch(fp radio.Fingerprint, candidates []radio.TrackFingerprint) (match radio.TrackFingerprint, similarity float64, ok bool) {
+	for _, c := range candidates {
+		s := FingerprintSimilarity(fp, c.Fingerprint)
+		if s > similarity {
+			match, similarity = c, s
+		}
+	}
+	return match, similarity, similarity >= FingerprintThreshold
+}
@@ -0,0 +1,74 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syntheticPCM generates s16le stereo PCM of a sequence of random chords with
+// harmonics, the same seed results in the same chords
+func syntheticPCM(seed uint64, seconds int, delay int, noise float64) []byte {
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	noiseRnd := rand.New(rand.NewPCG(seed+1000, 0))
+
+	const chordLength = fingerprintInputRate / 4
+	var buf bytes.Buffer
+	var freqs [6]float64
+
+	total := seconds * fingerprintInputRate
+	for i := -delay; i < total; i++ {
+		if i < 0 {
+			binary.Write(&buf, binary.LittleEndian, [2]int16{})
+			continue
+		}
+		if i%chordLength == 0 {
+			for f := range freqs {
+				freqs[f] = 100 + rnd.Float64()*1000
+			}
+		}
+
+		var v float64
+		for _, f := range freqs {
+			for h := 1.0; h <= 4; h++ {
+				v += math.Sin(2*math.Pi*f*h*float64(i)/fingerprintInputRate) / h
+			}
+		}
+		v = v/float64(len(freqs))*0.25 + (noiseRnd.Float64()*2-1)*noise
+		s := int16(v * math.MaxInt16)
+		binary.Write(&buf, binary.LittleEndian, [2]int16{s, s})
+	}
+	return buf.Bytes()
+}
+
+func TestFingerprint(t *testing.T) {
+	original, err := FingerprintPCM(bytes.NewReader(syntheticPCM(1, 20, 0, 0)))
+	require.NoError(t, err)
+	require.NotEmpty(t, original)
+
+	t.Run("identical", func(t *testing.T) {
+		assert.Equal(t, 1.0, FingerprintSimilarity(original, original))
+	})
+
+	t.Run("noisy and delayed", func(t *testing.T) {
+		fp, err := FingerprintPCM(bytes.NewReader(syntheticPCM(1, 20, 12345, 0.01)))
+		require.NoError(t, err)
+		assert.Greater(t, FingerprintSimilarity(original, fp), FingerprintThreshold)
+	})
+
+	t.Run("different", func(t *testing.T) {
+		fp, err := FingerprintPCM(bytes.NewReader(syntheticPCM(2, 20, 0, 0)))
+		require.NoError(t, err)
+		assert.Less(t, FingerprintSimilarity(original, fp), FingerprintThreshold)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := FingerprintPCM(bytes.NewReader(make([]byte, 1024)))
+		require.Error(t, err)
+	})
+}
@@ -35,21 +35,66 @@ func DecodeFileGain(ctx context.Context, af AudioFormat, filename string) (*PCMR
 	return NewPCMReader(af, mr), nil
 }
 
+const (
+	// target loudness in LUFs (Loudness Units Full Scale)
+	replaygainI = -14
+	// true peak
+	replaygainTP = 0
+	// loudness range, this describes the overall loduness range,
+	// from the softest part to the loudest part.
+	replaygainLRA = 11
+)
+
+var replaygainSettings = fmt.Sprintf("I=%d:TP=%d:LRA=%d", replaygainI, replaygainTP, replaygainLRA)
+
 func newFFmpegWithReplaygain(ctx context.Context, filename string) (*ffmpeg, error) {
 	ctx, span := otel.Tracer("").Start(ctx, "streamer/audio.newFFmpegWithReplaygain")
 	defer span.End()
 
-	const (
-		// target loudness in LUFs (Loudness Units Full Scale)
-		I = -14
-		// true peak
-		TP = 0
-		// loudness range, this describes the overall loduness range,
-		// from the softest part to the loudest part.
-		LRA = 11
-	)
-	var settings = fmt.Sprintf("I=%d:TP=%d:LRA=%d", I, TP, LRA)
 	// analyze track first
+	info, err := loudnessAnalysis(ctx, filename, replaygainSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("input_i", info.InputI),
+		attribute.String("input_tp", info.InputTp),
+		attribute.String("input_lra", info.InputLra),
+		attribute.String("input_thresh", info.InputThresh),
+		attribute.String("output_i", info.OutputI),
+		attribute.String("output_tp", info.OutputTp),
+		attribute.String("output_lra", info.OutputLra),
+		attribute.String("output_thresh", info.OutputThresh),
+		attribute.String("normalization_type", info.NormalizationType),
+		attribute.String("target_offset", info.TargetOffset),
+	)
+
+	// prepare arguments for second pass
+	var replayinfo = "loudnorm=linear=true:" + replaygainSettings
+	replayinfo += fmt.Sprintf(":measured_I=%s:measured_LRA=%s:measured_TP=%s",
+		info.InputI, info.InputLra, info.InputTp)
+	replayinfo += fmt.Sprintf(":measured_thresh=%s:offset=%s",
+		info.InputThresh, info.TargetOffset)
+
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-i", filename,
+		"-af", replayinfo,
+		"-f", "s16le",
+		"-ac", "2",
+		"-ar", "44100",
+		"-acodec", "pcm_s16le",
+		"-",
+	}
+
+	return newFFmpegCmd(ctx, filename, args)
+}
+
+// loudnessAnalysis runs the analysis pass of the loudnorm filter with the
+// settings given over the file and returns the measured values
+func loudnessAnalysis(ctx context.Context, filename string, settings string) (*replaygainInfo, error) {
 	args := []string{
 		"-hide_banner",
 		"-i", filename,
@@ -89,40 +134,7 @@ func newFFmpegWithReplaygain(ctx context.Context, filename string) (*ffmpeg, err
 	if err != nil {
 		return nil, err
 	}
-
-	span.SetAttributes(
-		attribute.String("input_i", info.InputI),
-		attribute.String("input_tp", info.InputTp),
-		attribute.String("input_lra", info.InputLra),
-		attribute.String("input_thresh", info.InputThresh),
-		attribute.String("output_i", info.OutputI),
-		attribute.String("output_tp", info.OutputTp),
-		attribute.String("output_lra", info.OutputLra),
-		attribute.String("output_thresh", info.OutputThresh),
-		attribute.String("normalization_type", info.NormalizationType),
-		attribute.String("target_offset", info.TargetOffset),
-	)
-
-	// prepare arguments for second pass
-	var replayinfo = "loudnorm=linear=true:" + settings
-	replayinfo += fmt.Sprintf(":measured_I=%s:measured_LRA=%s:measured_TP=%s",
-		info.InputI, info.InputLra, info.InputTp)
-	replayinfo += fmt.Sprintf(":measured_thresh=%s:offset=%s",
-		info.InputThresh, info.TargetOffset)
-
-	args = []string{
-		"-hide_banner",
-		"-loglevel", "error",
-		"-i", filename,
-		"-af", replayinfo,
-		"-f", "s16le",
-		"-ac", "2",
-		"-ar", "44100",
-		"-acodec", "pcm_s16le",
-		"-",
-	}
-
-	return newFFmpegCmd(ctx, filename, args)
+	return info, nil
 }
 
 type replaygainInfo struct {
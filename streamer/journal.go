@@ -0,0 +1,166 @@
+package streamer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/rs/zerolog"
+)
+
+// journalInterval is how often the playback position is stored while
+// a track is playing
+const journalInterval = time.Second * 5
+
+// journalTimeout is how long we wait on storage when storing a position
+const journalTimeout = time.Second * 5
+
+// journal stores the playback position of the streamer so that it can resume
+// where it left off after an unclean exit
+type journal struct {
+	logger  *zerolog.Logger
+	storage radio.StorageService
+
+	// mu is held while writing to storage so that an older position can
+	// never overwrite a newer one
+	mu sync.Mutex
+	// pending is the position waiting to be written by run
+	pending atomic.Pointer[radio.QueuePosition]
+	wake    chan struct{}
+}
+
+func newJournal(ctx context.Context, storage radio.StorageService) *journal {
+	j := &journal{
+		logger:  zerolog.Ctx(ctx),
+		storage: storage,
+		wake:    make(chan struct{}, 1),
+	}
+	go j.run(ctx)
+	return j
+}
+
+// Load returns the stored position, or nil if there is none
+func (j *journal) Load(ctx context.Context) (*radio.QueuePosition, error) {
+	const op errors.Op = "streamer/journal.Load"
+
+	pos, err := j.storage.Queue(ctx).LoadPosition(queueName)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return pos, nil
+}
+
+// Store stores the position right away and drops any pending update, a nil
+// position clears the journal
+func (j *journal) Store(ctx context.Context, pos *radio.QueuePosition) error {
+	const op errors.Op = "streamer/journal.Store"
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.pending.Store(nil)
+	err := j.store(ctx, pos)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// positionQueue is a queue that can remove an entry and store the playback
+// position in a single transaction, *QueueService implements this
+type positionQueue interface {
+	RemovePlaying(ctx context.Context, id radio.QueueID, pos radio.QueuePosition) (bool, error)
+}
+
+// StoreRemove stores the position right away like Store and removes the entry
+// of it from the queue. These happen in a single transaction if the queue
+// supports it, otherwise the position is stored first so that an exit in
+// between plays the entry again instead of losing it
+func (j *journal) StoreRemove(ctx context.Context, pos radio.QueuePosition, queue radio.QueueService) (bool, error) {
+	const op errors.Op = "streamer/journal.StoreRemove"
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.pending.Store(nil)
+
+	if pq, ok := queue.(positionQueue); ok {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), journalTimeout)
+		defer cancel()
+
+		ok, err := pq.RemovePlaying(ctx, pos.Entry.QueueID, pos)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+		return ok, nil
+	}
+
+	err := j.store(ctx, &pos)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	ok, err := queue.Remove(ctx, pos.Entry.QueueID)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return ok, nil
+}
+
+// Update stores the position in the background, it never blocks
+func (j *journal) Update(pos radio.QueuePosition) {
+	j.pending.Store(&pos)
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (j *journal) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-j.wake:
+		}
+
+		j.mu.Lock()
+		if pos := j.pending.Swap(nil); pos != nil {
+			err := j.store(ctx, pos)
+			if err != nil {
+				j.logger.Error().Ctx(ctx).Err(err).Msg("failed to update playback position")
+			}
+		}
+		j.mu.Unlock()
+	}
+}
+
+func (j *journal) store(ctx context.Context, pos *radio.QueuePosition) error {
+	// the position should still be stored when we're stopping
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), journalTimeout)
+	defer cancel()
+
+	return j.storage.Queue(ctx).StorePosition(queueName, pos)
+}
+
+// skipAudio reads and throws away audio from r until atleast n bytes are skipped
+// or r runs out, it returns the amount of bytes skipped. A read of zero bytes
+// is treated as r running out
+func skipAudio(r audio.Reader, n int64) int64 {
+	// use a small buffer so we don't overshoot by much, it only has to
+	// fit a single frame
+	buf := make([]byte, 4096)
+
+	var skipped int64
+	for skipped < n {
+		m, err := r.Read(buf)
+		skipped += int64(m)
+		if m == 0 || err != nil {
+			break
+		}
+	}
+	return skipped
+}
@@ -0,0 +1,180 @@
+package streamer
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var stored []*radio.QueuePosition
+	qs := &mocks.QueueStorageMock{
+		StorePositionFunc: func(name string, pos *radio.QueuePosition) error {
+			mu.Lock()
+			defer mu.Unlock()
+			stored = append(stored, pos)
+			return nil
+		},
+	}
+	j := newJournal(ctx, &mocks.StorageServiceMock{
+		QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
+			return qs
+		},
+	})
+
+	last := func() *radio.QueuePosition {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(stored) == 0 {
+			return nil
+		}
+		return stored[len(stored)-1]
+	}
+
+	entry := radio.QueueEntry{QueueID: radio.NewQueueID()}
+	require.NoError(t, j.Store(ctx, &radio.QueuePosition{Entry: entry}))
+	require.NotNil(t, last())
+	assert.EqualValues(t, 0, last().Offset)
+
+	// updates happen in the background
+	j.Update(radio.QueuePosition{Entry: entry, Offset: 100})
+	j.Update(radio.QueuePosition{Entry: entry, Offset: 200})
+	require.Eventually(t, func() bool {
+		pos := last()
+		return pos != nil && pos.Offset == 200
+	}, time.Second*5, time.Millisecond*10)
+
+	// a pending update should never overwrite a later Store
+	j.mu.Lock()
+	j.Update(radio.QueuePosition{Entry: entry, Offset: 300})
+	j.mu.Unlock()
+	require.NoError(t, j.Store(ctx, nil))
+	time.Sleep(time.Millisecond * 50)
+	assert.Nil(t, last())
+}
+
+func TestNextEntryResume(t *testing.T) {
+	ctx := context.Background()
+
+	reserved := radio.QueueEntry{QueueID: radio.NewQueueID()}
+	s := &Streamer{
+		queue: &mocks.QueueServiceMock{
+			ReserveNextFunc: func(contextMoqParam context.Context) (*radio.QueueEntry, error) {
+				return &reserved, nil
+			},
+		},
+		resume: &radio.QueuePosition{
+			Entry:  radio.QueueEntry{QueueID: radio.NewQueueID()},
+			Offset: 4096,
+		},
+	}
+	resumed := s.resume.Entry
+
+	// the resume position comes first
+	entry, offset, err := s.nextEntry(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, resumed.QueueID, entry.QueueID)
+	assert.EqualValues(t, 4096, offset)
+
+	// and then the queue as normal
+	entry, offset, err = s.nextEntry(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, reserved.QueueID, entry.QueueID)
+	assert.EqualValues(t, 0, offset)
+}
+
+func TestSkipAudio(t *testing.T) {
+	// a reader that returns frames of 1000 bytes
+	remaining := 10
+	r := &mocks.ReaderMock{
+		ReadFunc: func(p []byte) (int, error) {
+			if remaining == 0 {
+				return 0, io.EOF
+			}
+			remaining--
+			return 1000, nil
+		},
+	}
+
+	assert.EqualValues(t, 3000, skipAudio(r, 2500))
+	assert.EqualValues(t, 7000, skipAudio(r, 100000))
+
+	// a reader that returns nothing without an error shouldn't loop forever
+	empty := &mocks.ReaderMock{
+		ReadFunc: func(p []byte) (int, error) {
+			return 0, nil
+		},
+	}
+	assert.EqualValues(t, 0, skipAudio(empty, 1000))
+}
+
+// removePlayingQueue is a queue that supports RemovePlaying
+type removePlayingQueue struct {
+	*mocks.QueueServiceMock
+	removed []radio.QueuePosition
+}
+
+func (q *removePlayingQueue) RemovePlaying(ctx context.Context, id radio.QueueID, pos radio.QueuePosition) (bool, error) {
+	q.removed = append(q.removed, pos)
+	return true, nil
+}
+
+func TestJournalStoreRemove(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls []string
+	qs := &mocks.QueueStorageMock{
+		StorePositionFunc: func(name string, pos *radio.QueuePosition) error {
+			calls = append(calls, "position")
+			return nil
+		},
+	}
+	j := newJournal(ctx, &mocks.StorageServiceMock{
+		QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
+			return qs
+		},
+	})
+
+	pos := radio.QueuePosition{Entry: radio.QueueEntry{QueueID: radio.NewQueueID()}, Offset: 100}
+
+	t.Run("transaction", func(t *testing.T) {
+		calls = nil
+		queue := &removePlayingQueue{QueueServiceMock: &mocks.QueueServiceMock{}}
+
+		ok, err := j.StoreRemove(ctx, pos, queue)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		// the queue stores the position as part of its transaction
+		require.Len(t, queue.removed, 1)
+		assert.Equal(t, pos.Entry.QueueID, queue.removed[0].Entry.QueueID)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		calls = nil
+		queue := &mocks.QueueServiceMock{
+			RemoveFunc: func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
+				calls = append(calls, "remove")
+				return true, nil
+			},
+		}
+
+		ok, err := j.StoreRemove(ctx, pos, queue)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		// the position should be stored before the entry is removed
+		assert.Equal(t, []string{"position", "remove"}, calls)
+	})
+}
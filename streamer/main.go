@@ -3,9 +3,11 @@ package streamer
 import (
 	"context"
 	"net"
+	"net/http"
 
 	"github.com/R-a-dio/valkyrie/cmd"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/storage"
 	"github.com/Wessie/fdstore"
 	"github.com/rs/zerolog"
@@ -19,7 +21,7 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	}
 
 	zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("setting up queue")
-	queue, err := NewQueueService(ctx, cfg, store)
+	queue, err := NewQueueService(ctx, cfg, store, cfg.IRC)
 	if err != nil {
 		return err
 	}
@@ -27,11 +29,31 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	fdstorage := fdstore.NewStoreListenFDs()
 
 	zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("setting up streamer")
-	streamer, err := NewStreamer(ctx, cfg, fdstorage, queue, store.User(ctx))
+	streamer, err := NewStreamer(ctx, cfg, fdstorage, queue, store)
 	if err != nil {
 		return err
 	}
 
+	if addr := cfg.Conf().Streamer.MonitorAddr; addr.Port() != 0 {
+		monitor := NewMonitorServer(ctx, streamer, store)
+		defer monitor.Close()
+
+		ln, err := net.Listen("tcp", addr.String())
+		if err != nil {
+			streamer.Stop(ctx, true)
+			streamer.Wait(ctx)
+			return err
+		}
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Str("address", ln.Addr().String()).Msg("started monitor server")
+
+		go func() {
+			err := monitor.Serve(ln)
+			if err != nil && !errors.IsE(err, http.ErrServerClosed) {
+				zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("monitor server exit")
+			}
+		}()
+	}
+
 	zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("starting grpc server")
 	// setup a http server for our RPC API
 	srv, err := NewGRPCServer(ctx, cfg, store, queue, cfg.IRC, streamer)
@@ -0,0 +1,301 @@
+package streamer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/go-chi/chi/v5"
+	chiware "github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/hlog"
+)
+
+// monitorTimingsSize is the amount of encoder timings kept for the monitor
+const monitorTimingsSize = 10
+
+// monitorSubscriberBuffer is the amount of writes buffered for each listener of
+// the monitor stream, writes are dropped for listeners that fall behind
+const monitorSubscriberBuffer = 64
+
+// monitor keeps track of what the streamer is doing so that staff can look at
+// it through the monitor http server
+type monitor struct {
+	// current is the track being sent to icecast
+	current atomic.Pointer[StreamTrack]
+	// sent is the amount of bytes of current that were sent
+	sent atomic.Int64
+	// bufferEnd is the unix nano time the audio sent so far ends if
+	// played back in realtime
+	bufferEnd atomic.Int64
+
+	// subMu protects subs
+	subMu sync.Mutex
+	subs  map[chan []byte]struct{}
+
+	// timingsMu protects timings
+	timingsMu sync.Mutex
+	timings   []encodeTiming
+}
+
+func newMonitor() *monitor {
+	return &monitor{
+		subs: make(map[chan []byte]struct{}),
+	}
+}
+
+// encodeTiming is how long it took to prepare a single track
+type encodeTiming struct {
+	QueueID  string        `json:"queue_id"`
+	TrackID  radio.TrackID `json:"track_id"`
+	Metadata string        `json:"metadata"`
+	// Decode is how long decoding the file took
+	Decode time.Duration `json:"decode_ns"`
+	// Encode is how long encoding to mp3 took
+	Encode time.Duration `json:"encode_ns"`
+	// Length is the length of the encoded audio
+	Length     time.Duration `json:"length_ns"`
+	FinishedAt time.Time     `json:"finished_at"`
+}
+
+// addTiming records the timing of an encoded track
+func (m *monitor) addTiming(t encodeTiming) {
+	m.timingsMu.Lock()
+	defer m.timingsMu.Unlock()
+
+	m.timings = append(m.timings, t)
+	if len(m.timings) > monitorTimingsSize {
+		m.timings = m.timings[len(m.timings)-monitorTimingsSize:]
+	}
+}
+
+// setCurrent records the track that is now being sent to icecast
+func (m *monitor) setCurrent(track StreamTrack) {
+	m.current.Store(&track)
+	m.sent.Store(track.Offset)
+}
+
+// write records data sent to icecast and passes it to any listeners
+func (m *monitor) write(p []byte, bufferEnd time.Time) {
+	m.sent.Add(int64(len(p)))
+	m.bufferEnd.Store(bufferEnd.UnixNano())
+
+	m.subMu.Lock()
+	defer m.subMu.Unlock()
+	if len(m.subs) == 0 {
+		return
+	}
+
+	// the caller reuses p so we need our own copy
+	data := make([]byte, len(p))
+	copy(data, p)
+	for ch := range m.subs {
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+func (m *monitor) subscribe() chan []byte {
+	ch := make(chan []byte, monitorSubscriberBuffer)
+	m.subMu.Lock()
+	m.subs[ch] = struct{}{}
+	m.subMu.Unlock()
+	return ch
+}
+
+func (m *monitor) unsubscribe(ch chan []byte) {
+	m.subMu.Lock()
+	delete(m.subs, ch)
+	m.subMu.Unlock()
+}
+
+// monitorTrack is a track as shown in the monitor status
+type monitorTrack struct {
+	QueueID  string        `json:"queue_id"`
+	TrackID  radio.TrackID `json:"track_id"`
+	Metadata string        `json:"metadata"`
+	// Length is the length of the encoded audio
+	Length time.Duration `json:"length_ns"`
+	// Progress is how much of the audio was read
+	Progress time.Duration `json:"progress_ns"`
+	// Size is the size of the encoded audio in bytes
+	Size int64 `json:"size"`
+}
+
+func newMonitorTrack(track StreamTrack) monitorTrack {
+	mt := monitorTrack{
+		QueueID:  track.QueueID.String(),
+		TrackID:  track.TrackID,
+		Metadata: track.Metadata,
+		Length:   track.TotalLength(),
+		Progress: track.Audio.Progress(),
+	}
+	if f := track.Audio.GetFile(); f != nil {
+		if fi, err := f.Stat(); err == nil {
+			mt.Size = fi.Size()
+		}
+	}
+	return mt
+}
+
+// monitorStatus is the json response of the monitor status endpoint
+type monitorStatus struct {
+	Running bool `json:"running"`
+	// Current is the track being sent to icecast
+	Current *monitorTrack `json:"current,omitempty"`
+	// Sent is the amount of bytes of Current sent to icecast
+	Sent int64 `json:"sent"`
+	// BufferAhead is how far ahead of realtime the audio sent to icecast is
+	BufferAhead time.Duration `json:"buffer_ahead_ns"`
+	// Preloaded are the tracks encoded and waiting to be played
+	Preloaded []monitorTrack `json:"preloaded"`
+	// PreloadedLength is the total length of the preloaded tracks
+	PreloadedLength time.Duration `json:"preloaded_length_ns"`
+	// PreloadTarget is the length the encoder tries to keep preloaded
+	PreloadTarget time.Duration `json:"preload_target_ns"`
+	// Timings are the timings of the most recently encoded tracks
+	Timings []encodeTiming `json:"timings"`
+}
+
+// currentMonitorStatus returns the status of the streamer for the monitor
+func (s *Streamer) currentMonitorStatus() monitorStatus {
+	m := s.monitor
+
+	s.mu.Lock()
+	status := monitorStatus{
+		Running:       s.running,
+		PreloadTarget: preloadLengthTarget,
+	}
+	s.mu.Unlock()
+
+	if status.Running {
+		if current := m.current.Load(); current != nil {
+			mt := newMonitorTrack(*current)
+			status.Current = &mt
+			status.Sent = m.sent.Load()
+		}
+		if end := m.bufferEnd.Load(); end > 0 {
+			status.BufferAhead = max(time.Until(time.Unix(0, end)), 0)
+		}
+	}
+
+	tracks, length := s.trackStore.Tracks()
+	status.Preloaded = make([]monitorTrack, 0, len(tracks))
+	for _, track := range tracks {
+		status.Preloaded = append(status.Preloaded, newMonitorTrack(track))
+	}
+	status.PreloadedLength = length
+
+	m.timingsMu.Lock()
+	status.Timings = append([]encodeTiming{}, m.timings...)
+	m.timingsMu.Unlock()
+
+	return status
+}
+
+// NewMonitorServer returns the http server of the monitor, it requires basic
+// authentication from a user with admin permissions. The server is plain http
+// so it should only be reachable over a trusted network or from behind a
+// proxy that adds TLS, the credentials are sent in the clear otherwise
+func NewMonitorServer(ctx context.Context, s *Streamer, storage radio.StorageService) *http.Server {
+	r := chi.NewRouter()
+	r.Use(
+		util.NewZerologAttributes(*zerolog.Ctx(ctx)),
+		hlog.RequestIDHandler("req_id", "Request-Id"),
+		hlog.AccessHandler(util.ZerologLoggerFunc),
+	)
+	r.Use(chiware.Recoverer)
+	r.Use(middleware.BasicAuth(s.Config, storage))
+	r.Get("/stream", middleware.RequirePermission(radio.PermAdmin, s.GetMonitorStream))
+	r.Get("/next", middleware.RequirePermission(radio.PermAdmin, s.GetMonitorNext))
+	r.Get("/status", middleware.RequirePermission(radio.PermAdmin, s.GetMonitorStatus))
+
+	return &http.Server{
+		Handler:     r,
+		ReadTimeout: time.Second * 10,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+}
+
+// GetMonitorStream sends the same mp3 data we send to icecast
+func (s *Streamer) GetMonitorStream(w http.ResponseWriter, r *http.Request) {
+	ch := s.monitor.subscribe()
+	defer s.monitor.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "audio/mpeg")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+	_ = rc.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data := <-ch:
+			_, err := w.Write(data)
+			if err != nil {
+				return
+			}
+			_ = rc.Flush()
+		}
+	}
+}
+
+// GetMonitorNext sends the encoded audio of a preloaded track, the ?n= query
+// parameter selects which one with 0 being the next track to play
+func (s *Streamer) GetMonitorNext(w http.ResponseWriter, r *http.Request) {
+	var n int
+	if v := r.URL.Query().Get("n"); v != "" {
+		var err error
+		n, err = strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "invalid n", http.StatusBadRequest)
+			return
+		}
+	}
+
+	tracks, _ := s.trackStore.Tracks()
+	if n >= len(tracks) {
+		http.Error(w, "no such preloaded track", http.StatusNotFound)
+		return
+	}
+	track := tracks[n]
+
+	f := track.Audio.GetFile()
+	if f == nil {
+		http.Error(w, "track has no audio file", http.StatusNotFound)
+		return
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to stat preloaded track")
+		http.Error(w, "failed to read track", http.StatusInternalServerError)
+		return
+	}
+
+	// use ReadAt so that we don't move the offset the streamer reads from
+	audio := io.NewSectionReader(f, 0, fi.Size())
+	w.Header().Set("X-Queue-Id", track.QueueID.String())
+	http.ServeContent(w, r, "next.mp3", time.Time{}, audio)
+}
+
+// GetMonitorStatus sends the status of the streamer as json
+func (s *Streamer) GetMonitorStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(s.currentMonitorStatus())
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to encode monitor status")
+	}
+}
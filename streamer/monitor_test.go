@@ -0,0 +1,153 @@
+package streamer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMonitorTestTrack(t *testing.T, id radio.TrackID, data string) StreamTrack {
+	f, err := os.CreateTemp(t.TempDir(), "track")
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+
+	return StreamTrack{
+		QueueEntry: radio.QueueEntry{
+			QueueID: radio.NewQueueID(),
+			Song: radio.Song{
+				Metadata:      "track " + id.String(),
+				DatabaseTrack: &radio.DatabaseTrack{TrackID: id},
+			},
+		},
+		Audio: &mocks.ReaderMock{
+			TotalLengthFunc: func() time.Duration { return time.Minute },
+			ProgressFunc:    func() time.Duration { return 0 },
+			GetFileFunc:     func() *os.File { return f },
+		},
+	}
+}
+
+func TestMonitorStatus(t *testing.T) {
+	first := newMonitorTestTrack(t, 1, "first")
+	second := newMonitorTestTrack(t, 2, "second")
+
+	s := &Streamer{
+		monitor:    newMonitor(),
+		trackStore: newTracks([]StreamTrack{first, second}),
+	}
+	// pop the first track so it's waiting on the popper, it should still
+	// show up as the next track
+	s.trackStore.pop()
+
+	s.monitor.addTiming(encodeTiming{TrackID: 1, Decode: time.Second, Encode: time.Second * 2})
+
+	req := httptest.NewRequest(http.MethodGet, "/status", nil)
+	rec := httptest.NewRecorder()
+	s.GetMonitorStatus(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status monitorStatus
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.False(t, status.Running)
+	require.Len(t, status.Preloaded, 2)
+	assert.Equal(t, radio.TrackID(1), status.Preloaded[0].TrackID)
+	assert.EqualValues(t, len("first"), status.Preloaded[0].Size)
+	assert.Equal(t, radio.TrackID(2), status.Preloaded[1].TrackID)
+	assert.Equal(t, time.Minute*2, status.PreloadedLength)
+	require.Len(t, status.Timings, 1)
+	assert.Equal(t, time.Second*2, status.Timings[0].Encode)
+}
+
+func TestMonitorNext(t *testing.T) {
+	first := newMonitorTestTrack(t, 1, "first")
+	second := newMonitorTestTrack(t, 2, "second")
+
+	s := &Streamer{
+		monitor:    newMonitor(),
+		trackStore: newTracks([]StreamTrack{first, second}),
+	}
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/next"+query, nil)
+		rec := httptest.NewRecorder()
+		s.GetMonitorNext(rec, req)
+		return rec
+	}
+
+	rec := get("")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "first", rec.Body.String())
+	assert.Equal(t, "audio/mpeg", rec.Header().Get("Content-Type"))
+
+	rec = get("?n=1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "second", rec.Body.String())
+
+	// reading it shouldn't move the offset the streamer reads from
+	off, err := first.Audio.GetFile().Seek(0, io.SeekCurrent)
+	require.NoError(t, err)
+	assert.EqualValues(t, len("first"), off)
+
+	assert.Equal(t, http.StatusNotFound, get("?n=2").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?n=-1").Code)
+}
+
+func TestMonitorStream(t *testing.T) {
+	s := &Streamer{
+		monitor: newMonitor(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.GetMonitorStream(rec, req)
+	}()
+
+	// wait for the handler to subscribe
+	require.Eventually(t, func() bool {
+		s.monitor.subMu.Lock()
+		defer s.monitor.subMu.Unlock()
+		return len(s.monitor.subs) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	buf := []byte("mp3 data")
+	s.monitor.write(buf, time.Now())
+	// the monitor should have its own copy of the data
+	copy(buf, "xxxxxxxx")
+
+	// once the handler received the data it writes it out before it exits
+	require.Eventually(t, func() bool {
+		s.monitor.subMu.Lock()
+		defer s.monitor.subMu.Unlock()
+		for ch := range s.monitor.subs {
+			return len(ch) == 0
+		}
+		return false
+	}, time.Second*5, time.Millisecond*10)
+	cancel()
+	<-done
+
+	assert.Equal(t, "mp3 data", rec.Body.String())
+	assert.EqualValues(t, len("mp3 data"), s.monitor.sent.Load())
+
+	s.monitor.subMu.Lock()
+	assert.Len(t, s.monitor.subs, 0)
+	s.monitor.subMu.Unlock()
+}
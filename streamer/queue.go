@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"sync"
 	"time"
 
@@ -26,8 +27,28 @@ const queueRequestThreshold = 10
 
 const queueName = "default"
 
+const (
+	// QueueStrategyRandom picks random songs for the queue
+	QueueStrategyRandom = "random"
+	// QueueStrategySimilar picks songs similar to the last song in the queue
+	// and falls back to random songs if there are none
+	QueueStrategySimilar = "similar"
+)
+
+// queuePromoteLimit is the maximum amount of pending requests promoted to the
+// queue each time it is populated, so that the pool gets time to collect votes
+const queuePromoteLimit = 2
+
+// queueSimilarLimit is the amount of similar songs looked at when picking a
+// song with QueueStrategySimilar
+const queueSimilarLimit = 20
+
+// queueSimilarPick is the amount of most similar songs we randomly pick from
+// so that the same song doesn't always follow another
+const queueSimilarPick = 3
+
 // NewQueueService returns you a new QueueService with the configuration given
-func NewQueueService(ctx context.Context, cfg config.Config, storage radio.StorageService) (*QueueService, error) {
+func NewQueueService(ctx context.Context, cfg config.Config, storage radio.StorageService, announce radio.AnnounceService) (*QueueService, error) {
 	const op errors.Op = "streamer/NewQueueService"
 
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
@@ -46,10 +67,14 @@ func NewQueueService(ctx context.Context, cfg config.Config, storage radio.Stora
 	}
 
 	qs := &QueueService{
-		logger:  zerolog.Ctx(ctx),
-		Storage: storage,
-		prober:  audio.NewProber(cfg, time.Second*2), // wait 2 seconds at most for ffprobe to run
-		queue:   queue,
+		logger:   zerolog.Ctx(ctx),
+		Storage:  storage,
+		announce: announce,
+		prober:   audio.NewProber(cfg, time.Second*2), // wait 2 seconds at most for ffprobe to run
+		strategy: config.Value(cfg, func(cfg config.Config) string {
+			return cfg.Conf().Streamer.QueueStrategy
+		}),
+		queue: queue,
 	}
 
 	if err = qs.populate(ctx); err != nil {
@@ -67,8 +92,11 @@ func NewQueueService(ctx context.Context, cfg config.Config, storage radio.Stora
 type QueueService struct {
 	logger *zerolog.Logger
 
-	Storage radio.StorageService
-	prober  audio.Prober
+	Storage  radio.StorageService
+	announce radio.AnnounceService
+	prober   audio.Prober
+	// strategy is the QueueStrategy to use when populating
+	strategy func() string
 
 	// mu protects the fields below
 	mu    sync.Mutex
@@ -85,16 +113,7 @@ func (qs *QueueService) append(ctx context.Context, entry radio.QueueEntry) {
 	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
 	defer span.End()
 
-	// try running an ffprobe to get a more accurate song length
-	length, err := qs.prober(ctx, entry.Song)
-	if err != nil {
-		// log any error, but it isn't critical so just continue
-		qs.logger.Error().Ctx(ctx).Err(err).Msg("duration probe failure")
-	}
-
-	if length > 0 { // only change the length if we actually got one
-		entry.Length = length
-	}
+	qs.probeLength(ctx, &entry)
 
 	if len(qs.queue) == 0 {
 		entry.ExpectedStartTime = time.Now()
@@ -108,6 +127,19 @@ func (qs *QueueService) append(ctx context.Context, entry radio.QueueEntry) {
 	qs.queue = append(qs.queue, entry)
 }
 
+// probeLength tries running an ffprobe to get a more accurate song length
+func (qs *QueueService) probeLength(ctx context.Context, entry *radio.QueueEntry) {
+	length, err := qs.prober(ctx, entry.Song)
+	if err != nil {
+		// log any error, but it isn't critical so just continue
+		qs.logger.Error().Ctx(ctx).Err(err).Msg("duration probe failure")
+	}
+
+	if length > 0 { // only change the length if we actually got one
+		entry.Length = length
+	}
+}
+
 // calculateExpectedStartTime calculates the ExpectedStartTime fields of all entries
 // based on the first entries ExpectedStartTime; This will generate incorrect times
 // if the first entry has a wrong time.
@@ -202,20 +234,58 @@ func (qs *QueueService) Remove(ctx context.Context, id radio.QueueID) (bool, err
 	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
 	defer span.End()
 
+	ok, err := qs.remove(ctx, id, nil)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return ok, nil
+}
+
+// RemovePlaying removes the song given from the queue and stores pos as the
+// playback position, both are stored in a single transaction so that the
+// entry is never in the queue and recorded as playing at the same time
+func (qs *QueueService) RemovePlaying(ctx context.Context, id radio.QueueID, pos radio.QueuePosition) (bool, error) {
+	const op errors.Op = "streamer/QueueService.RemovePlaying"
+	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
+	defer span.End()
+
+	ok, err := qs.remove(ctx, id, &pos)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return ok, nil
+}
+
+// remove removes the song given from the queue and stores the queue, and pos
+// if it isn't nil
+func (qs *QueueService) remove(ctx context.Context, id radio.QueueID, pos *radio.QueuePosition) (bool, error) {
 	qs.mu.Lock()
 	defer qs.mu.Unlock()
 
 	size := len(qs.queue)
-	for i, e := range qs.queue {
-		if e.QueueID != id {
-			continue
-		}
-
-		qs.logger.Info().Ctx(ctx).Str("entry", e.String()).Msg("removing from queue")
+	if i := qs.index(id); i != -1 {
+		e := qs.queue[i]
+
+		// entries at the front are being played, everything behind them moves up
+		// including pinned entries; anything else has to leave pinned entries
+		// where they are
+		if i == 0 || i < qs.reservedIndex {
+			qs.logger.Info().Ctx(ctx).Str("entry", e.String()).Msg("removing from queue")
+
+			qs.queue = slices.Delete(qs.queue, i, i+1)
+			if i < qs.reservedIndex {
+				qs.reservedIndex--
+			}
+		} else {
+			queue, ok := removeAnchored(qs.queue, i)
+			if !ok {
+				return false, errors.E(errors.QueueEntryPinned)
+			}
 
-		qs.queue = append(qs.queue[:i], qs.queue[i+1:]...)
-		if i < qs.reservedIndex {
-			qs.reservedIndex--
+			qs.logger.Info().Ctx(ctx).Str("entry", e.String()).Msg("removing from queue")
+			qs.reorder(func() {
+				qs.queue = queue
+			})
 		}
 
 		// we've removed the first song so assume it just started playing; now we update
@@ -225,7 +295,6 @@ func (qs *QueueService) Remove(ctx context.Context, id radio.QueueID) (bool, err
 			qs.queue[0].ExpectedStartTime = time.Now().Add(e.Length)
 			qs.calculateExpectedStartTime()
 		}
-		break
 	}
 
 	go func() {
@@ -246,14 +315,195 @@ func (qs *QueueService) Remove(ctx context.Context, id radio.QueueID) (bool, err
 		}
 	}()
 
-	err := qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	err := qs.store(ctx, pos)
 	if err != nil {
-		return false, errors.E(op, err)
+		return false, err
 	}
 
 	return size != len(qs.queue), nil
 }
 
+// removeAnchored returns a copy of queue without the entry at index i, pinned
+// entries behind it keep their index and the first entries that aren't pinned
+// behind them move up to fill the space. It returns false if that isn't possible
+// because the last entry is pinned
+func removeAnchored(queue []radio.QueueEntry, i int) ([]radio.QueueEntry, bool) {
+	last := len(queue) - 1
+	if i != last && queue[last].IsPinned {
+		return nil, false
+	}
+
+	var free []radio.QueueEntry
+	for _, e := range queue[i+1:] {
+		if !e.IsPinned {
+			free = append(free, e)
+		}
+	}
+
+	res := slices.Clone(queue[:i])
+	for j := i; j < last; j++ {
+		if j != i && queue[j].IsPinned {
+			res = append(res, queue[j])
+			continue
+		}
+		res = append(res, free[0])
+		free = free[1:]
+	}
+	return res, true
+}
+
+// store stores the queue and pos if it isn't nil in a single transaction,
+// qs.mu should be held by the caller
+func (qs *QueueService) store(ctx context.Context, pos *radio.QueuePosition) error {
+	if pos == nil {
+		return qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	}
+
+	qstore, tx, err := qs.Storage.QueueTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = qstore.StorePosition(queueName, pos)
+	if err != nil {
+		return err
+	}
+	err = qstore.Store(queueName, qs.queue)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// index returns the index of the entry with the id given or -1 if it
+// isn't in the queue
+func (qs *QueueService) index(id radio.QueueID) int {
+	return slices.IndexFunc(qs.queue, func(e radio.QueueEntry) bool {
+		return e.QueueID == id
+	})
+}
+
+// hasPinned returns true if any of the entries in qs.queue[lo:hi] is pinned
+func (qs *QueueService) hasPinned(lo, hi int) bool {
+	return slices.ContainsFunc(qs.queue[lo:hi], func(e radio.QueueEntry) bool {
+		return e.IsPinned
+	})
+}
+
+// reorder runs fn to modify the queue and then recalculates the ExpectedStartTime
+// of all entries from the start time the front of the queue had before fn ran
+func (qs *QueueService) reorder(fn func()) {
+	start := time.Now()
+	if len(qs.queue) > 0 {
+		start = qs.queue[0].ExpectedStartTime
+	}
+
+	fn()
+
+	if len(qs.queue) > 0 {
+		qs.queue[0].ExpectedStartTime = start
+		qs.calculateExpectedStartTime()
+	}
+}
+
+// Move implements radio.QueueService
+func (qs *QueueService) Move(ctx context.Context, id radio.QueueID, position int) error {
+	const op errors.Op = "streamer/QueueService.Move"
+	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
+	defer span.End()
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	i := qs.index(id)
+	if i == -1 {
+		return errors.E(op, errors.QueueEntryUnknown)
+	}
+	if i < qs.reservedIndex || position < qs.reservedIndex || position >= len(qs.queue) {
+		return errors.E(op, errors.InvalidArgument, errors.Info("position out of range"))
+	}
+	if i == position {
+		return nil
+	}
+	// everything between the old and new position shifts by one, including the
+	// entry itself, so none of it can be pinned
+	if qs.hasPinned(min(i, position), max(i, position)+1) {
+		return errors.E(op, errors.QueueEntryPinned)
+	}
+
+	entry := qs.queue[i]
+	qs.logger.Info().Ctx(ctx).Str("entry", entry.String()).Int("from", i).Int("to", position).Msg("moving in queue")
+	qs.reorder(func() {
+		qs.queue = slices.Insert(slices.Delete(qs.queue, i, i+1), position, entry)
+	})
+
+	err := qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Insert implements radio.QueueService
+func (qs *QueueService) Insert(ctx context.Context, song radio.Song, position int) (*radio.QueueEntry, error) {
+	const op errors.Op = "streamer/QueueService.Insert"
+	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
+	defer span.End()
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	if position < qs.reservedIndex || position > len(qs.queue) {
+		return nil, errors.E(op, errors.InvalidArgument, errors.Info("position out of range"))
+	}
+	// everything after the position shifts by one
+	if qs.hasPinned(position, len(qs.queue)) {
+		return nil, errors.E(op, errors.QueueEntryPinned)
+	}
+
+	entry := radio.QueueEntry{
+		QueueID: radio.NewQueueID(),
+		Song:    song.Copy(),
+	}
+	qs.probeLength(ctx, &entry)
+
+	qs.logger.Info().Ctx(ctx).Str("entry", entry.String()).Int("position", position).Msg("inserting in queue")
+	qs.reorder(func() {
+		qs.queue = slices.Insert(qs.queue, position, entry)
+	})
+
+	err := qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	entry = qs.queue[position].Copy()
+	return &entry, nil
+}
+
+// Pin implements radio.QueueService
+func (qs *QueueService) Pin(ctx context.Context, id radio.QueueID, pinned bool) error {
+	const op errors.Op = "streamer/QueueService.Pin"
+	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
+	defer span.End()
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	i := qs.index(id)
+	if i == -1 {
+		return errors.E(op, errors.QueueEntryUnknown)
+	}
+	qs.queue[i].IsPinned = pinned
+
+	err := qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // Entries returns all entries in the queue
 func (qs *QueueService) Entries(ctx context.Context) (radio.Queue, error) {
 	const op errors.Op = "streamer/QueueService.Entries"
@@ -275,6 +525,8 @@ func (qs *QueueService) populate(ctx context.Context) error {
 	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
 	defer span.End()
 
+	qs.promote(ctx)
+
 	ts, tx, err := qs.Storage.TrackTx(ctx, nil)
 	if err != nil {
 		return errors.E(op, err)
@@ -324,8 +576,7 @@ outer:
 			break
 		}
 
-		// grab a candidate at random
-		n := rand.IntN(len(candidates))
+		n := qs.pickCandidate(ctx, candidates)
 		id := candidates[n]
 
 		candidates[n] = candidates[len(candidates)-1]
@@ -390,6 +641,147 @@ outer:
 	return errors.E(op, errors.QueueShort)
 }
 
+// promote moves the pending requests with the most votes from the request pool
+// into the queue, if there is space for more requests
+func (qs *QueueService) promote(ctx context.Context) {
+	const op errors.Op = "streamer/QueueService.promote"
+	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
+	defer span.End()
+
+	var requestEntries int
+	for i := range qs.queue {
+		if qs.queue[i].IsUserRequest {
+			requestEntries++
+		}
+	}
+
+	slots := min(queuePromoteLimit, queueRequestThreshold-requestEntries)
+	if slots <= 0 {
+		return
+	}
+
+	pool := qs.Storage.RequestPool(ctx)
+	pending, err := pool.All()
+	if err != nil {
+		qs.logger.Error().Ctx(ctx).Err(err).Msg("failed to retrieve request pool")
+		return
+	}
+
+	for _, request := range pending[:min(slots, len(pending))] {
+		if err := qs.promoteRequest(ctx, request); err != nil {
+			qs.logger.Error().Ctx(ctx).Err(err).Str("song", request.Metadata).Msg("failed to promote request")
+			continue
+		}
+		qs.announceRequest(ctx, request.Song.Copy())
+
+		i := slices.IndexFunc(qs.queue, func(e radio.QueueEntry) bool {
+			return e.TrackID == request.TrackID
+		})
+		if i != -1 {
+			// already in the queue as a random song, see AddRequest
+			qs.queue[i].IsUserRequest = true
+			qs.queue[i].UserIdentifier = request.UserIdentifier
+			continue
+		}
+
+		qs.logger.Info().Ctx(ctx).Int("votes", request.Votes).Str("song", request.Metadata).Msg("promoting request")
+		qs.append(ctx, radio.QueueEntry{
+			Song:           request.Song.Copy(),
+			IsUserRequest:  true,
+			UserIdentifier: request.UserIdentifier,
+		})
+	}
+}
+
+// promoteRequest removes the request given from the request pool and updates
+// the database to represent the request, like RequestSong does for requests
+// that aren't moderated
+func (qs *QueueService) promoteRequest(ctx context.Context, request radio.PendingRequest) error {
+	const op errors.Op = "streamer/QueueService.promoteRequest"
+
+	ts, tx, err := qs.Storage.TrackTx(ctx, nil)
+	if err != nil {
+		return errors.E(op, errors.TransactionBegin, err)
+	}
+	defer tx.Rollback()
+
+	rs, _, err := qs.Storage.RequestTx(ctx, tx)
+	if err != nil {
+		return errors.E(op, errors.TransactionBegin, err)
+	}
+	pool, _, err := qs.Storage.RequestPoolTx(ctx, tx)
+	if err != nil {
+		return errors.E(op, errors.TransactionBegin, err)
+	}
+
+	// remove it first so that it can't get promoted twice
+	if err = pool.Remove(request.TrackID); err != nil {
+		return errors.E(op, err)
+	}
+	if err = rs.UpdateLastRequest(request.UserIdentifier); err != nil {
+		return errors.E(op, err)
+	}
+	if err = ts.UpdateRequestInfo(request.TrackID); err != nil {
+		return errors.E(op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.E(op, errors.TransactionCommit, err)
+	}
+	return nil
+}
+
+// announceRequest announces the promoted request given, this happens in the
+// background because the announcer looks at the queue and our caller holds qs.mu
+func (qs *QueueService) announceRequest(ctx context.Context, song radio.Song) {
+	if qs.announce == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*5)
+		defer cancel()
+
+		err := qs.announce.AnnounceRequest(ctx, song)
+		if err != nil {
+			// not a critical error, but log it anyway
+			qs.logger.Error().Ctx(ctx).Err(err).Msg("failed to announce request")
+		}
+	}()
+}
+
+// pickCandidate returns the index of the candidate that should be added to the
+// queue next
+func (qs *QueueService) pickCandidate(ctx context.Context, candidates []radio.TrackID) int {
+	if qs.strategy == nil || qs.strategy() != QueueStrategySimilar || len(qs.queue) == 0 {
+		// grab a candidate at random
+		return rand.IntN(len(candidates))
+	}
+
+	last := qs.queue[len(qs.queue)-1]
+	similar, err := qs.Storage.Recommendation(ctx).Similar(last.TrackID, queueSimilarLimit)
+	if err != nil {
+		qs.logger.Error().Ctx(ctx).Err(err).Msg("failed to get similar songs")
+		return rand.IntN(len(candidates))
+	}
+
+	// find the most similar songs that are also candidates
+	var picks []int
+	for _, s := range similar {
+		if n := slices.Index(candidates, s.TrackID); n != -1 {
+			picks = append(picks, n)
+		}
+		if len(picks) == queueSimilarPick {
+			break
+		}
+	}
+
+	if len(picks) == 0 {
+		return rand.IntN(len(candidates))
+	}
+	return picks[rand.IntN(len(picks))]
+}
+
 type skipped struct {
 	TrackID radio.TrackID
 	Reason  string
@@ -0,0 +1,504 @@
+package streamer
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickCandidateSimilar(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	recommendation := &mocks.RecommendationStorageMock{
+		SimilarFunc: func(id radio.TrackID, limit int) ([]radio.SimilarSong, error) {
+			if id != 1 {
+				return nil, nil
+			}
+			return []radio.SimilarSong{
+				{Song: radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 50}}, Score: 0.9},
+				{Song: radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 30}}, Score: 0.5},
+			}, nil
+		},
+	}
+
+	strategy := QueueStrategySimilar
+	qs := &QueueService{
+		logger: &logger,
+		Storage: &mocks.StorageServiceMock{
+			RecommendationFunc: func(contextMoqParam context.Context) radio.RecommendationStorage {
+				return recommendation
+			},
+		},
+		strategy: func() string { return strategy },
+		queue: []radio.QueueEntry{
+			{Song: radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 1}}},
+		},
+	}
+
+	candidates := []radio.TrackID{10, 20, 30, 40}
+	for range 10 {
+		n := qs.pickCandidate(ctx, candidates)
+		assert.Equal(t, radio.TrackID(30), candidates[n], "only similar candidate should be picked")
+	}
+
+	// nothing similar should fall back to random
+	qs.queue[0].TrackID = 2
+	n := qs.pickCandidate(ctx, candidates)
+	assert.Contains(t, candidates, candidates[n])
+
+	// and the random strategy shouldn't look at similar songs at all
+	calls := len(recommendation.SimilarCalls())
+	strategy = QueueStrategyRandom
+	qs.pickCandidate(ctx, candidates)
+	assert.Len(t, recommendation.SimilarCalls(), calls)
+}
+
+func TestPromote(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	song := func(id radio.TrackID) radio.Song {
+		return radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: id}}
+	}
+
+	pool := &mocks.RequestPoolStorageMock{
+		AllFunc: func() ([]radio.PendingRequest, error) {
+			return []radio.PendingRequest{
+				{Song: song(5), UserIdentifier: "a", Votes: 10},
+				{Song: song(2), UserIdentifier: "b", Votes: 5},
+				{Song: song(7), UserIdentifier: "c", Votes: 1},
+			}, nil
+		},
+		RemoveFunc: func(id radio.TrackID) error {
+			return nil
+		},
+	}
+
+	tx := &mocks.StorageTxMock{
+		CommitFunc:   func() error { return nil },
+		RollbackFunc: func() error { return nil },
+	}
+	ts := &mocks.TrackStorageMock{
+		UpdateRequestInfoFunc: func(trackID radio.TrackID) error {
+			return nil
+		},
+	}
+	rs := &mocks.RequestStorageMock{
+		UpdateLastRequestFunc: func(identifier string) error {
+			return nil
+		},
+	}
+	announce := &mocks.AnnounceServiceMock{
+		AnnounceRequestFunc: func(contextMoqParam context.Context, song radio.Song) error {
+			return nil
+		},
+	}
+
+	qs := &QueueService{
+		logger: &logger,
+		Storage: &mocks.StorageServiceMock{
+			RequestPoolFunc: func(contextMoqParam context.Context) radio.RequestPoolStorage {
+				return pool
+			},
+			RequestPoolTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestPoolStorage, radio.StorageTx, error) {
+				return pool, tx, nil
+			},
+			TrackTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+				return ts, tx, nil
+			},
+			RequestTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.RequestStorage, radio.StorageTx, error) {
+				return rs, tx, nil
+			},
+		},
+		announce: announce,
+		prober: func(ctx context.Context, song radio.Song) (time.Duration, error) {
+			return time.Minute, nil
+		},
+		queue: []radio.QueueEntry{
+			{Song: song(1)},
+			{Song: song(2)},
+		},
+	}
+
+	qs.promote(ctx)
+
+	// only the two with the most votes should be promoted
+	require.Len(t, pool.RemoveCalls(), 2)
+	assert.Equal(t, radio.TrackID(5), pool.RemoveCalls()[0].TrackID)
+	assert.Equal(t, radio.TrackID(2), pool.RemoveCalls()[1].TrackID)
+
+	require.Len(t, qs.queue, 3)
+	// the one already in the queue should've been turned into a request
+	assert.True(t, qs.queue[1].IsUserRequest)
+	assert.Equal(t, "b", qs.queue[1].UserIdentifier)
+	// and the other one added at the end
+	assert.Equal(t, radio.TrackID(5), qs.queue[2].TrackID)
+	assert.True(t, qs.queue[2].IsUserRequest)
+	assert.Equal(t, "a", qs.queue[2].UserIdentifier)
+
+	// the cooldowns should only be applied to the promoted requests
+	require.Len(t, rs.UpdateLastRequestCalls(), 2)
+	assert.Equal(t, "a", rs.UpdateLastRequestCalls()[0].Identifier)
+	assert.Equal(t, "b", rs.UpdateLastRequestCalls()[1].Identifier)
+	require.Len(t, ts.UpdateRequestInfoCalls(), 2)
+	assert.Equal(t, radio.TrackID(5), ts.UpdateRequestInfoCalls()[0].TrackID)
+	assert.Len(t, tx.CommitCalls(), 2)
+
+	// and they should be announced like any other request
+	require.Eventually(t, func() bool {
+		return len(announce.AnnounceRequestCalls()) == 2
+	}, time.Second, time.Millisecond*10)
+
+	// no promotions if the queue already has enough requests
+	qs.queue = nil
+	for i := range queueRequestThreshold {
+		qs.queue = append(qs.queue, radio.QueueEntry{Song: song(radio.TrackID(100 + i)), IsUserRequest: true})
+	}
+	qs.promote(ctx)
+	assert.Len(t, pool.RemoveCalls(), 2)
+}
+
+func TestQueueReorder(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newQueueService := func() *QueueService {
+		queue := make([]radio.QueueEntry, 4)
+		for i := range queue {
+			queue[i] = radio.QueueEntry{
+				QueueID: radio.NewQueueID(),
+				Song: radio.Song{
+					Length:        time.Minute * time.Duration(i+1),
+					DatabaseTrack: &radio.DatabaseTrack{TrackID: radio.TrackID(i + 1)},
+				},
+			}
+		}
+		queue[0].ExpectedStartTime = start
+
+		qs := &QueueService{
+			logger: &logger,
+			Storage: &mocks.StorageServiceMock{
+				QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
+					return &mocks.QueueStorageMock{
+						StoreFunc: func(name string, queue []radio.QueueEntry) error {
+							return nil
+						},
+					}
+				},
+			},
+			prober: func(ctx context.Context, song radio.Song) (time.Duration, error) {
+				return time.Minute * 10, nil
+			},
+			queue: queue,
+		}
+		qs.calculateExpectedStartTime()
+		return qs
+	}
+
+	trackIDs := func(qs *QueueService) []radio.TrackID {
+		var ids []radio.TrackID
+		for _, e := range qs.queue {
+			ids = append(ids, e.TrackID)
+		}
+		return ids
+	}
+
+	// the expected start times should always follow from the first entry
+	checkTimes := func(t *testing.T, qs *QueueService) {
+		assert.Equal(t, start, qs.queue[0].ExpectedStartTime)
+		for i := 1; i < len(qs.queue); i++ {
+			prev := qs.queue[i-1]
+			assert.Equal(t, prev.ExpectedStartTime.Add(prev.Length), qs.queue[i].ExpectedStartTime)
+		}
+	}
+
+	t.Run("move", func(t *testing.T) {
+		qs := newQueueService()
+		require.NoError(t, qs.Move(ctx, qs.queue[3].QueueID, 0))
+		assert.Equal(t, []radio.TrackID{4, 1, 2, 3}, trackIDs(qs))
+		checkTimes(t, qs)
+
+		require.NoError(t, qs.Move(ctx, qs.queue[0].QueueID, 2))
+		assert.Equal(t, []radio.TrackID{1, 2, 4, 3}, trackIDs(qs))
+		checkTimes(t, qs)
+
+		err := qs.Move(ctx, radio.NewQueueID(), 0)
+		assert.True(t, errors.Is(errors.QueueEntryUnknown, err))
+		err = qs.Move(ctx, qs.queue[0].QueueID, 4)
+		assert.True(t, errors.Is(errors.InvalidArgument, err))
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		qs := newQueueService()
+		entry, err := qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 50}}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, radio.TrackID(50), entry.TrackID)
+		assert.Equal(t, time.Minute*10, entry.Length)
+		assert.Equal(t, start.Add(time.Minute), entry.ExpectedStartTime)
+		assert.Equal(t, []radio.TrackID{1, 50, 2, 3, 4}, trackIDs(qs))
+		checkTimes(t, qs)
+
+		_, err = qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 60}}, 5)
+		require.NoError(t, err)
+		assert.Equal(t, radio.TrackID(60), qs.queue[5].TrackID)
+
+		_, err = qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 70}}, 7)
+		assert.True(t, errors.Is(errors.InvalidArgument, err))
+	})
+
+	t.Run("reserved", func(t *testing.T) {
+		qs := newQueueService()
+		qs.reservedIndex = 1
+
+		err := qs.Move(ctx, qs.queue[0].QueueID, 2)
+		assert.True(t, errors.Is(errors.InvalidArgument, err))
+		err = qs.Move(ctx, qs.queue[2].QueueID, 0)
+		assert.True(t, errors.Is(errors.InvalidArgument, err))
+		_, err = qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 50}}, 0)
+		assert.True(t, errors.Is(errors.InvalidArgument, err))
+
+		require.NoError(t, qs.Move(ctx, qs.queue[3].QueueID, 1))
+		assert.Equal(t, []radio.TrackID{1, 4, 2, 3}, trackIDs(qs))
+	})
+
+	t.Run("pin", func(t *testing.T) {
+		qs := newQueueService()
+		require.NoError(t, qs.Pin(ctx, qs.queue[2].QueueID, true))
+		assert.True(t, qs.queue[2].IsPinned)
+
+		// anything that shifts the pinned entry is refused
+		err := qs.Move(ctx, qs.queue[3].QueueID, 0)
+		assert.True(t, errors.Is(errors.QueueEntryPinned, err))
+		err = qs.Move(ctx, qs.queue[2].QueueID, 3)
+		assert.True(t, errors.Is(errors.QueueEntryPinned, err))
+		_, err = qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 50}}, 1)
+		assert.True(t, errors.Is(errors.QueueEntryPinned, err))
+
+		// but moves around it are fine
+		require.NoError(t, qs.Move(ctx, qs.queue[1].QueueID, 0))
+		_, err = qs.Insert(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 50}}, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{2, 1, 3, 50, 4}, trackIDs(qs))
+
+		require.NoError(t, qs.Pin(ctx, qs.queue[2].QueueID, false))
+		require.NoError(t, qs.Move(ctx, qs.queue[4].QueueID, 0))
+
+		err = qs.Pin(ctx, radio.NewQueueID(), true)
+		assert.True(t, errors.Is(errors.QueueEntryUnknown, err))
+	})
+
+	t.Run("pin anchored", func(t *testing.T) {
+		qs := newQueueService()
+		qs.queue = append(qs.queue, radio.QueueEntry{
+			QueueID: radio.NewQueueID(),
+			Song: radio.Song{
+				Length:        time.Minute,
+				DatabaseTrack: &radio.DatabaseTrack{TrackID: 5},
+			},
+		})
+		qs.calculateExpectedStartTime()
+
+		// Remove populates the queue in the background, make that a no-op
+		storage := qs.Storage.(*mocks.StorageServiceMock)
+		storage.RequestPoolFunc = func(contextMoqParam context.Context) radio.RequestPoolStorage {
+			return &mocks.RequestPoolStorageMock{
+				AllFunc: func() ([]radio.PendingRequest, error) { return nil, nil },
+			}
+		}
+		storage.TrackTxFunc = func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+			return &mocks.TrackStorageMock{
+				QueueCandidatesFunc: func() ([]radio.TrackID, error) { return nil, nil },
+			}, mocks.RollbackTx(t), nil
+		}
+		remove := func(id radio.QueueID) error {
+			calls := len(storage.TrackTxCalls())
+			_, err := qs.Remove(ctx, id)
+			if err != nil {
+				return err
+			}
+			// wait for the populate to start and then for it to release the lock
+			require.Eventually(t, func() bool {
+				return len(storage.TrackTxCalls()) > calls
+			}, time.Second, time.Millisecond)
+			qs.mu.Lock()
+			defer qs.mu.Unlock()
+			return nil
+		}
+
+		require.NoError(t, qs.Pin(ctx, qs.queue[2].QueueID, true))
+
+		// removing something in front of it should fill the space with the
+		// first entry behind it
+		err := remove(qs.queue[1].QueueID)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{1, 4, 3, 5}, trackIDs(qs))
+		checkTimes(t, qs)
+
+		// requests, reserving and populating only add to the end
+		require.NoError(t, qs.AddRequest(ctx, radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 60}}, "someone"))
+		_, err = qs.ReserveNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{1, 4, 3, 5, 60}, trackIDs(qs))
+
+		// removing the one being played moves everything up
+		err = remove(qs.queue[0].QueueID)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{4, 3, 5, 60}, trackIDs(qs))
+		assert.True(t, qs.queue[1].IsPinned)
+
+		// and removing behind it doesn't touch it
+		err = remove(qs.queue[2].QueueID)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{4, 3, 60}, trackIDs(qs))
+
+		// a pinned entry at the end has nothing behind it to fill the space
+		require.NoError(t, qs.Pin(ctx, qs.queue[2].QueueID, true))
+		qs.reservedIndex = 0
+		qs.queue = slices.Insert(qs.queue, 0, radio.QueueEntry{
+			QueueID: radio.NewQueueID(),
+			Song:    radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 70}},
+		})
+		err = remove(qs.queue[1].QueueID)
+		assert.True(t, errors.Is(errors.QueueEntryPinned, err))
+		assert.Equal(t, []radio.TrackID{70, 4, 3, 60}, trackIDs(qs))
+
+		// but removing the pinned entry itself is fine
+		err = remove(qs.queue[3].QueueID)
+		require.NoError(t, err)
+		assert.Equal(t, []radio.TrackID{70, 4, 3}, trackIDs(qs))
+	})
+}
+
+func TestQueueStorePosition(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	var positions []*radio.QueuePosition
+	var stored int
+	qstore := &mocks.QueueStorageMock{
+		StorePositionFunc: func(name string, pos *radio.QueuePosition) error {
+			positions = append(positions, pos)
+			return nil
+		},
+		StoreFunc: func(name string, queue []radio.QueueEntry) error {
+			stored++
+			return nil
+		},
+	}
+	storage := &mocks.StorageServiceMock{
+		QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
+			return qstore
+		},
+		QueueTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.QueueStorage, radio.StorageTx, error) {
+			return qstore, mocks.CommitTx(t), nil
+		},
+	}
+	qs := &QueueService{
+		logger:  &logger,
+		Storage: storage,
+	}
+
+	// without a position the queue is stored as usual
+	require.NoError(t, qs.store(ctx, nil))
+	assert.Equal(t, 1, stored)
+	assert.Empty(t, positions)
+	assert.Empty(t, storage.QueueTxCalls())
+
+	// with a position both are stored in the same transaction
+	pos := &radio.QueuePosition{Entry: radio.QueueEntry{QueueID: radio.NewQueueID()}}
+	require.NoError(t, qs.store(ctx, pos))
+	assert.Equal(t, 2, stored)
+	require.Len(t, positions, 1)
+	assert.Equal(t, pos, positions[0])
+	assert.Len(t, storage.QueueTxCalls(), 1)
+}
+
+func TestRemoveAnchored(t *testing.T) {
+	cases := []struct {
+		name   string
+		pinned []int
+		remove int
+		expect []radio.TrackID
+		ok     bool
+	}{
+		{
+			name:   "nothing pinned",
+			remove: 1,
+			expect: []radio.TrackID{1, 3, 4, 5, 6},
+			ok:     true,
+		},
+		{
+			name:   "pinned in a row",
+			pinned: []int{2, 3},
+			remove: 1,
+			expect: []radio.TrackID{1, 5, 3, 4, 6},
+			ok:     true,
+		},
+		{
+			name:   "pinned apart",
+			pinned: []int{2, 4},
+			remove: 0,
+			expect: []radio.TrackID{2, 4, 3, 6, 5},
+			ok:     true,
+		},
+		{
+			name:   "removing a pinned entry",
+			pinned: []int{2, 3},
+			remove: 2,
+			expect: []radio.TrackID{1, 2, 5, 4, 6},
+			ok:     true,
+		},
+		{
+			name:   "pinned at the end",
+			pinned: []int{5},
+			remove: 1,
+		},
+		{
+			name:   "removing the pinned end",
+			pinned: []int{5},
+			remove: 5,
+			expect: []radio.TrackID{1, 2, 3, 4, 5},
+			ok:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			queue := make([]radio.QueueEntry, 6)
+			for i := range queue {
+				queue[i].Song = radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: radio.TrackID(i + 1)}}
+			}
+			for _, i := range c.pinned {
+				queue[i].IsPinned = true
+			}
+
+			res, ok := removeAnchored(queue, c.remove)
+			require.Equal(t, c.ok, ok)
+			if !ok {
+				return
+			}
+
+			var ids []radio.TrackID
+			for _, e := range res {
+				ids = append(ids, e.TrackID)
+			}
+			assert.Equal(t, c.expect, ids)
+			// pinned entries that weren't removed should keep their index
+			for _, i := range c.pinned {
+				if i != c.remove {
+					assert.True(t, res[i].IsPinned)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package streamer
 
 import (
 	"context"
+	"io"
 	"net"
 	"net/url"
 	"sync"
@@ -33,7 +34,7 @@ var (
 func NewStreamer(ctx context.Context, cfg config.Config,
 	fdstorage *fdstore.Store,
 	qs radio.QueueService,
-	us radio.UserStorage,
+	storage radio.StorageService,
 ) (*Streamer, error) {
 	const op errors.Op = "streamer.NewStreamer"
 
@@ -42,6 +43,7 @@ func NewStreamer(ctx context.Context, cfg config.Config,
 		baseCtx:   ctx,
 		queue:     qs,
 		fdstorage: fdstorage,
+		monitor:   newMonitor(),
 		lastStartPoke: util.NewTypedValue(
 			time.Now().Add(-time.Duration(cfg.Conf().Streamer.ConnectTimeout) * 2),
 		),
@@ -62,7 +64,7 @@ func NewStreamer(ctx context.Context, cfg config.Config,
 	}
 
 	// grab the full user from the database
-	user, err := us.Get(username)
+	user, err := storage.User(ctx).Get(username)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -70,13 +72,20 @@ func NewStreamer(ctx context.Context, cfg config.Config,
 
 	zerolog.Ctx(ctx).Info().Ctx(ctx).Str("username", user.Username).Msg("this is me")
 
+	s.journal = newJournal(ctx, storage)
+
 	// before we check for the user from the manager, check if we are doing a restart
 	// and have saved state in the fdstore
-	s.checkFDStore(ctx, fdstorage)
+	recovered := s.checkFDStore(ctx, fdstorage)
 	if s.trackStore == nil {
 		// only create a new track storage if checkFDStore didn't make one for us
 		s.trackStore = NewTracks(ctx, fdstorage, nil)
 	}
+	if !recovered {
+		// no current song from a graceful restart, see if we exited in the
+		// middle of one instead
+		s.checkJournal(ctx)
+	}
 
 	// timer we use for starting the streamer if nobody is on
 	startTimer := util.NewCallbackTimer(func() {
@@ -100,10 +109,12 @@ func NewStreamer(ctx context.Context, cfg config.Config,
 	return s, nil
 }
 
-func (s *Streamer) checkFDStore(ctx context.Context, store *fdstore.Store) {
+// checkFDStore restores the state stored by a graceful restart, it returns true
+// if the current song was restored
+func (s *Streamer) checkFDStore(ctx context.Context, store *fdstore.Store) bool {
 	if store == nil {
 		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("nothing to restore from fdstore")
-		return
+		return false
 	}
 
 	var conn net.Conn
@@ -153,9 +164,12 @@ func (s *Streamer) checkFDStore(ctx context.Context, store *fdstore.Store) {
 
 	if current != nil {
 		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("recovered the current song")
+		// the file offset is how far we got in the song before the restart
+		offset, _ := current.Seek(0, io.SeekCurrent)
 		entries = append(entries, StreamTrack{
 			QueueEntry: currentEntry,
 			Audio:      current,
+			Offset:     offset,
 		})
 	}
 
@@ -228,6 +242,36 @@ func (s *Streamer) checkFDStore(ctx context.Context, store *fdstore.Store) {
 		// only force a start if we recovered something
 		s.start(ctx, conn)
 	}
+	return current != nil
+}
+
+// checkJournal looks for a playback position left behind by an unclean exit
+// and sets it up to be resumed by the encoder
+func (s *Streamer) checkJournal(ctx context.Context) {
+	pos, err := s.journal.Load(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to load playback position")
+		return
+	}
+	if pos == nil {
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Msg("nothing to resume from journal")
+		return
+	}
+
+	zerolog.Ctx(ctx).Info().Ctx(ctx).
+		Str("queue_id", pos.Entry.QueueID.String()).
+		Uint64("trackid", uint64(pos.Entry.TrackID)).
+		Str("metadata", pos.Entry.Metadata).
+		Int64("offset", pos.Offset).
+		Msg("resuming after unclean exit")
+
+	// the entry is still in the queue if we exited before removing it, it
+	// shouldn't play twice
+	_, err = s.journal.StoreRemove(ctx, *pos, s.queue)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to remove resumed queue entry")
+	}
+	s.resume = pos
 }
 
 func (s *Streamer) userChange(ctx context.Context, user *radio.User, timer *util.CallbackTimer) {
@@ -299,6 +343,13 @@ type Streamer struct {
 	baseCtx context.Context
 	// trackStore holds preloaded tracks for the encoder
 	trackStore *trackstore
+	// journal stores our playback position for crash recovery
+	journal *journal
+	// resume is the position to resume from after an unclean exit, it is
+	// only used by the encoder
+	resume *radio.QueuePosition
+	// monitor records what we're doing for the monitor server
+	monitor *monitor
 
 	userValue     *util.Value[*radio.User]
 	lastStartPoke *util.TypedValue[time.Time]
@@ -446,7 +497,7 @@ func (s *Streamer) encoder(ctx context.Context, encoder *audio.LAME) error {
 
 	defer s.queue.ResetReserved(context.WithoutCancel(ctx))
 	for !s.forced.Load() {
-		entry, err := s.queue.ReserveNext(ctx)
+		entry, offset, err := s.nextEntry(ctx)
 		if err != nil {
 			logger.Error().Ctx(ctx).Err(err).Msg("failed to get next queue entry")
 			time.Sleep(time.Second * 2)
@@ -467,11 +518,12 @@ func (s *Streamer) encoder(ctx context.Context, encoder *audio.LAME) error {
 			s.queue.Remove(ctx, entry.QueueID)
 			continue
 		}
+		decodeElapsed := time.Since(start)
 		logger.Info().
 			Str("queue_id", entry.QueueID.String()).
 			Uint64("trackid", uint64(entry.TrackID)).
 			Str("metadata", entry.Metadata).
-			Dur("elapsed", time.Since(start)).
+			Dur("elapsed", decodeElapsed).
 			Msg("finished decoding")
 
 		mp3, err := audio.NewMP3Buffer(entry.Metadata, nil)
@@ -531,13 +583,23 @@ func (s *Streamer) encoder(ctx context.Context, encoder *audio.LAME) error {
 			logger.Error().Ctx(ctx).Err(err).Msg("failed to write mp3 data")
 			continue
 		}
+		encodeElapsed := time.Since(start)
 		logger.Info().
 			Str("queue_id", entry.QueueID.String()).
 			Uint64("trackid", uint64(entry.TrackID)).
 			Str("metadata", entry.Metadata).
-			Dur("elapsed", time.Since(start)).
+			Dur("elapsed", encodeElapsed).
 			Dur("length", mp3.TotalLength()).
 			Msg("finished encoding")
+		s.monitor.addTiming(encodeTiming{
+			QueueID:    entry.QueueID.String(),
+			TrackID:    entry.TrackID,
+			Metadata:   entry.Metadata,
+			Decode:     decodeElapsed,
+			Encode:     encodeElapsed,
+			Length:     mp3.TotalLength(),
+			FinishedAt: time.Now(),
+		})
 
 		// make a reader out of our buffer
 		mp3r, err := mp3.Reader()
@@ -548,9 +610,14 @@ func (s *Streamer) encoder(ctx context.Context, encoder *audio.LAME) error {
 		// close the write side
 		mp3.Close()
 
+		// skip what was already played if we're resuming this entry
+		if offset > 0 {
+			offset = skipAudio(mp3r, offset)
+		}
+
 		// send the data to the icecast routine
 		select {
-		case <-s.trackStore.add(StreamTrack{*entry, mp3r}):
+		case <-s.trackStore.add(StreamTrack{*entry, mp3r, offset}):
 		case <-ctx.Done():
 			return context.Cause(ctx)
 		}
@@ -559,6 +626,18 @@ func (s *Streamer) encoder(ctx context.Context, encoder *audio.LAME) error {
 	return nil
 }
 
+// nextEntry returns the entry to encode next and the amount of encoded bytes
+// that were already played of it, this is the resume position if we have one
+func (s *Streamer) nextEntry(ctx context.Context) (*radio.QueueEntry, int64, error) {
+	if pos := s.resume; pos != nil {
+		s.resume = nil
+		return &pos.Entry, pos.Offset, nil
+	}
+
+	entry, err := s.queue.ReserveNext(ctx)
+	return entry, 0, err
+}
+
 const preloadLengthTarget = time.Second * 60
 
 var closedChannel = make(chan struct{})
@@ -575,6 +654,8 @@ type trackstore struct {
 	mu              sync.Mutex
 	tracks          []StreamTrack
 	preloadedLength time.Duration
+	// waiting is the track that was popped but not yet received by the popper
+	waiting *StreamTrack
 
 	addNotify chan struct{}
 }
@@ -664,6 +745,7 @@ func (ts *trackstore) notify(track StreamTrack) {
 
 	// remove total song length from our counter
 	ts.preloadedLength -= track.TotalLength()
+	ts.waiting = nil
 
 	// check if there was a notify channel
 	if ts.addNotify == nil {
@@ -767,9 +849,24 @@ func (ts *trackstore) pop() *StreamTrack {
 	track := ts.tracks[0]
 	copy(ts.tracks, ts.tracks[1:])
 	ts.tracks = ts.tracks[:len(ts.tracks)-1]
+	ts.waiting = &track
 	return &track
 }
 
+// Tracks returns the preloaded tracks in the order they will be played and
+// the total length of them
+func (ts *trackstore) Tracks() ([]StreamTrack, time.Duration) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	tracks := make([]StreamTrack, 0, len(ts.tracks)+1)
+	if ts.waiting != nil {
+		tracks = append(tracks, *ts.waiting)
+	}
+	tracks = append(tracks, ts.tracks...)
+	return tracks, ts.preloadedLength
+}
+
 func (s *Streamer) icecast(ctx context.Context, conn net.Conn, trackCh <-chan StreamTrack) error {
 	defer func() {
 		// we take ownership of the conn passed in, close it once we exit
@@ -779,6 +876,20 @@ func (s *Streamer) icecast(ctx context.Context, conn net.Conn, trackCh <-chan St
 	}()
 	logger := zerolog.Ctx(ctx)
 
+	// stopped is true if we were asked to stop after the current song
+	var stopped bool
+	defer func() {
+		// a deliberate stop leaves nothing to resume, so only a restart or
+		// an unclean exit should leave a position behind
+		if s.restart.Load() || (!stopped && !s.forced.Load()) {
+			return
+		}
+		err := s.journal.Store(ctx, nil)
+		if err != nil {
+			logger.Error().Ctx(ctx).Err(err).Msg("failed to clear playback position")
+		}
+	}()
+
 	var track StreamTrack
 	var ok bool
 	buf := make([]byte, bufferMP3Size)
@@ -793,16 +904,20 @@ func (s *Streamer) icecast(ctx context.Context, conn net.Conn, trackCh <-chan St
 		select {
 		case track, ok = <-trackCh:
 			if !ok {
+				stopped = true
 				return nil
 			}
 		case <-ctx.Done():
 			return context.Cause(ctx)
 		}
 
-		// remove the entry we're about to play from the queue
-		ok, err := s.queue.Remove(ctx, track.QueueID)
+		// record the entry as playing and remove it from the queue
+		ok, err := s.journal.StoreRemove(ctx, radio.QueuePosition{
+			Entry:  track.QueueEntry,
+			Offset: track.Offset,
+		}, s.queue)
 		if err != nil {
-			logger.Error().Ctx(ctx).Err(err).Msg("failed to remove queue entry")
+			logger.Error().Ctx(ctx).Err(err).Msg("failed to store playback position and remove queue entry")
 		}
 		if !ok {
 			logger.Warn().Msg("failed to remove queue entry")
@@ -810,9 +925,14 @@ func (s *Streamer) icecast(ctx context.Context, conn net.Conn, trackCh <-chan St
 
 		// send the entries metadata to icecast
 		go s.metadataToIcecast(ctx, track.QueueEntry)
+		s.monitor.setCurrent(track)
 
-		// lastProgress is the value of the previous loops Progress call
-		lastProgress := time.Duration(0)
+		// lastProgress is the value of the previous loops Progress call, this
+		// isn't zero if part of the track was skipped
+		lastProgress := track.Audio.Progress()
+		// offset is the amount of bytes of the track we've sent
+		offset := track.Offset
+		lastJournal := time.Now()
 
 		for !s.forced.Load() {
 			// read some audio data
@@ -851,6 +971,16 @@ func (s *Streamer) icecast(ctx context.Context, conn net.Conn, trackCh <-chan St
 				continue
 			}
 
+			s.monitor.write(buf[:n], bufferEnd)
+			offset += int64(n)
+			if time.Since(lastJournal) >= journalInterval {
+				s.journal.Update(radio.QueuePosition{
+					Entry:  track.QueueEntry,
+					Offset: offset,
+				})
+				lastJournal = time.Now()
+			}
+
 			time.Sleep(time.Until(bufferEnd) - bufferSlack)
 		}
 
@@ -957,6 +1087,9 @@ func (s *Streamer) streamURL() *url.URL {
 type StreamTrack struct {
 	radio.QueueEntry
 	Audio audio.Reader
+	// Offset is the amount of bytes of the track that were played before
+	// Audio, this is non-zero for a resumed track
+	Offset int64
 }
 
 func (st *StreamTrack) StoreSelf(fdstorage *fdstore.Store) error {
@@ -57,7 +57,7 @@ func ExecuteStandalone(ctx context.Context, cfg config.Config) error {
 	sessionManager := vmiddleware.NewSessionManager(ctx, storage, true)
 	r.Use(sessionManager.LoadAndSave)
 	// user handling
-	authentication := vmiddleware.NewAuthentication(storage, executor, sessionManager)
+	authentication := vmiddleware.NewAuthentication(cfg, storage, executor, sessionManager)
 	r.Use(authentication.UserMiddleware)
 	// theme handling, not really needed but the login middleware wants it
 	r.Use(templates.ThemeCtxSimple(templates.ThemeAdminDefault))
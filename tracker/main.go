@@ -13,7 +13,10 @@ func Execute(ctx context.Context, cfg config.Config) error {
 
 	fds := fdstore.NewStoreListenFDs()
 
-	srv := NewServer(ctx, cfg)
+	srv, err := NewServer(ctx, cfg)
+	if err != nil {
+		return err
+	}
 
 	errCh := make(chan error, 1)
 	go func() {
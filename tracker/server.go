@@ -179,7 +179,7 @@ func IcecastInfoHandler(next http.Handler) http.Handler {
 	})
 }
 
-func NewServer(ctx context.Context, cfg config.Config) *Server {
+func NewServer(ctx context.Context, cfg config.Config) (*Server, error) {
 	s := new(Server)
 	s.recorder = NewRecorder(ctx, cfg)
 
@@ -203,12 +203,16 @@ func NewServer(ctx context.Context, cfg config.Config) *Server {
 	}
 	s.h = r
 
-	gs := rpc.NewGrpcServer(ctx)
+	opts, err := cfg.RPCServerOptions(config.RPCTracker)
+	if err != nil {
+		return nil, err
+	}
+	gs := rpc.NewGrpcServer(ctx, opts...)
 	rpc.RegisterListenerTrackerServer(gs, rpc.NewListenerTracker(s.recorder))
 
 	s.grpc = gs
 
-	return s
+	return s, nil
 }
 
 func ListenerAdd(ctx context.Context, recorder *Recorder) http.HandlerFunc {
@@ -25,7 +25,8 @@ func TestListenerAddAndRemove(t *testing.T) {
 	defer cancel()
 	cfg := config.TestConfig()
 
-	dummy := NewServer(ctx, cfg)
+	dummy, err := NewServer(ctx, cfg)
+	require.NoError(t, err)
 
 	srv := httptest.NewServer(dummy.h)
 	defer srv.Close()
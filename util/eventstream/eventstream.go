@@ -10,6 +10,7 @@ import (
 
 const (
 	SUBSCRIBE cmd = iota
+	SUBSCRIBE_FROM
 	SEND
 	SEND_COMPARE
 	LEAVE
@@ -26,10 +27,19 @@ type cmd byte
 type request[T any] struct {
 	cmd       cmd
 	ch        chan T
+	ech       chan Event[T]
+	after     uint64
 	m         T
 	compareFn func(new, old T) bool
 }
 
+// Event is a value send through an EventStream together with its sequence ID,
+// IDs are only meaningful for streams created with history enabled
+type Event[T any] struct {
+	ID    uint64
+	Value T
+}
+
 // NewEventStream returns a new EventStream with an initial value set to
 // initial
 func NewEventStream[M any](initial M) *EventStream[M] {
@@ -47,6 +57,17 @@ func NewEventStreamNoInit[M any]() *EventStream[M] {
 	return es
 }
 
+// NewEventStreamHistory is like NewEventStream but keeps the last size values
+// send, subscribers can use these to resume from the last value they've seen
+// by passing its ID to SubFrom or WithLastEventID
+func NewEventStreamHistory[M any](initial M, size int) *EventStream[M] {
+	es := newEventStream[M](true)
+	es.last.Store(&initial)
+	es.historySize = max(size, 1)
+	go es.run()
+	return es
+}
+
 func newEventStream[M any](sendInit bool) *EventStream[M] {
 	return &EventStream[M]{
 		shutdownCh:  make(chan struct{}),
@@ -71,6 +92,9 @@ type EventStream[M any] struct {
 	// sendInitial indicates if we should send our last seen value to
 	// new subscribers or not
 	sendInitial bool
+	// historySize is the amount of values we keep for resuming
+	// subscribers, zero if history is disabled
+	historySize int
 
 	// reqs is the request channel to the manager goroutine
 	reqs chan request[M]
@@ -88,6 +112,15 @@ func (es *EventStream[M]) run() {
 
 	var closed bool
 	var subs = make([]chan M, 0, 16)
+	var esubs []chan Event[M]
+
+	// seq is the ID of the last value, it starts at the current time so that
+	// IDs are still increasing if we get restarted
+	var seq = uint64(time.Now().UnixNano())
+	var history []Event[M]
+	if es.historySize > 0 && es.sendInitial {
+		history = append(history, Event[M]{ID: seq, Value: *es.last.Load()})
+	}
 
 	for req := range es.reqs {
 		switch req.cmd {
@@ -103,8 +136,26 @@ func (es *EventStream[M]) run() {
 			}
 			// add the channel
 			subs = append(subs, req.ch)
+		case SUBSCRIBE_FROM:
+			if closed {
+				close(req.ech)
+				continue
+			}
+			// the channel has enough buffer space for the whole history
+			// so none of these should block
+			for _, e := range missedEvents(history, req.after) {
+				req.ech <- e
+			}
+			if len(history) == 0 && es.sendInitial {
+				req.ech <- Event[M]{ID: seq, Value: *es.last.Load()}
+			}
+			esubs = append(esubs, req.ech)
 		case LEAVE:
 			// remove the channel
+			if req.ech != nil {
+				esubs = removeSub(esubs, req.ech)
+				continue
+			}
 			subs = removeSub(subs, req.ch)
 		case SEND_COMPARE:
 			old := *es.last.Load()
@@ -117,6 +168,13 @@ func (es *EventStream[M]) run() {
 			v := req.m
 			// store the value as our last known value
 			es.last.Store(&v)
+			seq++
+			if es.historySize > 0 {
+				if len(history) >= es.historySize {
+					history = append(history[:0], history[1:]...)
+				}
+				history = append(history, Event[M]{ID: seq, Value: v})
+			}
 			// send to all our subs with a small timeout grace period
 			// so that clients have a bit of leeway between receives
 			ticker.Reset(TIMEOUT)
@@ -136,6 +194,14 @@ func (es *EventStream[M]) run() {
 					log.Println("TIMEOUT REACHED")
 				}
 			}
+			for _, ch := range esubs {
+				ticker.Reset(TIMEOUT)
+				select {
+				case ch <- Event[M]{ID: seq, Value: req.m}:
+				case <-ticker.C:
+					log.Println("TIMEOUT REACHED")
+				}
+			}
 		case CLOSE:
 			if !closed {
 				close(es.closeCh)
@@ -145,8 +211,12 @@ func (es *EventStream[M]) run() {
 			for _, ch := range subs {
 				close(ch)
 			}
+			for _, ch := range esubs {
+				close(ch)
+			}
 			closed = true
 			subs = nil
+			esubs = nil
 		case SHUTDOWN:
 			if !closed {
 				close(es.closeCh)
@@ -156,13 +226,31 @@ func (es *EventStream[M]) run() {
 			for _, ch := range subs {
 				close(ch)
 			}
+			for _, ch := range esubs {
+				close(ch)
+			}
 			return
 		case LENGTH:
-			es.lengthCh <- len(subs)
+			es.lengthCh <- len(subs) + len(esubs)
 		}
 	}
 }
 
+// missedEvents returns the events in history that came after the ID given,
+// if the ID isn't known to us only the latest event is returned
+func missedEvents[M any](history []Event[M], after uint64) []Event[M] {
+	if len(history) == 0 {
+		return nil
+	}
+	first, last := history[0].ID, history[len(history)-1].ID
+	if after == 0 || after+1 < first || after > last {
+		// either a new subscriber, or one that has missed more than
+		// we remember, or one that has an ID from before a restart
+		return history[len(history)-1:]
+	}
+	return history[after+1-first:]
+}
+
 // removeSub removes the needle given from the slice s by swapping
 // the last element with the needle and slicing the end off
 func removeSub[M any](s []chan M, needle chan M) []chan M {
@@ -180,8 +268,7 @@ func removeSub[M any](s []chan M, needle chan M) []chan M {
 	return s
 }
 
-// length returns the length of the internal subs slice, this is the amount
-// of active subscribers
+// length returns the amount of active subscribers, both from Sub and SubFrom
 func (es *EventStream[M]) length() int {
 	select {
 	case es.reqs <- request[M]{cmd: LENGTH}:
@@ -191,6 +278,28 @@ func (es *EventStream[M]) length() int {
 	}
 }
 
+// SubFrom is like Sub but the channel receives the values together with
+// their ID, if the stream has history enabled it first receives all values
+// that came after the ID given, otherwise it receives the latest value like Sub
+func (es *EventStream[M]) SubFrom(after uint64) chan Event[M] {
+	ch := make(chan Event[M], SUB_BUFFER_SIZE+es.historySize)
+
+	select {
+	case es.reqs <- request[M]{cmd: SUBSCRIBE_FROM, ech: ch, after: after}:
+	case <-es.closeCh:
+		close(ch)
+	}
+	return ch
+}
+
+// LeaveFrom is like Leave but for channels returned by SubFrom
+func (es *EventStream[M]) LeaveFrom(ch chan Event[M]) {
+	select {
+	case es.reqs <- request[M]{cmd: LEAVE, ech: ch}:
+	case <-es.closeCh:
+	}
+}
+
 // Send sends the value M to all subscribers previously subscribed through
 // Sub() or SubStream(), the last value Send is also stored and send when
 // a new subscriber appears.
@@ -229,8 +338,17 @@ func (es *EventStream[M]) Sub() chan M {
 	return ch
 }
 
-// SubStream is like Sub but returns a Stream interface instead of a channel
+// SubStream is like Sub but returns a Stream interface instead of a channel,
+// if the stream has history enabled the Stream resumes after the ID stored
+// in ctx by WithLastEventID and implements IDStream
 func (es *EventStream[M]) SubStream(ctx context.Context) Stream[M] {
+	if es.historySize > 0 {
+		return &eventStream[M]{
+			ctx: ctx,
+			p:   es,
+			C:   es.SubFrom(LastEventID(ctx)),
+		}
+	}
 	return NewStream(ctx, es)
 }
 
@@ -299,3 +417,61 @@ func (s *stream[T]) Close() error {
 	s.p.Leave(s.C)
 	return nil
 }
+
+// IDStream is a Stream that knows the ID of the last value returned by Next
+type IDStream[T any] interface {
+	Stream[T]
+	EventID() uint64
+}
+
+// EventID returns the ID of the last value returned by s.Next, or zero if s
+// doesn't implement IDStream
+func EventID[T any](s Stream[T]) uint64 {
+	if is, ok := s.(IDStream[T]); ok {
+		return is.EventID()
+	}
+	return 0
+}
+
+type lastEventIDKey struct{}
+
+// WithLastEventID returns a context that makes streams created with it
+// resume after the event ID given
+func WithLastEventID(ctx context.Context, id uint64) context.Context {
+	return context.WithValue(ctx, lastEventIDKey{}, id)
+}
+
+// LastEventID returns the ID stored by WithLastEventID, or zero if none
+func LastEventID(ctx context.Context) uint64 {
+	id, _ := ctx.Value(lastEventIDKey{}).(uint64)
+	return id
+}
+
+type eventStream[T any] struct {
+	ctx context.Context
+	p   *EventStream[T]
+	C   chan Event[T]
+	id  uint64
+}
+
+func (s *eventStream[T]) Next() (v T, err error) {
+	select {
+	case e, ok := <-s.C:
+		if !ok {
+			return v, io.EOF
+		}
+		s.id = e.ID
+		return e.Value, nil
+	case <-s.ctx.Done():
+		return v, io.EOF
+	}
+}
+
+func (s *eventStream[T]) EventID() uint64 {
+	return s.id
+}
+
+func (s *eventStream[T]) Close() error {
+	s.p.LeaveFrom(s.C)
+	return nil
+}
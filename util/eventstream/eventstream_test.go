@@ -175,6 +175,25 @@ func TestEventServerLeave(t *testing.T) {
 	}
 }
 
+func TestEventServerLeaveFrom(t *testing.T) {
+	es := NewEventStreamHistory[string]("hello world", 5)
+
+	ch := es.Sub()
+	ech := es.SubFrom(0)
+	if es.length() != 2 {
+		t.Fatal("failed to subscribe")
+	}
+	es.LeaveFrom(ech)
+	es.Send("secondary")
+	if es.length() != 1 {
+		t.Fatal("failed to leave")
+	}
+	es.Leave(ch)
+	if es.length() != 0 {
+		t.Fatal("failed to leave")
+	}
+}
+
 func TestEventServerLeaveStream(t *testing.T) {
 	es := NewEventStream[string]("hello world")
 
@@ -339,3 +358,88 @@ func BenchmarkEventStream50(b *testing.B)    { benchmarkEventStream(50, b) }
 func BenchmarkEventStream100(b *testing.B)   { benchmarkEventStream(100, b) }
 func BenchmarkEventStream1000(b *testing.B)  { benchmarkEventStream(1000, b) }
 func BenchmarkEventStream10000(b *testing.B) { benchmarkEventStream(10000, b) }
+
+func TestEventStreamHistory(t *testing.T) {
+	es := NewEventStreamHistory(0, 4)
+	defer es.Shutdown()
+
+	// a new subscriber only gets the latest value
+	ch := es.SubFrom(0)
+	initial := <-ch
+	assert.Equal(t, 0, initial.Value)
+	es.LeaveFrom(ch)
+
+	for i := 1; i <= 6; i++ {
+		es.Send(i)
+	}
+
+	// only the last 4 values are kept, so resuming from the initial value
+	// is too far back and should only get the latest value
+	ch = es.SubFrom(initial.ID)
+	latest := <-ch
+	assert.Equal(t, 6, latest.Value)
+	assert.Equal(t, initial.ID+6, latest.ID)
+	es.LeaveFrom(ch)
+
+	// resuming from a value we remember should replay everything after it
+	ch = es.SubFrom(latest.ID - 2)
+	assert.Equal(t, 5, (<-ch).Value)
+	assert.Equal(t, 6, (<-ch).Value)
+	es.Send(7)
+	e := <-ch
+	assert.Equal(t, 7, e.Value)
+	assert.Equal(t, latest.ID+1, e.ID)
+	es.LeaveFrom(ch)
+
+	// resuming from the latest value shouldn't replay anything
+	ch = es.SubFrom(e.ID)
+	select {
+	case v := <-ch:
+		t.Fatal("received value when nothing was missed", v)
+	case <-time.After(time.Millisecond * 50):
+	}
+	es.LeaveFrom(ch)
+
+	// unknown IDs from the future are treated like a new subscriber
+	ch = es.SubFrom(e.ID + 100)
+	assert.Equal(t, 7, (<-ch).Value)
+	es.LeaveFrom(ch)
+}
+
+func TestEventStreamHistoryStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	es := NewEventStreamHistory("a", 8)
+	defer es.Shutdown()
+
+	s := es.SubStream(ctx)
+	v, err := s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "a", v)
+	id := EventID(s)
+	assert.NotZero(t, id)
+	require.NoError(t, s.Close())
+
+	es.Send("b")
+	es.Send("c")
+
+	s = es.SubStream(WithLastEventID(ctx, id))
+	defer s.Close()
+	v, err = s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "b", v)
+	v, err = s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "c", v)
+	assert.Equal(t, id+2, EventID(s))
+
+	// streams without history don't have IDs
+	plain := NewEventStream("a")
+	defer plain.Shutdown()
+	ps := plain.SubStream(ctx)
+	defer ps.Close()
+	_, err = ps.Next()
+	require.NoError(t, err)
+	assert.Zero(t, EventID(ps))
+}
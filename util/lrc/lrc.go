@@ -0,0 +1,147 @@
+// Package lrc parses synced lyrics in the LRC format
+package lrc
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+// Line is a single line of synced lyrics
+type Line struct {
+	// At is when the line starts, relative to the start of the song
+	At time.Duration
+	// Text is the text of the line, empty lines are used for breaks
+	Text string
+}
+
+// Parse parses the LRC lyrics given and returns the lines ordered by time.
+// Lines without a timestamp and any metadata tags are ignored, the [offset:]
+// tag is applied to all lines
+func Parse(lyrics string) []Line {
+	var lines []Line
+	var offset time.Duration
+
+	s := bufio.NewScanner(strings.NewReader(lyrics))
+	for s.Scan() {
+		text := strings.TrimSpace(s.Text())
+
+		// a line can have multiple timestamps in front of it if it's
+		// repeated, collect all of them
+		var stamps []time.Duration
+		for strings.HasPrefix(text, "[") {
+			end := strings.IndexByte(text, ']')
+			if end < 0 {
+				break
+			}
+			tag := text[1:end]
+			text = text[end+1:]
+
+			if at, ok := parseTimestamp(tag); ok {
+				stamps = append(stamps, at)
+				continue
+			}
+			if v, ok := strings.CutPrefix(tag, "offset:"); ok {
+				// offset is in milliseconds, a positive offset makes
+				// the lyrics show up earlier
+				if ms, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+					offset = time.Duration(ms) * time.Millisecond
+				}
+			}
+		}
+
+		text = strings.TrimSpace(text)
+		for _, at := range stamps {
+			lines = append(lines, Line{At: at, Text: text})
+		}
+	}
+
+	for i := range lines {
+		lines[i].At = max(lines[i].At-offset, 0)
+	}
+
+	slices.SortStableFunc(lines, func(a, b Line) int {
+		return cmp.Compare(a.At, b.At)
+	})
+	return lines
+}
+
+// parseTimestamp parses a timestamp of the form mm:ss, mm:ss.xx or mm:ss.xxx
+func parseTimestamp(tag string) (time.Duration, bool) {
+	mins, secs, ok := strings.Cut(tag, ":")
+	if !ok {
+		return 0, false
+	}
+
+	m, err := strconv.ParseUint(mins, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	s, err := strconv.ParseFloat(secs, 64)
+	if err != nil || s < 0 || s >= 60 || strings.ContainsAny(secs, "eE+-") {
+		return 0, false
+	}
+
+	return time.Duration(m)*time.Minute + time.Duration(s*float64(time.Second)).Round(time.Millisecond), true
+}
+
+// Current returns the index of the line playing at the position given, or -1
+// if the first line hasn't started yet
+func Current(lines []Line, position time.Duration) int {
+	i, found := slices.BinarySearchFunc(lines, position, func(l Line, pos time.Duration) int {
+		return cmp.Compare(l.At, pos)
+	})
+	if found {
+		// multiple lines can share a timestamp, use the last of them
+		for i+1 < len(lines) && lines[i+1].At == position {
+			i++
+		}
+		return i
+	}
+	return i - 1
+}
+
+// LyricsExt is the extension of lyrics files next to the audio file
+const LyricsExt = ".lrc"
+
+// NotesExt is the extension of notes files next to the audio file
+const NotesExt = ".txt"
+
+// ReadFiles reads the lyrics and notes files that sit next to the audio file
+// given, they share the name of the audio file but with LyricsExt and NotesExt
+// as extension. Files that don't exist are returned as empty strings
+func ReadFiles(fsys afero.Fs, audioPath string) (lyrics, notes string, err error) {
+	base := strings.TrimSuffix(audioPath, filepath.Ext(audioPath))
+
+	lyrics, err = readFile(fsys, base+LyricsExt)
+	if err != nil {
+		return "", "", err
+	}
+	notes, err = readFile(fsys, base+NotesExt)
+	if err != nil {
+		return "", "", err
+	}
+	return lyrics, notes, nil
+}
+
+func readFile(fsys afero.Fs, path string) (string, error) {
+	b, err := afero.ReadFile(fsys, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	// editors like to put a byte order mark in front
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,76 @@
+package lrc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	lyrics := `[ar:Some Artist]
+[ti:Some Title]
+[00:12.00]first line
+[00:17.20][01:17.20] chorus
+no timestamp
+[00:20.5]
+[00:21.123]third line
+[bad:ti.me]broken`
+
+	lines := Parse(lyrics)
+	assert.Equal(t, []Line{
+		{At: 12 * time.Second, Text: "first line"},
+		{At: 17*time.Second + 200*time.Millisecond, Text: "chorus"},
+		{At: 20*time.Second + 500*time.Millisecond, Text: ""},
+		{At: 21*time.Second + 123*time.Millisecond, Text: "third line"},
+		{At: time.Minute + 17*time.Second + 200*time.Millisecond, Text: "chorus"},
+	}, lines)
+}
+
+func TestParseOffset(t *testing.T) {
+	lines := Parse("[offset:+500]\n[00:00.20]early\n[00:01.00]later")
+	assert.Equal(t, []Line{
+		{At: 0, Text: "early"},
+		{At: 500 * time.Millisecond, Text: "later"},
+	}, lines)
+}
+
+func TestParseEmpty(t *testing.T) {
+	assert.Empty(t, Parse(""))
+	assert.Empty(t, Parse("just some plain lyrics\nwithout any timing"))
+}
+
+func TestCurrent(t *testing.T) {
+	lines := []Line{
+		{At: time.Second},
+		{At: time.Second * 5},
+		{At: time.Second * 5},
+		{At: time.Second * 9},
+	}
+
+	assert.Equal(t, -1, Current(lines, 0))
+	assert.Equal(t, 0, Current(lines, time.Second))
+	assert.Equal(t, 0, Current(lines, time.Second*3))
+	assert.Equal(t, 2, Current(lines, time.Second*5))
+	assert.Equal(t, 2, Current(lines, time.Second*8))
+	assert.Equal(t, 3, Current(lines, time.Minute))
+	assert.Equal(t, -1, Current(nil, time.Minute))
+}
+
+func TestReadFiles(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fsys, "/music/song.lrc", []byte("\xef\xbb\xbf[00:01.00]line\n"), 0644))
+	require.NoError(t, afero.WriteFile(fsys, "/music/song.txt", []byte("notes\n"), 0644))
+
+	lyrics, notes, err := ReadFiles(fsys, "/music/song.flac")
+	require.NoError(t, err)
+	assert.Equal(t, "[00:01.00]line", lyrics)
+	assert.Equal(t, "notes", notes)
+
+	lyrics, notes, err = ReadFiles(fsys, "/music/other.mp3")
+	require.NoError(t, err)
+	assert.Empty(t, lyrics)
+	assert.Empty(t, notes)
+}
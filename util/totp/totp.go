@@ -0,0 +1,127 @@
+// Package totp implements time-based one-time passwords as described in RFC 6238
+// with the defaults used by most authenticator apps: HMAC-SHA1, 6 digits and a
+// 30 second period.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the amount of digits in a code
+	Digits = 6
+	// Period is how long a single code is valid for
+	Period = 30 * time.Second
+	// Skew is the amount of periods before and after the current one that
+	// are also accepted, to account for clock drift and slow typers
+	Skew = 1
+
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random base32 encoded secret
+func GenerateSecret() (string, error) {
+	key := make([]byte, secretSize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(key), nil
+}
+
+// Code returns the code for the secret given at time t
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, counter(t), Digits), nil
+}
+
+// Validate returns true if code is a valid code for the secret given at time t,
+// it also returns the time step the code belongs to. Codes from a time step at or
+// before last are refused so that a code can't be used twice, the returned step
+// should be stored and passed as last on the next call
+func Validate(secret, code string, t time.Time, last uint64) (uint64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	c := counter(t)
+	for i := -Skew; i <= Skew; i++ {
+		step := uint64(int64(c) + int64(i))
+		if step <= last {
+			continue
+		}
+		expected := generate(key, step, Digits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// URL returns an otpauth:// url for the secret given, this is the format
+// understood by authenticator apps when presented as a QR code
+func URL(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+	return encoding.DecodeString(secret)
+}
+
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(Period.Seconds()))
+}
+
+// generate implements HOTP as described in RFC 4226
+func generate(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
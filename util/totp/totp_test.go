@@ -0,0 +1,75 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRFC6238 checks the SHA1 test vectors from RFC 6238 Appendix B
+func TestRFC6238(t *testing.T) {
+	key := []byte("12345678901234567890")
+
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, c := range cases {
+		got := generate(key, counter(time.Unix(c.unix, 0)), 8)
+		assert.Equal(t, c.code, got, "time %d", c.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+	require.Len(t, code, Digits)
+
+	valid := func(secret, code string, t time.Time, last uint64) bool {
+		_, ok := Validate(secret, code, t, last)
+		return ok
+	}
+
+	step, ok := Validate(secret, code, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, counter(now), step)
+	// lowercase and spaced secrets should work the same
+	assert.True(t, valid(strings.ToLower(secret), code, now, 0))
+	// within the skew
+	assert.True(t, valid(secret, code, now.Add(Period), 0))
+	assert.True(t, valid(secret, code, now.Add(-Period), 0))
+	// outside of the skew
+	assert.False(t, valid(secret, code, now.Add(Period*3), 0))
+	// garbage
+	assert.False(t, valid(secret, "", now, 0))
+	assert.False(t, valid(secret, "abcdef", now, 0))
+	assert.False(t, valid("not base32!", code, now, 0))
+	// already used, either the same step or a later one
+	assert.False(t, valid(secret, code, now, step))
+	assert.False(t, valid(secret, code, now.Add(Period), step+1))
+	// only newer steps are accepted after a code was used
+	next, err := Code(secret, now.Add(Period))
+	require.NoError(t, err)
+	assert.True(t, valid(secret, next, now, step))
+}
+
+func TestURL(t *testing.T) {
+	u := URL("R/a/dio", "user", "SECRET")
+	assert.True(t, strings.HasPrefix(u, "otpauth://totp/"))
+	assert.Contains(t, u, "secret=SECRET")
+}
@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+)
+
+const (
+	apiTokenMaxNameLength = 100
+	apiTokenMaxExpiryDays = 365 * 5
+)
+
+// ProfileAPITokensForm is the form used to manage the api tokens of a user
+type ProfileAPITokensForm struct {
+	// CSRFTokenInput is the <input> that should be included in the form for CSRF
+	CSRFTokenInput template.HTML
+	// Tokens is the list of existing tokens
+	Tokens []radio.APIToken
+	// ScopeList is the list of scopes the user can choose from
+	ScopeList []ProfilePermissionEntry
+	// NewToken is a token that was just created, this is the only time the
+	// token is available in full
+	NewToken string
+}
+
+func (ProfileAPITokensForm) TemplateBundle() string {
+	return "profile"
+}
+
+func (ProfileAPITokensForm) TemplateName() string {
+	return "form_profile_tokens"
+}
+
+func (ProfileAPITokensForm) FormAction() template.HTMLAttr {
+	return "/admin/profile/tokens"
+}
+
+// generateScopeList returns the scopes the user given can give to a token, this
+// is every permission they have except for PermActive
+func generateScopeList(user radio.User) []ProfilePermissionEntry {
+	all := radio.AllUserPermissions()
+	entries := make([]ProfilePermissionEntry, 0, len(all))
+	for _, perm := range all {
+		if perm == radio.PermActive || !user.UserPermissions.Has(perm) {
+			continue
+		}
+		entries = append(entries, ProfilePermissionEntry{
+			Perm: perm,
+		})
+	}
+	return entries
+}
+
+func (s *State) newProfileAPITokensForm(r *http.Request, user radio.User) (ProfileAPITokensForm, error) {
+	const op errors.Op = "website/admin.newProfileAPITokensForm"
+
+	tokens, err := s.Storage.APIToken(r.Context()).ListByUser(user.ID)
+	if err != nil {
+		return ProfileAPITokensForm{}, errors.E(op, err)
+	}
+
+	return ProfileAPITokensForm{
+		CSRFTokenInput: csrf.TemplateField(r),
+		Tokens:         tokens,
+		ScopeList:      generateScopeList(user),
+	}, nil
+}
+
+// PostProfileTokenCreate creates a new api token for the current user and shows
+// the profile page with the new token included
+func (s *State) PostProfileTokenCreate(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postProfileTokenCreate(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "failed to create api token")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "template failure")
+		return
+	}
+}
+
+func (s *State) postProfileTokenCreate(r *http.Request) (*ProfileInput, error) {
+	const op errors.Op = "website/admin.postProfileTokenCreate"
+	ctx := r.Context()
+
+	user := middleware.UserFromContext(ctx)
+
+	err := r.ParseForm()
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, err)
+	}
+
+	token, err := NewAPITokenFromForm(user, r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	raw, hash, err := radio.GenerateAPIToken()
+	if err != nil {
+		return nil, errors.E(op, errors.InternalServer, err)
+	}
+	token.Hash = hash
+
+	_, err = s.Storage.APIToken(ctx).Create(*token)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input, err := s.newSelfProfileInput(r, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	input.APITokens.NewToken = raw
+	return input, nil
+}
+
+// NewAPITokenFromForm parses the form in the request given into an APIToken
+// for the user given, the Hash is not filled in
+func NewAPITokenFromForm(user radio.User, r *http.Request) (*radio.APIToken, error) {
+	const op errors.Op = "website/admin.NewAPITokenFromForm"
+
+	name := r.PostFormValue("token.name")
+	if name == "" || len(name) > apiTokenMaxNameLength {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("token.name"), "empty or long token name")
+	}
+
+	scopes := make(radio.UserPermissions)
+	for _, perm := range r.PostForm["token.scopes"] {
+		perm := radio.UserPermission(perm)
+		// users can't give out permissions they don't have themselves
+		if perm == radio.PermActive || !user.UserPermissions.Has(perm) {
+			return nil, errors.E(op, errors.AccessDenied, errors.Info("token.scopes"), "invalid scope")
+		}
+		scopes[perm] = struct{}{}
+	}
+	if len(scopes) == 0 {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("token.scopes"), "no scopes selected")
+	}
+
+	token := radio.APIToken{
+		UserID: user.ID,
+		Name:   name,
+		Scopes: scopes,
+	}
+
+	// expiry is in days, empty means never
+	if days := r.PostFormValue("token.expires"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 || n > apiTokenMaxExpiryDays {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("token.expires"), "invalid expiry")
+		}
+		expires := time.Now().Add(time.Duration(n) * 24 * time.Hour)
+		token.ExpiresAt = &expires
+	}
+
+	return &token, nil
+}
+
+// PostProfileTokenRevoke deletes an api token of the current user
+func (s *State) PostProfileTokenRevoke(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostProfileTokenRevoke"
+	ctx := r.Context()
+
+	user := middleware.UserFromContext(ctx)
+
+	id, err := radio.ParseAPITokenID(r.FormValue("token.id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+
+	err = s.Storage.APIToken(ctx).Delete(user.ID, id)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	http.Redirect(w, r, profileFormAction, http.StatusSeeOther)
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var apiTokenTestUser = radio.User{
+	ID:       50,
+	Username: "token-test",
+	UserPermissions: radio.NewUserPermissions(
+		radio.PermActive,
+		radio.PermDJ,
+		radio.PermQueueEdit,
+	),
+}
+
+func newAPITokenRequest(t *testing.T, path string, form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	user := apiTokenTestUser
+	return middleware.RequestWithUser(req, &user)
+}
+
+func TestNewAPITokenFromForm(t *testing.T) {
+	cases := []struct {
+		name   string
+		form   url.Values
+		err    errors.Kind
+		scopes radio.UserPermissions
+	}{
+		{
+			name:   "simple",
+			form:   url.Values{"token.name": {"obs"}, "token.scopes": {"dj"}},
+			scopes: radio.NewUserPermissions(radio.PermDJ),
+		},
+		{
+			name:   "multiple scopes",
+			form:   url.Values{"token.name": {"bot"}, "token.scopes": {"dj", "queue_edit"}},
+			scopes: radio.NewUserPermissions(radio.PermDJ, radio.PermQueueEdit),
+		},
+		{
+			name: "missing name",
+			form: url.Values{"token.scopes": {"dj"}},
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "no scopes",
+			form: url.Values{"token.name": {"obs"}},
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "scope the user doesn't have",
+			form: url.Values{"token.name": {"obs"}, "token.scopes": {"admin"}},
+			err:  errors.AccessDenied,
+		},
+		{
+			name: "active scope",
+			form: url.Values{"token.name": {"obs"}, "token.scopes": {"active"}},
+			err:  errors.AccessDenied,
+		},
+		{
+			name: "invalid expiry",
+			form: url.Values{"token.name": {"obs"}, "token.scopes": {"dj"}, "token.expires": {"-5"}},
+			err:  errors.InvalidForm,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := newAPITokenRequest(t, "/admin/profile/tokens", c.form)
+			require.NoError(t, req.ParseForm())
+
+			token, err := NewAPITokenFromForm(apiTokenTestUser, req)
+			if c.err != errors.Other {
+				assert.True(t, errors.Is(c.err, err), "expected %s got %v", c.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, apiTokenTestUser.ID, token.UserID)
+			assert.Equal(t, c.scopes, token.Scopes)
+		})
+	}
+}
+
+func TestPostProfileTokenCreate(t *testing.T) {
+	var created radio.APIToken
+	tokenMock := &mocks.APITokenStorageMock{
+		CreateFunc: func(token radio.APIToken) (radio.APITokenID, error) {
+			created = token
+			return 1, nil
+		},
+		ListByUserFunc: func(userID radio.UserID) ([]radio.APIToken, error) {
+			return []radio.APIToken{created}, nil
+		},
+	}
+	state := &State{
+		Storage: &mocks.StorageServiceMock{
+			APITokenFunc: func(contextMoqParam context.Context) radio.APITokenStorage {
+				return tokenMock
+			},
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return &mocks.UserStorageMock{
+					TwoFactorFunc: func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+						return nil, errors.E(errors.TwoFactorUnknown)
+					},
+				}
+			},
+		},
+	}
+
+	req := newAPITokenRequest(t, "/admin/profile/tokens", url.Values{
+		"token.name":    {"obs"},
+		"token.scopes":  {"dj"},
+		"token.expires": {"30"},
+	})
+
+	input, err := state.postProfileTokenCreate(req)
+	require.NoError(t, err)
+
+	// the raw token should only be shown in the input, and the storage should
+	// only ever see the hash of it
+	require.True(t, radio.IsAPIToken(input.APITokens.NewToken))
+	assert.Equal(t, radio.NewAPITokenHash(input.APITokens.NewToken), created.Hash)
+	assert.NotNil(t, created.ExpiresAt)
+	assert.Len(t, input.APITokens.Tokens, 1)
+}
+
+func TestPostProfileTokenRevoke(t *testing.T) {
+	tokenMock := &mocks.APITokenStorageMock{
+		DeleteFunc: func(userID radio.UserID, apiTokenID radio.APITokenID) error {
+			if apiTokenID != 5 {
+				return errors.E(errors.TokenUnknown)
+			}
+			return nil
+		},
+	}
+	state := &State{
+		Storage: &mocks.StorageServiceMock{
+			APITokenFunc: func(contextMoqParam context.Context) radio.APITokenStorage {
+				return tokenMock
+			},
+		},
+	}
+
+	req := newAPITokenRequest(t, "/admin/profile/tokens/revoke", url.Values{
+		"token.id": {"5"},
+	})
+	w := httptest.NewRecorder()
+
+	state.PostProfileTokenRevoke(w, req)
+
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	require.Len(t, tokenMock.DeleteCalls(), 1)
+	// should only ever delete tokens owned by the current user
+	assert.Equal(t, apiTokenTestUser.ID, tokenMock.DeleteCalls()[0].UserID)
+}
@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/rs/zerolog"
+)
+
+const auditPageSize = 50
+
+type AuditInput struct {
+	middleware.Input
+
+	Entries []radio.AuditEntry
+	// Filter is the filter used for Entries
+	Filter radio.AuditFilter
+	// Actions is the list of actions that can be filtered on
+	Actions []radio.AuditAction
+	Page    *shared.Pagination
+}
+
+func (AuditInput) TemplateBundle() string {
+	return "audit"
+}
+
+func NewAuditInput(as radio.AuditStorage, r *http.Request) (*AuditInput, error) {
+	const op errors.Op = "website/admin.NewAuditInput"
+
+	page, offset, err := shared.PageAndOffset(r, auditPageSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	filter := radio.AuditFilter{
+		Actor:  r.FormValue("actor"),
+		Action: radio.AuditAction(r.FormValue("action")),
+		Target: r.FormValue("target"),
+	}
+
+	list, err := as.Search(filter, auditPageSize, offset)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input := &AuditInput{
+		Input:   middleware.InputFromRequest(r),
+		Entries: list.Entries,
+		Filter:  filter,
+		Actions: radio.AllAuditActions(),
+		Page: shared.NewPagination(
+			page, shared.PageCount(int64(list.Total), auditPageSize),
+			r.URL,
+		),
+	}
+	return input, nil
+}
+
+func (s *State) GetAudit(w http.ResponseWriter, r *http.Request) {
+	input, err := NewAuditInput(s.Storage.Audit(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "input creation failure")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "template failure")
+		return
+	}
+}
+
+// audit records an administrative action done by the user of the request,
+// before and after are encoded as JSON and either can be nil. Any errors
+// are logged but otherwise ignored since the action already happened
+func (s *State) audit(r *http.Request, action radio.AuditAction, target string, before, after any) {
+	const op errors.Op = "website/admin.audit"
+	ctx := r.Context()
+
+	entry, err := radio.NewAuditEntry(middleware.UserFromContext(ctx), action, target, before, after)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(errors.E(op, err)).Str("action", string(action)).Msg("failed to encode audit entry")
+		return
+	}
+	// RemoteAddr should've already been cleaned up by the RealIP middleware
+	entry.IP = r.RemoteAddr
+	entry.CreatedAt = time.Now()
+
+	entry.ID, err = s.Storage.Audit(ctx).Add(entry)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(errors.E(op, err)).Str("action", string(action)).Msg("failed to store audit entry")
+		return
+	}
+
+	if s.IRC == nil {
+		return
+	}
+	err = s.IRC.AnnounceAudit(ctx, entry)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(errors.E(op, err)).Str("action", string(action)).Msg("failed to announce audit entry")
+	}
+}
+
+// auditTrackTarget returns the audit target description of a track
+func auditTrackTarget(song radio.Song) string {
+	if song.DatabaseTrack == nil {
+		return song.Metadata
+	}
+	return fmt.Sprintf("track %d (%s)", song.TrackID, song.Metadata)
+}
+
+// auditSubmissionTarget returns the audit target description of a submission
+func auditSubmissionTarget(song radio.PendingSong) string {
+	return fmt.Sprintf("submission %d (%s)", song.ID, song.Metadata())
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit(t *testing.T) {
+	auditMock := &mocks.AuditStorageMock{
+		AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+			return 10, nil
+		},
+	}
+	ircMock := &mocks.AnnounceServiceMock{
+		AnnounceAuditFunc: func(ctx context.Context, auditEntry radio.AuditEntry) error {
+			return nil
+		},
+	}
+	state := &State{
+		Storage: &mocks.StorageServiceMock{
+			AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+				return auditMock
+			},
+		},
+		IRC: ircMock,
+	}
+
+	user := radio.User{ID: 5, Username: "auditor"}
+	req := httptest.NewRequest(http.MethodPost, "/admin/queue/remove", nil)
+	req.RemoteAddr = "10.0.0.1"
+	req = middleware.RequestWithUser(req, &user)
+
+	state.audit(req, radio.AuditQueueRemove, "queue entry", radio.QueueEntry{UserIdentifier: "me"}, nil)
+
+	require.Len(t, auditMock.AddCalls(), 1)
+	entry := auditMock.AddCalls()[0].AuditEntry
+	assert.Equal(t, user.ID, entry.ActorID)
+	assert.Equal(t, user.Username, entry.Actor)
+	assert.Equal(t, radio.AuditQueueRemove, entry.Action)
+	assert.Equal(t, "10.0.0.1", entry.IP)
+	assert.Contains(t, entry.Before, `"UserIdentifier":"me"`)
+	assert.Empty(t, entry.After)
+
+	// the announcement should include the ID the storage gave us
+	require.Len(t, ircMock.AnnounceAuditCalls(), 1)
+	assert.Equal(t, radio.AuditEntryID(10), ircMock.AnnounceAuditCalls()[0].AuditEntry.ID)
+
+	// a storage failure should not be announced
+	auditMock.AddFunc = func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+		return 0, errors.E(errors.InternalServer)
+	}
+	state.audit(req, radio.AuditQueueRemove, "queue entry", nil, nil)
+	assert.Len(t, ircMock.AnnounceAuditCalls(), 1)
+}
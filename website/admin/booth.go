@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"slices"
+	"strings"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
@@ -58,6 +59,8 @@ type BoothInput struct {
 	ProxyStatus    *BoothProxyStatusInput
 	StreamerInfo   *BoothStopStreamerInput
 	ThreadInfo     *BoothSetThreadInput
+	RedeemInvite   *BoothRedeemInviteInput
+	Handover       *BoothHandoverInput
 	BoothStreamURL *url.URL
 }
 
@@ -106,6 +109,20 @@ func NewBoothInput(gs radio.GuestService, ps radio.ProxyService, r *http.Request
 		return nil, errors.E(op, err)
 	}
 
+	if radio.IsGuest(*input.User) {
+		input.RedeemInvite = NewBoothRedeemInviteInput(r, r.FormValue("invite"))
+	}
+
+	handover, err := currentHandover(r.Context(), ps)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input.Handover, err = NewBoothHandoverInput(ps, r, handover, input.Status.StreamUser)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	return input, nil
 }
 
@@ -464,8 +481,13 @@ func (s *State) sseBoothAPI(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// the user currently streaming, the handover input depends on it
+	streamUser := util.NewTypedValue(middleware.InputFromRequest(r).Status.StreamUser)
+
 	// stream for who is currently streaming
 	util.StreamValue(ctx, s.Manager.CurrentUser, func(ctx context.Context, user *radio.User) {
+		streamUser.Store(user)
+
 		// update the streamer view
 		write("streamer", (*BoothStreamerInput)(user))
 
@@ -509,6 +531,17 @@ func (s *State) sseBoothAPI(w http.ResponseWriter, r *http.Request) {
 		},
 	)
 
+	// stream for the planned handover, the countdown is done client side
+	util.StreamValue(ctx, s.Proxy.HandoverStream, func(ctx context.Context, h radio.Handover) {
+		input, err := NewBoothHandoverInput(s.Proxy, r, h, streamUser.Load())
+		if err != nil {
+			logger.Error().Ctx(ctx).Err(err).Msg("failed to create handover input")
+			return
+		}
+
+		write("handover", input)
+	})
+
 	util.StreamValue(ctx, s.Proxy.SourceStream, func(ctx context.Context, event radio.ProxySourceEvent) {
 		switch event.Event {
 		case radio.SourceConnect, radio.SourceDisconnect:
@@ -572,3 +605,125 @@ type BoothStatusInput struct {
 func (BoothStatusInput) TemplateName() string {
 	return "status"
 }
+
+func NewBoothRedeemInviteInput(r *http.Request, code string) *BoothRedeemInviteInput {
+	return &BoothRedeemInviteInput{
+		CSRFTokenInput: csrf.TemplateField(r),
+		Code:           code,
+		LoggedIn:       middleware.MaybeUserFromContext(r.Context()) != nil,
+	}
+}
+
+type BoothRedeemInviteInput struct {
+	boothInput
+	CSRFTokenInput template.HTML
+
+	// Code is the invite code to redeem, this is filled in from the link
+	// the guest received
+	Code string
+	// LoggedIn is true if the invite is redeemed by a logged in guest, otherwise
+	// a Nick is asked for and a new guest user is created for it
+	LoggedIn bool
+	// Nick is the nick the invite is redeemed as if not LoggedIn
+	Nick string
+	// Success is true if the invite was redeemed
+	Success bool
+	// Error is a message describing why the invite couldn't be redeemed
+	Error string
+}
+
+func (BoothRedeemInviteInput) FormAction() template.HTMLAttr {
+	return "/admin/redeem-invite"
+}
+
+func (BoothRedeemInviteInput) TemplateName() string {
+	return "redeem-invite"
+}
+
+// GetRedeemInvite shows the form to redeem an invite, this doesn't require
+// the user to be logged in
+func (s *State) GetRedeemInvite(w http.ResponseWriter, r *http.Request) {
+	input := NewBoothRedeemInviteInput(r, r.FormValue("invite"))
+
+	err := s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+// PostBoothRedeemInvite redeems an invite, this doesn't require the user to be
+// logged in. A guest that isn't logged in gets a new guest user for the nick
+// they enter and is logged in as it
+func (s *State) PostBoothRedeemInvite(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postBoothRedeemInvite(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) postBoothRedeemInvite(r *http.Request) (*BoothRedeemInviteInput, error) {
+	const op errors.Op = "website/admin.postBoothRedeemInvite"
+	ctx := r.Context()
+	user := middleware.MaybeUserFromContext(ctx)
+
+	input := NewBoothRedeemInviteInput(r, r.FormValue("invite"))
+
+	var nick string
+	if user != nil {
+		// only guests have invites to redeem
+		if !radio.IsGuest(*user) {
+			return nil, errors.E(op, errors.AccessDenied)
+		}
+		nick = radio.UsernameToNick(user.Username)
+	} else {
+		nick = strings.ToLower(strings.TrimSpace(r.FormValue("nick")))
+		input.Nick = nick
+		if nick == "" || len(radio.NickToUsername(nick)) > middleware.MAX_USERNAME_LENGTH {
+			input.Error = "invalid nick"
+			return input, nil
+		}
+
+		// existing guests have to login first, otherwise anyone with a code
+		// could login as them
+		_, err := s.Storage.User(ctx).Get(radio.NickToUsername(nick))
+		if err == nil {
+			input.Error = "nick already has an account, login to redeem the invite"
+			return input, nil
+		}
+		if !errors.Is(errors.UserUnknown, err) {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	redeemed, err := s.Guest.Redeem(ctx, input.Code, nick)
+	if err != nil {
+		if !errors.Is(errors.GuestInviteUnknown, err) {
+			// guest service broken or offline
+			zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to redeem invite")
+			input.Error = "failed to redeem invite, try again later"
+			return input, nil
+		}
+		input.Error = "invite is invalid, expired or already used"
+		return input, nil
+	}
+
+	if user == nil {
+		// the guest user was just created for them, so log them in as it
+		err = s.Authentication.StartSession(ctx, redeemed.Username)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		input.LoggedIn = true
+	}
+
+	input.Success = true
+	return input, nil
+}
@@ -0,0 +1,234 @@
+package admin
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+)
+
+const (
+	guestInviteCodeLength = 32
+	// guestInviteTimeLayout is the layout of a datetime-local input, the
+	// times are interpreted as UTC
+	guestInviteTimeLayout  = "2006-01-02T15:04"
+	guestInviteMaxDuration = time.Hour * 24
+	guestInviteMaxLimit    = 100
+	guestsFormAction       = "/admin/guests"
+)
+
+type GuestsInput struct {
+	middleware.Input
+	CSRFTokenInput template.HTML
+
+	// Invites is the list of invites that haven't ended yet
+	Invites []radio.GuestInvite
+	// NewInvite is an invite that was just created, this is the only time
+	// the link to redeem it is shown
+	NewInvite *radio.GuestInvite
+	// NewInviteURL is the link the guest should use to redeem NewInvite
+	NewInviteURL string
+	// NewGuestPassword is the password of the guest user that was created
+	// for NewInvite, empty if the user already existed
+	NewGuestPassword string
+}
+
+func (GuestsInput) TemplateBundle() string {
+	return "guests"
+}
+
+func (GuestsInput) FormAction() template.HTMLAttr {
+	return guestsFormAction
+}
+
+func NewGuestsInput(gs radio.GuestStorage, r *http.Request) (*GuestsInput, error) {
+	const op errors.Op = "website/admin.NewGuestsInput"
+
+	invites, err := gs.Invites()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &GuestsInput{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Invites:        invites,
+	}, nil
+}
+
+func (s *State) GetGuests(w http.ResponseWriter, r *http.Request) {
+	input, err := NewGuestsInput(s.Storage.Guest(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "input creation failure")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "template failure")
+		return
+	}
+}
+
+// PostGuests creates a new guest invite and shows the guests page with the
+// link to redeem it included
+func (s *State) PostGuests(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postGuests(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "failed to create guest invite")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "template failure")
+		return
+	}
+}
+
+func (s *State) postGuests(r *http.Request) (*GuestsInput, error) {
+	const op errors.Op = "website/admin.postGuests"
+	ctx := r.Context()
+
+	user := middleware.UserFromContext(ctx)
+
+	err := r.ParseForm()
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, err)
+	}
+
+	invite, err := NewGuestInviteFromForm(user, r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	invite.Code, err = radio.GenerateRandomPassword(guestInviteCodeLength)
+	if err != nil {
+		return nil, errors.E(op, errors.InternalServer, err)
+	}
+
+	// create the guest user if the invite is for a nick that doesn't have
+	// one yet, so that the guest can login to redeem it
+	var passwd string
+	if invite.Nick != "" {
+		_, err = s.Storage.User(ctx).Get(radio.NickToUsername(invite.Nick))
+		if errors.Is(errors.UserUnknown, err) {
+			_, passwd, err = s.Guest.Create(ctx, invite.Nick)
+		}
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	invite.ID, err = s.Storage.Guest(ctx).CreateInvite(*invite)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// don't put the code in the audit log, it's as good as a password
+	audited := *invite
+	audited.Code = ""
+	s.audit(r, radio.AuditGuestInvite, auditGuestInviteTarget(audited), nil, audited)
+
+	input, err := NewGuestsInput(s.Storage.Guest(ctx), r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	input.NewInvite = invite
+	input.NewInviteURL = "/admin/redeem-invite?invite=" + url.QueryEscape(invite.Code)
+	input.NewGuestPassword = passwd
+	return input, nil
+}
+
+// NewGuestInviteFromForm parses the form in the request given into a
+// GuestInvite created by the user given, the Code is not filled in
+func NewGuestInviteFromForm(user radio.User, r *http.Request) (*radio.GuestInvite, error) {
+	const op errors.Op = "website/admin.NewGuestInviteFromForm"
+
+	start, err := time.Parse(guestInviteTimeLayout, r.PostFormValue("invite.start"))
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("invite.start"), err)
+	}
+	end, err := time.Parse(guestInviteTimeLayout, r.PostFormValue("invite.end"))
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("invite.end"), err)
+	}
+	if !start.Before(end) || end.Sub(start) > guestInviteMaxDuration {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("invite.end"), "invalid window")
+	}
+	if !end.After(time.Now()) {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("invite.end"), "window already ended")
+	}
+
+	limit := func(name string) (int, error) {
+		v := r.PostFormValue(name)
+		if v == "" {
+			return 0, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > guestInviteMaxLimit {
+			return 0, errors.E(op, errors.InvalidForm, errors.Info(name), "invalid number")
+		}
+		return n, nil
+	}
+
+	invite := radio.GuestInvite{
+		Nick:      strings.ToLower(strings.TrimSpace(r.PostFormValue("invite.nick"))),
+		CreatedBy: user.ID,
+		Start:     start,
+		End:       end,
+		Mount:     strings.TrimSpace(r.PostFormValue("invite.mount")),
+	}
+
+	if invite.Mount != "" && !strings.HasPrefix(invite.Mount, "/") {
+		return nil, errors.E(op, errors.InvalidForm, errors.Info("invite.mount"), "mount should start with /")
+	}
+	if invite.Priority, err = limit("invite.priority"); err != nil {
+		return nil, err
+	}
+	if invite.KillLimit, err = limit("invite.kill_limit"); err != nil {
+		return nil, err
+	}
+	if invite.ThreadLimit, err = limit("invite.thread_limit"); err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// PostGuestInviteDelete deletes a guest invite
+func (s *State) PostGuestInviteDelete(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostGuestInviteDelete"
+	ctx := r.Context()
+
+	id, err := radio.ParseGuestInviteID(r.FormValue("invite.id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+
+	err = s.Storage.Guest(ctx).DeleteInvite(id)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	s.audit(r, radio.AuditGuestInviteDelete, "guest invite "+id.String(), nil, nil)
+	http.Redirect(w, r, guestsFormAction, http.StatusSeeOther)
+}
+
+func auditGuestInviteTarget(invite radio.GuestInvite) string {
+	nick := invite.Nick
+	if nick == "" {
+		nick = "anyone"
+	}
+	return fmt.Sprintf("guest invite %s (%s)", invite.ID, nick)
+}
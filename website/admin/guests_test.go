@@ -0,0 +1,277 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGuestRequest(t *testing.T, path string, form url.Values, user radio.User) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return middleware.RequestWithUser(req, &user)
+}
+
+func TestNewGuestInviteFromForm(t *testing.T) {
+	start := time.Now().Add(time.Hour).UTC()
+	window := func(start time.Time, length time.Duration) url.Values {
+		return url.Values{
+			"invite.start": {start.Format(guestInviteTimeLayout)},
+			"invite.end":   {start.Add(length).Format(guestInviteTimeLayout)},
+		}
+	}
+	with := func(v url.Values, key, value string) url.Values {
+		v.Set(key, value)
+		return v
+	}
+
+	cases := []struct {
+		name string
+		form url.Values
+		err  errors.Kind
+	}{
+		{
+			name: "simple",
+			form: window(start, time.Hour),
+		},
+		{
+			name: "everything",
+			form: with(with(with(window(start, time.Hour), "invite.nick", " Guest "), "invite.mount", "/main.mp3"), "invite.kill_limit", "2"),
+		},
+		{
+			name: "missing start",
+			form: url.Values{"invite.end": {start.Format(guestInviteTimeLayout)}},
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "end before start",
+			form: window(start, -time.Hour),
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "too long",
+			form: window(start, guestInviteMaxDuration+time.Hour),
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "already ended",
+			form: window(start.Add(-time.Hour*3), time.Hour),
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "negative limit",
+			form: with(window(start, time.Hour), "invite.thread_limit", "-1"),
+			err:  errors.InvalidForm,
+		},
+		{
+			name: "invalid mount",
+			form: with(window(start, time.Hour), "invite.mount", "main.mp3"),
+			err:  errors.InvalidForm,
+		},
+	}
+
+	user := radio.User{ID: 10}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := newGuestRequest(t, "/admin/guests", c.form, user)
+			require.NoError(t, req.ParseForm())
+
+			invite, err := NewGuestInviteFromForm(user, req)
+			if c.err != errors.Other {
+				assert.True(t, errors.Is(c.err, err), "expected %s got %v", c.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, user.ID, invite.CreatedBy)
+			assert.Equal(t, strings.ToLower(strings.TrimSpace(c.form.Get("invite.nick"))), invite.Nick)
+		})
+	}
+}
+
+func TestPostGuests(t *testing.T) {
+	var created radio.GuestInvite
+	guestStorage := &mocks.GuestStorageMock{
+		CreateInviteFunc: func(invite radio.GuestInvite) (radio.GuestInviteID, error) {
+			created = invite
+			return 5, nil
+		},
+		InvitesFunc: func() ([]radio.GuestInvite, error) {
+			return []radio.GuestInvite{created}, nil
+		},
+	}
+	auditMock := &mocks.AuditStorageMock{
+		AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+			return 1, nil
+		},
+	}
+	guestService := &mocks.GuestServiceMock{
+		CreateFunc: func(ctx context.Context, nick string) (*radio.User, string, error) {
+			return &radio.User{Username: radio.NickToUsername(nick)}, "password", nil
+		},
+	}
+	state := &State{
+		Storage: &mocks.StorageServiceMock{
+			GuestFunc: func(contextMoqParam context.Context) radio.GuestStorage {
+				return guestStorage
+			},
+			AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+				return auditMock
+			},
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return &mocks.UserStorageMock{
+					GetFunc: func(name string) (*radio.User, error) {
+						return nil, errors.E(errors.UserUnknown)
+					},
+				}
+			},
+		},
+		Guest: guestService,
+	}
+
+	start := time.Now().Add(time.Hour).UTC()
+	req := newGuestRequest(t, "/admin/guests", url.Values{
+		"invite.nick":  {"Guest"},
+		"invite.start": {start.Format(guestInviteTimeLayout)},
+		"invite.end":   {start.Add(time.Hour).Format(guestInviteTimeLayout)},
+	}, radio.User{ID: 10, Username: "staff"})
+
+	input, err := state.postGuests(req)
+	require.NoError(t, err)
+
+	// a guest user should've been created since it didn't exist yet
+	require.Len(t, guestService.CreateCalls(), 1)
+	assert.Equal(t, "guest", guestService.CreateCalls()[0].Nick)
+	assert.Equal(t, "password", input.NewGuestPassword)
+
+	require.NotNil(t, input.NewInvite)
+	assert.NotEmpty(t, created.Code)
+	assert.Equal(t, radio.GuestInviteID(5), input.NewInvite.ID)
+	assert.Contains(t, input.NewInviteURL, url.QueryEscape(created.Code))
+	assert.Len(t, input.Invites, 1)
+
+	// the code shouldn't end up in the audit log
+	require.Len(t, auditMock.AddCalls(), 1)
+	entry := auditMock.AddCalls()[0].AuditEntry
+	assert.Equal(t, radio.AuditGuestInvite, entry.Action)
+	assert.NotContains(t, string(entry.After), created.Code)
+}
+
+func TestPostBoothRedeemInvite(t *testing.T) {
+	guestService := &mocks.GuestServiceMock{
+		RedeemFunc: func(ctx context.Context, code string, nick string) (*radio.User, error) {
+			if code != "valid" {
+				return nil, errors.E(errors.GuestInviteUnknown)
+			}
+			return &radio.User{Username: radio.NickToUsername(nick)}, nil
+		},
+	}
+	state := &State{
+		Guest: guestService,
+	}
+	guest := radio.User{
+		Username:        radio.NickToUsername("guest"),
+		UserPermissions: radio.NewUserPermissions(radio.PermDJ, radio.PermGuest),
+	}
+
+	req := newGuestRequest(t, "/admin/redeem-invite", url.Values{"invite": {"valid"}}, guest)
+	input, err := state.postBoothRedeemInvite(req)
+	require.NoError(t, err)
+	assert.True(t, input.Success)
+	require.Len(t, guestService.RedeemCalls(), 1)
+	assert.Equal(t, "guest", guestService.RedeemCalls()[0].Nick)
+
+	req = newGuestRequest(t, "/admin/redeem-invite", url.Values{"invite": {"invalid"}}, guest)
+	input, err = state.postBoothRedeemInvite(req)
+	require.NoError(t, err)
+	assert.False(t, input.Success)
+	assert.NotEmpty(t, input.Error)
+
+	// only guests can redeem invites
+	req = newGuestRequest(t, "/admin/redeem-invite", url.Values{"invite": {"valid"}}, radio.User{Username: "dj"})
+	_, err = state.postBoothRedeemInvite(req)
+	assert.True(t, errors.Is(errors.AccessDenied, err))
+}
+
+// sessionAuth is an Authentication that remembers the sessions started
+type sessionAuth struct {
+	middleware.Authentication
+	started []string
+}
+
+func (a *sessionAuth) StartSession(ctx context.Context, username string) error {
+	a.started = append(a.started, username)
+	return nil
+}
+
+func TestPostRedeemInviteAnonymous(t *testing.T) {
+	guestService := &mocks.GuestServiceMock{
+		RedeemFunc: func(ctx context.Context, code string, nick string) (*radio.User, error) {
+			if code != "valid" {
+				return nil, errors.E(errors.GuestInviteUnknown)
+			}
+			return &radio.User{Username: radio.NickToUsername(nick)}, nil
+		},
+	}
+	auth := &sessionAuth{}
+	state := &State{
+		Guest:          guestService,
+		Authentication: auth,
+		Storage: &mocks.StorageServiceMock{
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return &mocks.UserStorageMock{
+					GetFunc: func(name string) (*radio.User, error) {
+						if name == radio.NickToUsername("existing") {
+							return &radio.User{Username: name}, nil
+						}
+						return nil, errors.E(errors.UserUnknown)
+					},
+				}
+			},
+		},
+	}
+
+	redeem := func(form url.Values) (*BoothRedeemInviteInput, error) {
+		req := httptest.NewRequest(http.MethodPost, "/admin/redeem-invite", strings.NewReader(form.Encode()))
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		return state.postBoothRedeemInvite(middleware.RequestWithUser(req, nil))
+	}
+
+	// a new nick gets a guest user and is logged in as it
+	input, err := redeem(url.Values{"invite": {"valid"}, "nick": {" NewGuest "}})
+	require.NoError(t, err)
+	assert.True(t, input.Success)
+	assert.True(t, input.LoggedIn)
+	require.Len(t, guestService.RedeemCalls(), 1)
+	assert.Equal(t, "newguest", guestService.RedeemCalls()[0].Nick)
+	assert.Equal(t, []string{radio.NickToUsername("newguest")}, auth.started)
+
+	// a nick with an existing user has to login first
+	input, err = redeem(url.Values{"invite": {"valid"}, "nick": {"existing"}})
+	require.NoError(t, err)
+	assert.False(t, input.Success)
+	assert.NotEmpty(t, input.Error)
+
+	// no nick at all
+	input, err = redeem(url.Values{"invite": {"valid"}})
+	require.NoError(t, err)
+	assert.False(t, input.Success)
+
+	// invalid codes shouldn't log anyone in
+	input, err = redeem(url.Values{"invite": {"invalid"}, "nick": {"other"}})
+	require.NoError(t, err)
+	assert.False(t, input.Success)
+
+	assert.Len(t, guestService.RedeemCalls(), 2)
+	assert.Len(t, auth.started, 1)
+}
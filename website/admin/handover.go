@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+	"github.com/rs/zerolog"
+)
+
+// handoverMaxMinutes is the maximum amount of minutes a handover can be
+// planned in the future, the proxy refuses anything further out
+const handoverMaxMinutes = int(radio.HandoverMaxDelay / time.Minute)
+
+type BoothHandoverInput struct {
+	boothInput
+	CSRFTokenInput template.HTML
+
+	// Handover is the latest handover, the State is HandoverNone if there
+	// hasn't been one
+	Handover radio.Handover
+	// Candidates are the DJs connected to the proxy that can be handed over to
+	Candidates []radio.User
+	// AllowedToPlan is true if the user is allowed to plan a handover, this is
+	// only the case if they're currently live
+	AllowedToPlan bool
+	// AllowedToCancel is true if the user is allowed to cancel the handover
+	AllowedToCancel bool
+	// Error is a message describing why planning the handover failed
+	Error string
+}
+
+func (BoothHandoverInput) FormAction() template.HTMLAttr {
+	return "/admin/booth/handover"
+}
+
+func (BoothHandoverInput) TemplateName() string {
+	return "handover"
+}
+
+// Countdown returns the time left until the handover
+func (bhi BoothHandoverInput) Countdown() time.Duration {
+	return max(time.Until(bhi.Handover.At), 0)
+}
+
+func NewBoothHandoverInput(ps radio.ProxyService, r *http.Request, h radio.Handover, streamUser *radio.User) (*BoothHandoverInput, error) {
+	const op errors.Op = "website/admin.NewBoothHandoverInput"
+	ctx := r.Context()
+	user := middleware.UserFromContext(ctx)
+
+	sources, err := ps.ListSources(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// everyone connected to the main mount except ourselves can be handed
+	// over to, some might have multiple connections so only list them once
+	var candidates []radio.User
+	for _, source := range filterAndSortProxySources(sources) {
+		if source.User.ID == user.ID {
+			continue
+		}
+		if slices.ContainsFunc(candidates, func(u radio.User) bool { return u.ID == source.User.ID }) {
+			continue
+		}
+		candidates = append(candidates, source.User)
+	}
+
+	isLive := streamUser.IsValid() && streamUser.ID == user.ID
+	isAdmin := user.UserPermissions.Has(radio.PermAdmin)
+
+	return &BoothHandoverInput{
+		CSRFTokenInput: csrf.TemplateField(r),
+		Handover:       h,
+		Candidates:     candidates,
+		AllowedToPlan:  isLive || isAdmin,
+		AllowedToCancel: h.IsPending() &&
+			(isAdmin || h.From.ID == user.ID || h.To.ID == user.ID),
+	}, nil
+}
+
+func currentHandover(ctx context.Context, ps radio.ProxyService) (radio.Handover, error) {
+	return util.OneOff(ctx, func(ctx context.Context) (eventstream.Stream[radio.Handover], error) {
+		return ps.HandoverStream(ctx)
+	})
+}
+
+func (s *State) PostBoothHandover(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postBoothHandover(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) postBoothHandover(r *http.Request) (*BoothHandoverInput, error) {
+	const op errors.Op = "website/admin.postBoothHandover"
+	ctx := r.Context()
+	user := middleware.UserFromContext(ctx)
+	streamUser := middleware.InputFromRequest(r).Status.StreamUser
+
+	current, err := currentHandover(ctx, s.Proxy)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input, err := NewBoothHandoverInput(s.Proxy, r, current, streamUser)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if !input.AllowedToPlan {
+		input.Error = "only the current dj can plan a handover"
+		return input, nil
+	}
+
+	to, err := radio.ParseUserID(r.FormValue("handover.to"))
+	if err != nil {
+		input.Error = "invalid next dj"
+		return input, nil
+	}
+	minutes, err := strconv.Atoi(r.FormValue("handover.in"))
+	if err != nil || minutes < 0 || minutes > handoverMaxMinutes {
+		input.Error = "invalid handover time"
+		return input, nil
+	}
+
+	next, err := s.Storage.User(ctx).GetByID(to)
+	if err != nil {
+		if errors.Is(errors.UserUnknown, err) {
+			input.Error = "unknown next dj"
+			return input, nil
+		}
+		return nil, errors.E(op, err)
+	}
+	if !next.UserPermissions.Has(radio.PermDJ) {
+		input.Error = "next dj doesn't have access to the stream"
+		return input, nil
+	}
+
+	h := radio.Handover{
+		From: user,
+		To:   *next,
+		At:   time.Now().Add(time.Duration(minutes) * time.Minute),
+	}
+	if streamUser.IsValid() {
+		h.From = *streamUser
+	}
+
+	err = s.Proxy.PlanHandover(ctx, h)
+	if err != nil {
+		if errors.Is(errors.InvalidArgument, err) {
+			input.Error = "invalid handover"
+			return input, nil
+		}
+		// proxy service broken or offline
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to plan handover")
+		input.Error = "failed to plan handover, try again later"
+		return input, nil
+	}
+
+	h.State = radio.HandoverPlanned
+	return NewBoothHandoverInput(s.Proxy, r, h, streamUser)
+}
+
+func (s *State) PostBoothHandoverCancel(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postBoothHandoverCancel(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) postBoothHandoverCancel(r *http.Request) (*BoothHandoverInput, error) {
+	const op errors.Op = "website/admin.postBoothHandoverCancel"
+	ctx := r.Context()
+	streamUser := middleware.InputFromRequest(r).Status.StreamUser
+
+	current, err := currentHandover(ctx, s.Proxy)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input, err := NewBoothHandoverInput(s.Proxy, r, current, streamUser)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if !input.AllowedToCancel {
+		return input, nil
+	}
+
+	err = s.Proxy.CancelHandover(ctx)
+	if err != nil {
+		// proxy service broken or offline
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to cancel handover")
+		input.Error = "failed to cancel handover, try again later"
+		return input, nil
+	}
+
+	current.State = radio.HandoverCanceled
+	return NewBoothHandoverInput(s.Proxy, r, current, streamUser)
+}
@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/util/lrc"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+)
+
+type SongLyricsForm struct {
+	CSRFTokenInput template.HTML
+
+	Errors  map[string]string
+	Success bool
+	// Imported indicates the lyrics came from the files next to the audio
+	Imported bool
+
+	// HasEdit indicates if we should allow editing of the form
+	HasEdit bool
+	Song    radio.Song
+	Lyrics  radio.TrackLyrics
+	// Lines are the parsed synced lyrics, for previewing
+	Lines []lrc.Line
+
+	// original is the lyrics as they were before any changes from the form
+	original radio.TrackLyrics
+}
+
+func (SongLyricsForm) TemplateName() string {
+	return "form_admin_songs_lyrics"
+}
+
+func (SongLyricsForm) TemplateBundle() string {
+	return "database"
+}
+
+// NewSongLyricsForm returns the lyrics form of the track given by the id
+// in the request, with the lyrics currently stored
+func NewSongLyricsForm(storage radio.StorageService, user radio.User, r *http.Request) (*SongLyricsForm, error) {
+	const op errors.Op = "website/admin.NewSongLyricsForm"
+	ctx := r.Context()
+
+	tid, err := radio.ParseTrackID(r.FormValue("id"))
+	if err != nil {
+		return nil, errors.E(op, err, errors.InvalidForm, errors.Info("missing or malformed id in form"))
+	}
+
+	song, err := storage.Track(ctx).Get(tid)
+	if err != nil {
+		return nil, errors.E(op, err, errors.InvalidForm)
+	}
+
+	lyrics, err := storage.Lyrics(ctx).Get(tid)
+	if err != nil && !errors.Is(errors.LyricsUnknown, err) {
+		return nil, errors.E(op, err)
+	}
+	if lyrics == nil {
+		lyrics = &radio.TrackLyrics{TrackID: tid}
+	}
+
+	form := &SongLyricsForm{
+		CSRFTokenInput: csrf.TemplateField(r),
+		HasEdit:        user.UserPermissions.Has(radio.PermDatabaseEdit),
+		Song:           *song,
+		Lyrics:         *lyrics,
+		Lines:          lrc.Parse(lyrics.Lyrics),
+		original:       *lyrics,
+	}
+	return form, nil
+}
+
+func (sf *SongLyricsForm) Validate() bool {
+	sf.Errors = make(map[string]string)
+
+	if len(sf.Lyrics.Lyrics) > radio.LimitLyricsLength {
+		sf.Errors["lyrics"] = "lyrics too long"
+	}
+	if len(sf.Lyrics.Notes) > radio.LimitNotesLength {
+		sf.Errors["notes"] = "notes too long"
+	}
+
+	return len(sf.Errors) == 0
+}
+
+func (s *State) GetSongLyrics(w http.ResponseWriter, r *http.Request) {
+	form, err := NewSongLyricsForm(s.Storage, middleware.UserFromContext(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, form)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) PostSongLyrics(w http.ResponseWriter, r *http.Request) {
+	form, err := s.postSongLyrics(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	if util.IsHTMX(r) {
+		err = s.TemplateExecutor.Execute(w, r, form)
+		if err != nil {
+			s.errorHandler(w, r, err, "")
+			return
+		}
+		return
+	}
+
+	// otherwise just return to the existing listing
+	r, _ = util.RedirectBack(r)
+	s.GetSongs(w, r)
+}
+
+func (s *State) postSongLyrics(r *http.Request) (*SongLyricsForm, error) {
+	const op errors.Op = "website/admin.postSongLyrics"
+	ctx := r.Context()
+
+	// parse the form explicitly, net/http otherwise eats any errors
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.E(op, err, errors.InvalidForm)
+	}
+
+	user := middleware.UserFromContext(ctx)
+	if !user.IsValid() {
+		return nil, errors.E(op, errors.AccessDenied)
+	}
+
+	form, err := NewSongLyricsForm(s.Storage, user, r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if r.Form.Get("action") == "import" {
+		// import replaces the lyrics and notes with the files next to
+		// the audio file, anything that doesn't exist is left as is
+		path := util.AbsolutePath(s.Config.MusicPath(), form.Song.FilePath)
+		lyrics, notes, err := lrc.ReadFiles(s.FS, path)
+		if err != nil {
+			return form, errors.E(op, err, errors.InternalServer)
+		}
+		if lyrics == "" && notes == "" {
+			form.Errors = map[string]string{
+				"import": "no " + lrc.LyricsExt + " or " + lrc.NotesExt + " file found next to the audio file",
+			}
+			return form, errors.E(op, errors.InvalidForm)
+		}
+		if lyrics != "" {
+			form.Lyrics.Lyrics = lyrics
+		}
+		if notes != "" {
+			form.Lyrics.Notes = notes
+		}
+		form.Imported = true
+	} else {
+		form.Lyrics.Lyrics = strings.TrimSpace(r.Form.Get("lyrics"))
+		form.Lyrics.Notes = strings.TrimSpace(r.Form.Get("notes"))
+	}
+	form.Lyrics.UpdatedBy = user.Username
+	form.Lines = lrc.Parse(form.Lyrics.Lyrics)
+
+	if !form.Validate() {
+		return form, errors.E(op, errors.InvalidForm)
+	}
+
+	err = s.Storage.Lyrics(ctx).Update(form.Lyrics)
+	if err != nil {
+		return form, errors.E(op, err, errors.InternalServer)
+	}
+	s.audit(r, radio.AuditTrackLyrics, auditTrackTarget(form.Song), form.original, form.Lyrics)
+
+	form.Success = true
+	return form, nil
+}
@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostSongLyrics(t *testing.T) {
+	cfg := config.TestConfig()
+
+	song := &radio.Song{DatabaseTrack: &radio.DatabaseTrack{
+		TrackID:  500,
+		Artist:   "You",
+		Title:    "Hello World",
+		FilePath: "hello.mp3",
+	}}
+
+	var stored *radio.TrackLyrics
+	lyricsMock := &mocks.LyricsStorageMock{
+		GetFunc: func(trackID radio.TrackID) (*radio.TrackLyrics, error) {
+			if stored == nil || stored.TrackID != trackID {
+				return nil, errors.E(errors.LyricsUnknown)
+			}
+			tl := *stored
+			return &tl, nil
+		},
+		UpdateFunc: func(trackLyrics radio.TrackLyrics) error {
+			stored = &trackLyrics
+			return nil
+		},
+	}
+	auditMock := &mocks.AuditStorageMock{
+		AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+			return 1, nil
+		},
+	}
+	storage := &mocks.StorageServiceMock{
+		TrackFunc: func(contextMoqParam context.Context) radio.TrackStorage {
+			return &mocks.TrackStorageMock{
+				GetFunc: func(trackID radio.TrackID) (*radio.Song, error) {
+					if trackID == song.TrackID {
+						return song, nil
+					}
+					return nil, errors.E(errors.SongUnknown)
+				},
+			}
+		},
+		LyricsFunc: func(contextMoqParam context.Context) radio.LyricsStorage {
+			return lyricsMock
+		},
+		AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+			return auditMock
+		},
+	}
+
+	fs := afero.NewMemMapFs()
+	state := State{
+		Storage: storage,
+		Config:  NewConfig(cfg),
+		FS:      fs,
+	}
+
+	user := radio.User{
+		Username: "test",
+		UserPermissions: radio.UserPermissions{
+			radio.PermActive:       struct{}{},
+			radio.PermDatabaseEdit: struct{}{},
+		},
+	}
+
+	prepReq := func(values url.Values) *http.Request {
+		body := strings.NewReader(values.Encode())
+		req := httptest.NewRequest(http.MethodPost, "/admin/songs/lyrics", body)
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		return middleware.RequestWithUser(req, &user)
+	}
+
+	t.Run("save", func(t *testing.T) {
+		form, err := state.postSongLyrics(prepReq(url.Values{
+			"id":     {"500"},
+			"lyrics": {"[00:01.00]first\n[00:02.00]second"},
+			"notes":  {" some notes "},
+		}))
+		require.NoError(t, err)
+		require.NotNil(t, form)
+		assert.True(t, form.Success)
+		assert.Len(t, form.Lines, 2)
+
+		require.NotNil(t, stored)
+		assert.Equal(t, song.TrackID, stored.TrackID)
+		assert.Equal(t, "some notes", stored.Notes)
+		assert.Equal(t, user.Username, stored.UpdatedBy)
+
+		calls := auditMock.AddCalls()
+		require.NotEmpty(t, calls)
+		assert.Equal(t, radio.AuditTrackLyrics, calls[len(calls)-1].AuditEntry.Action)
+	})
+
+	t.Run("import without files", func(t *testing.T) {
+		form, err := state.postSongLyrics(prepReq(url.Values{
+			"id":     {"500"},
+			"action": {"import"},
+		}))
+		assert.True(t, errors.Is(errors.InvalidForm, err))
+		require.NotNil(t, form)
+		assert.NotEmpty(t, form.Errors["import"])
+	})
+
+	t.Run("import", func(t *testing.T) {
+		path := util.AbsolutePath(cfg.Conf().MusicPath, "hello.lrc")
+		require.NoError(t, afero.WriteFile(fs, path, []byte("[00:03.00]imported"), 0644))
+
+		form, err := state.postSongLyrics(prepReq(url.Values{
+			"id":     {"500"},
+			"action": {"import"},
+		}))
+		require.NoError(t, err)
+		require.NotNil(t, form)
+		assert.True(t, form.Imported)
+
+		require.NotNil(t, stored)
+		assert.Equal(t, "[00:03.00]imported", stored.Lyrics)
+		// there was no notes file so the notes should be kept
+		assert.Equal(t, "some notes", stored.Notes)
+	})
+}
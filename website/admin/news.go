@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"html/template"
 	"net/http"
 	"time"
@@ -188,6 +189,7 @@ func (s *State) PostNewsEntry(w http.ResponseWriter, r *http.Request) {
 	isNew := id == "new"
 
 	var post radio.NewsPost
+	var before *radio.NewsPost
 	var nid radio.NewsPostID
 	var err error
 
@@ -206,6 +208,7 @@ func (s *State) PostNewsEntry(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		post = *ppost
+		before = ppost
 	}
 
 	post = NewNewsPostFromRequest(post, r)
@@ -222,6 +225,15 @@ func (s *State) PostNewsEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	action := radio.AuditNewsEdit
+	if isNew {
+		action = radio.AuditNewsCreate
+		post.ID = nid
+	} else if post.DeletedAt != nil {
+		action = radio.AuditNewsDelete
+	}
+	s.audit(r, action, fmt.Sprintf("news %d (%s)", post.ID, post.Title), before, post)
+
 	// and after an update we need to re-render any markdown that
 	// was stored in the cache, so clear the cache and the next
 	// thing requesting the post will prompt a re-render
@@ -369,5 +381,6 @@ func (s *State) PostNewsCommentDelete(w http.ResponseWriter, r *http.Request) {
 		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to delete NewsComment")
 		return
 	}
+	s.audit(r, radio.AuditNewsCommentDelete, "news comment "+id.String(), nil, nil)
 	return
 }
@@ -40,6 +40,9 @@ type PendingForm struct {
 
 	CSRFTokenInput template.HTML
 	Errors         map[string]string
+	// DuplicateOf is the existing track this submission is likely a duplicate
+	// of, nil if it isn't a duplicate
+	DuplicateOf *radio.Song
 }
 
 func (PendingForm) TemplateBundle() string {
@@ -50,8 +53,9 @@ func (PendingForm) TemplateName() string {
 	return "form_admin_pending"
 }
 
-// Hydrate hydrates the PendingInput with information from the SubmissionStorage
-func (pi *PendingInput) Hydrate(s radio.SubmissionStorage, r *http.Request) error {
+// Hydrate hydrates the PendingInput with information from the SubmissionStorage, the
+// TrackStorage is used to retrieve the tracks that submissions are duplicates of
+func (pi *PendingInput) Hydrate(s radio.SubmissionStorage, ts radio.TrackStorage, r *http.Request) error {
 	const op errors.Op = "website/admin.pendingInput.Hydrate"
 
 	subms, err := s.All()
@@ -64,6 +68,19 @@ func (pi *PendingInput) Hydrate(s radio.SubmissionStorage, r *http.Request) erro
 	for i, v := range subms {
 		pi.Submissions[i].PendingSong = v
 		pi.Submissions[i].CSRFTokenInput = csrfInput
+
+		if v.DuplicateID == nil {
+			continue
+		}
+		dupe, err := ts.Get(*v.DuplicateID)
+		if err != nil {
+			if errors.Is(errors.SongUnknown, err) {
+				// track was removed after the submission was made
+				continue
+			}
+			return errors.E(op, err)
+		}
+		pi.Submissions[i].DuplicateOf = dupe
 	}
 	return nil
 }
@@ -113,7 +130,7 @@ func (s *State) GetPendingSong(w http.ResponseWriter, r *http.Request) {
 func (s *State) GetPending(w http.ResponseWriter, r *http.Request) {
 	var input = NewPendingInput(r)
 
-	if err := input.Hydrate(s.Storage.Submissions(r.Context()), r); err != nil {
+	if err := input.Hydrate(s.Storage.Submissions(r.Context()), s.Storage.Track(r.Context()), r); err != nil {
 		s.errorHandler(w, r, err, "database failure")
 		return
 	}
@@ -160,7 +177,7 @@ func (s *State) PostPending(w http.ResponseWriter, r *http.Request) {
 
 	// no htmx, send a full page back, but we have to hydrate the full list and swap out
 	// the element that was posted with the posted values
-	if err := input.Hydrate(s.Storage.Submissions(r.Context()), r); err != nil {
+	if err := input.Hydrate(s.Storage.Submissions(r.Context()), s.Storage.Track(r.Context()), r); err != nil {
 		s.errorHandler(w, r, err, "database failure")
 		return
 	}
@@ -244,6 +261,8 @@ func (s *State) postPendingDoReplace(r *http.Request, form PendingForm) (Pending
 		return form, errors.E(op, err, errors.InternalServer)
 	}
 	new.AcceptedSong = existing
+	// keep a copy of the original for the audit log
+	before := existing.Copy()
 
 	// insert into post-pending
 	err = ss.InsertPostPending(new.PendingSong)
@@ -297,6 +316,8 @@ func (s *State) postPendingDoReplace(r *http.Request, form PendingForm) (Pending
 		return form, errors.E(op, err, errors.InternalServer)
 	}
 
+	s.storeFingerprint(r, *existing)
+	s.audit(r, radio.AuditPendingReplace, auditTrackTarget(*existing), before, *existing)
 	return newPendingForm(r), nil
 }
 
@@ -337,6 +358,7 @@ func (s *State) postPendingDoDecline(r *http.Request, form PendingForm) (Pending
 		return form, errors.E(op, err, errors.InternalServer)
 	}
 
+	s.audit(r, radio.AuditPendingDecline, auditSubmissionTarget(form.PendingSong), form.PendingSong, nil)
 	return form, nil
 }
 
@@ -428,9 +450,35 @@ func (s *State) postPendingDoAccept(r *http.Request, form PendingForm) (PendingF
 		return form, errors.E(op, err, errors.InternalServer)
 	}
 
+	s.storeFingerprint(r, track)
+	s.audit(r, radio.AuditPendingAccept, auditTrackTarget(track), form.PendingSong, track)
 	return new, nil
 }
 
+// storeFingerprint creates and stores the acoustic fingerprint of the track given
+// such that future submissions can be checked against it. Errors are only logged
+// since the track has already been added by the time this is called
+func (s *State) storeFingerprint(r *http.Request, track radio.Song) {
+	ctx := r.Context()
+
+	path := util.AbsolutePath(s.Config.MusicPath(), track.FilePath)
+	fp, err := audio.Fingerprint(ctx, path)
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(ctx).Err(err).Str("filename", path).Msg("failed to fingerprint track")
+		return
+	}
+
+	err = s.Storage.Fingerprint(ctx).Store(radio.TrackFingerprint{
+		TrackID:     track.TrackID,
+		Length:      track.Length,
+		Fingerprint: fp,
+	})
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(ctx).Err(err).Uint64("track_id", uint64(track.TrackID)).Msg("failed to store fingerprint")
+		return
+	}
+}
+
 // NewPendingForm creates a PendingForm with song as a base and updating those
 // values from the form values given.
 func NewPendingForm(r *http.Request, song radio.PendingSong) (PendingForm, error) {
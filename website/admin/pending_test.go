@@ -185,6 +185,13 @@ func TestPostPending(t *testing.T) {
 
 			// setup mocks
 			storage := &mocks.StorageServiceMock{}
+			storage.AuditFunc = func(contextMoqParam context.Context) radio.AuditStorage {
+				return &mocks.AuditStorageMock{
+					AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+						return 1, nil
+					},
+				}
+			}
 			storage.SubmissionsFunc = func(contextMoqParam context.Context) radio.SubmissionStorage {
 				return &mocks.SubmissionStorageMock{
 					GetSubmissionFunc: func(submissionID radio.SubmissionID) (*radio.PendingSong, error) {
@@ -126,6 +126,12 @@ type ProfileInput struct {
 	middleware.Input
 
 	Form ProfileForm
+	// APITokens is the api token management form, only available
+	// when viewing your own profile
+	APITokens ProfileAPITokensForm
+	// TwoFactor is the two-factor management form, only available
+	// when viewing your own profile
+	TwoFactor ProfileTwoFactorForm
 }
 
 func (ProfileInput) TemplateBundle() string {
@@ -141,6 +147,28 @@ func NewProfileInput(forUser radio.User, r *http.Request) (*ProfileInput, error)
 	return &input, nil
 }
 
+// newSelfProfileInput returns the ProfileInput of a user viewing their own
+// profile, this includes the forms only available to the user themselves
+func (s *State) newSelfProfileInput(r *http.Request, user radio.User) (*ProfileInput, error) {
+	const op errors.Op = "website/admin.newSelfProfileInput"
+
+	input, err := NewProfileInput(user, r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input.APITokens, err = s.newProfileAPITokensForm(r, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input.TwoFactor, err = s.loadProfileTwoFactorForm(r, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return input, nil
+}
+
 type ProfilePasswordChangeForm struct {
 	// For is the user we're changing the password for
 	For radio.User
@@ -176,7 +204,13 @@ func (s *State) getProfile(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
-	input, err := NewProfileInput(toView, r)
+	var input *ProfileInput
+	var err error
+	if toView.ID == user.ID {
+		input, err = s.newSelfProfileInput(r, user)
+	} else {
+		input, err = NewProfileInput(toView, r)
+	}
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -309,6 +343,7 @@ func (s *State) postProfile(r *http.Request) (*ProfileForm, error) {
 	if err != nil {
 		return form, errors.E(op, err)
 	}
+	s.audit(r, radio.AuditUserEdit, form.User.Username, toEdit, form.User)
 
 	// tell the manager to update any state
 	err = s.Manager.UpdateFromStorage(ctx)
@@ -351,6 +386,7 @@ func (s *State) postNewProfileUser(r *http.Request, username string) (*ProfileFo
 		return form, errors.E(op, err)
 	}
 	form.User.ID = uid
+	s.audit(r, radio.AuditUserCreate, form.User.Username, nil, form.User)
 	return form, nil
 }
 
@@ -381,6 +417,7 @@ func (s *State) postNewProfileDJ(r *http.Request, username string) (*ProfileForm
 	}
 	dj.ID = djid      // apply the id
 	form.User.DJ = dj // then add it to the form we're returning
+	s.audit(r, radio.AuditDJCreate, form.User.Username, nil, dj)
 	return form, nil
 }
 
@@ -344,6 +344,13 @@ func TestPostProfile(t *testing.T) {
 
 			// setup storage mocks
 			storage := &mocks.StorageServiceMock{}
+			storage.AuditFunc = func(contextMoqParam context.Context) radio.AuditStorage {
+				return &mocks.AuditStorageMock{
+					AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+						return 1, nil
+					},
+				}
+			}
 			storage.UserFunc = func(contextMoqParam context.Context) radio.UserStorage {
 				return &mocks.UserStorageMock{
 					CreateFunc: func(user radio.User) (radio.UserID, error) {
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"cmp"
+	"fmt"
 	"html/template"
 	"net/http"
 	"slices"
@@ -73,11 +74,25 @@ func (s *State) PostRemoveSource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// find the source before kicking it so the audit log knows who it was
+	var before *radio.ProxySource
+	target := "source " + id.String()
+	if sources, err := s.Proxy.ListSources(r.Context()); err == nil {
+		i := slices.IndexFunc(sources, func(source radio.ProxySource) bool {
+			return source.ID == id
+		})
+		if i != -1 {
+			before = &sources[i]
+			target = fmt.Sprintf("%s on %s", before.User.Username, before.MountName)
+		}
+	}
+
 	err = s.Proxy.KickSource(r.Context(), id)
 	if err != nil {
 		s.errorHandler(w, r, err, "")
 		return
 	}
+	s.audit(r, radio.AuditSourceKick, target, before, nil)
 
 	s.GetProxy(w, r)
 }
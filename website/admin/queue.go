@@ -3,6 +3,8 @@ package admin
 import (
 	"html/template"
 	"net/http"
+	"slices"
+	"strconv"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
@@ -16,13 +18,17 @@ type QueueInput struct {
 	CSRFTokenInput template.HTML
 
 	Queue []radio.QueueEntry
+	// Pending are the requests waiting in the request pool
+	Pending []radio.PendingRequest
+	// Error is set when a change to the queue was refused
+	Error string
 }
 
 func (QueueInput) TemplateBundle() string {
 	return "queue"
 }
 
-func NewQueueInput(qs radio.QueueService, r *http.Request) (*QueueInput, error) {
+func NewQueueInput(qs radio.QueueService, rps radio.RequestPoolStorage, r *http.Request) (*QueueInput, error) {
 	const op errors.Op = "website/admin.NewQueueInput"
 
 	queue, err := qs.Entries(r.Context())
@@ -30,16 +36,22 @@ func NewQueueInput(qs radio.QueueService, r *http.Request) (*QueueInput, error)
 		hlog.FromRequest(r).Err(errors.E(op, err)).Ctx(r.Context()).Msg("failed to retrieve queue")
 	}
 
+	pending, err := rps.All()
+	if err != nil {
+		hlog.FromRequest(r).Err(errors.E(op, err)).Ctx(r.Context()).Msg("failed to retrieve request pool")
+	}
+
 	input := &QueueInput{
 		Input:          middleware.InputFromRequest(r),
 		CSRFTokenInput: csrf.TemplateField(r),
 		Queue:          queue,
+		Pending:        pending,
 	}
 	return input, nil
 }
 
 func (s *State) GetQueue(w http.ResponseWriter, r *http.Request) {
-	input, err := NewQueueInput(s.Queue, r)
+	input, err := NewQueueInput(s.Queue, s.Storage.RequestPool(r.Context()), r)
 	if err != nil {
 		s.errorHandler(w, r, err, "")
 		return
@@ -59,11 +71,193 @@ func (s *State) PostQueueRemove(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = s.Queue.Remove(r.Context(), id)
+	// find the entry before removing it so the audit log knows what it was
+	before, target := s.findQueueEntry(r, id)
+
+	ok, err := s.Queue.Remove(r.Context(), id)
+	if err != nil {
+		s.queueError(w, r, err)
+		return
+	}
+	if ok {
+		s.audit(r, radio.AuditQueueRemove, target, before, nil)
+	}
+
+	s.GetQueue(w, r)
+}
+
+// findQueueEntry returns the entry with the id given and the audit target
+// describing it, the entry is nil if it isn't in the queue
+func (s *State) findQueueEntry(r *http.Request, id radio.QueueID) (*radio.QueueEntry, string) {
+	queue, err := s.Queue.Entries(r.Context())
+	if err != nil {
+		return nil, "queue entry " + id.String()
+	}
+
+	i := slices.IndexFunc(queue, func(entry radio.QueueEntry) bool {
+		return entry.QueueID == id
+	})
+	if i == -1 {
+		return nil, "queue entry " + id.String()
+	}
+	return &queue[i], auditTrackTarget(queue[i].Song)
+}
+
+// queueError renders the queue with a message if err is a refused change
+// to the queue, other errors go to the error handler
+func (s *State) queueError(w http.ResponseWriter, r *http.Request, err error) {
+	var msg string
+	switch {
+	case errors.Is(errors.QueueEntryPinned, err):
+		msg = "That would shift a pinned entry"
+	case errors.Is(errors.QueueEntryUnknown, err):
+		msg = "That entry is no longer in the queue"
+	case errors.Is(errors.InvalidArgument, err):
+		msg = "That position is not available"
+	default:
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	input, err := NewQueueInput(s.Queue, s.Storage.RequestPool(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+	input.Error = msg
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+// queueMove is the audit value of a moved or inserted entry
+type queueMove struct {
+	Entry    radio.QueueEntry
+	Position int
+}
+
+// PostQueueMove moves an entry to another position in the queue
+func (s *State) PostQueueMove(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostQueueMove"
+
+	id, err := radio.ParseQueueID(r.FormValue("id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+	position, err := strconv.Atoi(r.FormValue("position"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+
+	entry, target := s.findQueueEntry(r, id)
+
+	err = s.Queue.Move(r.Context(), id, position)
+	if err != nil {
+		s.queueError(w, r, errors.E(op, err))
+		return
+	}
+	if entry != nil {
+		s.audit(r, radio.AuditQueueMove, target, nil, queueMove{*entry, position})
+	}
+
+	s.GetQueue(w, r)
+}
+
+// PostQueueInsert inserts a track at a position in the queue
+func (s *State) PostQueueInsert(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostQueueInsert"
+	ctx := r.Context()
+
+	tid, err := radio.ParseTrackID(r.FormValue("trackid"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+	position, err := strconv.Atoi(r.FormValue("position"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+
+	song, err := s.Storage.Track(ctx).Get(tid)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	entry, err := s.Queue.Insert(ctx, *song, position)
+	if err != nil {
+		s.queueError(w, r, errors.E(op, err))
+		return
+	}
+	s.audit(r, radio.AuditQueueInsert, auditTrackTarget(*song), nil, queueMove{*entry, position})
+
+	s.GetQueue(w, r)
+}
+
+// PostQueuePin pins or unpins an entry in the queue
+func (s *State) PostQueuePin(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostQueuePin"
+
+	id, err := radio.ParseQueueID(r.FormValue("id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, err), "")
+		return
+	}
+	pinned := r.FormValue("pinned") == "true"
+
+	before, target := s.findQueueEntry(r, id)
+
+	err = s.Queue.Pin(r.Context(), id, pinned)
+	if err != nil {
+		s.queueError(w, r, errors.E(op, err))
+		return
+	}
+	if before != nil {
+		after := *before
+		after.IsPinned = pinned
+		s.audit(r, radio.AuditQueuePin, target, before, after)
+	}
+
+	s.GetQueue(w, r)
+}
+
+// PostQueueVeto removes a pending request from the request pool
+func (s *State) PostQueueVeto(w http.ResponseWriter, r *http.Request) {
+	tid, err := radio.ParseTrackID(r.FormValue("trackid"))
 	if err != nil {
 		s.errorHandler(w, r, err, "")
 		return
 	}
 
+	rps := s.Storage.RequestPool(r.Context())
+
+	// find the request before removing it so the audit log knows what it was
+	var before *radio.PendingRequest
+	target := "pending request " + tid.String()
+	if pending, err := rps.All(); err == nil {
+		i := slices.IndexFunc(pending, func(pr radio.PendingRequest) bool {
+			return pr.TrackID == tid
+		})
+		if i != -1 {
+			before = &pending[i]
+			target = auditTrackTarget(before.Song)
+		}
+	}
+
+	err = rps.Remove(tid)
+	if err != nil && !errors.Is(errors.PendingRequestUnknown, err) {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+	if err == nil {
+		s.audit(r, radio.AuditRequestVeto, target, before, nil)
+	}
+
 	s.GetQueue(w, r)
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/httprate"
 )
 
 var NavBar = navbar.New(`hx-boost="true" hx-push-url="true" hx-target="#content"`,
@@ -32,6 +33,8 @@ var NavBar = navbar.New(`hx-boost="true" hx-push-url="true" hx-target="#content"
 	navbar.NewProtectedItem("Pending", radio.PermPendingView, navbar.Attrs("href", "/admin/pending")),
 	navbar.NewProtectedItem("Song Database", radio.PermDatabaseView, navbar.Attrs("href", "/admin/songs")),
 	navbar.NewProtectedItem("Users", radio.PermAdmin, navbar.Attrs("href", "/admin/users")),
+	navbar.NewProtectedItem("Audit", radio.PermAuditView, navbar.Attrs("href", "/admin/audit")),
+	navbar.NewProtectedItem("Guests", radio.PermGuestInvite, navbar.Attrs("href", "/admin/guests")),
 	navbar.NewProtectedItem("Telemetry", radio.PermTelemetryView, navbar.Attrs(
 		"href", "/admin/telemetry/",
 		// avoid htmx doing the request, it will try and
@@ -78,6 +81,7 @@ func NewState(
 		Manager:          cfg.Manager,
 		Queue:            cfg.Queue,
 		Tracker:          cfg.Tracker,
+		IRC:              cfg.IRC,
 		Templates:        siteTmpl,
 		TemplateExecutor: exec,
 		SessionManager:   sessionManager,
@@ -108,6 +112,7 @@ type State struct {
 	Manager  radio.ManagerService
 	Queue    radio.QueueService
 	Tracker  radio.ListenerTrackerService
+	IRC      radio.AnnounceService
 
 	// Templates is the actual Site collection of templates, used to
 	// be able to reload templates from the admin panel
@@ -128,65 +133,88 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 	return func(r chi.Router) {
 
 		r.Use(
-			// the login middleware will require atleast the active permission
-			s.Authentication.LoginMiddleware,
 			// disable all cacheing for admin pages
 			middleware.NoCache,
 			// strip trailing slashes
 			middleware.StripSlashes,
 		)
 
-		p := vmiddleware.RequirePermission
-
-		r.Handle("/set-theme", templates.SetThemeHandler(
-			templates.ThemeAdminCookieName,
-		))
-		r.HandleFunc("/", s.GetHome)
-		r.Get("/profile", s.GetProfile)
-		r.Post("/profile", s.PostProfile)
-		r.Get("/pending", p(radio.PermPendingView, s.GetPending))
-		r.Post("/pending", p(radio.PermPendingEdit, s.PostPending))
-		r.Get("/pending-song/{SubmissionID:[0-9]+}", p(radio.PermPendingView, s.GetPendingSong))
-		r.Get("/songs", p(radio.PermDatabaseView, s.GetSongs))
-		r.Post("/songs", p(radio.PermDatabaseEdit, s.PostSongs))
-		r.Get("/users", p(radio.PermAdmin, s.GetUsersList))
-		r.Get("/news", p(radio.PermNews, s.GetNews))
-		r.Get("/news/{NewsID:[0-9]+|new}", p(radio.PermNews, s.GetNewsEntry))
-		r.Post("/news/{NewsID:[0-9]+|new}", p(radio.PermNews, s.PostNewsEntry))
-		r.Post("/news/render", p(radio.PermNews, s.PostNewsRender))
-		r.Post("/news/comments/remove", p(radio.PermAdmin, s.PostNewsCommentDelete))
-		r.Get("/queue", p(radio.PermQueueEdit, s.GetQueue))
-		r.Post("/queue/remove", p(radio.PermQueueEdit, s.PostQueueRemove))
-		r.Get("/schedule", p(radio.PermScheduleEdit, s.GetSchedule))
-		r.Post("/schedule", p(radio.PermScheduleEdit, s.PostSchedule))
-		r.Get("/tracker", p(radio.PermListenerView, s.GetListeners))
-		r.Post("/tracker/remove", p(radio.PermListenerKick, s.PostRemoveListener))
-		r.Get("/proxy", p(radio.PermDJ, s.GetProxy))
-		r.Post("/proxy/remove", p(radio.PermProxyKick, s.PostRemoveSource))
-		r.Get("/booth", p(radio.PermDJ, s.GetBooth))
-		r.Get("/booth/sse", p(radio.PermDJ, s.sseBoothAPI))
-		r.Post("/booth/stop-streamer", p(radio.PermDJ, s.PostBoothStopStreamer))
-		r.Post("/booth/start-streamer", p(radio.PermDJ, s.PostBoothStartStreamer))
-		r.Post("/booth/set-thread", p(radio.PermDJ, s.PostBoothSetThread))
-
-		// setup monitoring endpoint
-		var telemetryHandler http.HandlerFunc
-		if s.TelemetryProxy != nil {
-			telemetryHandler = s.TelemetryProxy.ServeHTTP
-		} else {
-			telemetryHandler = func(w http.ResponseWriter, r *http.Request) {
-				http.Redirect(w, r, s.Config.TelemetryProxyURL(), http.StatusFound)
+		// invites are redeemed by guests that might not have an account yet
+		redeemLimiter := httprate.LimitByIP(10, time.Minute)
+		r.Get("/redeem-invite", s.GetRedeemInvite)
+		r.With(redeemLimiter).Post("/redeem-invite", s.PostBoothRedeemInvite)
+
+		r.Group(func(r chi.Router) {
+			// the login middleware will require atleast the active permission
+			r.Use(s.Authentication.LoginMiddleware)
+
+			p := vmiddleware.RequirePermission
+
+			r.Handle("/set-theme", templates.SetThemeHandler(
+				templates.ThemeAdminCookieName,
+			))
+			r.HandleFunc("/", s.GetHome)
+			r.Get("/profile", s.GetProfile)
+			r.Post("/profile", s.PostProfile)
+			r.Post("/profile/tokens", s.PostProfileTokenCreate)
+			r.Post("/profile/tokens/revoke", s.PostProfileTokenRevoke)
+			r.Post("/profile/twofactor", s.PostProfileTwoFactor)
+			r.Get("/pending", p(radio.PermPendingView, s.GetPending))
+			r.Post("/pending", p(radio.PermPendingEdit, s.PostPending))
+			r.Get("/pending-song/{SubmissionID:[0-9]+}", p(radio.PermPendingView, s.GetPendingSong))
+			r.Get("/songs", p(radio.PermDatabaseView, s.GetSongs))
+			r.Post("/songs", p(radio.PermDatabaseEdit, s.PostSongs))
+			r.Get("/songs/lyrics", p(radio.PermDatabaseView, s.GetSongLyrics))
+			r.Post("/songs/lyrics", p(radio.PermDatabaseEdit, s.PostSongLyrics))
+			r.Get("/users", p(radio.PermAdmin, s.GetUsersList))
+			r.Get("/audit", p(radio.PermAuditView, s.GetAudit))
+			r.Get("/guests", p(radio.PermGuestInvite, s.GetGuests))
+			r.Post("/guests", p(radio.PermGuestInvite, s.PostGuests))
+			r.Post("/guests/delete", p(radio.PermGuestInvite, s.PostGuestInviteDelete))
+			r.Get("/news", p(radio.PermNews, s.GetNews))
+			r.Get("/news/{NewsID:[0-9]+|new}", p(radio.PermNews, s.GetNewsEntry))
+			r.Post("/news/{NewsID:[0-9]+|new}", p(radio.PermNews, s.PostNewsEntry))
+			r.Post("/news/render", p(radio.PermNews, s.PostNewsRender))
+			r.Post("/news/comments/remove", p(radio.PermAdmin, s.PostNewsCommentDelete))
+			r.Get("/queue", p(radio.PermQueueEdit, s.GetQueue))
+			r.Post("/queue/remove", p(radio.PermQueueEdit, s.PostQueueRemove))
+			r.Post("/queue/veto", p(radio.PermQueueEdit, s.PostQueueVeto))
+			r.Post("/queue/move", p(radio.PermQueueEdit, s.PostQueueMove))
+			r.Post("/queue/insert", p(radio.PermQueueEdit, s.PostQueueInsert))
+			r.Post("/queue/pin", p(radio.PermQueueEdit, s.PostQueuePin))
+			r.Get("/schedule", p(radio.PermScheduleEdit, s.GetSchedule))
+			r.Post("/schedule", p(radio.PermScheduleEdit, s.PostSchedule))
+			r.Get("/tracker", p(radio.PermListenerView, s.GetListeners))
+			r.Post("/tracker/remove", p(radio.PermListenerKick, s.PostRemoveListener))
+			r.Get("/proxy", p(radio.PermDJ, s.GetProxy))
+			r.Post("/proxy/remove", p(radio.PermProxyKick, s.PostRemoveSource))
+			r.Get("/booth", p(radio.PermDJ, s.GetBooth))
+			r.Get("/booth/sse", p(radio.PermDJ, s.sseBoothAPI))
+			r.Post("/booth/stop-streamer", p(radio.PermDJ, s.PostBoothStopStreamer))
+			r.Post("/booth/start-streamer", p(radio.PermDJ, s.PostBoothStartStreamer))
+			r.Post("/booth/set-thread", p(radio.PermDJ, s.PostBoothSetThread))
+			r.Post("/booth/handover", p(radio.PermDJ, s.PostBoothHandover))
+			r.Post("/booth/handover/cancel", p(radio.PermDJ, s.PostBoothHandoverCancel))
+
+			// setup monitoring endpoint
+			var telemetryHandler http.HandlerFunc
+			if s.TelemetryProxy != nil {
+				telemetryHandler = s.TelemetryProxy.ServeHTTP
+			} else {
+				telemetryHandler = func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, s.Config.TelemetryProxyURL(), http.StatusFound)
+				}
 			}
-		}
-		// use a mounted subrouter to avoid the StripSlashes middleware breaking our wildcard
-		r.Route("/telemetry", func(r chi.Router) {
-			r.Handle("/*", p(radio.PermTelemetryView, telemetryHandler))
-		})
+			// use a mounted subrouter to avoid the StripSlashes middleware breaking our wildcard
+			r.Route("/telemetry", func(r chi.Router) {
+				r.Handle("/*", p(radio.PermTelemetryView, telemetryHandler))
+			})
 
-		// debug handlers, might not be needed later
-		r.Post("/api/streamer/stop", p(radio.PermAdmin, s.PostStreamerStop))
-		r.Post("/api/website/reload-templates", p(radio.PermAdmin, s.PostReloadTemplates))
-		r.Post("/api/website/set-holiday-theme", p(radio.PermAdmin, s.PostSetHolidayTheme))
+			// debug handlers, might not be needed later
+			r.Post("/api/streamer/stop", p(radio.PermAdmin, s.PostStreamerStop))
+			r.Post("/api/website/reload-templates", p(radio.PermAdmin, s.PostReloadTemplates))
+			r.Post("/api/website/set-holiday-theme", p(radio.PermAdmin, s.PostSetHolidayTheme))
+		})
 
 		// error handlers
 		r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
@@ -201,7 +229,12 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 // PostStreamerStop stops the streamer forcefully
 func (s *State) PostStreamerStop(w http.ResponseWriter, r *http.Request) {
 	user := vmiddleware.UserFromContext(r.Context())
-	s.Streamer.Stop(r.Context(), &user, true)
+	err := s.Streamer.Stop(r.Context(), &user, true)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+	s.audit(r, radio.AuditStreamerStop, "streamer", nil, nil)
 }
 
 func (s *State) errorHandler(w http.ResponseWriter, r *http.Request, err error, msg string) {
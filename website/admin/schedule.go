@@ -125,6 +125,7 @@ func (s *State) PostSchedule(w http.ResponseWriter, r *http.Request) {
 			s.errorHandler(w, r, err, "")
 			return
 		}
+		s.audit(r, radio.AuditScheduleEdit, "schedule "+form.Entry.Weekday.String(), nil, *form.Entry)
 	}
 
 	err = s.TemplateExecutor.Execute(w, r, form)
@@ -40,6 +40,9 @@ type SongsForm struct {
 	HasEdit bool
 	Song    radio.Song
 	SongURL string
+
+	// original is the song as it was before any changes from the form
+	original radio.Song
 }
 
 func (SongsForm) TemplateName() string {
@@ -179,6 +182,8 @@ func (s *State) postSongs(r *http.Request) (*SongsForm, error) {
 		if err != nil {
 			return nil, errors.E(op, err, errors.InternalServer)
 		}
+
+		s.audit(r, radio.AuditTrackDelete, auditTrackTarget(form.original), form.original, nil)
 		return nil, nil
 	}
 
@@ -192,6 +197,7 @@ func (s *State) postSongs(r *http.Request) (*SongsForm, error) {
 	if err != nil {
 		return form, errors.E(op, err, errors.InternalServer)
 	}
+	s.audit(r, radio.AuditTrackEdit, auditTrackTarget(form.Song), form.original, form.Song)
 
 	form.Success = true
 	form.SongURL = GenerateSongURL(s.SongSecret, form.Song)
@@ -213,6 +219,8 @@ func NewSongsForm(ts radio.TrackStorage, user radio.User, r *http.Request) (*Son
 	if err != nil {
 		return nil, errors.E(op, err, errors.InvalidForm)
 	}
+	// copy the song so the changes below don't end up in original
+	form.original = song.Copy()
 
 	song.Artist = values.Get("artist")
 	song.Album = values.Get("album")
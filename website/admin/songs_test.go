@@ -150,6 +150,14 @@ func TestPostSongs(t *testing.T) {
 			},
 		}
 	}
+	auditMock := &mocks.AuditStorageMock{
+		AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+			return 1, nil
+		},
+	}
+	storage.AuditFunc = func(contextMoqParam context.Context) radio.AuditStorage {
+		return auditMock
+	}
 
 	// setup fake filesystem
 	fs := afero.NewMemMapFs()
@@ -216,6 +224,12 @@ func TestPostSongs(t *testing.T) {
 				checkExist(t, true, form.Song.FilePath)
 			}
 		}
+		calls := auditMock.AddCalls()
+		if assert.NotEmpty(t, calls) {
+			entry := calls[len(calls)-1].AuditEntry
+			assert.Equal(t, radio.AuditTrackEdit, entry.Action)
+			assert.Equal(t, user.Username, entry.Actor)
+		}
 	})
 
 	t.Run("delete path with absolute", func(t *testing.T) {
@@ -249,6 +263,13 @@ func TestPostSongs(t *testing.T) {
 			assert.Nil(t, form, "delete action should return nothing")
 			checkExist(t, false, c.FilePath)
 		}
+		calls := auditMock.AddCalls()
+		if assert.NotEmpty(t, calls) {
+			entry := calls[len(calls)-1].AuditEntry
+			assert.Equal(t, radio.AuditTrackDelete, entry.Action)
+			assert.NotEmpty(t, entry.Before)
+			assert.Empty(t, entry.After)
+		}
 	})
 
 	t.Run("delete path with relative path", func(t *testing.T) {
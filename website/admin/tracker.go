@@ -3,6 +3,7 @@ package admin
 import (
 	"html/template"
 	"net/http"
+	"slices"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
@@ -58,11 +59,25 @@ func (s *State) PostRemoveListener(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// find the listener before kicking it so the audit log knows who it was
+	var before *radio.Listener
+	target := "listener " + id.String()
+	if listeners, err := s.Tracker.ListClients(r.Context()); err == nil {
+		i := slices.IndexFunc(listeners, func(listener radio.Listener) bool {
+			return listener.ID == id
+		})
+		if i != -1 {
+			before = &listeners[i]
+			target += " (" + before.IP + ")"
+		}
+	}
+
 	err = s.Tracker.RemoveClient(r.Context(), id)
 	if err != nil {
 		s.errorHandler(w, r, err, "")
 		return
 	}
+	s.audit(r, radio.AuditListenerKick, target, before, nil)
 
 	s.GetListeners(w, r)
 }
@@ -0,0 +1,269 @@
+package admin
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util/totp"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+)
+
+const (
+	twoFactorIssuer        = "R/a/dio"
+	twoFactorRecoveryCodes = 10
+
+	twoFactorActionEnroll   = "enroll"
+	twoFactorActionConfirm  = "confirm"
+	twoFactorActionDisable  = "disable"
+	twoFactorActionRecovery = "recovery"
+)
+
+// ProfileTwoFactorForm is the form used to manage two-factor authentication
+type ProfileTwoFactorForm struct {
+	// CSRFTokenInput is the <input> that should be included in the form for CSRF
+	CSRFTokenInput template.HTML
+	// Enabled indicates if the user has two-factor authentication enabled
+	Enabled bool
+	// Secret is the secret to add to an authenticator app, only set while
+	// the user is enrolling
+	Secret string
+	// URL is the otpauth:// url of Secret, for use in a QR code
+	URL template.URL
+	// RecoveryCodes are newly generated recovery codes, this is the only time
+	// they're available in full
+	RecoveryCodes []string
+	// RecoveryCodesLeft is the amount of unused recovery codes
+	RecoveryCodesLeft int
+}
+
+func (ProfileTwoFactorForm) TemplateBundle() string {
+	return "profile"
+}
+
+func (ProfileTwoFactorForm) TemplateName() string {
+	return "form_profile_twofactor"
+}
+
+func (ProfileTwoFactorForm) FormAction() template.HTMLAttr {
+	return "/admin/profile/twofactor"
+}
+
+func newProfileTwoFactorForm(r *http.Request, user radio.User, tf *radio.UserTwoFactor) ProfileTwoFactorForm {
+	form := ProfileTwoFactorForm{
+		CSRFTokenInput: csrf.TemplateField(r),
+	}
+	if tf == nil {
+		return form
+	}
+
+	form.Enabled = tf.IsEnabled()
+	form.RecoveryCodesLeft = len(tf.RecoveryCodes)
+	if !form.Enabled {
+		// still enrolling, show them the secret
+		form.Secret = tf.Secret
+		form.URL = template.URL(totp.URL(twoFactorIssuer, user.Username, tf.Secret))
+	}
+	return form
+}
+
+func (s *State) loadProfileTwoFactorForm(r *http.Request, user radio.User) (ProfileTwoFactorForm, error) {
+	const op errors.Op = "website/admin.loadProfileTwoFactorForm"
+
+	tf, err := s.Storage.User(r.Context()).TwoFactor(user.ID)
+	if err != nil && !errors.Is(errors.TwoFactorUnknown, err) {
+		return ProfileTwoFactorForm{}, errors.E(op, err)
+	}
+	return newProfileTwoFactorForm(r, user, tf), nil
+}
+
+// PostProfileTwoFactor handles enrollment and removal of two-factor authentication
+func (s *State) PostProfileTwoFactor(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postProfileTwoFactor(w, r)
+	if err != nil {
+		s.errorHandler(w, r, err, "failed two-factor change")
+		return
+	}
+	if input == nil {
+		// handled by a redirect
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "template failure")
+		return
+	}
+}
+
+func (s *State) postProfileTwoFactor(w http.ResponseWriter, r *http.Request) (*ProfileInput, error) {
+	const op errors.Op = "website/admin.postProfileTwoFactor"
+	ctx := r.Context()
+
+	user := middleware.UserFromContext(ctx)
+
+	err := r.ParseForm()
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, err)
+	}
+
+	// devs can remove two-factor from other users, for when someone
+	// loses their device and recovery codes
+	if username := r.PostFormValue("username"); username != "" && username != user.Username {
+		if !user.UserPermissions.Has(radio.PermDev) {
+			return nil, errors.E(op, errors.AccessDenied)
+		}
+		if r.PostFormValue("twofactor.action") != twoFactorActionDisable {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.action"))
+		}
+
+		other, err := s.Storage.User(ctx).Get(username)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		err = s.Storage.User(ctx).DeleteTwoFactor(other.ID)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		s.audit(r, radio.AuditTwoFactorRemove, other.Username, nil, nil)
+
+		http.Redirect(w, r, profileFormAction+"?"+url.Values{"username": {username}}.Encode(), http.StatusSeeOther)
+		return nil, nil
+	}
+
+	form, err := s.updateTwoFactor(r, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	input, err := s.newSelfProfileInput(r, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	input.TwoFactor = *form
+	return input, nil
+}
+
+// updateTwoFactor applies the two-factor action in the form to the user given
+func (s *State) updateTwoFactor(r *http.Request, user radio.User) (*ProfileTwoFactorForm, error) {
+	const op errors.Op = "website/admin.updateTwoFactor"
+	us := s.Storage.User(r.Context())
+
+	tf, err := us.TwoFactor(user.ID)
+	if err != nil && !errors.Is(errors.TwoFactorUnknown, err) {
+		return nil, errors.E(op, err)
+	}
+
+	code := r.PostFormValue("twofactor.code")
+
+	switch action := r.PostFormValue("twofactor.action"); action {
+	case twoFactorActionEnroll:
+		if tf.IsEnabled() {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.action"), "already enabled")
+		}
+
+		secret, err := totp.GenerateSecret()
+		if err != nil {
+			return nil, errors.E(op, errors.InternalServer, err)
+		}
+		tf = &radio.UserTwoFactor{
+			UserID: user.ID,
+			Secret: secret,
+		}
+		err = us.UpdateTwoFactor(*tf)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	case twoFactorActionConfirm:
+		if tf == nil || tf.IsEnabled() {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.action"), "not enrolling")
+		}
+		ok, err := middleware.ValidateTwoFactorCode(us, tf, code)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if !ok {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.code"), "invalid code")
+		}
+
+		now := time.Now()
+		tf.EnabledAt = &now
+
+		form, err := s.newRecoveryCodes(r, user, us, tf)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return form, nil
+	case twoFactorActionRecovery:
+		if !tf.IsEnabled() {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.code"), "invalid code")
+		}
+		ok, err := middleware.ValidateTwoFactorCode(us, tf, code)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if !ok {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.code"), "invalid code")
+		}
+
+		form, err := s.newRecoveryCodes(r, user, us, tf)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return form, nil
+	case twoFactorActionDisable:
+		if !tf.IsEnabled() {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.action"), "not enabled")
+		}
+		// require a valid code so a stolen session can't remove it
+		ok, err := middleware.ValidateTwoFactorCode(us, tf, code)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if !ok {
+			ok, err = middleware.UseRecoveryCode(us, tf, code)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+		if !ok {
+			return nil, errors.E(op, errors.InvalidForm, errors.Info("twofactor.code"), "invalid code")
+		}
+
+		err = us.DeleteTwoFactor(user.ID)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		s.audit(r, radio.AuditTwoFactorRemove, user.Username, nil, nil)
+		tf = nil
+	default:
+		return nil, errors.E(op, errors.InvalidForm, errors.Info(action), "unknown action")
+	}
+
+	form := newProfileTwoFactorForm(r, user, tf)
+	return &form, nil
+}
+
+// newRecoveryCodes replaces the recovery codes of tf with new ones and stores it
+func (s *State) newRecoveryCodes(r *http.Request, user radio.User, us radio.UserStorage, tf *radio.UserTwoFactor) (*ProfileTwoFactorForm, error) {
+	const op errors.Op = "website/admin.newRecoveryCodes"
+
+	codes, hashes, err := radio.GenerateRecoveryCodes(twoFactorRecoveryCodes)
+	if err != nil {
+		return nil, errors.E(op, errors.InternalServer, err)
+	}
+	tf.RecoveryCodes = hashes
+
+	err = us.UpdateTwoFactor(*tf)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	form := newProfileTwoFactorForm(r, user, tf)
+	form.RecoveryCodes = codes
+	return &form, nil
+}
@@ -0,0 +1,206 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/totp"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTwoFactorTestState returns a State with a UserStorage that keeps the
+// two-factor state in memory
+func newTwoFactorTestState() (*State, **radio.UserTwoFactor, *mocks.AuditStorageMock) {
+	var stored *radio.UserTwoFactor
+	userMock := &mocks.UserStorageMock{
+		TwoFactorFunc: func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+			if stored == nil {
+				return nil, errors.E(errors.TwoFactorUnknown)
+			}
+			tf := *stored
+			return &tf, nil
+		},
+		UpdateTwoFactorFunc: func(tf radio.UserTwoFactor) error {
+			stored = &tf
+			return nil
+		},
+		DeleteTwoFactorFunc: func(userID radio.UserID) error {
+			stored = nil
+			return nil
+		},
+		ReplaceRecoveryCodesFunc: func(id radio.UserID, old, new []string) (bool, error) {
+			if stored == nil || !slices.Equal(stored.RecoveryCodes, old) {
+				return false, nil
+			}
+			stored.RecoveryCodes = slices.Clone(new)
+			return true, nil
+		},
+		UseTwoFactorStepFunc: func(userID radio.UserID, step uint64) (bool, error) {
+			if stored == nil || step <= stored.LastStep {
+				return false, nil
+			}
+			stored.LastStep = step
+			return true, nil
+		},
+	}
+
+	auditMock := &mocks.AuditStorageMock{
+		AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+			return 1, nil
+		},
+	}
+
+	return &State{
+		Storage: &mocks.StorageServiceMock{
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return userMock
+			},
+			AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+				return auditMock
+			},
+		},
+	}, &stored, auditMock
+}
+
+func TestProfileTwoFactorFlow(t *testing.T) {
+	state, stored, auditMock := newTwoFactorTestState()
+
+	do := func(action, code string) (*ProfileTwoFactorForm, error) {
+		req := newAPITokenRequest(t, "/admin/profile/twofactor", url.Values{
+			"twofactor.action": {action},
+			"twofactor.code":   {code},
+		})
+		require.NoError(t, req.ParseForm())
+		return state.updateTwoFactor(req, apiTokenTestUser)
+	}
+
+	// confirming before enrolling should fail
+	_, err := do(twoFactorActionConfirm, "000000")
+	assert.True(t, errors.Is(errors.InvalidForm, err))
+
+	// enroll, we should get a secret back but it shouldn't be enabled yet
+	form, err := do(twoFactorActionEnroll, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, form.Secret)
+	assert.False(t, form.Enabled)
+	assert.False(t, (*stored).IsEnabled())
+
+	// wrong code should not confirm it
+	_, err = do(twoFactorActionConfirm, "000000")
+	assert.True(t, errors.Is(errors.InvalidForm, err))
+
+	// a correct code should enable it and give us recovery codes
+	code, err := totp.Code(form.Secret, time.Now())
+	require.NoError(t, err)
+	form, err = do(twoFactorActionConfirm, code)
+	require.NoError(t, err)
+	assert.True(t, form.Enabled)
+	assert.Empty(t, form.Secret)
+	assert.Len(t, form.RecoveryCodes, twoFactorRecoveryCodes)
+	assert.True(t, (*stored).IsEnabled())
+
+	// enrolling again while enabled should fail
+	_, err = do(twoFactorActionEnroll, "")
+	assert.True(t, errors.Is(errors.InvalidForm, err))
+
+	// the code used to confirm can't be used again
+	_, err = do(twoFactorActionRecovery, code)
+	assert.True(t, errors.Is(errors.InvalidForm, err))
+
+	// disabling with a recovery code should work
+	form, err = do(twoFactorActionDisable, form.RecoveryCodes[0])
+	require.NoError(t, err)
+	assert.False(t, form.Enabled)
+	assert.Nil(t, *stored)
+
+	// and it should leave an audit entry behind
+	require.Len(t, auditMock.AddCalls(), 1)
+	assert.Equal(t, radio.AuditTwoFactorRemove, auditMock.AddCalls()[0].AuditEntry.Action)
+	assert.Equal(t, apiTokenTestUser.Username, auditMock.AddCalls()[0].AuditEntry.Target)
+}
+
+func TestRemoveOtherTwoFactor(t *testing.T) {
+	other := radio.User{ID: 60, Username: "lost-device"}
+
+	cases := []struct {
+		name    string
+		perms   radio.UserPermissions
+		allowed bool
+	}{
+		{
+			name:  "admin",
+			perms: radio.NewUserPermissions(radio.PermActive, radio.PermAdmin),
+		},
+		{
+			name:    "dev",
+			perms:   radio.NewUserPermissions(radio.PermActive, radio.PermDev),
+			allowed: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			userMock := &mocks.UserStorageMock{
+				GetFunc: func(name string) (*radio.User, error) {
+					require.Equal(t, other.Username, name)
+					return &other, nil
+				},
+				DeleteTwoFactorFunc: func(userID radio.UserID) error {
+					return nil
+				},
+			}
+			auditMock := &mocks.AuditStorageMock{
+				AddFunc: func(auditEntry radio.AuditEntry) (radio.AuditEntryID, error) {
+					return 1, nil
+				},
+			}
+			state := &State{
+				Storage: &mocks.StorageServiceMock{
+					UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+						return userMock
+					},
+					AuditFunc: func(contextMoqParam context.Context) radio.AuditStorage {
+						return auditMock
+					},
+				},
+			}
+
+			form := url.Values{
+				"username":         {other.Username},
+				"twofactor.action": {twoFactorActionDisable},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/admin/profile/twofactor", strings.NewReader(form.Encode()))
+			req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+			req = middleware.RequestWithUser(req, &radio.User{
+				ID:              1,
+				Username:        "remover",
+				UserPermissions: c.perms,
+			})
+
+			_, err := state.postProfileTwoFactor(httptest.NewRecorder(), req)
+			if !c.allowed {
+				assert.True(t, errors.Is(errors.AccessDenied, err))
+				assert.Empty(t, userMock.DeleteTwoFactorCalls())
+				assert.Empty(t, auditMock.AddCalls())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, userMock.DeleteTwoFactorCalls(), 1)
+			assert.Equal(t, other.ID, userMock.DeleteTwoFactorCalls()[0].UserID)
+			require.Len(t, auditMock.AddCalls(), 1)
+			assert.Equal(t, radio.AuditTwoFactorRemove, auditMock.AddCalls()[0].AuditEntry.Action)
+			assert.Equal(t, other.Username, auditMock.AddCalls()[0].AuditEntry.Target)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+const (
+	completeLimit = 10
+	// completeCacheTTL is how long completions are cached for, both by us
+	// and by the client
+	completeCacheTTL = time.Minute
+	// completeCacheSize is the maximum amount of prefixes cached
+	completeCacheSize = 4096
+)
+
+// CompleteResponse is the response of GET /v1/tracks/complete
+type CompleteResponse struct {
+	Query       string           `json:"query"`
+	Completions []CompletionJSON `json:"completions"`
+}
+
+type CompletionJSON struct {
+	Kind  radio.SearchCompletionKind `json:"kind"`
+	Value string                     `json:"value"`
+	Count int                        `json:"count"`
+}
+
+func (a *API) GetComplete(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetComplete"
+
+	query := strings.TrimSpace(r.FormValue("q"))
+	if query == "" {
+		a.jsonErrorHandler(w, r, errors.E(op, errors.InvalidForm, errors.Info("missing q")))
+		return
+	}
+
+	completions, ok := a.completions.Get(query)
+	if !ok {
+		res, err := a.Search.Complete(r.Context(), query, completeLimit)
+		if err != nil {
+			a.jsonErrorHandler(w, r, errors.E(op, err))
+			return
+		}
+
+		completions = make([]CompletionJSON, len(res))
+		for i, c := range res {
+			completions[i] = CompletionJSON{
+				Kind:  c.Kind,
+				Value: c.Value,
+				Count: c.Count,
+			}
+		}
+		a.completions.Set(query, completions)
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	writeJSON(w, r, http.StatusOK, CompleteResponse{
+		Query:       query,
+		Completions: completions,
+	})
+}
+
+// completeCache caches completions by prefix, the zero value is ready for use
+type completeCache struct {
+	mu      sync.Mutex
+	entries map[string]completeCacheEntry
+}
+
+type completeCacheEntry struct {
+	expires     time.Time
+	completions []CompletionJSON
+}
+
+func (cc *completeCache) Get(prefix string) ([]CompletionJSON, bool) {
+	prefix = strings.ToLower(prefix)
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	entry, ok := cc.entries[prefix]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.completions, true
+}
+
+func (cc *completeCache) Set(prefix string, completions []CompletionJSON) {
+	prefix = strings.ToLower(prefix)
+	now := time.Now()
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if cc.entries == nil {
+		cc.entries = make(map[string]completeCacheEntry)
+	}
+
+	if len(cc.entries) >= completeCacheSize {
+		// make room by removing anything that expired
+		for key, entry := range cc.entries {
+			if now.After(entry.expires) {
+				delete(cc.entries, key)
+			}
+		}
+		// and if that wasn't enough just start over
+		if len(cc.entries) >= completeCacheSize {
+			clear(cc.entries)
+		}
+	}
+
+	cc.entries[prefix] = completeCacheEntry{
+		expires:     now.Add(completeCacheTTL),
+		completions: completions,
+	}
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCompleteCached(t *testing.T) {
+	api := newTestJSONAPI(t)
+
+	r := chi.NewRouter()
+	api.Route(r)
+
+	for _, q := range []string{"art", "ART", "art"} {
+		req := httptest.NewRequest(http.MethodGet, "/tracks/complete?q="+q, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("Cache-Control"))
+
+		var resp CompleteResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, q, resp.Query)
+		require.Len(t, resp.Completions, 1)
+		assert.Equal(t, "artist", resp.Completions[0].Value)
+	}
+
+	calls := api.Search.(*mocks.SearchServiceMock).CompleteCalls()
+	require.Len(t, calls, 1, "should've been cached")
+	assert.Equal(t, completeLimit, calls[0].Limit)
+}
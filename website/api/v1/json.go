@@ -0,0 +1,247 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/rs/zerolog/hlog"
+)
+
+// writeJSON encodes v as json and writes it to w with the status code given
+func writeJSON(w http.ResponseWriter, r *http.Request, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Any("value", v).Msg("failed to encode json")
+		return
+	}
+}
+
+// ErrorResponse is the body returned by all json endpoints when an error occurs
+type ErrorResponse struct {
+	Error ErrorJSON `json:"error"`
+}
+
+type ErrorJSON struct {
+	// Status is the http status code, duplicated here for convenience
+	Status int `json:"status"`
+	// Code is a machine readable identifier for the kind of error
+	Code string `json:"code"`
+	// Message is a human readable description of the error
+	Message string `json:"message"`
+	// RetryAfter is the amount of seconds until the action can be retried,
+	// only set for cooldown errors
+	RetryAfter int64 `json:"retry_after,omitempty"`
+	// RequestID is the identifier of the request, useful when reporting bugs
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// jsonErrorHandler writes a structured json error to w based on the error given
+func (a *API) jsonErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	var statusCode = http.StatusInternalServerError
+	var code = "internal_error"
+	var msg = "something broke, report to IRC."
+
+	switch {
+	case errors.IsE(err, shared.ErrNotFound),
+		errors.Is(errors.SongUnknown, err),
+		errors.Is(errors.NewsUnknown, err),
+		errors.Is(errors.UserUnknown, err):
+		statusCode = http.StatusNotFound
+		code = "not_found"
+		msg = "resource not found"
+	case errors.IsE(err, shared.ErrMethodNotAllowed):
+		statusCode = http.StatusMethodNotAllowed
+		code = "method_not_allowed"
+		msg = "method not allowed"
+	case errors.Is(errors.InvalidForm, err), errors.Is(errors.InvalidArgument, err):
+		statusCode = http.StatusBadRequest
+		code = "invalid_argument"
+		msg = "invalid or missing argument"
+	case errors.Is(errors.AccessDenied, err):
+		statusCode = http.StatusForbidden
+		code = "access_denied"
+		msg = "access denied"
+	case errors.Is(errors.SongCooldown, err):
+		statusCode = http.StatusTooManyRequests
+		code = "song_cooldown"
+		msg = "song is on cooldown"
+	case errors.Is(errors.UserCooldown, err):
+		statusCode = http.StatusTooManyRequests
+		code = "user_cooldown"
+		msg = "you can't request yet"
+	case errors.Is(errors.StreamerNoRequests, err):
+		statusCode = http.StatusServiceUnavailable
+		code = "requests_disabled"
+		msg = "requests are currently disabled"
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("")
+	} else {
+		// only log as an error if it's not something we expect
+		hlog.FromRequest(r).Info().Ctx(r.Context()).Err(err).Msg("")
+	}
+
+	// tell the client what argument was wrong if we know
+	if info, ok := errors.SelectInfo(err); ok && statusCode == http.StatusBadRequest {
+		msg = string(info)
+	}
+
+	resp := ErrorResponse{
+		Error: ErrorJSON{
+			Status:  statusCode,
+			Code:    code,
+			Message: msg,
+		},
+	}
+	if delay, ok := errors.SelectDelay(err); ok {
+		resp.Error.RetryAfter = int64(time.Duration(delay) / time.Second)
+		w.Header().Set("Retry-After", strconv.FormatInt(resp.Error.RetryAfter, 10))
+	}
+	if rid, ok := hlog.IDFromRequest(r); ok {
+		resp.Error.RequestID = rid.String()
+	}
+
+	writeJSON(w, r, statusCode, resp)
+}
+
+// PaginationJSON is the pagination information included in all paginated responses
+type PaginationJSON struct {
+	// Page is the current page number, starting at 1
+	Page int64 `json:"page"`
+	// PerPage is the maximum amount of entries on a single page
+	PerPage int64 `json:"per_page"`
+	// Total is the total amount of entries across all pages
+	Total int64 `json:"total"`
+	// TotalPages is the total amount of pages
+	TotalPages int64 `json:"total_pages"`
+	// Next is the url of the next page, empty if there is none
+	Next string `json:"next,omitempty"`
+	// Prev is the url of the previous page, empty if there is none
+	Prev string `json:"prev,omitempty"`
+}
+
+// newPaginationJSON creates the json pagination information from the page given
+func newPaginationJSON(p *shared.Pagination, perPage, total int64) PaginationJSON {
+	return PaginationJSON{
+		Page:       p.Nr,
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: p.Total,
+		Next:       string(p.Next(1).URL()),
+		Prev:       string(p.Prev(1).URL()),
+	}
+}
+
+// newFromPaginationJSON is like newPaginationJSON but for key based pagination
+func newFromPaginationJSON(p *shared.FromPagination[radio.LastPlayedKey], perPage, total int64) PaginationJSON {
+	return PaginationJSON{
+		Page:       int64(p.Nr),
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: shared.PageCount(total, perPage),
+		Next:       string(p.Next(1).URL()),
+		Prev:       string(p.Prev(1).URL()),
+	}
+}
+
+// SongJSON is a song as returned by the json api
+type SongJSON struct {
+	ID       radio.SongID   `json:"id"`
+	Hash     radio.SongHash `json:"hash"`
+	Metadata string         `json:"metadata"`
+	// Length is the length of the song in seconds
+	Length     int64      `json:"length"`
+	LastPlayed *time.Time `json:"last_played,omitempty"`
+	// Track is only set if the song is in the streamer database
+	Track *TrackJSON `json:"track,omitempty"`
+}
+
+// TrackJSON is a track in the streamer database as returned by the json api
+type TrackJSON struct {
+	ID            radio.TrackID `json:"id"`
+	Artist        string        `json:"artist"`
+	Title         string        `json:"title"`
+	Album         string        `json:"album"`
+	Tags          string        `json:"tags"`
+	LastRequested *time.Time    `json:"last_requested,omitempty"`
+	RequestCount  int           `json:"request_count"`
+	Requestable   bool          `json:"requestable"`
+	// RequestableIn is the amount of seconds until the track can be requested again
+	RequestableIn int64 `json:"requestable_in"`
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func NewSongJSON(s radio.Song) SongJSON {
+	sj := SongJSON{
+		ID:         s.ID,
+		Hash:       s.Hash,
+		Metadata:   s.Metadata,
+		Length:     int64(s.Length / time.Second),
+		LastPlayed: optionalTime(s.LastPlayed),
+	}
+
+	if s.HasTrack() {
+		sj.Track = &TrackJSON{
+			ID:            s.TrackID,
+			Artist:        s.Artist,
+			Title:         s.Title,
+			Album:         s.Album,
+			Tags:          s.Tags,
+			LastRequested: optionalTime(s.LastRequested),
+			RequestCount:  s.RequestCount,
+			Requestable:   s.Requestable(),
+			RequestableIn: int64(s.UntilRequestable() / time.Second),
+		}
+	}
+	return sj
+}
+
+func NewSongsJSON(songs []radio.Song) []SongJSON {
+	res := make([]SongJSON, 0, len(songs))
+	for _, s := range songs {
+		res = append(res, NewSongJSON(s))
+	}
+	return res
+}
+
+// DJJSON is a dj as returned by the json api
+type DJJSON struct {
+	ID       radio.DJID `json:"id"`
+	Name     string     `json:"name"`
+	Text     string     `json:"text"`
+	Role     string     `json:"role"`
+	Color    string     `json:"color"`
+	Priority int        `json:"priority"`
+	// ImageURL is the url of the dj image, empty if the dj has no image
+	ImageURL string `json:"image_url,omitempty"`
+}
+
+func NewDJJSON(dj radio.DJ) DJJSON {
+	dj2 := DJJSON{
+		ID:       dj.ID,
+		Name:     dj.Name,
+		Text:     dj.Text,
+		Role:     dj.Role,
+		Color:    dj.Color,
+		Priority: dj.Priority,
+	}
+	if dj.Image != "" {
+		dj2.ImageURL = "/api/dj-image/" + dj.ID.String() + "-" + dj.Image
+	}
+	return dj2
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/go-chi/chi/v5"
+)
+
+const newsPageSize = 20
+
+// NewsPostJSON is a news post as returned by the json api, the header
+// and body are rendered html
+type NewsPostJSON struct {
+	ID        radio.NewsPostID `json:"id"`
+	Title     string           `json:"title"`
+	Header    string           `json:"header"`
+	Body      string           `json:"body,omitempty"`
+	Author    string           `json:"author"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt *time.Time       `json:"updated_at,omitempty"`
+}
+
+// NewsResponse is the response of GET /v1/news
+type NewsResponse struct {
+	News       []NewsPostJSON `json:"news"`
+	Pagination PaginationJSON `json:"pagination"`
+}
+
+func (a *API) newNewsPostJSON(post radio.NewsPost, withBody bool) (NewsPostJSON, error) {
+	header, err := a.newsCache.RenderHeader(post)
+	if err != nil {
+		return NewsPostJSON{}, err
+	}
+
+	res := NewsPostJSON{
+		ID:        post.ID,
+		Title:     post.Title,
+		Header:    string(header.Output),
+		Author:    post.User.DJ.Name,
+		CreatedAt: post.CreatedAt,
+		UpdatedAt: post.UpdatedAt,
+	}
+	if res.Author == "" {
+		res.Author = post.User.Username
+	}
+
+	if withBody {
+		body, err := a.newsCache.RenderBody(post)
+		if err != nil {
+			return NewsPostJSON{}, err
+		}
+		res.Body = string(body.Output)
+	}
+	return res, nil
+}
+
+func (a *API) GetNews(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetNews"
+
+	page, offset, err := shared.PageAndOffset(r, newsPageSize)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err, errors.Info("invalid page")))
+		return
+	}
+
+	entries, err := a.storage.News(r.Context()).ListPublic(newsPageSize, offset)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	resp := NewsResponse{
+		News: make([]NewsPostJSON, 0, len(entries.Entries)),
+		Pagination: newPaginationJSON(
+			shared.NewPagination(page, shared.PageCount(int64(entries.Total), newsPageSize), r.URL),
+			newsPageSize, int64(entries.Total),
+		),
+	}
+
+	for _, post := range entries.Entries {
+		pj, err := a.newNewsPostJSON(post, false)
+		if err != nil {
+			a.jsonErrorHandler(w, r, errors.E(op, err))
+			return
+		}
+		resp.News = append(resp.News, pj)
+	}
+
+	writeJSON(w, r, http.StatusOK, resp)
+}
+
+func (a *API) GetNewsPost(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetNewsPost"
+
+	id, err := radio.ParseNewsPostID(chi.URLParam(r, "NewsID"))
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err, errors.InvalidForm, errors.Info("invalid news id")))
+		return
+	}
+
+	post, err := a.storage.News(r.Context()).Get(id)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	// deleted and private posts are not public
+	if post.DeletedAt != nil || post.Private {
+		a.jsonErrorHandler(w, r, errors.E(op, errors.NewsUnknown))
+		return
+	}
+
+	pj, err := a.newNewsPostJSON(*post, true)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, pj)
+}
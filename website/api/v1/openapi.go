@@ -0,0 +1,17 @@
+package v1
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPIDocument is the OpenAPI description of the json endpoints, it should be
+// kept in sync with the routes registered in API.Route
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+func (a *API) GetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPIDocument)
+}
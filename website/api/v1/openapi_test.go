@@ -0,0 +1,313 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticStatus radio.Status
+
+func (s staticStatus) Latest() radio.Status {
+	return radio.Status(s)
+}
+
+type openAPIDoc struct {
+	Paths map[string]map[string]struct {
+		OperationID string `json:"operationId"`
+		Parameters  []struct {
+			Name     string `json:"name"`
+			In       string `json:"in"`
+			Required bool   `json:"required"`
+			Example  any    `json:"example"`
+		} `json:"parameters"`
+		Responses map[string]struct {
+			Content map[string]struct {
+				Schema openAPISchema `json:"schema"`
+			} `json:"content"`
+		} `json:"responses"`
+	} `json:"paths"`
+	Components struct {
+		Schemas map[string]openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPISchema struct {
+	Ref      string          `json:"$ref"`
+	AllOf    []openAPISchema `json:"allOf"`
+	Required []string        `json:"required"`
+}
+
+// required returns all required properties of the schema given, resolving
+// any references and allOf compositions
+func (doc openAPIDoc) required(s openAPISchema) []string {
+	if s.Ref != "" {
+		return doc.required(doc.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")])
+	}
+	res := s.Required
+	for _, sub := range s.AllOf {
+		res = append(res, doc.required(sub)...)
+	}
+	return res
+}
+
+func loadOpenAPI(t *testing.T) openAPIDoc {
+	var doc openAPIDoc
+	require.NoError(t, json.Unmarshal(openAPIDocument, &doc))
+	return doc
+}
+
+func newTestJSONAPI(t *testing.T) *API {
+	now := time.Now()
+	track := radio.Song{
+		ID:       10,
+		Metadata: "artist - title",
+		Length:   time.Minute,
+		DatabaseTrack: &radio.DatabaseTrack{
+			TrackID: 1,
+			Artist:  "artist",
+			Title:   "title",
+		},
+	}
+	track.Hydrate()
+	robot := radio.User{
+		Username:        "AFK",
+		UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermRobot),
+	}
+
+	songMock := &mocks.SongStorageMock{
+		LastPlayedFunc: func(key radio.LastPlayedKey, amountPerPage int) ([]radio.Song, error) {
+			return []radio.Song{track}, nil
+		},
+		LastPlayedPaginationFunc: func(key radio.LastPlayedKey, amountPerPage, pageCount int) ([]radio.LastPlayedKey, []radio.LastPlayedKey, error) {
+			return nil, []radio.LastPlayedKey{5}, nil
+		},
+		LastPlayedCountFunc: func() (int64, error) {
+			return 50, nil
+		},
+		PlayedCountFunc: func(song radio.Song) (int64, error) {
+			return 5, nil
+		},
+		FavoriteCountFunc: func(song radio.Song) (int64, error) {
+			return 2, nil
+		},
+		FavoritesOfFunc: func(nick string, limit, offset int64) ([]radio.Song, int64, error) {
+			return []radio.Song{track}, 1, nil
+		},
+	}
+	trackMock := &mocks.TrackStorageMock{
+		GetFunc: func(trackID radio.TrackID) (*radio.Song, error) {
+			return &track, nil
+		},
+	}
+	recommendationMock := &mocks.RecommendationStorageMock{
+		SimilarFunc: func(id radio.TrackID, limit int) ([]radio.SimilarSong, error) {
+			return []radio.SimilarSong{{Song: track, Score: 0.5}}, nil
+		},
+	}
+	newsMock := &mocks.NewsStorageMock{
+		ListPublicFunc: func(limit, offset int64) (radio.NewsList, error) {
+			return radio.NewsList{
+				Entries: []radio.NewsPost{{ID: 1, Title: "title", Header: "header", Body: "body", CreatedAt: now}},
+				Total:   1,
+			}, nil
+		},
+		GetFunc: func(newsPostID radio.NewsPostID) (*radio.NewsPost, error) {
+			return &radio.NewsPost{ID: newsPostID, Title: "title", Header: "header", Body: "body", CreatedAt: now}, nil
+		},
+	}
+	scheduleMock := &mocks.ScheduleStorageMock{
+		LatestFunc: func() ([]*radio.ScheduleEntry, error) {
+			return []*radio.ScheduleEntry{{Weekday: radio.Monday, Text: "hello", Owner: &robot}, nil}, nil
+		},
+	}
+	userMock := &mocks.UserStorageMock{
+		AllFunc: func() ([]radio.User, error) {
+			return []radio.User{robot, {
+				Username:        "dj",
+				DJ:              radio.DJ{ID: 5, Name: "dj", Visible: true},
+				UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+			}}, nil
+		},
+	}
+
+	return &API{
+		Config:    NewConfig(config.TestConfig()),
+		newsCache: shared.NewNewsCache(),
+		status: staticStatus{
+			StreamUser: &robot,
+			User:       robot,
+			Song:       track,
+			SongInfo:   radio.SongInfo{Start: now, End: now.Add(time.Minute)},
+			Listeners:  100,
+		},
+		Search: &mocks.SearchServiceMock{
+			SearchFunc: func(ctx context.Context, query string, opt radio.SearchOptions) (radio.SearchResult, error) {
+				return radio.SearchResult{Songs: []radio.Song{track}, TotalHits: 1}, nil
+			},
+			CompleteFunc: func(ctx context.Context, prefix string, limit int) ([]radio.SearchCompletion, error) {
+				return []radio.SearchCompletion{{Kind: radio.SearchCompletionArtist, Value: "artist", Count: 1}}, nil
+			},
+		},
+		queue: &mocks.QueueServiceMock{
+			EntriesFunc: func(contextMoqParam context.Context) (radio.Queue, error) {
+				return radio.Queue{{Song: track, ExpectedStartTime: now}}, nil
+			},
+		},
+		storage: &mocks.StorageServiceMock{
+			SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
+				return songMock
+			},
+			TrackFunc: func(contextMoqParam context.Context) radio.TrackStorage {
+				return trackMock
+			},
+			NewsFunc: func(contextMoqParam context.Context) radio.NewsStorage {
+				return newsMock
+			},
+			RecommendationFunc: func(contextMoqParam context.Context) radio.RecommendationStorage {
+				return recommendationMock
+			},
+			ScheduleFunc: func(contextMoqParam context.Context) radio.ScheduleStorage {
+				return scheduleMock
+			},
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return userMock
+			},
+		},
+	}
+}
+
+// TestOpenAPIOperations generates a test for every operation in the openapi
+// document and checks that the handler responds with a documented status code
+// and a body containing all required properties
+func TestOpenAPIOperations(t *testing.T) {
+	doc := loadOpenAPI(t)
+	api := newTestJSONAPI(t)
+
+	r := chi.NewRouter()
+	api.Route(r)
+
+	for path, methods := range doc.Paths {
+		for method, op := range methods {
+			t.Run(op.OperationID, func(t *testing.T) {
+				target := path
+				query := url.Values{}
+				for _, param := range op.Parameters {
+					if !param.Required {
+						continue
+					}
+					value := fmt.Sprint(param.Example)
+					switch param.In {
+					case "path":
+						target = strings.ReplaceAll(target, "{"+param.Name+"}", value)
+					case "query":
+						query.Set(param.Name, value)
+					}
+				}
+
+				req := httptest.NewRequest(strings.ToUpper(method), target+"?"+query.Encode(), nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				status := fmt.Sprint(w.Code)
+				resp, ok := op.Responses[status]
+				require.True(t, ok, "undocumented status code %s: %s", status, w.Body.String())
+				require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+				var body map[string]any
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+				for _, prop := range doc.required(resp.Content["application/json"].Schema) {
+					assert.Contains(t, body, prop)
+				}
+			})
+		}
+	}
+}
+
+// TestOpenAPIRoutesDocumented checks that all json routes are in the openapi document
+func TestOpenAPIRoutesDocumented(t *testing.T) {
+	doc := loadOpenAPI(t)
+	api := newTestJSONAPI(t)
+
+	r := chi.NewRouter()
+	api.Route(r)
+
+	// routes that existed before the json api and are not json
+	notJSON := map[string]bool{
+		"/sse":          true,
+		"/search":       true,
+		"/song":         true,
+		"/request":      true,
+		"/openapi.json": true,
+	}
+
+	err := chi.Walk(r, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if notJSON[route] {
+			return nil
+		}
+		// strip the regex part of route parameters
+		for {
+			start := strings.Index(route, ":")
+			if start == -1 {
+				break
+			}
+			end := strings.Index(route[start:], "}")
+			route = route[:start] + route[start+end:]
+		}
+
+		_, ok := doc.Paths[route][strings.ToLower(method)]
+		assert.True(t, ok, "route %s %s is not documented", method, route)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestJSONErrors(t *testing.T) {
+	api := newTestJSONAPI(t)
+
+	r := chi.NewRouter()
+	api.Route(r)
+
+	cases := []struct {
+		name   string
+		target string
+		status int
+		code   string
+	}{
+		{"missing query", "/tracks/search", http.StatusBadRequest, "invalid_argument"},
+		{"missing prefix", "/tracks/complete", http.StatusBadRequest, "invalid_argument"},
+		{"invalid page", "/news?page=abc", http.StatusBadRequest, "invalid_argument"},
+		{"invalid from", "/lastplayed?from=abc", http.StatusBadRequest, "invalid_argument"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, c.target, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, c.status, w.Code)
+
+			var resp ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, c.status, resp.Error.Status)
+			assert.Equal(t, c.code, resp.Error.Code)
+			assert.NotEmpty(t, resp.Error.Message)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/templates"
 	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/website/middleware"
 	"github.com/R-a-dio/valkyrie/website/public"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/hlog"
@@ -134,5 +135,14 @@ func (a *API) postRequest(r *http.Request) error {
 		return errors.E(op, err)
 	}
 
+	// add it to the request history if they're logged in as a listener
+	if listener := middleware.ListenerFromContext(ctx); listener != nil {
+		err = a.storage.ListenerAccount(ctx).AddRequest(listener.ID, song.TrackID)
+		if err != nil {
+			// the request itself went through, so don't fail on this
+			zerolog.Ctx(ctx).Error().Ctx(ctx).Err(errors.E(op, err)).Msg("failed to add listener request")
+		}
+	}
+
 	return nil
 }
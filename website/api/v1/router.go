@@ -12,6 +12,7 @@ import (
 	"github.com/R-a-dio/valkyrie/search"
 	"github.com/R-a-dio/valkyrie/storage"
 	"github.com/R-a-dio/valkyrie/templates"
+	"github.com/R-a-dio/valkyrie/util"
 	"github.com/R-a-dio/valkyrie/util/secret"
 	"github.com/R-a-dio/valkyrie/website/shared"
 	"github.com/go-chi/chi/v5"
@@ -22,7 +23,9 @@ import (
 func NewAPI(ctx context.Context, cfg config.Config,
 	templates templates.Executor,
 	fs afero.Fs,
-	songSecret secret.Secret) (*API, error) {
+	songSecret secret.Secret,
+	newsCache *shared.NewsCache,
+	statusValue util.StreamValuer[radio.Status]) (*API, error) {
 	sg, err := storage.Open(ctx, cfg)
 	if err != nil {
 		return nil, err
@@ -44,6 +47,8 @@ func NewAPI(ctx context.Context, cfg config.Config,
 		storage:    sg,
 		songSecret: songSecret,
 		fs:         fs,
+		newsCache:  newsCache,
+		status:     statusValue,
 	}
 
 	// start up status updates
@@ -80,6 +85,10 @@ type API struct {
 	storage    radio.StorageService
 	songSecret secret.Secret
 	fs         afero.Fs
+	newsCache  *shared.NewsCache
+	status     util.StreamValuer[radio.Status]
+
+	completions completeCache
 }
 
 func (a *API) Route(r chi.Router) {
@@ -104,6 +113,20 @@ func (a *API) Route(r chi.Router) {
 	r.Get("/search", a.SearchHTML)
 	r.Get("/song", a.GetSong)
 	r.Post("/request", a.PostRequest)
+
+	// json api
+	r.Get("/openapi.json", a.GetOpenAPI)
+	r.Get("/status", a.GetStatus)
+	r.Get("/queue", a.GetQueue)
+	r.Get("/lastplayed", a.GetLastPlayed)
+	r.Get("/schedule", a.GetSchedule)
+	r.Get("/news", a.GetNews)
+	r.Get("/news/{NewsID:[0-9]+}", a.GetNewsPost)
+	r.Get("/djs", a.GetDJs)
+	r.Get("/tracks/search", a.GetSearch)
+	r.Get("/tracks/complete", a.GetComplete)
+	r.Get("/tracks/{TrackID:[0-9]+}", a.GetTrack)
+	r.Get("/favorites/{Nick}", a.GetFavorites)
 }
 
 func (a *API) Shutdown() error {
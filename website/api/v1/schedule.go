@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// ScheduleEntryJSON is a single day of the schedule as returned by the json api
+type ScheduleEntryJSON struct {
+	Day  string `json:"day"`
+	Text string `json:"text"`
+	// Owner is the dj that owns this day, nil if nobody does
+	Owner     *DJJSON   `json:"owner"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ScheduleResponse is the response of GET /v1/schedule
+type ScheduleResponse struct {
+	Schedule []ScheduleEntryJSON `json:"schedule"`
+}
+
+func (a *API) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetSchedule"
+
+	schedule, err := a.storage.Schedule(r.Context()).Latest()
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	resp := ScheduleResponse{
+		Schedule: make([]ScheduleEntryJSON, 0, len(schedule)),
+	}
+	for _, entry := range schedule {
+		if entry == nil {
+			continue
+		}
+
+		ej := ScheduleEntryJSON{
+			Day:       entry.Weekday.String(),
+			Text:      entry.Text,
+			UpdatedAt: entry.UpdatedAt,
+		}
+		if entry.Owner != nil {
+			dj := NewDJJSON(entry.Owner.DJ)
+			ej.Owner = &dj
+		}
+		resp.Schedule = append(resp.Schedule, ej)
+	}
+
+	writeJSON(w, r, http.StatusOK, resp)
+}
+
+// DJsResponse is the response of GET /v1/djs
+type DJsResponse struct {
+	DJs []DJJSON `json:"djs"`
+}
+
+func (a *API) GetDJs(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetDJs"
+
+	users, err := a.storage.User(r.Context()).All()
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	resp := DJsResponse{
+		DJs: []DJJSON{},
+	}
+	for _, user := range users {
+		// only show active users that have a visible dj
+		if !user.UserPermissions.Has(radio.PermActive) || user.DJ.ID == 0 || !user.DJ.Visible {
+			continue
+		}
+		resp.DJs = append(resp.DJs, NewDJJSON(user.DJ))
+	}
+	slices.SortStableFunc(resp.DJs, func(a, b DJJSON) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+
+	writeJSON(w, r, http.StatusOK, resp)
+}
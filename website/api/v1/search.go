@@ -25,8 +25,8 @@ func (a *API) SearchHTML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// return an empty response if the query is empty
-	if len(input.Query) == 0 {
+	// return an empty response if the query and filter are empty
+	if len(input.Query) == 0 && input.Filter.IsZero() {
 		w.WriteHeader(http.StatusOK)
 		return
 	}
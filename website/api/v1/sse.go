@@ -2,9 +2,12 @@ package v1
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"io"
 	"maps"
 	"net/http"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -15,6 +18,7 @@ import (
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/templates"
 	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/util/lrc"
 	"github.com/R-a-dio/valkyrie/util/pool"
 	"github.com/R-a-dio/valkyrie/util/sse"
 	"github.com/rs/zerolog"
@@ -63,6 +67,9 @@ func (a *API) runStatusUpdates(ctx context.Context) {
 
 			log.Debug().Ctx(ctx).Str("event", EventMetadata).Any("value", status).Msg("sending")
 			a.sse.SendNowPlaying(ctx, status)
+			// lyrics go out right after so that they always match the song
+			// in the metadata event before them
+			a.sendLyrics(ctx, status)
 			go a.sendQueue(ctx, status.StreamUser)
 			go a.sendLastPlayed(ctx)
 		}
@@ -105,6 +112,29 @@ func (a *API) sendLastPlayed(ctx context.Context) {
 	a.sse.SendLastPlayed(ctx, lp)
 }
 
+func (a *API) sendLyrics(ctx context.Context, status radio.Status) {
+	lyrics := Lyrics{
+		Start: status.SongInfo.Start,
+	}
+
+	// always send lyrics even if there are none, otherwise the previous
+	// song its lyrics would stay up
+	if status.Song.HasTrack() {
+		tl, err := a.storage.Lyrics(ctx).Get(status.Song.TrackID)
+		if err != nil && !errors.Is(errors.LyricsUnknown, err) {
+			zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("sse", "lyrics").Msg("failed to retrieve lyrics")
+		}
+		if tl != nil {
+			lyrics.TrackID = tl.TrackID
+			lyrics.Lines = lrc.Parse(tl.Lyrics)
+			lyrics.Notes = tl.Notes
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().Ctx(ctx).Str("event", EventLyrics).Any("value", lyrics).Msg("sending")
+	a.sse.SendLyrics(ctx, lyrics)
+}
+
 const (
 	SUBSCRIBE = "subscribe"
 	SEND      = "send"
@@ -121,8 +151,13 @@ const (
 	EventQueue      = "queue"
 	EventLastPlayed = "lastplayed"
 	EventThread     = "thread"
+	EventLyrics     = "lyrics"
 )
 
+// SSE_HISTORY_SIZE is the amount of events kept for clients that reconnect
+// with a Last-Event-ID
+const SSE_HISTORY_SIZE = 64
+
 type EventName = string
 
 type Stream struct {
@@ -155,15 +190,19 @@ func NewStream(ctx context.Context, exec templates.Executor) *Stream {
 }
 
 // ServeHTTP implements http.Handler where each client gets send all SSE events that
-// occur after connecting. There is no history.
+// occur after connecting. Clients that reconnect with a Last-Event-ID get send the
+// events they missed if we still have them, otherwise the latest of each event.
 func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := hlog.FromRequest(r)
 	controller := http.NewResponseController(w)
 	theme := templates.GetTheme(r.Context()).Name
 
-	log.Debug().Ctx(ctx).Msg("subscribing")
-	ch := s.sub()
+	// invalid or missing ids are treated as a new client
+	lastEventID, _ := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	log.Debug().Ctx(ctx).Uint64("last_event_id", lastEventID).Msg("subscribing")
+	ch, missed := s.sub(lastEventID)
 	defer func() {
 		log.Debug().Ctx(ctx).Msg("leave")
 		s.leave(ch)
@@ -176,31 +215,19 @@ func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
 	_, _ = w.Write(sse.Event{Name: string(EventTime), Data: []byte(now)}.Encode())
 
-	// send events that have already happened, one for each event so that
-	// we're certain the page is current
-	log.Debug().Ctx(ctx).Msg("init")
-	s.mu.RLock()
-	init := maps.Clone(s.last)
-	s.mu.RUnlock()
-
-	for _, m := range init {
-		if m.genFn == nil {
-			continue
-		}
-
-		data, err := m.genFn(r)
-		if err != nil {
-			log.Error().Ctx(ctx).Err(err).Msg("sse init generator error")
-			continue
-		}
-
-		log.Debug().Ctx(ctx).Bytes("value", data).Msg("send")
-		if _, err := w.Write(data); err != nil {
-			if !errors.IsE(err, syscall.EPIPE) {
-				log.Error().Ctx(ctx).Err(err).Msg("sse client write error")
+	if missed != nil {
+		// we know the client, so only send what they missed
+		log.Debug().Ctx(ctx).Int("missed", len(missed)).Msg("resume")
+		for _, m := range missed {
+			if err := m.write(w, m.encoded[theme]); err != nil {
+				if !errors.IsE(err, syscall.EPIPE) {
+					log.Error().Ctx(ctx).Err(err).Msg("sse client write error")
+				}
+				return
 			}
-			return
 		}
+	} else if !s.writeInit(w, r) {
+		return
 	}
 
 	if err := controller.Flush(); err != nil {
@@ -212,7 +239,7 @@ func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Debug().Ctx(ctx).Msg("start")
 	for m := range ch {
 		log.Debug().Ctx(ctx).Bytes("value", m.encoded[theme]).Msg("send")
-		if _, err := w.Write(m.encoded[theme]); err != nil {
+		if err := m.write(w, m.encoded[theme]); err != nil {
 			if !errors.IsE(err, syscall.EPIPE) {
 				log.Error().Ctx(ctx).Err(err).Msg("sse client write error")
 			}
@@ -225,6 +252,44 @@ func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// writeInit sends events that have already happened, one for each event so that
+// we're certain the page is current, it returns false if the client is gone
+func (s *Stream) writeInit(w http.ResponseWriter, r *http.Request) bool {
+	ctx := r.Context()
+	log := hlog.FromRequest(r)
+
+	log.Debug().Ctx(ctx).Msg("init")
+	s.mu.RLock()
+	init := slices.Collect(maps.Values(s.last))
+	s.mu.RUnlock()
+
+	// send them in order so that the client ends up with the latest id
+	slices.SortFunc(init, func(a, b message) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	for _, m := range init {
+		if m.genFn == nil {
+			continue
+		}
+
+		data, err := m.genFn(r)
+		if err != nil {
+			log.Error().Ctx(ctx).Err(err).Msg("sse init generator error")
+			continue
+		}
+
+		log.Debug().Ctx(ctx).Bytes("value", data).Msg("send")
+		if err := m.write(w, data); err != nil {
+			if !errors.IsE(err, syscall.EPIPE) {
+				log.Error().Ctx(ctx).Err(err).Msg("sse client write error")
+			}
+			return false
+		}
+	}
+	return true
+}
+
 // SendEvent sends an SSE event with the data given.
 func (s *Stream) SendEvent(event EventName, m message) {
 	select {
@@ -236,9 +301,15 @@ func (s *Stream) SendEvent(event EventName, m message) {
 func (s *Stream) run() {
 	subs := make([]chan message, 0, 128)
 
+	// seq is the id of the last event, it starts at the current time so that
+	// ids from before a restart aren't mistaken for new ones
+	seq := uint64(time.Now().UnixNano())
+	history := make([]message, 0, SSE_HISTORY_SIZE)
+
 	for req := range s.reqs {
 		switch req.cmd {
 		case SUBSCRIBE:
+			req.missed <- missedMessages(history, req.after)
 			subs = append(subs, req.ch)
 		case LEAVE:
 			for i, ch := range subs {
@@ -251,6 +322,13 @@ func (s *Stream) run() {
 				}
 			}
 		case SEND:
+			seq++
+			req.m.id = seq
+			if len(history) == SSE_HISTORY_SIZE {
+				history = append(history[:0], history[1:]...)
+			}
+			history = append(history, req.m)
+
 			s.mu.Lock()
 			s.last[req.e] = req.m
 			s.mu.Unlock()
@@ -271,16 +349,33 @@ func (s *Stream) run() {
 	}
 }
 
+// missedMessages returns the messages in history that came after the id
+// given, it returns nil if the id is unknown or zero
+func missedMessages(history []message, after uint64) []message {
+	if after == 0 || len(history) == 0 {
+		return nil
+	}
+	first, last := history[0].id, history[len(history)-1].id
+	if after+1 < first || after > last {
+		return nil
+	}
+	// clone so the caller doesn't share the backing array with history
+	return slices.Clone(history[after+1-first:])
+}
+
 // sub subscribes to the event stream and returns a channel that
-// will receive all messages
-func (s *Stream) sub() chan message {
+// will receive all messages, and the messages that happened after
+// the id given; this is nil if the id is unknown
+func (s *Stream) sub(after uint64) (chan message, []message) {
 	ch := make(chan message, 2)
+	missed := make(chan []message, 1)
 	select {
-	case s.reqs <- request{cmd: SUBSCRIBE, ch: ch}:
+	case s.reqs <- request{cmd: SUBSCRIBE, ch: ch, after: after, missed: missed}:
+		return ch, <-missed
 	case <-s.shutdownCh:
 		close(ch)
 	}
-	return ch
+	return ch, nil
 }
 
 // leave sends a LEAVE command for the channel given, the channel
@@ -377,6 +472,10 @@ func (s *Stream) SendNowPlaying(ctx context.Context, data radio.Status) {
 	s.SendEvent(EventMetadata, s.NewMessage(ctx, EventMetadata, NowPlaying(data)))
 }
 
+func (s *Stream) SendLyrics(ctx context.Context, data Lyrics) {
+	s.SendEvent(EventLyrics, s.NewMessage(ctx, EventLyrics, data))
+}
+
 func (s *Stream) SendLastPlayed(ctx context.Context, data []radio.Song) {
 	s.SendEvent(EventLastPlayed, s.NewMessage(ctx, EventLastPlayed, LastPlayed(data)))
 }
@@ -391,19 +490,39 @@ func (s *Stream) SendListeners(ctx context.Context, data radio.Listeners) {
 
 // request send over the management channel
 type request struct {
-	cmd string       // required
-	ch  chan message // SUB/LEAVE only
-	m   message      // SEND only
-	e   EventName    // SEND only
+	cmd    string         // required
+	ch     chan message   // SUB/LEAVE only
+	after  uint64         // SUB only
+	missed chan []message // SUB only
+	m      message        // SEND only
+	e      EventName      // SEND only
 }
 
 type messageGen func(r *http.Request) ([]byte, error)
 
 type message struct {
+	id      uint64
 	encoded map[radio.ThemeName][]byte
 	genFn   messageGen
 }
 
+// write writes the encoded event given to w with the id of m added to it
+func (m message) write(w io.Writer, encoded []byte) error {
+	if m.id == 0 || len(encoded) == 0 {
+		_, err := w.Write(encoded)
+		return err
+	}
+
+	// an id line directly in front of the encoded event belongs to the same event
+	buf := make([]byte, 0, len(encoded)+24)
+	buf = append(buf, "id: "...)
+	buf = strconv.AppendUint(buf, m.id, 10)
+	buf = append(buf, '\n')
+	buf = append(buf, encoded...)
+	_, err := w.Write(buf)
+	return err
+}
+
 // NowPlaying is for what is currently playing on the home page
 type NowPlaying radio.Status
 
@@ -415,6 +534,37 @@ func (NowPlaying) TemplateBundle() string {
 	return "home"
 }
 
+// Lyrics is for the lyrics panel on the home page
+type Lyrics struct {
+	// TrackID is the track the lyrics belong to, zero if there are none
+	TrackID radio.TrackID
+	// Start is when the song started playing, the time of each line is
+	// relative to it
+	Start time.Time
+	// Lines are the synced lyrics of the song
+	Lines []lrc.Line
+	// Notes are freeform notes about the song
+	Notes string
+}
+
+func (Lyrics) TemplateName() string {
+	return "lyrics"
+}
+
+func (Lyrics) TemplateBundle() string {
+	return "home"
+}
+
+// LineTime returns the time the line given starts playing
+func (l Lyrics) LineTime(line lrc.Line) time.Time {
+	return l.Start.Add(line.At)
+}
+
+// IsEmpty returns true if there are no lyrics and no notes
+func (l Lyrics) IsEmpty() bool {
+	return len(l.Lines) == 0 && l.Notes == ""
+}
+
 type Thread radio.Thread
 
 func (Thread) TemplateName() string {
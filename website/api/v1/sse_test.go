@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"io"
@@ -8,11 +9,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/mocks"
 	"github.com/R-a-dio/valkyrie/templates"
 	"github.com/leanovate/gopter"
@@ -152,6 +156,11 @@ func TestStreamSendInputs(t *testing.T) {
 		assert.Equal(t, "nowplaying", name)
 	})
 
+	t.Run("SendLyrics", func(t *testing.T) {
+		stream.SendLyrics(ctx, Lyrics{})
+		assert.Equal(t, "lyrics", name)
+	})
+
 	t.Run("SendQueue", func(t *testing.T) {
 		stream.SendQueue(ctx, []radio.QueueEntry{})
 		assert.Equal(t, "queue", name)
@@ -186,3 +195,154 @@ func TestStreamSlowSub(t *testing.T) {
 		stream.ServeHTTP(w, req)
 	}()
 }
+
+// readEvent reads a single event from an SSE stream and returns its id and data
+func readEvent(t *testing.T, r *bufio.Reader) (id, name, data string) {
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return id, name, data
+		}
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			id = value
+		case "event":
+			name = value
+		case "data":
+			data += value
+		}
+	}
+}
+
+func TestStreamResume(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ctx = zerolog.New(zerolog.NewTestWriter(t)).WithContext(ctx)
+
+	exec := &mocks.ExecutorMock{
+		ExecuteAllFunc: func(ctx context.Context, input templates.TemplateSelectable) (map[radio.ThemeName][]byte, error) {
+			return map[radio.ThemeName][]byte{
+				"json": []byte(input.(Thread)),
+			}, nil
+		},
+		ExecuteFunc: func(w io.Writer, r *http.Request, input templates.TemplateSelectable) error {
+			_, err := w.Write([]byte(input.(Thread)))
+			return err
+		},
+	}
+
+	stream := NewStream(ctx, exec)
+	server := httptest.NewUnstartedServer(stream)
+	server.Config.BaseContext = func(l net.Listener) context.Context { return ctx }
+	server.Config.ConnContext = func(ctx context.Context, c net.Conn) context.Context {
+		return templates.SetTheme(ctx, "json", false, false)
+	}
+	server.Start()
+	defer server.Close()
+	// shutdown the stream first so the handlers return
+	defer stream.Shutdown()
+
+	connect := func(lastEventID string) *bufio.Reader {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+
+		r := bufio.NewReader(resp.Body)
+		_, name, _ := readEvent(t, r)
+		require.Equal(t, EventTime, name)
+		return r
+	}
+
+	stream.SendThread(ctx, "a")
+	stream.SendThread(ctx, "b")
+	stream.SendThread(ctx, "c")
+
+	// a new client only gets the latest thread
+	id, name, data := readEvent(t, connect(""))
+	assert.Equal(t, EventThread, name)
+	assert.Equal(t, "c", data)
+	latest, err := strconv.ParseUint(id, 10, 64)
+	require.NoError(t, err)
+
+	// a client that has seen "a" should get everything after it
+	r := connect(strconv.FormatUint(latest-2, 10))
+	id, _, data = readEvent(t, r)
+	assert.Equal(t, strconv.FormatUint(latest-1, 10), id)
+	assert.Equal(t, "b", data)
+	id, _, data = readEvent(t, r)
+	assert.Equal(t, strconv.FormatUint(latest, 10), id)
+	assert.Equal(t, "c", data)
+
+	// and new events after that with their id
+	stream.SendThread(ctx, "d")
+	id, _, data = readEvent(t, r)
+	assert.Equal(t, strconv.FormatUint(latest+1, 10), id)
+	assert.Equal(t, "d", data)
+
+	// an unknown id is treated like a new client
+	id, _, data = readEvent(t, connect("12345"))
+	assert.Equal(t, strconv.FormatUint(latest+1, 10), id)
+	assert.Equal(t, "d", data)
+}
+
+func TestSendLyrics(t *testing.T) {
+	var lyrics Lyrics
+	exec := &mocks.ExecutorMock{
+		ExecuteAllFunc: func(ctx context.Context, input templates.TemplateSelectable) (map[radio.ThemeName][]byte, error) {
+			if l, ok := input.(Lyrics); ok {
+				lyrics = l
+			}
+			return nil, nil
+		},
+	}
+
+	ctx := context.Background()
+	stream := NewStream(ctx, exec)
+	defer stream.Shutdown()
+
+	a := &API{
+		sse: stream,
+		storage: &mocks.StorageServiceMock{
+			LyricsFunc: func(contextMoqParam context.Context) radio.LyricsStorage {
+				return &mocks.LyricsStorageMock{
+					GetFunc: func(trackID radio.TrackID) (*radio.TrackLyrics, error) {
+						if trackID != 10 {
+							return nil, errors.E(errors.LyricsUnknown)
+						}
+						return &radio.TrackLyrics{
+							TrackID: trackID,
+							Lyrics:  "[00:01.00]first\n[00:04.50]second",
+							Notes:   "notes",
+						}, nil
+					},
+				}
+			},
+		},
+	}
+
+	start := time.Date(2000, 10, 9, 8, 7, 6, 0, time.UTC)
+	status := radio.Status{
+		Song:     radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 10}},
+		SongInfo: radio.SongInfo{Start: start},
+	}
+
+	a.sendLyrics(ctx, status)
+	require.Len(t, lyrics.Lines, 2)
+	assert.Equal(t, "notes", lyrics.Notes)
+	assert.Equal(t, start, lyrics.Start)
+	assert.Equal(t, start.Add(time.Second*4+time.Millisecond*500), lyrics.LineTime(lyrics.Lines[1]))
+
+	// a song without lyrics should clear them
+	status.Song.TrackID = 20
+	a.sendLyrics(ctx, status)
+	assert.True(t, lyrics.IsEmpty())
+	assert.Equal(t, start, lyrics.Start)
+}
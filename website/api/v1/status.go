@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/shared"
+)
+
+const lastPlayedPageSize = 20
+
+// StatusResponse is the response of GET /v1/status
+type StatusResponse struct {
+	Song      SongJSON   `json:"song"`
+	Start     *time.Time `json:"start,omitempty"`
+	End       *time.Time `json:"end,omitempty"`
+	Listeners int64      `json:"listeners"`
+	DJ        DJJSON     `json:"dj"`
+	Thread    string     `json:"thread"`
+	// RequestsEnabled indicates if the automated streamer is on and taking requests
+	RequestsEnabled bool `json:"requests_enabled"`
+}
+
+func (a *API) GetStatus(w http.ResponseWriter, r *http.Request) {
+	status := a.status.Latest()
+
+	writeJSON(w, r, http.StatusOK, StatusResponse{
+		Song:            NewSongJSON(status.Song),
+		Start:           optionalTime(status.SongInfo.Start),
+		End:             optionalTime(status.SongInfo.End),
+		Listeners:       status.Listeners,
+		DJ:              NewDJJSON(status.User.DJ),
+		Thread:          status.Thread,
+		RequestsEnabled: status.StreamUser != nil && radio.IsRobot(*status.StreamUser),
+	})
+}
+
+// QueueResponse is the response of GET /v1/queue
+type QueueResponse struct {
+	Entries []QueueEntryJSON `json:"entries"`
+}
+
+type QueueEntryJSON struct {
+	SongJSON
+	IsRequest         bool      `json:"is_request"`
+	ExpectedStartTime time.Time `json:"expected_start_time"`
+}
+
+func (a *API) GetQueue(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetQueue"
+
+	resp := QueueResponse{
+		Entries: []QueueEntryJSON{},
+	}
+
+	// the queue is only relevant if the automated streamer is the one streaming
+	status := a.status.Latest()
+	if status.StreamUser == nil || !radio.IsRobot(*status.StreamUser) {
+		writeJSON(w, r, http.StatusOK, resp)
+		return
+	}
+
+	queue, err := a.queue.Entries(r.Context())
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	for _, entry := range queue {
+		resp.Entries = append(resp.Entries, QueueEntryJSON{
+			SongJSON:          NewSongJSON(entry.Song),
+			IsRequest:         entry.IsUserRequest,
+			ExpectedStartTime: entry.ExpectedStartTime,
+		})
+	}
+
+	writeJSON(w, r, http.StatusOK, resp)
+}
+
+// LastPlayedResponse is the response of GET /v1/lastplayed
+type LastPlayedResponse struct {
+	Songs      []SongJSON     `json:"songs"`
+	Pagination PaginationJSON `json:"pagination"`
+}
+
+func (a *API) GetLastPlayed(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetLastPlayed"
+
+	key, page, err := lastPlayedKeyAndPage(r)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	ss := a.storage.Song(r.Context())
+	songs, err := ss.LastPlayed(key, lastPlayedPageSize)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	prev, next, err := ss.LastPlayedPagination(key, lastPlayedPageSize, 1)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	total, err := ss.LastPlayedCount()
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	pagination := shared.NewFromPagination(key, prev, next, r.URL).WithPage(page)
+
+	writeJSON(w, r, http.StatusOK, LastPlayedResponse{
+		Songs:      NewSongsJSON(songs),
+		Pagination: newFromPaginationJSON(pagination, lastPlayedPageSize, total),
+	})
+}
+
+// lastPlayedKeyAndPage parses the from and page form values
+func lastPlayedKeyAndPage(r *http.Request) (radio.LastPlayedKey, int, error) {
+	var key = radio.LPKeyLast
+
+	page, _, err := shared.PageAndOffset(r, lastPlayedPageSize)
+	if err != nil {
+		return key, 0, errors.E(err, errors.Info("invalid page"))
+	}
+
+	if rawFrom := r.FormValue("from"); rawFrom != "" {
+		from, err := strconv.ParseUint(rawFrom, 10, 32)
+		if err != nil {
+			return key, 0, errors.E(err, errors.InvalidForm, errors.Info("invalid from"))
+		}
+		key = radio.LastPlayedKey(from)
+	}
+
+	return key, int(page), nil
+}
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"net/http"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	searchJSONPageSize = 20
+	favoritesPageSize  = 100
+	similarTracksLimit = 10
+)
+
+// TrackResponse is the response of GET /v1/tracks/{TrackID}
+type TrackResponse struct {
+	SongJSON
+	PlayCount     int64 `json:"play_count"`
+	FavoriteCount int64 `json:"favorite_count"`
+	// Similar are the tracks that listeners who like this track also like
+	Similar []SimilarSongJSON `json:"similar"`
+}
+
+type SimilarSongJSON struct {
+	SongJSON
+	Score float64 `json:"score"`
+}
+
+func (a *API) GetTrack(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetTrack"
+	ctx := r.Context()
+
+	tid, err := radio.ParseTrackID(chi.URLParam(r, "TrackID"))
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err, errors.InvalidForm, errors.Info("invalid track id")))
+		return
+	}
+
+	song, err := a.storage.Track(ctx).Get(tid)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	ss := a.storage.Song(ctx)
+	playCount, err := ss.PlayedCount(*song)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+	faveCount, err := ss.FavoriteCount(*song)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+	similar, err := a.storage.Recommendation(ctx).Similar(tid, similarTracksLimit)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	similarJSON := make([]SimilarSongJSON, len(similar))
+	for i, s := range similar {
+		similarJSON[i] = SimilarSongJSON{
+			SongJSON: NewSongJSON(s.Song),
+			Score:    s.Score,
+		}
+	}
+
+	writeJSON(w, r, http.StatusOK, TrackResponse{
+		SongJSON:      NewSongJSON(*song),
+		PlayCount:     playCount,
+		FavoriteCount: faveCount,
+		Similar:       similarJSON,
+	})
+}
+
+// SearchResponse is the response of GET /v1/tracks/search
+type SearchResponse struct {
+	Query      string         `json:"query"`
+	Songs      []SongJSON     `json:"songs"`
+	Pagination PaginationJSON `json:"pagination"`
+}
+
+func (a *API) GetSearch(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetSearch"
+
+	query := r.FormValue("q")
+	if query == "" {
+		a.jsonErrorHandler(w, r, errors.E(op, errors.InvalidForm, errors.Info("missing q")))
+		return
+	}
+
+	page, offset, err := shared.PageAndOffset(r, searchJSONPageSize)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err, errors.Info("invalid page")))
+		return
+	}
+
+	result, err := a.Search.Search(r.Context(), query, radio.SearchOptions{
+		Limit:  searchJSONPageSize,
+		Offset: offset,
+	})
+	if err != nil && !errors.Is(errors.SearchNoResults, err) {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	total := int64(result.TotalHits)
+	writeJSON(w, r, http.StatusOK, SearchResponse{
+		Query: query,
+		Songs: NewSongsJSON(result.Songs),
+		Pagination: newPaginationJSON(
+			shared.NewPagination(page, shared.PageCount(total, searchJSONPageSize), r.URL),
+			searchJSONPageSize, total,
+		),
+	})
+}
+
+// FavoritesResponse is the response of GET /v1/favorites/{Nick}
+type FavoritesResponse struct {
+	Nick       string         `json:"nick"`
+	Songs      []SongJSON     `json:"songs"`
+	Pagination PaginationJSON `json:"pagination"`
+}
+
+func (a *API) GetFavorites(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/api/v1/API.GetFavorites"
+
+	nick := chi.URLParam(r, "Nick")
+
+	page, _, err := shared.PageAndOffset(r, favoritesPageSize)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err, errors.Info("invalid page")))
+		return
+	}
+
+	faves, total, err := a.storage.Song(r.Context()).FavoritesOf(nick, favoritesPageSize, page)
+	if err != nil {
+		a.jsonErrorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, FavoritesResponse{
+		Nick:  nick,
+		Songs: NewSongsJSON(faves),
+		Pagination: newPaginationJSON(
+			shared.NewPagination(page, shared.PageCount(total, favoritesPageSize), r.URL),
+			favoritesPageSize, total,
+		),
+	})
+}
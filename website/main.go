@@ -125,6 +125,9 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	}
 	// cache for news posts
 	newsCache := shared.NewNewsCache()
+	// background checks of submissions
+	checker := public.NewSubmissionChecker(storage)
+	go checker.Run(ctx)
 
 	r := NewRouter()
 
@@ -144,8 +147,10 @@ func Execute(ctx context.Context, cfg config.Config) error {
 	sessionManager := vmiddleware.NewSessionManager(ctx, storage, !cfg.Conf().DevelopmentMode)
 	r.Use(sessionManager.LoadAndSave)
 	// user handling
-	authentication := vmiddleware.NewAuthentication(storage, executor, sessionManager)
+	authentication := vmiddleware.NewAuthentication(cfg, storage, executor, sessionManager)
 	r.Use(authentication.UserMiddleware)
+	// listener account handling
+	r.Use(vmiddleware.ListenerMiddleware(storage, sessionManager))
 	// CSRF token handling
 	// fixes a compatibility issue with the PHP api, see middleware documentation
 	r.Use(phpapi.MoveTokenToHeaderForRequests)
@@ -209,7 +214,7 @@ func Execute(ctx context.Context, cfg config.Config) error {
 
 	// version 1 of the api
 	logger.Info().Ctx(ctx).Str("event", "init").Str("part", "api_v1").Msg("")
-	v1, err := v1.NewAPI(ctx, cfg, executor, afero.NewReadOnlyFs(afero.NewOsFs()), songSecret)
+	v1, err := v1.NewAPI(ctx, cfg, executor, afero.NewReadOnlyFs(afero.NewOsFs()), songSecret, newsCache, statusValue)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -249,6 +254,8 @@ func Execute(ctx context.Context, cfg config.Config) error {
 		executor,
 		storage,
 		searchService,
+		sessionManager,
+		checker,
 	)))
 
 	// setup the http server
@@ -4,13 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"html/template"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/templates"
+	"github.com/R-a-dio/valkyrie/util/totp"
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/httprate"
@@ -26,20 +30,39 @@ const (
 	usernameKey           = "admin-username"
 	failedLoginKey        = "admin-failed-login"
 	failedLoginMessageKey = "admin-failed-login-message"
+	twoFactorUsernameKey  = "admin-twofactor-username"
+	twoFactorTimeKey      = "admin-twofactor-time"
 )
 
 const MAX_USERNAME_LENGTH = 50
 
+// twoFactorTimeout is how long a user has to enter their two-factor code
+// after entering their password
+const twoFactorTimeout = 5 * time.Minute
+
 type userContextKey struct{}
 
-func NewAuthentication(storage radio.StorageService, tmpl templates.Executor, sessions *scs.SessionManager) Authentication {
+func NewAuthentication(cfg config.Config, storage radio.StorageService, tmpl templates.Executor, sessions *scs.SessionManager) Authentication {
 	return &authentication{
-		storage:   storage,
-		sessions:  sessions,
-		templates: tmpl,
+		storage:              storage,
+		sessions:             sessions,
+		templates:            tmpl,
+		twoFactorPermissions: twoFactorPermissions(cfg),
 	}
 }
 
+// twoFactorPermissions returns the configured permissions that require
+// two-factor authentication
+func twoFactorPermissions(cfg config.Config) func() []radio.UserPermission {
+	return config.Value(cfg, func(cfg config.Config) []radio.UserPermission {
+		perms := make([]radio.UserPermission, 0, len(cfg.Conf().Website.TwoFactorPermissions))
+		for _, perm := range cfg.Conf().Website.TwoFactorPermissions {
+			perms = append(perms, radio.UserPermission(perm))
+		}
+		return perms
+	})
+}
+
 type Authentication interface {
 	// UserMiddleware is a middleware that adds the current user to the request
 	// context if available. Retrievable by using UserFromContext.
@@ -54,12 +77,18 @@ type Authentication interface {
 	PostLogin(http.ResponseWriter, *http.Request)
 	// LogoutHandler logs the user out if they are currently logged in.
 	LogoutHandler(http.ResponseWriter, *http.Request)
+	// StartSession logs the session of the request in as the user given, the
+	// caller should have verified the user some other way than a password.
+	StartSession(ctx context.Context, username string) error
 }
 
 type authentication struct {
 	storage   radio.StorageService
 	sessions  *scs.SessionManager
 	templates templates.Executor
+	// twoFactorPermissions are the permissions that require two-factor
+	// authentication to be enabled
+	twoFactorPermissions func() []radio.UserPermission
 }
 
 func RequirePermission(perm radio.UserPermission, handler http.HandlerFunc) http.HandlerFunc {
@@ -87,6 +116,32 @@ func (a authentication) UserMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
+		// api tokens take priority over the session
+		if token, ok := bearerToken(r); ok {
+			user, err := userFromAPIToken(ctx, a.storage, token)
+			if err != nil {
+				err = errors.E(op, err)
+				hlog.FromRequest(r).Warn().Ctx(ctx).Err(err).Msg("failed to retrieve user from api token")
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			// tokens are limited by two-factor the same as a session is
+			err = a.enforceTwoFactor(ctx, user)
+			if err != nil {
+				err = errors.E(op, err)
+				hlog.FromRequest(r).Error().Ctx(ctx).Err(err).Msg("failed to check two-factor state")
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+
+			// tokens aren't send automatically by browsers, so CSRF isn't
+			// a concern for these requests
+			r = csrf.UnsafeSkipCheck(RequestWithUser(r, user))
+			middleware.NoCache(next).ServeHTTP(w, r)
+			return
+		}
+
 		username := a.sessions.GetString(ctx, usernameKey)
 
 		// no known username
@@ -105,6 +160,14 @@ func (a authentication) UserMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		err = a.enforceTwoFactor(ctx, user)
+		if err != nil {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(ctx).Err(err).Msg("failed to check two-factor state")
+			next.ServeHTTP(w, RequestWithUser(r, nil))
+			return
+		}
+
 		r = RequestWithUser(r, user)
 		// also add no-cache headers to any authenticated request
 		middleware.NoCache(next).ServeHTTP(w, r)
@@ -133,10 +196,35 @@ func (a authentication) LoginMiddleware(next http.Handler) http.Handler {
 
 	postHandler := limiter(http.HandlerFunc(a.PostLogin))
 
+	// two-factor codes are short so they get their own stricter limiter
+	twoFactorLimiter := httprate.Limit(5, 5*time.Minute,
+		httprate.WithKeyByIP(),
+		httprate.WithKeyFuncs(func(r *http.Request) (string, error) {
+			return a.sessions.GetString(r.Context(), twoFactorUsernameKey), nil
+		}),
+	)
+
+	postTwoFactorHandler := twoFactorLimiter(http.HandlerFunc(a.PostTwoFactor))
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		username := a.sessions.GetString(ctx, usernameKey)
 
+		// no known username yet, but they might be half-way through a login
+		// that requires a two-factor code
+		if username == "" && a.twoFactorPending(ctx) {
+			if r.Method == http.MethodPost && r.PostFormValue("username") == "" {
+				postTwoFactorHandler.ServeHTTP(w, r)
+			} else if r.Method == http.MethodPost {
+				// they're trying to login with a password again
+				a.clearTwoFactor(ctx)
+				postHandler.ServeHTTP(w, r)
+			} else {
+				a.GetTwoFactor(w, r)
+			}
+			return
+		}
+
 		// no known username yet, so we're not logged in
 		if username == "" {
 			if r.Method == http.MethodPost {
@@ -174,6 +262,16 @@ func (a authentication) LoginMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		err = a.enforceTwoFactor(ctx, user)
+		if err != nil {
+			err = errors.E(op, err)
+			http.Error(w,
+				http.StatusText(http.StatusInternalServerError),
+				http.StatusInternalServerError)
+			hlog.FromRequest(r).Error().Ctx(ctx).Err(err).Msg("")
+			return
+		}
+
 		// otherwise, user is active so forward them to their destination
 		r = RequestWithUser(r, user)
 		// also add no-cache headers to any authenticated request
@@ -229,6 +327,11 @@ func (a *authentication) PostLogin(w http.ResponseWriter, r *http.Request) {
 	const op errors.Op = "website/middleware.authentication.PostLogin"
 
 	err := a.postLogin(r)
+	if errors.Is(errors.TwoFactorRequired, err) {
+		// password was correct, but they need to enter a code now
+		a.GetTwoFactor(w, r)
+		return
+	}
 	if err != nil {
 		err = errors.E(op, err)
 		// failed to login, log an error and give the user a generic error
@@ -288,11 +391,236 @@ func (a *authentication) postLogin(r *http.Request) error {
 		return errors.E(op, err, errors.LoginError, "invalid password")
 	}
 
+	tf, err := a.storage.User(ctx).TwoFactor(user.ID)
+	if err != nil && !errors.Is(errors.TwoFactorUnknown, err) {
+		return errors.E(op, err)
+	}
+	if tf.IsEnabled() {
+		// password is correct, but we need a two-factor code before they're
+		// actually logged in
+		a.sessions.Put(ctx, twoFactorUsernameKey, username)
+		a.sessions.Put(ctx, twoFactorTimeKey, time.Now().Format(time.RFC3339))
+		return errors.E(op, errors.TwoFactorRequired)
+	}
+
 	// success put their username in the session so we know they're logged in
 	a.sessions.Put(ctx, usernameKey, username)
 	return nil
 }
 
+// enforceTwoFactor removes the permissions that require two-factor authentication
+// from the user if they don't have it enabled. PermDev implies every permission
+// so it is removed as well
+func (a *authentication) enforceTwoFactor(ctx context.Context, user *radio.User) error {
+	const op errors.Op = "website/middleware.authentication.enforceTwoFactor"
+
+	var required []radio.UserPermission
+	for _, perm := range a.twoFactorPermissions() {
+		if user.UserPermissions.Has(perm) {
+			required = append(required, perm)
+		}
+	}
+	if len(required) == 0 {
+		return nil
+	}
+
+	tf, err := a.storage.User(ctx).TwoFactor(user.ID)
+	if err != nil && !errors.Is(errors.TwoFactorUnknown, err) {
+		return errors.E(op, err)
+	}
+	if tf.IsEnabled() {
+		return nil
+	}
+
+	// copy the permissions so we don't modify anything shared
+	perms := maps.Clone(user.UserPermissions)
+	for _, perm := range required {
+		delete(perms, perm)
+	}
+	delete(perms, radio.PermDev)
+	user.UserPermissions = perms
+	return nil
+}
+
+// ValidateTwoFactorCode returns true if code is a valid TOTP code for tf, the time
+// step of the code is marked as used so that the same code can't be used again
+func ValidateTwoFactorCode(us radio.UserStorage, tf *radio.UserTwoFactor, code string) (bool, error) {
+	const op errors.Op = "website/middleware.ValidateTwoFactorCode"
+
+	step, ok := totp.Validate(tf.Secret, code, time.Now(), tf.LastStep)
+	if !ok {
+		return false, nil
+	}
+
+	// the step is only updated if it's newer than the stored one, so two requests
+	// racing with the same code can't both succeed
+	ok, err := us.UseTwoFactorStep(tf.UserID, step)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if ok {
+		tf.LastStep = step
+	}
+	return ok, nil
+}
+
+// UseRecoveryCode returns true if code is one of the recovery codes of tf, the
+// code is removed from the stored codes so that it can't be used again
+func UseRecoveryCode(us radio.UserStorage, tf *radio.UserTwoFactor, code string) (bool, error) {
+	const op errors.Op = "website/middleware.UseRecoveryCode"
+
+	old := slices.Clone(tf.RecoveryCodes)
+	if !tf.UseRecoveryCode(code) {
+		return false, nil
+	}
+
+	// only replace the codes if nobody else used one in the meantime, so two
+	// requests racing with the same code can't both succeed
+	ok, err := us.ReplaceRecoveryCodes(tf.UserID, old, tf.RecoveryCodes)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if !ok {
+		tf.RecoveryCodes = old
+	}
+	return ok, nil
+}
+
+// twoFactorPending returns true if the session is waiting on a two-factor code
+func (a *authentication) twoFactorPending(ctx context.Context) bool {
+	if a.sessions.GetString(ctx, twoFactorUsernameKey) == "" {
+		return false
+	}
+
+	started, err := time.Parse(time.RFC3339, a.sessions.GetString(ctx, twoFactorTimeKey))
+	if err != nil || time.Since(started) > twoFactorTimeout {
+		// took too long, they will have to enter their password again
+		a.clearTwoFactor(ctx)
+		return false
+	}
+	return true
+}
+
+func (a *authentication) clearTwoFactor(ctx context.Context) {
+	a.sessions.Remove(ctx, twoFactorUsernameKey)
+	a.sessions.Remove(ctx, twoFactorTimeKey)
+}
+
+type TwoFactorInput struct {
+	Input
+	CSRFTokenInput template.HTML
+	ErrorMessage   string
+}
+
+func (TwoFactorInput) TemplateBundle() string {
+	return "login-twofactor"
+}
+
+func NewTwoFactorInput(r *http.Request, message string) TwoFactorInput {
+	return TwoFactorInput{
+		Input:          InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		ErrorMessage:   message,
+	}
+}
+
+// GetTwoFactor returns the two-factor code page
+func (a *authentication) GetTwoFactor(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/middleware.authentication.GetTwoFactor"
+
+	err := a.templates.Execute(w, r, NewTwoFactorInput(r, ""))
+	if err != nil {
+		err = errors.E(op, err)
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("")
+		return
+	}
+}
+
+// PostTwoFactor handles the two-factor code form submission
+func (a *authentication) PostTwoFactor(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/middleware.authentication.PostTwoFactor"
+
+	err := a.postTwoFactor(r)
+	if err != nil {
+		err = errors.E(op, err)
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("")
+
+		err = a.templates.Execute(w, r, NewTwoFactorInput(r, "invalid code"))
+		if err != nil {
+			err = errors.E(op, err)
+			hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("failed to send two-factor page")
+		}
+		return
+	}
+
+	// successful login so send them to where they were trying to go
+	http.Redirect(w, r, r.URL.String(), http.StatusFound)
+}
+
+func (a *authentication) postTwoFactor(r *http.Request) error {
+	const op errors.Op = "website/middleware.authentication.postTwoFactor"
+	ctx := r.Context()
+
+	username := a.sessions.GetString(ctx, twoFactorUsernameKey)
+	if username == "" {
+		return errors.E(op, errors.LoginError, "no pending two-factor login")
+	}
+
+	code := strings.TrimSpace(r.PostFormValue("code"))
+	if code == "" {
+		return errors.E(op, errors.LoginError, "empty code")
+	}
+
+	us := a.storage.User(ctx)
+	user, err := us.Get(username)
+	if err != nil {
+		return errors.E(op, err, errors.LoginError)
+	}
+
+	tf, err := us.TwoFactor(user.ID)
+	if err != nil {
+		return errors.E(op, err, errors.LoginError)
+	}
+
+	ok, err := ValidateTwoFactorCode(us, tf, code)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !ok {
+		// not a valid code, but might be a recovery code
+		ok, err = UseRecoveryCode(us, tf, code)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if !ok {
+			return errors.E(op, errors.LoginError, "invalid code")
+		}
+		zerolog.Ctx(ctx).Info().Ctx(ctx).Str("username", username).Int("remaining", len(tf.RecoveryCodes)).Msg("recovery code used")
+	}
+
+	// success, swap the pending state for a real login
+	err = a.sessions.RenewToken(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	a.clearTwoFactor(ctx)
+	a.sessions.Put(ctx, usernameKey, username)
+	return nil
+}
+
+// StartSession implements Authentication
+func (a *authentication) StartSession(ctx context.Context, username string) error {
+	const op errors.Op = "website/middleware.authentication.StartSession"
+
+	err := a.sessions.RenewToken(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	a.clearTwoFactor(ctx)
+	a.sessions.Put(ctx, usernameKey, username)
+	return nil
+}
+
 func (a *authentication) LogoutHandler(w http.ResponseWriter, r *http.Request) {
 	const op errors.Op = "admin/authentication.GetLogout"
 
@@ -427,11 +755,62 @@ func RequestWithUser(r *http.Request, u *radio.User) *http.Request {
 	return r.WithContext(ctx)
 }
 
-// BasicAuth lets users login through the HTTP Basic Authorization header
+// bearerToken returns the token in the Authorization header if it is an api token
+func bearerToken(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, radio.IsAPIToken(token)
+}
+
+// userFromAPIToken returns the user that owns the api token given, the permissions
+// of the user returned are limited to the scopes of the token
+func userFromAPIToken(ctx context.Context, storage radio.StorageService, raw string) (*radio.User, error) {
+	const op errors.Op = "website/middleware.userFromAPIToken"
+
+	token, err := storage.APIToken(ctx).Get(radio.NewAPITokenHash(raw))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if token.IsExpired(time.Now()) {
+		return nil, errors.E(op, errors.AccessDenied, "expired api token")
+	}
+
+	user, err := storage.User(ctx).GetByID(token.UserID)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if !user.UserPermissions.Has(radio.PermActive) {
+		return nil, errors.E(op, errors.AccessDenied, "inactive user")
+	}
+
+	user.UserPermissions = token.Permissions(*user)
+
+	err = storage.APIToken(ctx).UpdateLastUsed(token.ID)
+	if err != nil {
+		// not critical, the token is still valid
+		zerolog.Ctx(ctx).Warn().Ctx(ctx).Err(err).Msg("failed to update api token last used")
+	}
+	return user, nil
+}
+
+// BasicAuth lets users login through the HTTP Basic Authorization header, an api
+// token can be used in place of the password
 //
 // This should ONLY be used for situations where a human cannot input the
 // login info somehow. Namely for icecast source clients.
-func BasicAuth(uss radio.UserStorageService) func(http.Handler) http.Handler {
+func BasicAuth(cfg config.Config, storage radio.StorageService) func(http.Handler) http.Handler {
+	// basic auth can't ask for a two-factor code, but it should still take
+	// away the permissions that need it from users that don't have it enabled
+	a := &authentication{
+		storage:              storage,
+		twoFactorPermissions: twoFactorPermissions(cfg),
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			username, passwd, ok := r.BasicAuth()
@@ -458,8 +837,36 @@ func BasicAuth(uss radio.UserStorageService) func(http.Handler) http.Handler {
 			if err := ctx.Err(); err != nil {
 				zerolog.Ctx(ctx).Warn().Ctx(ctx).Err(err).Str("username", username).Msg("context cancellation edgecase")
 			}
+			if radio.IsAPIToken(passwd) {
+				// Remove that cancel here for just this one access.
+				user, err := userFromAPIToken(context.WithoutCancel(ctx), storage, passwd)
+				if err != nil {
+					zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("username", username).Msg("invalid api token")
+					BasicAuthFailure(w, r)
+					return
+				}
+
+				// the username should match the token owner, unless it's the
+				// generic source username
+				if username != "source" && username != user.Username {
+					zerolog.Ctx(ctx).Error().Ctx(ctx).Str("username", username).Msg("api token owner mismatch")
+					BasicAuthFailure(w, r)
+					return
+				}
+
+				err = a.enforceTwoFactor(context.WithoutCancel(ctx), user)
+				if err != nil {
+					zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("username", username).Msg("failed to check two-factor state")
+					BasicAuthFailure(w, r)
+					return
+				}
+
+				next.ServeHTTP(w, RequestWithUser(r, user))
+				return
+			}
+
 			// Remove that cancel here for just this one access.
-			us := uss.User(context.WithoutCancel(ctx))
+			us := storage.User(context.WithoutCancel(ctx))
 			user, err := us.Get(username)
 			if err != nil {
 				zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("username", username).Msg("database error")
@@ -480,6 +887,13 @@ func BasicAuth(uss radio.UserStorageService) func(http.Handler) http.Handler {
 				return
 			}
 
+			err = a.enforceTwoFactor(context.WithoutCancel(ctx), user)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Str("username", username).Msg("failed to check two-factor state")
+				BasicAuthFailure(w, r)
+				return
+			}
+
 			// before we pass it back to the handlers we reset the deadlines because the
 			// comparison above is long with the race detector enabled
 			v := r.Context().Value(http.ServerContextKey)
@@ -2,14 +2,22 @@ package middleware
 
 import (
 	"context"
+	"maps"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/totp"
+	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -144,7 +152,7 @@ func TestBasicAuth(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			r := chi.NewRouter()
-			r.Use(BasicAuth(storage))
+			r.Use(BasicAuth(config.TestConfig(), storage))
 			r.Get("/*", func(w http.ResponseWriter, r *http.Request) {
 				user := MaybeUserFromContext(r.Context())
 				assert.Equal(t, test.GetFuncRet, user)
@@ -155,3 +163,467 @@ func TestBasicAuth(t *testing.T) {
 		})
 	}
 }
+
+func newAPITokenTestStorage(token string, user *radio.User, tokenErr error) *mocks.StorageServiceMock {
+	return &mocks.StorageServiceMock{
+		APITokenFunc: func(contextMoqParam context.Context) radio.APITokenStorage {
+			return &mocks.APITokenStorageMock{
+				GetFunc: func(apiTokenHash radio.APITokenHash) (*radio.APIToken, error) {
+					if tokenErr != nil {
+						return nil, tokenErr
+					}
+					if apiTokenHash != radio.NewAPITokenHash(token) {
+						return nil, errors.E(errors.TokenUnknown)
+					}
+					return &radio.APIToken{
+						ID:     1,
+						UserID: user.ID,
+						Scopes: radio.NewUserPermissions(radio.PermDJ),
+					}, nil
+				},
+				UpdateLastUsedFunc: func(apiTokenID radio.APITokenID) error {
+					return nil
+				},
+			}
+		},
+		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+			return &mocks.UserStorageMock{
+				GetByIDFunc: func(userID radio.UserID) (*radio.User, error) {
+					if userID != user.ID {
+						return nil, errors.E(errors.UserUnknown)
+					}
+					u := *user
+					return &u, nil
+				},
+				GetFunc: func(name string) (*radio.User, error) {
+					return nil, errors.E(errors.UserUnknown)
+				},
+			}
+		},
+	}
+}
+
+func TestBasicAuthAPIToken(t *testing.T) {
+	token, _, err := radio.GenerateAPIToken()
+	require.NoError(t, err)
+
+	user := &radio.User{
+		ID:              5,
+		Username:        "test",
+		UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermDJ, radio.PermAdmin),
+	}
+
+	cases := []struct {
+		Name     string
+		Code     int
+		Username string
+		Password string
+	}{
+		{"as source", 200, "source", token},
+		{"as owner", 200, "test", token},
+		{"in password", 200, "source", "test|" + token},
+		{"wrong owner", 401, "other", token},
+		{"unknown token", 401, "source", radio.APITokenPrefix + "nope"},
+	}
+
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			storage := newAPITokenTestStorage(token, user, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/main.mp3", nil)
+			req.SetBasicAuth(test.Username, test.Password)
+			req = req.WithContext(zerolog.New(os.Stdout).WithContext(context.Background()))
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Use(BasicAuth(config.TestConfig(), storage))
+			r.Get("/*", func(w http.ResponseWriter, r *http.Request) {
+				user := MaybeUserFromContext(r.Context())
+				require.NotNil(t, user)
+				// permissions should be limited to the token scopes
+				assert.True(t, user.UserPermissions.Has(radio.PermDJ))
+				assert.False(t, user.UserPermissions.Has(radio.PermAdmin))
+			})
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, test.Code, w.Code)
+		})
+	}
+}
+
+func TestUserMiddlewareAPIToken(t *testing.T) {
+	token, _, err := radio.GenerateAPIToken()
+	require.NoError(t, err)
+
+	user := &radio.User{
+		ID:              5,
+		Username:        "test",
+		UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermDJ, radio.PermAdmin),
+	}
+
+	cases := []struct {
+		Name   string
+		Header string
+		Code   int
+	}{
+		{"valid token", "Bearer " + token, 200},
+		{"unknown token", "Bearer " + radio.APITokenPrefix + "nope", 401},
+	}
+
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			auth := NewAuthentication(config.TestConfig(), newAPITokenTestStorage(token, user, nil), nil, scs.New())
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/status", nil)
+			req.Header.Set("Authorization", test.Header)
+			req = req.WithContext(zerolog.New(os.Stdout).WithContext(context.Background()))
+			w := httptest.NewRecorder()
+
+			auth.UserMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				user := MaybeUserFromContext(r.Context())
+				require.NotNil(t, user)
+				assert.Equal(t, "test", user.Username)
+				assert.True(t, user.UserPermissions.Has(radio.PermDJ))
+				assert.False(t, user.UserPermissions.Has(radio.PermAdmin))
+			})).ServeHTTP(w, req)
+
+			assert.Equal(t, test.Code, w.Code)
+		})
+	}
+}
+
+func TestBasicAuthTwoFactor(t *testing.T) {
+	token, _, err := radio.GenerateAPIToken()
+	require.NoError(t, err)
+	passwd := "a very important password"
+	hash, err := radio.GenerateHashFromPassword(passwd)
+	require.NoError(t, err)
+
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Website.TwoFactorPermissions = []string{radio.PermDJ}
+	cfg.StoreConf(c)
+
+	user := &radio.User{
+		ID:              5,
+		Username:        "test",
+		Password:        hash,
+		UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+	}
+
+	storage := newAPITokenTestStorage(token, user, nil)
+	userStorage := storage.UserFunc(context.Background()).(*mocks.UserStorageMock)
+	userStorage.GetFunc = func(name string) (*radio.User, error) {
+		u := *user
+		return &u, nil
+	}
+	userStorage.TwoFactorFunc = func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+		return nil, errors.E(errors.TwoFactorUnknown)
+	}
+	storage.UserFunc = func(contextMoqParam context.Context) radio.UserStorage {
+		return userStorage
+	}
+
+	for _, password := range []string{passwd, token} {
+		req := httptest.NewRequest(http.MethodGet, "/main.mp3", nil)
+		req.SetBasicAuth("test", password)
+		req = req.WithContext(zerolog.New(os.Stdout).WithContext(context.Background()))
+		w := httptest.NewRecorder()
+
+		var called bool
+		r := chi.NewRouter()
+		r.Use(BasicAuth(cfg, storage))
+		r.Get("/*", func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			user := MaybeUserFromContext(r.Context())
+			require.NotNil(t, user)
+			// the user doesn't have two-factor enabled so they lose DJ
+			assert.False(t, user.UserPermissions.Has(radio.PermDJ))
+		})
+		r.ServeHTTP(w, req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestUserMiddlewareAPITokenTwoFactor(t *testing.T) {
+	token, _, err := radio.GenerateAPIToken()
+	require.NoError(t, err)
+
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Website.TwoFactorPermissions = []string{radio.PermDJ}
+	cfg.StoreConf(c)
+
+	user := &radio.User{
+		ID:              5,
+		Username:        "test",
+		UserPermissions: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+	}
+
+	storage := newAPITokenTestStorage(token, user, nil)
+	userStorage := storage.UserFunc(context.Background()).(*mocks.UserStorageMock)
+	userStorage.TwoFactorFunc = func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+		return nil, errors.E(errors.TwoFactorUnknown)
+	}
+	storage.UserFunc = func(contextMoqParam context.Context) radio.UserStorage {
+		return userStorage
+	}
+
+	auth := NewAuthentication(cfg, storage, nil, scs.New())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/status", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req = req.WithContext(zerolog.New(os.Stdout).WithContext(context.Background()))
+	w := httptest.NewRecorder()
+
+	var called bool
+	auth.UserMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		user := MaybeUserFromContext(r.Context())
+		require.NotNil(t, user)
+		// the user doesn't have two-factor enabled so the token loses DJ
+		assert.False(t, user.UserPermissions.Has(radio.PermDJ))
+	})).ServeHTTP(w, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestEnforceTwoFactor(t *testing.T) {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Website.TwoFactorPermissions = []string{radio.PermAdmin, radio.PermDatabaseDelete}
+	cfg.StoreConf(c)
+
+	now := time.Now()
+	admin := radio.NewUserPermissions(radio.PermActive, radio.PermDJ, radio.PermAdmin)
+	dev := radio.NewUserPermissions(radio.PermActive, radio.PermDJ, radio.PermDev)
+	cases := []struct {
+		Name     string
+		Original radio.UserPermissions
+		TwoFac   *radio.UserTwoFactor
+		Expected radio.UserPermissions
+	}{
+		{
+			Name:     "without two-factor",
+			Original: admin,
+			Expected: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+		},
+		{
+			Name:     "pending two-factor",
+			Original: admin,
+			TwoFac:   &radio.UserTwoFactor{Secret: "ABC"},
+			Expected: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+		},
+		{
+			Name:     "with two-factor",
+			Original: admin,
+			TwoFac:   &radio.UserTwoFactor{Secret: "ABC", EnabledAt: &now},
+			Expected: admin,
+		},
+		{
+			// dev implies admin, so it should go as well
+			Name:     "dev without two-factor",
+			Original: dev,
+			Expected: radio.NewUserPermissions(radio.PermActive, radio.PermDJ),
+		},
+		{
+			Name:     "dev with two-factor",
+			Original: dev,
+			TwoFac:   &radio.UserTwoFactor{Secret: "ABC", EnabledAt: &now},
+			Expected: dev,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			storage := &mocks.StorageServiceMock{
+				UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+					return &mocks.UserStorageMock{
+						TwoFactorFunc: func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+							if test.TwoFac == nil {
+								return nil, errors.E(errors.TwoFactorUnknown)
+							}
+							return test.TwoFac, nil
+						},
+					}
+				},
+			}
+			auth := NewAuthentication(cfg, storage, nil, scs.New()).(*authentication)
+
+			original := maps.Clone(test.Original)
+			user := &radio.User{ID: 1, UserPermissions: original}
+
+			require.NoError(t, auth.enforceTwoFactor(context.Background(), user))
+			assert.Equal(t, test.Expected, user.UserPermissions)
+			assert.False(t, user.UserPermissions.Has(radio.PermAdmin) && test.TwoFac == nil)
+			// the original permissions should be untouched
+			assert.Equal(t, test.Original, original)
+		})
+	}
+}
+
+func TestTwoFactorLogin(t *testing.T) {
+	passwd := "a very important password"
+	hash, err := radio.GenerateHashFromPassword(passwd)
+	require.NoError(t, err)
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	codes, hashes, err := radio.GenerateRecoveryCodes(2)
+	require.NoError(t, err)
+
+	now := time.Now()
+	user := &radio.User{
+		ID:              1,
+		Username:        "test",
+		Password:        hash,
+		UserPermissions: radio.NewUserPermissions(radio.PermActive),
+	}
+	tf := &radio.UserTwoFactor{UserID: 1, Secret: secret, RecoveryCodes: hashes, EnabledAt: &now}
+
+	userMock := &mocks.UserStorageMock{
+		GetFunc: func(name string) (*radio.User, error) {
+			return user, nil
+		},
+		TwoFactorFunc: func(userID radio.UserID) (*radio.UserTwoFactor, error) {
+			tf := *tf
+			tf.RecoveryCodes = slices.Clone(tf.RecoveryCodes)
+			return &tf, nil
+		},
+		UpdateTwoFactorFunc: func(new radio.UserTwoFactor) error {
+			*tf = new
+			return nil
+		},
+		UseTwoFactorStepFunc: func(userID radio.UserID, step uint64) (bool, error) {
+			if step <= tf.LastStep {
+				return false, nil
+			}
+			tf.LastStep = step
+			return true, nil
+		},
+		ReplaceRecoveryCodesFunc: func(id radio.UserID, old, new []string) (bool, error) {
+			if !slices.Equal(tf.RecoveryCodes, old) {
+				return false, nil
+			}
+			tf.RecoveryCodes = slices.Clone(new)
+			return true, nil
+		},
+	}
+	storage := &mocks.StorageServiceMock{
+		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+			return userMock
+		},
+	}
+
+	sessions := scs.New()
+	auth := NewAuthentication(config.TestConfig(), storage, nil, sessions).(*authentication)
+
+	// run runs fn with a request that has the session loaded
+	var token string
+	run := func(form url.Values, fn func(r *http.Request)) {
+		ctx, err := sessions.Load(context.Background(), token)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req = req.WithContext(ctx)
+		fn(req)
+
+		token, _, err = sessions.Commit(ctx)
+		require.NoError(t, err)
+	}
+
+	// password login should ask for a code, and not log us in yet
+	run(url.Values{"username": {"test"}, "password": {passwd}}, func(r *http.Request) {
+		err := auth.postLogin(r)
+		assert.True(t, errors.Is(errors.TwoFactorRequired, err))
+		assert.Empty(t, sessions.GetString(r.Context(), usernameKey))
+		assert.True(t, auth.twoFactorPending(r.Context()))
+	})
+
+	// wrong code should fail
+	run(url.Values{"code": {"000000"}}, func(r *http.Request) {
+		assert.Error(t, auth.postTwoFactor(r))
+		assert.Empty(t, sessions.GetString(r.Context(), usernameKey))
+	})
+
+	// recovery code should log us in and be used up
+	run(url.Values{"code": {codes[0]}}, func(r *http.Request) {
+		require.NoError(t, auth.postTwoFactor(r))
+		assert.Equal(t, "test", sessions.GetString(r.Context(), usernameKey))
+		assert.False(t, auth.twoFactorPending(r.Context()))
+	})
+	assert.Len(t, tf.RecoveryCodes, 1)
+
+	login := func() {
+		token = ""
+		run(url.Values{"username": {"test"}, "password": {passwd}}, func(r *http.Request) {
+			assert.True(t, errors.Is(errors.TwoFactorRequired, auth.postLogin(r)))
+		})
+	}
+
+	// the used recovery code shouldn't work a second time
+	login()
+	run(url.Values{"code": {codes[0]}}, func(r *http.Request) {
+		assert.Error(t, auth.postTwoFactor(r))
+		assert.Empty(t, sessions.GetString(r.Context(), usernameKey))
+	})
+	assert.Len(t, tf.RecoveryCodes, 1)
+
+	// a totp code should log us in
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	login()
+	run(url.Values{"code": {code}}, func(r *http.Request) {
+		require.NoError(t, auth.postTwoFactor(r))
+		assert.Equal(t, "test", sessions.GetString(r.Context(), usernameKey))
+	})
+
+	// but only once
+	login()
+	run(url.Values{"code": {code}}, func(r *http.Request) {
+		assert.Error(t, auth.postTwoFactor(r))
+		assert.Empty(t, sessions.GetString(r.Context(), usernameKey))
+	})
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	codes, hashes, err := radio.GenerateRecoveryCodes(3)
+	require.NoError(t, err)
+
+	stored := slices.Clone(hashes)
+	us := &mocks.UserStorageMock{
+		ReplaceRecoveryCodesFunc: func(id radio.UserID, old, new []string) (bool, error) {
+			if !slices.Equal(stored, old) {
+				return false, nil
+			}
+			stored = slices.Clone(new)
+			return true, nil
+		},
+	}
+
+	// two requests that loaded the two-factor state at the same time
+	first := &radio.UserTwoFactor{UserID: 1, RecoveryCodes: slices.Clone(hashes)}
+	second := &radio.UserTwoFactor{UserID: 1, RecoveryCodes: slices.Clone(hashes)}
+
+	ok, err := UseRecoveryCode(us, first, codes[0])
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, stored, 2)
+
+	// the second one should lose the race with the same code
+	ok, err = UseRecoveryCode(us, second, codes[0])
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Len(t, stored, 2)
+	assert.Equal(t, hashes, second.RecoveryCodes)
+
+	// and codes that aren't recovery codes don't touch storage
+	ok, err = UseRecoveryCode(us, first, "not-a-code")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Len(t, us.ReplaceRecoveryCodesCalls(), 2)
+}
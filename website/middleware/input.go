@@ -26,6 +26,7 @@ func InputMiddleware(cfg config.Config, status util.StreamValuer[radio.Status],
 			ctx := r.Context()
 
 			user := MaybeUserFromContext(ctx)
+			listener := ListenerFromContext(ctx)
 			theme := templates.GetTheme(ctx)
 
 			input := Input{
@@ -33,6 +34,8 @@ func InputMiddleware(cfg config.Config, status util.StreamValuer[radio.Status],
 				IsHTMX:          util.IsHTMX(r),
 				IsUser:          user != nil,
 				User:            user,
+				IsListener:      listener != nil,
+				Listener:        listener,
 				StreamURL:       PublicStreamURL(),
 				RequestURL:      template.URL(r.URL.String()),
 				Status:          status.Latest(),
@@ -75,6 +78,10 @@ type Input struct {
 	IsHTMX bool
 	// User is non-nil if IsUser is true, and contains the logged in user
 	User *radio.User
+	// IsListener is true if the request was made by a logged in listener account
+	IsListener bool
+	// Listener is non-nil if IsListener is true, and contains the logged in listener
+	Listener *radio.ListenerAccount
 	// Status is the current radio Status
 	Status radio.Status
 	// StreamURL is the URL that points to the public url to listen to the stream
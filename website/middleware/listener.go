@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/alexedwards/scs/v2"
+	"github.com/rs/zerolog/hlog"
+)
+
+// listenerUsernameKey is the session key used for the username of the logged
+// in listener account, this is separate from the staff login
+const listenerUsernameKey = "listener-username"
+
+type listenerContextKey struct{}
+
+// ListenerMiddleware adds the currently logged in radio.ListenerAccount to the
+// request if available. Retrievable by using ListenerFromContext.
+func ListenerMiddleware(storage radio.ListenerAccountStorageService, sessions *scs.SessionManager) func(http.Handler) http.Handler {
+	const op errors.Op = "website/middleware.ListenerMiddleware"
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			username := sessions.GetString(ctx, listenerUsernameKey)
+			if username == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			account, err := storage.ListenerAccount(ctx).Get(username)
+			if err != nil {
+				err = errors.E(op, err)
+				hlog.FromRequest(r).Warn().Ctx(ctx).Err(err).Msg("failed to retrieve listener from session")
+				if errors.Is(errors.ListenerAccountUnknown, err) {
+					// account is gone, forget about it
+					sessions.Remove(ctx, listenerUsernameKey)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, RequestWithListener(r, account))
+		})
+	}
+}
+
+// LoginListener marks the session as belonging to the listener account given
+func LoginListener(ctx context.Context, sessions *scs.SessionManager, account radio.ListenerAccount) error {
+	const op errors.Op = "website/middleware.LoginListener"
+
+	err := sessions.RenewToken(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	sessions.Put(ctx, listenerUsernameKey, account.Username)
+	return nil
+}
+
+// LogoutListener removes the listener account from the session, a staff login
+// in the same session is left alone
+func LogoutListener(ctx context.Context, sessions *scs.SessionManager) {
+	sessions.Remove(ctx, listenerUsernameKey)
+}
+
+// ListenerFromContext returns the listener account stored in the context or nil
+// if there is none
+func ListenerFromContext(ctx context.Context) *radio.ListenerAccount {
+	a, _ := ctx.Value(listenerContextKey{}).(*radio.ListenerAccount)
+	return a
+}
+
+// RequestWithListener adds a listener account to a requests context and returns
+// the new updated request after, it can be retrieved by ListenerFromContext
+func RequestWithListener(r *http.Request, a *radio.ListenerAccount) *http.Request {
+	ctx := context.WithValue(r.Context(), listenerContextKey{}, a)
+	return r.WithContext(ctx)
+}
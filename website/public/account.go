@@ -0,0 +1,321 @@
+package public
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/R-a-dio/valkyrie/website/shared"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/csrf"
+	"github.com/rs/zerolog/hlog"
+)
+
+const (
+	accountRequestsPageSize = 50
+	profileTopSongsSize     = 10
+	profileRecentSize       = 10
+
+	// listenerMinPasswordLength is the minimum length of a listener account password
+	listenerMinPasswordLength = 8
+)
+
+type AccountInput struct {
+	middleware.Input
+	CSRFTokenInput template.HTML
+
+	// Account is the logged in listener account, nil if not logged in in which
+	// case the login and register forms should be shown
+	Account *radio.ListenerAccount
+	// Requests is the request history of Account
+	Requests []radio.ListenerRequest
+	Page     *shared.Pagination
+
+	// ClaimCode is a newly generated code to claim an IRC nick with
+	ClaimCode    string
+	ClaimExpires time.Time
+
+	// IsError indicates if the message given is an error
+	IsError bool
+	// Message to show at the top of the page
+	Message string
+}
+
+func (AccountInput) TemplateBundle() string {
+	return "account"
+}
+
+func NewAccountInput(ls radio.ListenerAccountStorage, r *http.Request) (*AccountInput, error) {
+	const op errors.Op = "website/public.NewAccountInput"
+
+	input := &AccountInput{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Account:        middleware.ListenerFromContext(r.Context()),
+	}
+	if input.Account == nil {
+		return input, nil
+	}
+
+	page, offset, err := shared.PageAndOffset(r, accountRequestsPageSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	list, err := ls.Requests(input.Account.ID, accountRequestsPageSize, offset)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// we also use this input after a POST to one of the /account/* urls which
+	// we can't use for the pagination logic
+	r.URL.Path = "/account"
+
+	input.Requests = list.Requests
+	input.Page = shared.NewPagination(
+		page, shared.PageCount(int64(list.Total), accountRequestsPageSize),
+		r.URL,
+	)
+	return input, nil
+}
+
+func (s *State) GetAccount(w http.ResponseWriter, r *http.Request) {
+	input, err := NewAccountInput(s.Storage.ListenerAccount(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+
+	err = s.Templates.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+}
+
+// accountResponse renders the account page with the message given, err is
+// logged and shown as an error message if it isn't nil
+func (s *State) accountResponse(w http.ResponseWriter, r *http.Request, message string, err error) {
+	if err != nil {
+		hlog.FromRequest(r).Error().Ctx(r.Context()).Err(err).Msg("")
+	}
+
+	input, ierr := NewAccountInput(s.Storage.ListenerAccount(r.Context()), r)
+	if ierr != nil {
+		s.errorHandler(w, r, ierr)
+		return
+	}
+	input.Message = message
+	input.IsError = err != nil
+
+	ierr = s.Templates.Execute(w, r, input)
+	if ierr != nil {
+		s.errorHandler(w, r, ierr)
+		return
+	}
+}
+
+func (s *State) PostAccountLogin(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/public.PostAccountLogin"
+	ctx := r.Context()
+
+	username := r.PostFormValue("username")
+	password := r.PostFormValue("password")
+	if username == "" || password == "" {
+		s.accountResponse(w, r, "invalid credentials", errors.E(op, errors.LoginError, "empty username or password"))
+		return
+	}
+
+	account, err := s.Storage.ListenerAccount(ctx).Get(username)
+	if err != nil {
+		s.accountResponse(w, r, "invalid credentials", errors.E(op, err, errors.LoginError))
+		return
+	}
+
+	err = account.ComparePassword(password)
+	if err != nil {
+		s.accountResponse(w, r, "invalid credentials", errors.E(op, err, errors.LoginError, "invalid password"))
+		return
+	}
+
+	err = middleware.LoginListener(ctx, s.Sessions, *account)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	http.Redirect(w, r, "/account", http.StatusSeeOther)
+}
+
+func (s *State) PostAccountRegister(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/public.PostAccountRegister"
+	ctx := r.Context()
+
+	username := r.PostFormValue("username")
+	if username == "" || len(username) > middleware.MAX_USERNAME_LENGTH {
+		s.accountResponse(w, r, "username is empty or too long", errors.E(op, errors.InvalidForm))
+		return
+	}
+
+	password := r.PostFormValue("password")
+	if len(password) < listenerMinPasswordLength {
+		s.accountResponse(w, r, "password is too short", errors.E(op, errors.InvalidForm))
+		return
+	}
+	if password != r.PostFormValue("password_repeat") {
+		s.accountResponse(w, r, "passwords do not match", errors.E(op, errors.InvalidForm))
+		return
+	}
+
+	hash, err := radio.GenerateHashFromPassword(password)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	account := radio.ListenerAccount{
+		Username: username,
+		Password: hash,
+	}
+
+	account.ID, err = s.Storage.ListenerAccount(ctx).Create(account)
+	if err != nil {
+		if errors.Is(errors.Duplicate, err) {
+			s.accountResponse(w, r, "username is already taken", errors.E(op, err))
+			return
+		}
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	err = middleware.LoginListener(ctx, s.Sessions, account)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	http.Redirect(w, r, "/account", http.StatusSeeOther)
+}
+
+func (s *State) PostAccountLogout(w http.ResponseWriter, r *http.Request) {
+	middleware.LogoutListener(r.Context(), s.Sessions)
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// PostAccountClaim generates a new code that can be used on IRC to claim
+// a nick for the logged in account
+func (s *State) PostAccountClaim(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/public.PostAccountClaim"
+	ctx := r.Context()
+
+	account := middleware.ListenerFromContext(ctx)
+	if account == nil {
+		http.Redirect(w, r, "/account", http.StatusSeeOther)
+		return
+	}
+
+	code, err := radio.NewListenerClaimCode()
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+	expires := time.Now().Add(radio.ListenerClaimCodeTimeout)
+
+	err = s.Storage.ListenerAccount(ctx).SetClaimCode(account.ID, code, expires)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	input, err := NewAccountInput(s.Storage.ListenerAccount(ctx), r)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+	input.ClaimCode = code
+	input.ClaimExpires = expires
+
+	err = s.Templates.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+}
+
+type ProfileInput struct {
+	middleware.Input
+
+	Username  string
+	Nick      string
+	CreatedAt time.Time
+	// TopSongs are the songs requested most by this listener
+	TopSongs []radio.ListenerSongCount
+	// Recent are the most recent requests by this listener
+	Recent []radio.ListenerRequest
+	// FaveCount is the amount of favorites of Nick
+	FaveCount int64
+}
+
+func (ProfileInput) TemplateBundle() string {
+	return "profile"
+}
+
+func NewProfileInput(ss radio.SongStorage, ls radio.ListenerAccountStorage, r *http.Request) (*ProfileInput, error) {
+	const op errors.Op = "website/public.NewProfileInput"
+
+	account, err := ls.Get(chi.URLParam(r, "Username"))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	top, err := ls.TopSongs(account.ID, profileTopSongsSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	recent, err := ls.Requests(account.ID, profileRecentSize, 0)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var faveCount int64
+	if account.Nick != "" {
+		_, faveCount, err = ss.FavoritesOf(account.Nick, 1, 1)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	return &ProfileInput{
+		Input:     middleware.InputFromRequest(r),
+		Username:  account.Username,
+		Nick:      account.Nick,
+		CreatedAt: account.CreatedAt,
+		TopSongs:  top,
+		Recent:    recent.Requests,
+		FaveCount: faveCount,
+	}, nil
+}
+
+func (s *State) GetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := NewProfileInput(s.Storage.Song(ctx), s.Storage.ListenerAccount(ctx), r)
+	if err != nil {
+		if errors.Is(errors.ListenerAccountUnknown, err) {
+			s.errorHandler(w, r, shared.ErrNotFound)
+			return
+		}
+		s.errorHandler(w, r, err)
+		return
+	}
+
+	err = s.Templates.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+}
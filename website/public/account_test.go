@@ -0,0 +1,91 @@
+package public
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/templates"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProfileInput(t *testing.T) {
+	account := radio.ListenerAccount{
+		ID:       5,
+		Username: "listener",
+		Password: "secret-hash",
+		Nick:     "nick",
+	}
+
+	ls := &mocks.ListenerAccountStorageMock{
+		GetFunc: func(username string) (*radio.ListenerAccount, error) {
+			assert.Equal(t, account.Username, username)
+			return &account, nil
+		},
+		TopSongsFunc: func(id radio.ListenerAccountID, limit int64) ([]radio.ListenerSongCount, error) {
+			assert.Equal(t, account.ID, id)
+			return []radio.ListenerSongCount{{Count: 3}}, nil
+		},
+		RequestsFunc: func(id radio.ListenerAccountID, limit, offset int64) (radio.ListenerRequestList, error) {
+			assert.Equal(t, account.ID, id)
+			return radio.ListenerRequestList{Requests: make([]radio.ListenerRequest, 2), Total: 2}, nil
+		},
+	}
+	ss := &mocks.SongStorageMock{
+		FavoritesOfFunc: func(nick string, limit, offset int64) ([]radio.Song, int64, error) {
+			assert.Equal(t, account.Nick, nick)
+			return nil, 20, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/u/listener", nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("Username", account.Username)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+	input, err := NewProfileInput(ss, ls, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, account.Username, input.Username)
+	assert.Equal(t, account.Nick, input.Nick)
+	assert.Len(t, input.TopSongs, 1)
+	assert.Len(t, input.Recent, 2)
+	assert.EqualValues(t, 20, input.FaveCount)
+}
+
+func TestPostFavesRequiresClaimedNick(t *testing.T) {
+	ss := &mocks.SongStorageMock{}
+	state := &State{
+		Storage: &mocks.StorageServiceMock{
+			SongFunc: func(contextMoqParam context.Context) radio.SongStorage {
+				return ss
+			},
+		},
+		Templates: &mocks.ExecutorMock{
+			ExecuteFunc: func(w io.Writer, r *http.Request, input templates.TemplateSelectable) error {
+				return nil
+			},
+		},
+	}
+
+	for _, account := range []*radio.ListenerAccount{
+		nil,
+		{ID: 1, Username: "unclaimed"},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/faves?hash=0000000000000000000000000000000000000000", nil)
+		req = middleware.RequestWithListener(req, account)
+		w := httptest.NewRecorder()
+
+		state.PostFaves(w, req)
+
+		assert.Empty(t, ss.AddFavoriteCalls())
+		assert.Empty(t, ss.RemoveFavoriteCalls())
+	}
+}
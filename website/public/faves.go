@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"html/template"
 	"net/http"
+	"net/url"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
@@ -149,7 +150,45 @@ func (s *State) GetFaves(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PostFaves lets a logged in listener add or remove favorites of the nick
+// claimed by their account
 func (s *State) PostFaves(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/public.PostFaves"
+	ctx := r.Context()
+
+	account := middleware.ListenerFromContext(ctx)
+	if account == nil || account.Nick == "" {
+		s.errorHandler(w, r, errors.E(op, errors.AccessDenied))
+		return
+	}
+
+	hash, err := radio.ParseSongHash(r.FormValue("hash"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err, errors.InvalidForm))
+		return
+	}
+
+	ss := s.Storage.Song(ctx)
+	song, err := ss.FromHash(hash)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	var dbFunc = ss.AddFavorite
+	if r.FormValue("action") == "remove" {
+		dbFunc = ss.RemoveFavorite
+	}
+
+	_, err = dbFunc(*song, account.Nick)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err))
+		return
+	}
+
+	q := url.Values{}
+	q.Set("nick", account.Nick)
+	http.Redirect(w, r, "/faves?"+q.Encode(), http.StatusSeeOther)
 }
 
 type FaveDownloadEntry struct {
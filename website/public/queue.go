@@ -1,37 +1,54 @@
 package public
 
 import (
+	"html/template"
 	"net/http"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
 	"github.com/rs/zerolog/hlog"
 )
 
 type QueueInput struct {
 	middleware.Input
+	CSRFTokenInput template.HTML
 
 	Queue []radio.QueueEntry
+	// Pending are the requests waiting for votes before they are added to
+	// the queue, this is empty if requests aren't moderated
+	Pending []radio.PendingRequest
+	// Message is the result of voting on a pending request
+	Message string
+	IsError bool
 }
 
 func (QueueInput) TemplateBundle() string {
 	return "queue"
 }
 
-func NewQueueInput(qs radio.QueueService, r *http.Request) (*QueueInput, error) {
+func NewQueueInput(qs radio.QueueService, rps radio.RequestPoolStorage, r *http.Request) (*QueueInput, error) {
 	queue, err := qs.Entries(r.Context())
 	if err != nil {
 		hlog.FromRequest(r).Err(err).Ctx(r.Context()).Msg("failed to retrieve queue")
 	}
 
+	pending, err := rps.All()
+	if err != nil {
+		hlog.FromRequest(r).Err(err).Ctx(r.Context()).Msg("failed to retrieve request pool")
+	}
+
 	return &QueueInput{
-		Input: middleware.InputFromRequest(r),
-		Queue: queue,
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Queue:          queue,
+		Pending:        pending,
 	}, nil
 }
 
 func (s *State) getQueue(w http.ResponseWriter, r *http.Request) error {
-	input, err := NewQueueInput(s.Queue, r)
+	input, err := NewQueueInput(s.Queue, s.Storage.RequestPool(r.Context()), r)
 	if err != nil {
 		return err
 	}
@@ -46,3 +63,51 @@ func (s *State) GetQueue(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// PostQueueVote adds a vote to a pending request
+func (s *State) PostQueueVote(w http.ResponseWriter, r *http.Request) {
+	input, err := s.postQueueVote(r)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+
+	err = s.Templates.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err)
+		return
+	}
+}
+
+func (s *State) postQueueVote(r *http.Request) (*QueueInput, error) {
+	const op errors.Op = "website/public.postQueueVote"
+	ctx := r.Context()
+
+	tid, err := radio.ParseTrackID(r.FormValue("trackid"))
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, err)
+	}
+
+	// votes use the same identifier as requests do
+	voteErr := s.Storage.RequestPool(ctx).Vote(tid, r.RemoteAddr)
+	if voteErr != nil && !errors.Is(errors.Duplicate, voteErr) && !errors.Is(errors.PendingRequestUnknown, voteErr) {
+		return nil, errors.E(op, voteErr)
+	}
+
+	input, err := NewQueueInput(s.Queue, s.Storage.RequestPool(ctx), r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	switch {
+	case voteErr == nil:
+		input.Message = "Thank you for voting"
+	case errors.Is(errors.Duplicate, voteErr):
+		input.Message = "You already voted for this request"
+		input.IsError = true
+	default:
+		input.Message = "That request is no longer pending"
+		input.IsError = true
+	}
+	return input, nil
+}
@@ -0,0 +1,87 @@
+package public
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostQueueVote(t *testing.T) {
+	cases := []struct {
+		name    string
+		voteErr error
+		isError bool
+	}{
+		{"success", nil, false},
+		{"duplicate", errors.E(errors.Duplicate), true},
+		{"unknown", errors.E(errors.PendingRequestUnknown), true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rps := &mocks.RequestPoolStorageMock{
+				VoteFunc: func(id radio.TrackID, identifier string) error {
+					assert.Equal(t, radio.TrackID(50), id)
+					assert.Equal(t, "192.0.2.1:1234", identifier)
+					return c.voteErr
+				},
+				AllFunc: func() ([]radio.PendingRequest, error) {
+					return []radio.PendingRequest{{Votes: 2}}, nil
+				},
+			}
+			state := &State{
+				Storage: &mocks.StorageServiceMock{
+					RequestPoolFunc: func(contextMoqParam context.Context) radio.RequestPoolStorage {
+						return rps
+					},
+				},
+				Queue: &mocks.QueueServiceMock{
+					EntriesFunc: func(contextMoqParam context.Context) (radio.Queue, error) {
+						return nil, nil
+					},
+				},
+			}
+
+			form := url.Values{"trackid": {"50"}}
+			req := httptest.NewRequest(http.MethodPost, "/queue/vote", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			input, err := state.postQueueVote(req)
+			require.NoError(t, err)
+			assert.Equal(t, c.isError, input.IsError)
+			assert.NotEmpty(t, input.Message)
+			assert.Len(t, input.Pending, 1)
+		})
+	}
+
+	t.Run("storage error", func(t *testing.T) {
+		rps := &mocks.RequestPoolStorageMock{
+			VoteFunc: func(id radio.TrackID, identifier string) error {
+				return errors.E(errors.InternalServer)
+			},
+		}
+		state := &State{
+			Storage: &mocks.StorageServiceMock{
+				RequestPoolFunc: func(contextMoqParam context.Context) radio.RequestPoolStorage {
+					return rps
+				},
+			},
+		}
+
+		form := url.Values{"trackid": {"50"}}
+		req := httptest.NewRequest(http.MethodPost, "/queue/vote", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		_, err := state.postQueueVote(req)
+		require.Error(t, err)
+	})
+}
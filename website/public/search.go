@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"html/template"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
@@ -13,7 +15,13 @@ import (
 	"github.com/gorilla/csrf"
 )
 
-const searchPageSize = 20
+const (
+	searchPageSize = 20
+	// searchFacetSize is the amount of tags and artists shown to browse by
+	searchFacetSize = 15
+	// searchDateLayout is the layout of the last played filter inputs
+	searchDateLayout = "2006-01-02"
+)
 
 type SearchInput struct {
 	middleware.Input
@@ -41,10 +49,16 @@ func NewSearchInput(s radio.SearchService, rs radio.RequestStorage, r *http.Requ
 }
 
 type SearchSharedInput struct {
-	CSRFTokenInput  template.HTML
-	Query           string
-	Songs           []radio.Song
-	TotalHits       int
+	CSRFTokenInput template.HTML
+	Query          string
+	Filter         radio.SearchFilter
+	Songs          []radio.Song
+	TotalHits      int
+	Facets         radio.SearchFacets
+	// Suggestions are corrected queries if the query had no results
+	Suggestions []string
+	// Fuzzy is true if Songs are fuzzy matches
+	Fuzzy           bool
 	CanRequest      bool
 	RequestCooldown time.Duration
 	Page            *shared.Pagination
@@ -64,19 +78,33 @@ func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *htt
 		return nil, errors.E(op, err)
 	}
 
+	filter, err := searchFilterFromRequest(r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	var songs []radio.Song
 	var totalHits int
+	var facets radio.SearchFacets
+	var suggestions []string
+	var fuzzy bool
 	query := r.FormValue("q")
-	if len(query) > 0 {
+	if len(query) > 0 || !filter.IsZero() {
 		searchResult, err := s.Search(ctx, query, radio.SearchOptions{
 			Limit:  searchPageSize,
 			Offset: offset,
+			Filter: filter,
+			Facets: searchFacetSize,
+			Fuzzy:  true,
 		})
 		if err != nil && !errors.Is(errors.SearchNoResults, err) {
 			return nil, errors.E(op, err)
 		}
 		songs = searchResult.Songs
 		totalHits = searchResult.TotalHits
+		facets = searchResult.Facets
+		suggestions = searchResult.Suggestions
+		fuzzy = searchResult.Fuzzy
 	}
 
 	// RemoteAddr on the request should've already been scrubbed by some middleware to not
@@ -97,8 +125,12 @@ func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *htt
 	return &SearchSharedInput{
 		CSRFTokenInput:  csrf.TemplateField(r),
 		Query:           query,
+		Filter:          filter,
 		Songs:           songs,
 		TotalHits:       totalHits,
+		Facets:          facets,
+		Suggestions:     suggestions,
+		Fuzzy:           fuzzy,
 		CanRequest:      ok,
 		RequestCooldown: cd,
 		Page: shared.NewPagination(
@@ -108,6 +140,57 @@ func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *htt
 	}, nil
 }
 
+// searchFilterFromRequest parses the search filter form fields, lengths are
+// in minutes and last played dates are in searchDateLayout
+func searchFilterFromRequest(r *http.Request) (radio.SearchFilter, error) {
+	const op errors.Op = "website/public.searchFilterFromRequest"
+
+	filter := radio.SearchFilter{
+		Requestable: r.FormValue("requestable") != "",
+		Tag:         strings.TrimSpace(r.FormValue("tag")),
+		Album:       strings.TrimSpace(r.FormValue("album")),
+		Acceptor:    strings.TrimSpace(r.FormValue("acceptor")),
+	}
+
+	minutes := func(field string) (time.Duration, error) {
+		raw := r.FormValue(field)
+		if raw == "" {
+			return 0, nil
+		}
+		m, err := strconv.ParseUint(raw, 10, 16)
+		if err != nil {
+			return 0, errors.E(op, err, errors.InvalidForm, errors.Info(field))
+		}
+		return time.Duration(m) * time.Minute, nil
+	}
+	date := func(field string) (time.Time, error) {
+		raw := r.FormValue(field)
+		if raw == "" {
+			return time.Time{}, nil
+		}
+		t, err := time.Parse(searchDateLayout, raw)
+		if err != nil {
+			return time.Time{}, errors.E(op, err, errors.InvalidForm, errors.Info(field))
+		}
+		return t, nil
+	}
+
+	var err error
+	if filter.MinLength, err = minutes("min_length"); err != nil {
+		return filter, err
+	}
+	if filter.MaxLength, err = minutes("max_length"); err != nil {
+		return filter, err
+	}
+	if filter.LastPlayedBefore, err = date("lp_before"); err != nil {
+		return filter, err
+	}
+	if filter.LastPlayedAfter, err = date("lp_after"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
 func (s *State) GetSearch(w http.ResponseWriter, r *http.Request) {
 	input, err := NewSearchInput(
 		s.Search,
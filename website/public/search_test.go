@@ -119,3 +119,42 @@ func TestCSRFLegacyFix(t *testing.T) {
 		require.Equal(t, http.StatusOK, resp.StatusCode)
 	}
 }
+
+func TestNewSearchSharedInputFilter(t *testing.T) {
+	var got radio.SearchOptions
+	ss := &mocks.SearchServiceMock{
+		SearchFunc: func(ctx context.Context, query string, opt radio.SearchOptions) (radio.SearchResult, error) {
+			got = opt
+			return radio.SearchResult{
+				Facets: radio.SearchFacets{
+					Tags: []radio.SearchFacetTerm{{Term: "anime", Count: 5}},
+				},
+			}, nil
+		},
+	}
+	rs := &mocks.RequestStorageMock{
+		LastRequestFunc: func(identifier string) (time.Time, error) {
+			return time.Time{}, nil
+		},
+	}
+
+	// no query, but a filter should still search
+	r := httptest.NewRequest(http.MethodGet, "/search?requestable=on&tag=anime&min_length=2&lp_before=2024-01-02", nil)
+
+	input, err := NewSearchSharedInput(ss, rs, r, time.Hour, searchPageSize)
+	require.NoError(t, err)
+	require.Len(t, ss.SearchCalls(), 1)
+
+	assert.True(t, got.Filter.Requestable)
+	assert.Equal(t, "anime", got.Filter.Tag)
+	assert.Equal(t, time.Minute*2, got.Filter.MinLength)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), got.Filter.LastPlayedBefore)
+	assert.Equal(t, searchFacetSize, got.Facets)
+	assert.True(t, got.Fuzzy)
+	assert.Equal(t, got.Filter, input.Filter)
+	assert.Len(t, input.Facets.Tags, 1)
+
+	r = httptest.NewRequest(http.MethodGet, "/search?q=test&min_length=long", nil)
+	_, err = NewSearchSharedInput(ss, rs, r, time.Hour, searchPageSize)
+	require.Error(t, err)
+}
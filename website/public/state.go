@@ -12,8 +12,10 @@ import (
 	"github.com/R-a-dio/valkyrie/website/shared"
 	"github.com/R-a-dio/valkyrie/website/shared/navbar"
 
+	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/httprate"
 )
 
 func NewState(
@@ -23,7 +25,9 @@ func NewState(
 	newsCache *shared.NewsCache,
 	exec templates.Executor,
 	storage radio.StorageService,
-	search radio.SearchService) State {
+	search radio.SearchService,
+	sessions *scs.SessionManager,
+	checker *SubmissionChecker) State {
 
 	return State{
 		Config:    NewConfig(cfg),
@@ -34,6 +38,8 @@ func NewState(
 		Queue:     cfg.Queue,
 		Storage:   storage,
 		Search:    search,
+		Sessions:  sessions,
+		Checker:   checker,
 	}
 }
 
@@ -47,6 +53,8 @@ type State struct {
 	Queue     radio.QueueService
 	Storage   radio.StorageService
 	Search    radio.SearchService
+	Sessions  *scs.SessionManager
+	Checker   *SubmissionChecker
 }
 
 var NavBar = navbar.New(`hx-boost="true" hx-push-url="true" hx-target="#content"`,
@@ -61,6 +69,7 @@ var NavBar = navbar.New(`hx-boost="true" hx-push-url="true" hx-target="#content"
 	navbar.NewItem("Favorites", navbar.Attrs("href", "/faves")),
 	navbar.NewItem("Staff", navbar.Attrs("href", "/staff")),
 	navbar.NewItem("Submit", navbar.Attrs("href", "/submit")),
+	navbar.NewItem("Account", navbar.Attrs("href", "/account")),
 )
 
 func Route(ctx context.Context, s State) func(chi.Router) {
@@ -74,6 +83,7 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 		r.Post("/news/{NewsID:[0-9]+}", s.PostNewsEntry)
 		r.Get("/schedule", s.GetSchedule)
 		r.Get("/queue", s.GetQueue)
+		r.With(httprate.LimitByIP(30, time.Minute)).Post("/queue/vote", s.PostQueueVote)
 		r.Get("/last-played", s.GetLastPlayed)
 		r.Get("/search", s.GetSearch)
 		r.Get("/submit", s.GetSubmit)
@@ -82,6 +92,12 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 		r.Get("/faves", s.GetFaves)
 		r.Get("/faves/{Nick}", s.GetFavesOld)
 		r.Post("/faves", s.PostFaves)
+		r.Get("/account", s.GetAccount)
+		r.With(httprate.LimitByIP(5, time.Minute)).Post("/account/login", s.PostAccountLogin)
+		r.With(httprate.LimitByIP(3, time.Hour)).Post("/account/register", s.PostAccountRegister)
+		r.Post("/account/logout", s.PostAccountLogout)
+		r.Post("/account/claim", s.PostAccountClaim)
+		r.Get("/u/{Username}", s.GetProfile)
 		r.Get("/irc", s.GetChat)
 		r.Get("/help", s.GetHelp)
 		r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
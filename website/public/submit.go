@@ -265,6 +265,9 @@ func (s *State) postSubmit(r *http.Request) (SubmissionForm, error) {
 		return *form, errors.E(op, err, errors.InternalServer)
 	}
 
+	// the duplicate and quality checks run in the background
+	s.Checker.Notify()
+
 	// clear the tmpFilename so that it doesn't get deleted after we return
 	tmpFilename = ""
 	return *form, nil
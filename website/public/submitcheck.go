@@ -0,0 +1,136 @@
+package public
+
+import (
+	"context"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/rs/zerolog"
+)
+
+// fingerprintLengthMargin is how much the length of a submission and an existing
+// track can differ before we stop considering them as possible duplicates
+const fingerprintLengthMargin = time.Second * 5
+
+// submissionCheckInterval is how often the checker looks for unchecked
+// submissions if it isn't notified of any
+const submissionCheckInterval = time.Minute * 10
+
+// SubmissionChecker runs the duplicate and quality checks of submissions in the
+// background, these decode the whole file and take too long to do while the
+// uploader is waiting
+type SubmissionChecker struct {
+	storage radio.StorageService
+	notify  chan struct{}
+}
+
+func NewSubmissionChecker(storage radio.StorageService) *SubmissionChecker {
+	return &SubmissionChecker{
+		storage: storage,
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// Notify tells the checker there is a new submission to check
+func (sc *SubmissionChecker) Notify() {
+	if sc == nil {
+		return
+	}
+	select {
+	case sc.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run checks all unchecked submissions whenever Notify is called, it also
+// checks periodically to pick up anything left over by a previous process.
+// Run returns when ctx is canceled
+func (sc *SubmissionChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(submissionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		sc.checkAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-sc.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAll checks all submissions that haven't been checked yet
+func (sc *SubmissionChecker) checkAll(ctx context.Context) {
+	songs, err := sc.storage.Submissions(ctx).UncheckedSubmissions()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to retrieve unchecked submissions")
+		return
+	}
+
+	for _, song := range songs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		sc.markDuplicate(ctx, &song)
+		sc.analyze(ctx, &song)
+
+		// the song is marked as checked even if the checks failed, they are
+		// only there to help the reviewer and a broken file would otherwise
+		// be retried forever
+		now := time.Now()
+		song.CheckedAt = &now
+		err = sc.storage.Submissions(ctx).UpdateSubmissionCheck(song)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).
+				Uint64("submission_id", uint64(song.ID)).
+				Msg("failed to store submission check")
+		}
+	}
+}
+
+// markDuplicate fingerprints the submitted song and compares it against the existing
+// tracks of similar length, the song is marked as a duplicate if any of them match.
+// Errors are only logged since this shouldn't stop a submission from happening
+func (sc *SubmissionChecker) markDuplicate(ctx context.Context, song *radio.PendingSong) {
+	fp, err := audio.Fingerprint(ctx, song.FilePath)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to fingerprint submitted file")
+		return
+	}
+
+	candidates, err := sc.storage.Fingerprint(ctx).Candidates(song.Length, fingerprintLengthMargin)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to retrieve fingerprint candidates")
+		return
+	}
+
+	match, similarity, ok := audio.FingerprintMatch(fp, candidates)
+	if !ok {
+		return
+	}
+
+	zerolog.Ctx(ctx).Info().Ctx(ctx).
+		Uint64("submission_id", uint64(song.ID)).
+		Uint64("track_id", uint64(match.TrackID)).
+		Float64("similarity", similarity).
+		Msg("submission is a likely duplicate")
+	song.Duplicate = true
+	song.DuplicateID = &match.TrackID
+}
+
+// analyze runs a quality analysis on the submitted song and attaches the
+// results to it. Errors are only logged since this shouldn't stop a submission
+func (sc *SubmissionChecker) analyze(ctx context.Context, song *radio.PendingSong) {
+	analysis, err := audio.Analyze(ctx, song.FilePath)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Ctx(ctx).Err(err).Msg("failed to analyze submitted file")
+		return
+	}
+
+	analysis.Warnings = audio.AnalysisWarnings(song.Format, song.Bitrate, *analysis)
+	song.Analysis = analysis
+}
@@ -0,0 +1,57 @@
+package public
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmissionCheckerCheckAll(t *testing.T) {
+	ctx := context.Background()
+
+	ss := &mocks.SubmissionStorageMock{
+		UncheckedSubmissionsFunc: func() ([]radio.PendingSong, error) {
+			return []radio.PendingSong{{
+				ID:       5,
+				FilePath: filepath.Join(t.TempDir(), "missing.flac"),
+			}}, nil
+		},
+		UpdateSubmissionCheckFunc: func(pendingSong radio.PendingSong) error {
+			return nil
+		},
+	}
+	storage := &mocks.StorageServiceMock{
+		SubmissionsFunc: func(contextMoqParam context.Context) radio.SubmissionStorage {
+			return ss
+		},
+	}
+
+	sc := NewSubmissionChecker(storage)
+	sc.checkAll(ctx)
+
+	// the file doesn't exist so the checks fail, the submission should still
+	// be marked as checked so that it isn't retried forever
+	calls := ss.UpdateSubmissionCheckCalls()
+	require.Len(t, calls, 1)
+	song := calls[0].PendingSong
+	assert.Equal(t, radio.SubmissionID(5), song.ID)
+	assert.NotNil(t, song.CheckedAt)
+	assert.False(t, song.Duplicate)
+	assert.Nil(t, song.Analysis)
+}
+
+func TestSubmissionCheckerNotify(t *testing.T) {
+	sc := NewSubmissionChecker(nil)
+	// notifying multiple times shouldn't block
+	sc.Notify()
+	sc.Notify()
+
+	// and a nil checker should be safe to use
+	var nilChecker *SubmissionChecker
+	nilChecker.Notify()
+}